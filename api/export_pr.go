@@ -15,6 +15,8 @@ func (issue *Issue) ExportData(fields []string) map[string]interface{} {
 			data[f] = issue.Comments.Nodes
 		case "assignees":
 			data[f] = issue.Assignees.Nodes
+		case "participants":
+			data[f] = issue.Participants.Nodes
 		case "labels":
 			data[f] = issue.Labels.Nodes
 		case "projectCards":
@@ -101,6 +103,8 @@ func (pr *PullRequest) ExportData(fields []string) map[string]interface{} {
 			data[f] = pr.Comments.Nodes
 		case "assignees":
 			data[f] = pr.Assignees.Nodes
+		case "participants":
+			data[f] = pr.Participants.Nodes
 		case "labels":
 			data[f] = pr.Labels.Nodes
 		case "projectCards":
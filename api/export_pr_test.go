@@ -49,6 +49,29 @@ func TestIssue_ExportData(t *testing.T) {
 				}
 			`),
 		},
+		{
+			name:   "participants and subscribers",
+			fields: []string{"participants", "subscribers"},
+			inputJSON: heredoc.Doc(`
+				{ "participants": { "nodes": [
+					{ "id": "123", "login": "monalisa", "name": "Mona Lisa" }
+				], "totalCount": 1 }, "subscribers": { "totalCount": 5 } }
+			`),
+			outputJSON: heredoc.Doc(`
+				{
+					"participants": [
+						{
+							"id": "123",
+							"login": "monalisa",
+							"name": "Mona Lisa"
+						}
+					],
+					"subscribers": {
+						"totalCount": 5
+					}
+				}
+			`),
+		},
 		{
 			name:   "project cards",
 			fields: []string{"projectCards"},
@@ -26,6 +26,12 @@ func (repo *Repository) ExportData(fields []string) map[string]interface{} {
 			data[f] = repo.Milestones.Nodes
 		case "projects":
 			data[f] = repo.Projects.Nodes
+		case "defaultBranchProtection":
+			data[f] = map[string]interface{}{
+				"name":                 repo.DefaultBranchProtection.Name,
+				"branchProtectionRule": repo.DefaultBranchProtection.BranchProtectionRule,
+				"rules":                repo.DefaultBranchProtection.Rules.Nodes,
+			}
 		case "repositoryTopics":
 			var topics []RepositoryTopic
 			for _, n := range repo.RepositoryTopics.Nodes {
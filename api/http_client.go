@@ -4,10 +4,9 @@ import (
 	"fmt"
 	"io"
 	"net/http"
-	"strings"
 	"time"
 
-	"github.com/cli/cli/v2/utils"
+	"github.com/cli/cli/v2/internal/debuglog"
 	ghAPI "github.com/cli/go-gh/v2/pkg/api"
 	ghauth "github.com/cli/go-gh/v2/pkg/auth"
 )
@@ -35,14 +34,17 @@ func NewHTTPClient(opts HTTPClientOptions) (*http.Client, error) {
 		LogIgnoreEnv: true,
 	}
 
-	debugEnabled, debugValue := utils.IsDebugEnabled()
-	if strings.Contains(debugValue, "api") {
+	debugSpec := debuglog.FromEnv()
+	if debugSpec.Enabled(debuglog.ScopeAPI) {
 		opts.LogVerboseHTTP = true
 	}
 
-	if opts.LogVerboseHTTP || debugEnabled {
+	if opts.LogVerboseHTTP || debugSpec.Any() {
 		clientOpts.Log = opts.Log
-		clientOpts.LogColorize = opts.LogColorize
+		if opts.Log != nil {
+			clientOpts.Log = debuglog.Output(opts.Log)
+		}
+		clientOpts.LogColorize = opts.LogColorize && !debuglog.LogsToFile()
 		clientOpts.LogVerboseHTTP = opts.LogVerboseHTTP
 	}
 
@@ -107,7 +107,7 @@ func TestNewHTTPClient(t *testing.T) {
 				> GET / HTTP/1.1
 				> Host: github.com
 				> Accept: application/vnd.github.merge-info-preview+json, application/vnd.github.nebula-preview
-				> Authorization: token ████████████████████
+				> Authorization: token [REDACTED]
 				> Content-Type: application/json; charset=utf-8
 				> Time-Zone: <timezone>
 				> User-Agent: GitHub CLI v1.2.3
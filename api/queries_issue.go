@@ -43,6 +43,8 @@ type Issue struct {
 	ProjectItems     ProjectItems
 	Milestone        *Milestone
 	ReactionGroups   ReactionGroups
+	Participants     Participants
+	Subscribers      Subscribers
 	IsPinned         bool
 }
 
@@ -69,6 +71,15 @@ func (a Assignees) Logins() []string {
 	return logins
 }
 
+type Participants struct {
+	Nodes      []GitHubUser
+	TotalCount int
+}
+
+type Subscribers struct {
+	TotalCount int `json:"totalCount"`
+}
+
 type Labels struct {
 	Nodes      []IssueLabel
 	TotalCount int
@@ -90,6 +90,8 @@ type PullRequest struct {
 	Milestone      *Milestone
 	Comments       Comments
 	ReactionGroups ReactionGroups
+	Participants   Participants
+	Subscribers    Subscribers
 	Reviews        PullRequestReviews
 	LatestReviews  PullRequestReviews
 	ReviewRequests ReviewRequests
@@ -219,8 +221,9 @@ type CheckSuite struct {
 }
 
 type WorkflowRun struct {
-	Event    string   `json:"event"`
-	Workflow Workflow `json:"workflow"`
+	Event      string   `json:"event"`
+	DatabaseID int64    `json:"databaseId"`
+	Workflow   Workflow `json:"workflow"`
 }
 
 type Workflow struct {
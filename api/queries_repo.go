@@ -72,6 +72,7 @@ type Repository struct {
 	CodeOfConduct                 *CodeOfConduct
 	ContactLinks                  []ContactLink
 	DefaultBranchRef              BranchRef
+	DefaultBranchProtection       BranchProtection
 	DeleteBranchOnMerge           bool
 	DiskUsage                     int
 	FundingLinks                  []FundingLink
@@ -150,6 +151,42 @@ type BranchRef struct {
 	Name string `json:"name"`
 }
 
+// BranchProtection summarizes the effective protections on a branch: the
+// classic branch protection rule, if any, plus the rules from any applicable
+// rulesets.
+type BranchProtection struct {
+	Name                 string                `json:"name"`
+	BranchProtectionRule *BranchProtectionRule `json:"branchProtectionRule"`
+	Rules                struct {
+		Nodes []RepositoryRule `json:"nodes"`
+	} `json:"rules"`
+}
+
+type BranchProtectionRule struct {
+	RequiresApprovingReviews     bool     `json:"requiresApprovingReviews"`
+	RequiredApprovingReviewCount int      `json:"requiredApprovingReviewCount"`
+	RequiresCodeOwnerReviews     bool     `json:"requiresCodeOwnerReviews"`
+	RequiresStatusChecks         bool     `json:"requiresStatusChecks"`
+	RequiresStrictStatusChecks   bool     `json:"requiresStrictStatusChecks"`
+	RequiredStatusCheckContexts  []string `json:"requiredStatusCheckContexts"`
+	RequiresCommitSignatures     bool     `json:"requiresCommitSignatures"`
+	RequiresLinearHistory        bool     `json:"requiresLinearHistory"`
+	IsAdminEnforced              bool     `json:"isAdminEnforced"`
+	AllowsForcePushes            bool     `json:"allowsForcePushes"`
+	AllowsDeletions              bool     `json:"allowsDeletions"`
+}
+
+type RepositoryRule struct {
+	Type    string             `json:"type"`
+	Ruleset *RepositoryRuleset `json:"ruleset"`
+}
+
+type RepositoryRuleset struct {
+	Name        string `json:"name"`
+	Enforcement string `json:"enforcement"`
+	Target      string `json:"target"`
+}
+
 type CodeOfConduct struct {
 	Key  string `json:"key"`
 	Name string `json:"name"`
@@ -189,7 +189,7 @@ func StatusCheckRollupGraphQLWithoutCountByState(after string) string {
 							},
 							...on CheckRun {
 								name,
-								checkSuite{workflowRun{workflow{name}}},
+								checkSuite{workflowRun{databaseId,workflow{name}}},
 								status,
 								conclusion,
 								startedAt,
@@ -232,7 +232,7 @@ func RequiredStatusCheckRollupGraphQL(prID, after string, includeEvent bool) str
 							},
 							...on CheckRun {
 								name,
-								checkSuite{workflowRun{%[3]sworkflow{name}}},
+								checkSuite{workflowRun{%[3]sdatabaseId,workflow{name}}},
 								status,
 								conclusion,
 								startedAt,
@@ -261,10 +261,12 @@ var sharedIssuePRFields = []string{
 	"labels",
 	"milestone",
 	"number",
+	"participants",
 	"projectCards",
 	"projectItems",
 	"reactionGroups",
 	"state",
+	"subscribers",
 	"title",
 	"updatedAt",
 	"url",
@@ -326,6 +328,10 @@ func IssueGraphQL(fields []string) string {
 			q = append(q, `headRepository{id,name}`)
 		case "assignees":
 			q = append(q, `assignees(first:100){nodes{id,login,name},totalCount}`)
+		case "participants":
+			q = append(q, `participants(first:100){nodes{id,login,name},totalCount}`)
+		case "subscribers":
+			q = append(q, `subscribers{totalCount}`)
 		case "labels":
 			q = append(q, `labels(first:100){nodes{id,name,description,color},totalCount}`)
 		case "projectCards":
@@ -422,6 +428,7 @@ var RepositoryFields = []string{
 	"codeOfConduct",
 	"contactLinks",
 	"defaultBranchRef",
+	"defaultBranchProtection",
 	"deleteBranchOnMerge",
 	"diskUsage",
 	"fundingLinks",
@@ -511,6 +518,8 @@ func RepositoryGraphQL(fields []string) string {
 			q = append(q, "pullRequests(states:OPEN){totalCount}")
 		case "defaultBranchRef":
 			q = append(q, "defaultBranchRef{name}")
+		case "defaultBranchProtection": // pseudo-field
+			q = append(q, "defaultBranchProtection:defaultBranchRef{name,branchProtectionRule{requiresApprovingReviews,requiredApprovingReviewCount,requiresCodeOwnerReviews,requiresStatusChecks,requiresStrictStatusChecks,requiredStatusCheckContexts,requiresCommitSignatures,requiresLinearHistory,isAdminEnforced,allowsForcePushes,allowsDeletions},rules(first:100){nodes{type,ruleset{name,enforcement,target}}}}")
 		default:
 			q = append(q, field)
 		}
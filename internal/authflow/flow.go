@@ -2,19 +2,22 @@ package authflow
 
 import (
 	"bufio"
+	"context"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/cli/cli/v2/api"
 	"github.com/cli/cli/v2/internal/browser"
+	"github.com/cli/cli/v2/internal/debuglog"
 	"github.com/cli/cli/v2/internal/ghinstance"
 	"github.com/cli/cli/v2/pkg/iostreams"
-	"github.com/cli/cli/v2/utils"
 	"github.com/cli/oauth"
+	"github.com/cli/oauth/device"
 	"github.com/henvic/httpretty"
 
 	ghauth "github.com/cli/go-gh/v2/pkg/auth"
@@ -33,12 +36,7 @@ func AuthFlow(oauthHost string, IO *iostreams.IOStreams, notice string, addition
 	w := IO.ErrOut
 	cs := IO.ColorScheme()
 
-	httpClient := &http.Client{}
-	debugEnabled, debugValue := utils.IsDebugEnabled()
-	if debugEnabled {
-		logTraffic := strings.Contains(debugValue, "api")
-		httpClient.Transport = verboseLog(IO.ErrOut, logTraffic, IO.ColorEnabled())(httpClient.Transport)
-	}
+	httpClient := oauthHTTPClient(IO)
 
 	minimumScopes := []string{"repo", "read:org", "gist"}
 	scopes := append(minimumScopes, additionalScopes...)
@@ -105,6 +103,66 @@ func AuthFlow(oauthHost string, IO *iostreams.IOStreams, notice string, addition
 	return token.Token, userLogin, nil
 }
 
+// DeviceAuthFlow is an OAuth device flow with no browser involvement: the
+// one-time code and verification URL are printed, and the flow then polls
+// until the user authorizes the device from another machine. A timeout of 0
+// polls until the device code expires.
+func DeviceAuthFlow(oauthHost string, IO *iostreams.IOStreams, additionalScopes []string, timeout time.Duration) (string, string, error) {
+	w := IO.ErrOut
+	cs := IO.ColorScheme()
+
+	httpClient := oauthHTTPClient(IO)
+
+	minimumScopes := []string{"repo", "read:org", "gist"}
+	scopes := append(minimumScopes, additionalScopes...)
+
+	host, err := oauth.NewGitHubHost(ghinstance.HostPrefix(oauthHost))
+	if err != nil {
+		return "", "", err
+	}
+
+	code, err := device.RequestCode(httpClient, host.DeviceCodeURL, oauthClientID, scopes)
+	if err != nil {
+		return "", "", err
+	}
+
+	fmt.Fprintf(w, "%s First copy your one-time code: %s\n", cs.Yellow("!"), cs.Bold(code.UserCode))
+	fmt.Fprintf(w, "%s to continue on another machine: %s\n", cs.Bold("Open this URL"), code.VerificationURI)
+
+	ctx := context.Background()
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	token, err := device.Wait(ctx, httpClient, host.TokenURL, device.WaitOptions{
+		ClientID:   oauthClientID,
+		DeviceCode: code,
+	})
+	if err != nil {
+		return "", "", err
+	}
+
+	userLogin, err := getViewer(oauthHost, token.Token, IO.ErrOut)
+	if err != nil {
+		return "", "", err
+	}
+
+	return token.Token, userLogin, nil
+}
+
+func oauthHTTPClient(IO *iostreams.IOStreams) *http.Client {
+	httpClient := &http.Client{}
+	debugSpec := debuglog.FromEnv()
+	if debugSpec.Enabled(debuglog.ScopeOAuth) || debugSpec.Enabled(debuglog.ScopeAPI) {
+		logTraffic := debugSpec.Enabled(debuglog.ScopeAPI) || debugSpec.Level(debuglog.ScopeOAuth) >= debuglog.LevelTrace
+		colorize := IO.ColorEnabled() && !debuglog.LogsToFile()
+		httpClient.Transport = verboseLog(debuglog.Output(IO.ErrOut), logTraffic, colorize)(httpClient.Transport)
+	}
+	return httpClient
+}
+
 func getCallbackURI(oauthHost string) string {
 	callbackURI := "http://127.0.0.1/callback"
 	if ghauth.IsEnterprise(oauthHost) {
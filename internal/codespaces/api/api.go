@@ -294,6 +294,7 @@ var ViewCodespaceFields = []string{
 	"createdAt",
 	"lastUsedAt",
 	"idleTimeoutMinutes",
+	"retentionPeriod",
 	"retentionPeriodDays",
 	"retentionExpiresAt",
 	"recentFolders",
@@ -315,6 +316,8 @@ func (c *Codespace) ExportData(fields []string) map[string]interface{} {
 			data[f] = c.Machine.Name
 		case "machineDisplayName":
 			data[f] = c.Machine.DisplayName
+		case "retentionPeriod":
+			data[f] = (time.Duration(c.RetentionPeriodMinutes) * time.Minute).String()
 		case "retentionPeriodDays":
 			data[f] = c.RetentionPeriodMinutes / 1440
 		case "gitStatus":
@@ -593,10 +596,262 @@ func (a *API) StopCodespace(ctx context.Context, codespaceName string, orgName s
 	return nil
 }
 
+// UserSecret is a user-level Codespaces secret.
+type UserSecret struct {
+	Name                    string `json:"name"`
+	CreatedAt               string `json:"created_at"`
+	UpdatedAt               string `json:"updated_at"`
+	Visibility              string `json:"visibility"`
+	SelectedRepositoriesURL string `json:"selected_repositories_url"`
+}
+
+// UserSecretFields is the list of exportable fields for a user secret when
+// using the `gh cs secret list` command.
+var UserSecretFields = []string{
+	"name",
+	"visibility",
+	"createdAt",
+	"updatedAt",
+}
+
+func (s *UserSecret) ExportData(fields []string) map[string]interface{} {
+	v := reflect.ValueOf(s).Elem()
+	data := map[string]interface{}{}
+	for _, f := range fields {
+		sf := v.FieldByNameFunc(func(s string) bool {
+			return strings.EqualFold(f, s)
+		})
+		data[f] = sf.Interface()
+	}
+	return data
+}
+
+// ListUserSecrets returns the user-level Codespaces secrets of the
+// authenticated user.
+func (a *API) ListUserSecrets(ctx context.Context) ([]*UserSecret, error) {
+	req, err := http.NewRequest(http.MethodGet, a.githubAPI+"/user/codespaces/secrets?per_page=100", nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	a.setHeaders(req)
+	resp, err := a.do(ctx, req, "/user/codespaces/secrets")
+	if err != nil {
+		return nil, fmt.Errorf("error making request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, api.HandleHTTPError(resp)
+	}
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading response body: %w", err)
+	}
+
+	var response struct {
+		Secrets []*UserSecret `json:"secrets"`
+	}
+	if err := json.Unmarshal(b, &response); err != nil {
+		return nil, fmt.Errorf("error unmarshalling response: %w", err)
+	}
+
+	return response.Secrets, nil
+}
+
+// UserSecretPublicKey is the public key used to seal user secret values
+// before uploading them.
+type UserSecretPublicKey struct {
+	KeyID string `json:"key_id"`
+	Key   string `json:"key"`
+}
+
+// GetUserSecretPublicKey returns the public key for sealing user-level
+// Codespaces secret values.
+func (a *API) GetUserSecretPublicKey(ctx context.Context) (*UserSecretPublicKey, error) {
+	req, err := http.NewRequest(http.MethodGet, a.githubAPI+"/user/codespaces/secrets/public-key", nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	a.setHeaders(req)
+	resp, err := a.do(ctx, req, "/user/codespaces/secrets/public-key")
+	if err != nil {
+		return nil, fmt.Errorf("error making request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, api.HandleHTTPError(resp)
+	}
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading response body: %w", err)
+	}
+
+	var response UserSecretPublicKey
+	if err := json.Unmarshal(b, &response); err != nil {
+		return nil, fmt.Errorf("error unmarshalling response: %w", err)
+	}
+
+	return &response, nil
+}
+
+// CreateUserSecretParams are the parameters for creating or updating a
+// user-level Codespaces secret.
+type CreateUserSecretParams struct {
+	EncryptedValue        string `json:"encrypted_value"`
+	KeyID                 string `json:"key_id"`
+	SelectedRepositoryIDs []int  `json:"selected_repository_ids,omitempty"`
+}
+
+// CreateUserSecret creates or updates a user-level Codespaces secret with an
+// already-encrypted value.
+func (a *API) CreateUserSecret(ctx context.Context, name string, params CreateUserSecretParams) error {
+	requestBody, err := json.Marshal(params)
+	if err != nil {
+		return fmt.Errorf("error marshaling request: %w", err)
+	}
+
+	req, err := http.NewRequest(http.MethodPut, a.githubAPI+"/user/codespaces/secrets/"+name, bytes.NewBuffer(requestBody))
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+
+	a.setHeaders(req)
+	resp, err := a.do(ctx, req, "/user/codespaces/secrets/*")
+	if err != nil {
+		return fmt.Errorf("error making request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusNoContent {
+		return api.HandleHTTPError(resp)
+	}
+
+	return nil
+}
+
+// DeleteUserSecret removes a user-level Codespaces secret.
+func (a *API) DeleteUserSecret(ctx context.Context, name string) error {
+	req, err := http.NewRequest(http.MethodDelete, a.githubAPI+"/user/codespaces/secrets/"+name, nil)
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+
+	a.setHeaders(req)
+	resp, err := a.do(ctx, req, "/user/codespaces/secrets/*")
+	if err != nil {
+		return fmt.Errorf("error making request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent {
+		return api.HandleHTTPError(resp)
+	}
+
+	return nil
+}
+
+// CodespacesUsageItem is a single metered line of codespaces billing usage,
+// as reported by the billing usage endpoints.
+type CodespacesUsageItem struct {
+	Date           string  `json:"date"`
+	SKU            string  `json:"sku"`
+	Quantity       float64 `json:"quantity"`
+	UnitType       string  `json:"unitType"`
+	PricePerUnit   float64 `json:"pricePerUnit"`
+	GrossAmount    float64 `json:"grossAmount"`
+	NetAmount      float64 `json:"netAmount"`
+	RepositoryName string  `json:"repositoryName"`
+}
+
+// CodespacesUsageFields is the list of exportable fields for a usage item
+// when using the `gh cs usage` command.
+var CodespacesUsageFields = []string{
+	"date",
+	"sku",
+	"quantity",
+	"unitType",
+	"pricePerUnit",
+	"grossAmount",
+	"netAmount",
+	"repositoryName",
+}
+
+func (u *CodespacesUsageItem) ExportData(fields []string) map[string]interface{} {
+	v := reflect.ValueOf(u).Elem()
+	data := map[string]interface{}{}
+	for _, f := range fields {
+		sf := v.FieldByNameFunc(func(s string) bool {
+			return strings.EqualFold(f, s)
+		})
+		data[f] = sf.Interface()
+	}
+	return data
+}
+
+// GetCodespacesUsage returns metered codespaces usage for the user (or an
+// organization the user administers, when orgName is non-empty) between
+// startDate and endDate, both in YYYY-MM-DD format.
+func (a *API) GetCodespacesUsage(ctx context.Context, userName string, orgName string, startDate string, endDate string) ([]*CodespacesUsageItem, error) {
+	var usageURL string
+	var spanName string
+
+	query := url.Values{}
+	query.Set("product", "codespaces")
+	query.Set("start_date", startDate)
+	query.Set("end_date", endDate)
+
+	if orgName != "" {
+		usageURL = fmt.Sprintf("%s/orgs/%s/settings/billing/usage?%s", a.githubAPI, orgName, query.Encode())
+		spanName = "/orgs/*/settings/billing/usage"
+	} else {
+		usageURL = fmt.Sprintf("%s/users/%s/settings/billing/usage?%s", a.githubAPI, userName, query.Encode())
+		spanName = "/users/*/settings/billing/usage"
+	}
+
+	req, err := http.NewRequest(http.MethodGet, usageURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	a.setHeaders(req)
+	resp, err := a.do(ctx, req, spanName)
+	if err != nil {
+		return nil, fmt.Errorf("error making request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, api.HandleHTTPError(resp)
+	}
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading response body: %w", err)
+	}
+
+	var response struct {
+		UsageItems []*CodespacesUsageItem `json:"usageItems"`
+	}
+	if err := json.Unmarshal(b, &response); err != nil {
+		return nil, fmt.Errorf("error unmarshalling response: %w", err)
+	}
+
+	return response.UsageItems, nil
+}
+
 type Machine struct {
-	Name                 string `json:"name"`
-	DisplayName          string `json:"display_name"`
-	PrebuildAvailability string `json:"prebuild_availability"`
+	Name                 string  `json:"name"`
+	DisplayName          string  `json:"display_name"`
+	PrebuildAvailability string  `json:"prebuild_availability"`
+	CPUCount             int     `json:"cpus"`
+	MemoryInBytes        uint64  `json:"memory_in_bytes"`
+	StorageInBytes       uint64  `json:"storage_in_bytes"`
+	HourlyPrice          float64 `json:"hourly_price"`
 }
 
 // GetCodespacesMachines returns the codespaces machines for the given repo, branch and location.
@@ -813,6 +1068,11 @@ type CreateCodespaceParams struct {
 	VSCSTargetURL          string
 	PermissionsOptOut      bool
 	DisplayName            string
+	ScheduledStopAt        string
+	// DotfilesRepository overrides the account-level dotfiles setting for this
+	// codespace: nil preserves the account setting, an empty string disables
+	// dotfiles, and any other value names the repository to clone.
+	DotfilesRepository *string
 }
 
 // CreateCodespace creates a codespace with the given parameters and returns a non-nil error if it
@@ -853,17 +1113,19 @@ func (a *API) CreateCodespace(ctx context.Context, params *CreateCodespaceParams
 }
 
 type startCreateRequest struct {
-	RepositoryID           int    `json:"repository_id"`
-	IdleTimeoutMinutes     int    `json:"idle_timeout_minutes,omitempty"`
-	RetentionPeriodMinutes *int   `json:"retention_period_minutes,omitempty"`
-	Ref                    string `json:"ref"`
-	Location               string `json:"location"`
-	Machine                string `json:"machine"`
-	DevContainerPath       string `json:"devcontainer_path,omitempty"`
-	VSCSTarget             string `json:"vscs_target,omitempty"`
-	VSCSTargetURL          string `json:"vscs_target_url,omitempty"`
-	PermissionsOptOut      bool   `json:"multi_repo_permissions_opt_out"`
-	DisplayName            string `json:"display_name"`
+	RepositoryID           int     `json:"repository_id"`
+	IdleTimeoutMinutes     int     `json:"idle_timeout_minutes,omitempty"`
+	RetentionPeriodMinutes *int    `json:"retention_period_minutes,omitempty"`
+	Ref                    string  `json:"ref"`
+	Location               string  `json:"location"`
+	Machine                string  `json:"machine"`
+	DevContainerPath       string  `json:"devcontainer_path,omitempty"`
+	VSCSTarget             string  `json:"vscs_target,omitempty"`
+	VSCSTargetURL          string  `json:"vscs_target_url,omitempty"`
+	PermissionsOptOut      bool    `json:"multi_repo_permissions_opt_out"`
+	DisplayName            string  `json:"display_name"`
+	ScheduledStopAt        string  `json:"scheduled_stop_at,omitempty"`
+	DotfilesRepository     *string `json:"dotfiles_repository,omitempty"`
 }
 
 var errProvisioningInProgress = errors.New("provisioning in progress")
@@ -898,6 +1160,8 @@ func (a *API) startCreate(ctx context.Context, params *CreateCodespaceParams) (*
 		VSCSTargetURL:          params.VSCSTargetURL,
 		PermissionsOptOut:      params.PermissionsOptOut,
 		DisplayName:            params.DisplayName,
+		ScheduledStopAt:        params.ScheduledStopAt,
+		DotfilesRepository:     params.DotfilesRepository,
 	})
 
 	if err != nil {
@@ -1068,9 +1332,10 @@ func (a *API) ListDevContainers(ctx context.Context, repoID int, branch string,
 }
 
 type EditCodespaceParams struct {
-	DisplayName        string `json:"display_name,omitempty"`
-	IdleTimeoutMinutes int    `json:"idle_timeout_minutes,omitempty"`
-	Machine            string `json:"machine,omitempty"`
+	DisplayName            string `json:"display_name,omitempty"`
+	IdleTimeoutMinutes     int    `json:"idle_timeout_minutes,omitempty"`
+	RetentionPeriodMinutes *int   `json:"retention_period_minutes,omitempty"`
+	Machine                string `json:"machine,omitempty"`
 }
 
 func (a *API) EditCodespace(ctx context.Context, codespaceName string, params *EditCodespaceParams) (*Codespace, error) {
@@ -1134,6 +1399,51 @@ type getCodespaceRepositoryContentsResponse struct {
 	Content string `json:"content"`
 }
 
+// GetRepositoryContents returns the contents of a file in a repository at
+// the given ref, or nil if the file does not exist.
+func (a *API) GetRepositoryContents(ctx context.Context, nwo string, path string, ref string) ([]byte, error) {
+	req, err := http.NewRequest(http.MethodGet, a.githubAPI+"/repos/"+nwo+"/contents/"+path, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	if ref != "" {
+		q := req.URL.Query()
+		q.Add("ref", ref)
+		req.URL.RawQuery = q.Encode()
+	}
+
+	a.setHeaders(req)
+	resp, err := a.do(ctx, req, "/repos/*/contents/*")
+	if err != nil {
+		return nil, fmt.Errorf("error making request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	} else if resp.StatusCode != http.StatusOK {
+		return nil, api.HandleHTTPError(resp)
+	}
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading response body: %w", err)
+	}
+
+	var response getCodespaceRepositoryContentsResponse
+	if err := json.Unmarshal(b, &response); err != nil {
+		return nil, fmt.Errorf("error unmarshalling response: %w", err)
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(response.Content)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding content: %w", err)
+	}
+
+	return decoded, nil
+}
+
 func (a *API) GetCodespaceRepositoryContents(ctx context.Context, codespace *Codespace, path string) ([]byte, error) {
 	req, err := http.NewRequest(http.MethodGet, a.githubAPI+"/repos/"+codespace.Repository.FullName+"/contents/"+path, nil)
 	if err != nil {
@@ -38,8 +38,15 @@ type progressIndicator interface {
 	StopProgressIndicator()
 }
 
+// DefaultConnectionTimeout is how long to wait for a codespace to become
+// connectable before giving up.
+const DefaultConnectionTimeout = 5 * time.Minute
+
 type TimeoutError struct {
-	message string
+	// CodespaceName identifies the codespace that did not become ready in
+	// time, so callers can suggest attaching to it later.
+	CodespaceName string
+	message       string
 }
 
 func (e *TimeoutError) Error() string {
@@ -49,7 +56,13 @@ func (e *TimeoutError) Error() string {
 // GetCodespaceConnection waits until a codespace is able
 // to be connected to and initializes a connection to it.
 func GetCodespaceConnection(ctx context.Context, progress progressIndicator, apiClient apiClient, codespace *api.Codespace) (*connection.CodespaceConnection, error) {
-	codespace, err := waitUntilCodespaceConnectionReady(ctx, progress, apiClient, codespace)
+	return GetCodespaceConnectionWithTimeout(ctx, progress, apiClient, codespace, DefaultConnectionTimeout)
+}
+
+// GetCodespaceConnectionWithTimeout is like GetCodespaceConnection but gives up
+// after the supplied timeout instead of the default.
+func GetCodespaceConnectionWithTimeout(ctx context.Context, progress progressIndicator, apiClient apiClient, codespace *api.Codespace, timeout time.Duration) (*connection.CodespaceConnection, error) {
+	codespace, err := waitUntilCodespaceConnectionReady(ctx, progress, apiClient, codespace, timeout)
 	if err != nil {
 		return nil, err
 	}
@@ -66,7 +79,10 @@ func GetCodespaceConnection(ctx context.Context, progress progressIndicator, api
 }
 
 // waitUntilCodespaceConnectionReady waits for a Codespace to be running and is able to be connected to.
-func waitUntilCodespaceConnectionReady(ctx context.Context, progress progressIndicator, apiClient apiClient, codespace *api.Codespace) (*api.Codespace, error) {
+func waitUntilCodespaceConnectionReady(ctx context.Context, progress progressIndicator, apiClient apiClient, codespace *api.Codespace, timeout time.Duration) (*api.Codespace, error) {
+	if timeout <= 0 {
+		timeout = DefaultConnectionTimeout
+	}
 	if codespace.State != api.CodespaceStateAvailable {
 		progress.StartProgressIndicatorWithLabel("Starting codespace")
 		defer progress.StopProgressIndicator()
@@ -79,7 +95,7 @@ func waitUntilCodespaceConnectionReady(ctx context.Context, progress progressInd
 		expBackoff := backoff.NewExponentialBackOff()
 		expBackoff.Multiplier = 1.1
 		expBackoff.MaxInterval = 10 * time.Second
-		expBackoff.MaxElapsedTime = 5 * time.Minute
+		expBackoff.MaxElapsedTime = timeout
 
 		err := backoff.Retry(func() error {
 			var err error
@@ -92,12 +108,15 @@ func waitUntilCodespaceConnectionReady(ctx context.Context, progress progressInd
 				return nil
 			}
 
-			return &TimeoutError{message: "codespace not ready yet"}
+			return &TimeoutError{CodespaceName: codespace.Name, message: "codespace not ready yet"}
 		}, backoff.WithContext(expBackoff, ctx))
 		if err != nil {
 			var timeoutErr *TimeoutError
 			if errors.As(err, &timeoutErr) {
-				return nil, errors.New("timed out while waiting for the codespace to start")
+				return nil, &TimeoutError{
+					CodespaceName: codespace.Name,
+					message:       fmt.Sprintf("timed out while waiting for codespace %q to start; it may still be starting, so you can attach to it later", codespace.Name),
+				}
 			}
 
 			return nil, err
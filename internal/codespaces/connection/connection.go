@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"net/url"
 	"sync"
+	"time"
 
 	"github.com/cli/cli/v2/internal/codespaces/api"
 	"github.com/microsoft/dev-tunnels/go/tunnels"
@@ -103,6 +104,45 @@ func (c *CodespaceConnection) Connect(ctx context.Context) error {
 	return nil
 }
 
+// Reconnect re-establishes a dropped tunnel connection, retrying up to
+// maxAttempts times with exponential backoff. The optional notify callback is
+// invoked before each attempt so callers can surface progress to the user.
+func (c *CodespaceConnection) Reconnect(ctx context.Context, maxAttempts int, notify func(attempt int, err error)) error {
+	c.TunnelClient.mu.Lock()
+	c.TunnelClient.connected = false
+	c.TunnelClient.mu.Unlock()
+
+	delay := time.Second
+	var lastErr error
+	for attempt := 1; attempt <= maxAttempts; attempt++ {
+		if notify != nil {
+			notify(attempt, lastErr)
+		}
+
+		// The underlying SSH session cannot be resumed, so build a fresh client.
+		tunnelClient, err := getTunnelClient(ctx, c.TunnelManager, c.Tunnel, c.Options)
+		if err == nil {
+			c.TunnelClient.mu.Lock()
+			c.TunnelClient.Client = tunnelClient.Client
+			c.TunnelClient.mu.Unlock()
+
+			if err = c.Connect(ctx); err == nil {
+				return nil
+			}
+		}
+		lastErr = err
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(delay):
+		}
+		delay *= 2
+	}
+
+	return fmt.Errorf("failed to reconnect after %d attempts: %w", maxAttempts, lastErr)
+}
+
 // Close closes the underlying tunnel client SSH connection.
 func (c *CodespaceConnection) Close() error {
 	// Lock the mutex to prevent race conditions with the underlying SSH connection
@@ -36,8 +36,11 @@ type ForwardPortOpts struct {
 }
 
 type CodespacesPortForwarder struct {
-	connection      connection.CodespaceConnection
-	keepAliveReason chan string
+	connection        connection.CodespaceConnection
+	keepAliveReason   chan string
+	reconnectAttempts int
+	reconnectNotify   func(attempt int, err error)
+	stats             *ConnectionStats
 }
 
 type PortForwarder interface {
@@ -48,6 +51,7 @@ type PortForwarder interface {
 	UpdatePortVisibility(ctx context.Context, remotePort int, visibility string) error
 	KeepAlive(reason string)
 	GetKeepAliveReason() string
+	SetStats(stats *ConnectionStats)
 	Close() error
 }
 
@@ -59,6 +63,19 @@ func NewPortForwarder(ctx context.Context, codespaceConnection *connection.Codes
 	}, nil
 }
 
+// NewPortForwarderWithReconnect returns a PortForwarder that transparently
+// re-establishes the tunnel when a forwarded connection drops, retrying up to
+// maxAttempts times with exponential backoff. The optional notify callback is
+// invoked before each reconnect attempt.
+func NewPortForwarderWithReconnect(ctx context.Context, codespaceConnection *connection.CodespaceConnection, maxAttempts int, notify func(attempt int, err error)) (PortForwarder, error) {
+	return &CodespacesPortForwarder{
+		connection:        *codespaceConnection,
+		keepAliveReason:   make(chan string, 1),
+		reconnectAttempts: maxAttempts,
+		reconnectNotify:   notify,
+	}, nil
+}
+
 // ForwardPortToListener forwards the specified port to the given TCP listener.
 func (fwd *CodespacesPortForwarder) ForwardPortToListener(ctx context.Context, opts ForwardPortOpts, listener *net.TCPListener) error {
 	err := fwd.ForwardPort(ctx, opts)
@@ -199,6 +216,12 @@ func (fwd *CodespacesPortForwarder) connectListenerToForwardedPort(ctx context.C
 	}
 }
 
+// SetStats registers a ConnectionStats to count the traffic of subsequent
+// forwarded connections.
+func (fwd *CodespacesPortForwarder) SetStats(stats *ConnectionStats) {
+	fwd.stats = stats
+}
+
 // ConnectToForwardedPort connects to the forwarded port via a given ReadWriteCloser.
 // Optionally, it detects traffic over the connection and sends activity signals to the server to keep the codespace from shutting down.
 func (fwd *CodespacesPortForwarder) ConnectToForwardedPort(ctx context.Context, conn io.ReadWriteCloser, opts ForwardPortOpts) error {
@@ -207,6 +230,11 @@ func (fwd *CodespacesPortForwarder) ConnectToForwardedPort(ctx context.Context,
 		conn = newTrafficMonitor(conn, fwd)
 	}
 
+	// Count traffic when connection statistics were requested
+	if fwd.stats != nil {
+		conn = &statsMonitor{conn, fwd.stats}
+	}
+
 	// Convert the port number to a uint16
 	port, err := convertIntToUint16(opts.Port)
 	if err != nil {
@@ -215,6 +243,14 @@ func (fwd *CodespacesPortForwarder) ConnectToForwardedPort(ctx context.Context,
 
 	// Connect to the forwarded port
 	err = fwd.connection.TunnelClient.ConnectToForwardedPort(ctx, conn, port)
+	if err != nil && fwd.reconnectAttempts > 0 && ctx.Err() == nil {
+		// The tunnel may have dropped due to a transient network blip; try to
+		// re-establish it before giving up on the session.
+		if rerr := fwd.connection.Reconnect(ctx, fwd.reconnectAttempts, fwd.reconnectNotify); rerr != nil {
+			return fmt.Errorf("error connecting to forwarded port: %w", rerr)
+		}
+		err = fwd.connection.TunnelClient.ConnectToForwardedPort(ctx, conn, port)
+	}
 	if err != nil {
 		return fmt.Errorf("error connecting to forwarded port: %w", err)
 	}
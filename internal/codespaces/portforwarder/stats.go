@@ -0,0 +1,92 @@
+package portforwarder
+
+import (
+	"fmt"
+	"io"
+	"sync/atomic"
+	"time"
+)
+
+// ConnectionStats accumulates tunnel traffic counters so that transfer totals
+// and rates can be reported while debugging slow connections.
+type ConnectionStats struct {
+	bytesIn   atomic.Int64
+	bytesOut  atomic.Int64
+	startedAt time.Time
+}
+
+// NewConnectionStats returns a ConnectionStats that starts counting now.
+func NewConnectionStats() *ConnectionStats {
+	return &ConnectionStats{startedAt: time.Now()}
+}
+
+// AddBytesIn records n bytes received from the codespace.
+func (s *ConnectionStats) AddBytesIn(n int) {
+	s.bytesIn.Add(int64(n))
+}
+
+// AddBytesOut records n bytes sent to the codespace.
+func (s *ConnectionStats) AddBytesOut(n int) {
+	s.bytesOut.Add(int64(n))
+}
+
+// BytesIn returns the total number of bytes received.
+func (s *ConnectionStats) BytesIn() int64 {
+	return s.bytesIn.Load()
+}
+
+// BytesOut returns the total number of bytes sent.
+func (s *ConnectionStats) BytesOut() int64 {
+	return s.bytesOut.Load()
+}
+
+// Summary formats the current totals and average rates for display.
+func (s *ConnectionStats) Summary() string {
+	elapsed := time.Since(s.startedAt)
+	seconds := elapsed.Seconds()
+	if seconds <= 0 {
+		seconds = 1
+	}
+
+	in := s.BytesIn()
+	out := s.BytesOut()
+	return fmt.Sprintf("received %s (%s/s), sent %s (%s/s) over %s",
+		formatBytes(in), formatBytes(int64(float64(in)/seconds)),
+		formatBytes(out), formatBytes(int64(float64(out)/seconds)),
+		elapsed.Round(time.Second))
+}
+
+func formatBytes(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+	div, exp := int64(unit), 0
+	for m := n / unit; m >= unit; m /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %cB", float64(n)/float64(div), "KMGTPE"[exp])
+}
+
+// statsMonitor wraps a connection and counts the bytes flowing through it.
+type statsMonitor struct {
+	rwc   io.ReadWriteCloser
+	stats *ConnectionStats
+}
+
+func (m *statsMonitor) Read(p []byte) (n int, err error) {
+	n, err = m.rwc.Read(p)
+	m.stats.AddBytesIn(n)
+	return
+}
+
+func (m *statsMonitor) Write(p []byte) (n int, err error) {
+	n, err = m.rwc.Write(p)
+	m.stats.AddBytesOut(n)
+	return
+}
+
+func (m *statsMonitor) Close() error {
+	return m.rwc.Close()
+}
@@ -27,6 +27,10 @@ func (PortForwarder) ForwardPort(ctx context.Context, opts portforwarder.Forward
 	panic("unimplemented")
 }
 
+// SetStats implements portforwarder.PortForwarder.
+func (PortForwarder) SetStats(stats *portforwarder.ConnectionStats) {
+}
+
 // GetKeepAliveReason implements portforwarder.PortForwarder.
 func (PortForwarder) GetKeepAliveReason() string {
 	panic("unimplemented")
@@ -48,6 +48,20 @@ func Copy(ctx context.Context, scpArgs []string, port int, destination string) e
 	return cmd.Run()
 }
 
+// Transfer runs an interactive sftp session over the specified port. sftpArgs
+// should contain any sftp flags; the destination is appended after them.
+//
+// The codespace's SSH server advertises the sftp subsystem, so external
+// clients pointed at the forwarded port can use the same session.
+func Transfer(ctx context.Context, sftpArgs []string, port int, destination string) error {
+	cmd, err := newSFTPCommand(ctx, port, destination, sftpArgs)
+	if err != nil {
+		return fmt.Errorf("failed to create sftp command: %w", err)
+	}
+
+	return cmd.Run()
+}
+
 // NewRemoteCommand returns an exec.Cmd that will securely run a shell
 // command on the remote machine.
 func NewRemoteCommand(ctx context.Context, tunnelPort int, destination string, sshArgs ...string) (*exec.Cmd, error) {
@@ -147,6 +161,32 @@ func parseSCPArgs(args []string) (cmdArgs, command []string, err error) {
 	return parseArgs(args, "cFiJloPS")
 }
 
+// newSFTPCommand populates an exec.Cmd to run an interactive sftp session
+// against the forwarded SSH server port.
+func newSFTPCommand(ctx context.Context, port int, dst string, cmdArgs []string) (*exec.Cmd, error) {
+	connArgs := []string{
+		"-P", strconv.Itoa(port),
+		"-o", "NoHostAuthenticationForLocalhost=yes",
+		"-o", "PasswordAuthentication=no",
+		"-C", // compression
+	}
+
+	cmdArgs = append(cmdArgs, connArgs...)
+	cmdArgs = append(cmdArgs, dst)
+
+	exe, err := safeexec.LookPath("sftp")
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute sftp: %w", err)
+	}
+
+	cmd := exec.CommandContext(ctx, exe, cmdArgs...)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+
+	return cmd, nil
+}
+
 // parseArgs parses arguments into two distinct slices of flags and command. Parsing stops
 // as soon as a non-flag argument is found assuming the remaining arguments are the command.
 // It returns an error if a unary flag is provided without an argument.
@@ -15,19 +15,22 @@ import (
 )
 
 const (
-	aliasesKey            = "aliases"
-	browserKey            = "browser"
-	editorKey             = "editor"
-	gitProtocolKey        = "git_protocol"
-	hostsKey              = "hosts"
-	httpUnixSocketKey     = "http_unix_socket"
-	oauthTokenKey         = "oauth_token"
-	pagerKey              = "pager"
-	promptKey             = "prompt"
-	preferEditorPromptKey = "prefer_editor_prompt"
-	userKey               = "user"
-	usersKey              = "users"
-	versionKey            = "version"
+	aliasesKey             = "aliases"
+	browserKey             = "browser"
+	codespacesKeepAliveKey = "codespaces_keep_alive"
+	editorKey              = "editor"
+	gitOptimizationsKey    = "git_optimizations"
+	gitProtocolKey         = "git_protocol"
+	hostsKey               = "hosts"
+	hyperlinksKey          = "hyperlinks"
+	httpUnixSocketKey      = "http_unix_socket"
+	oauthTokenKey          = "oauth_token"
+	pagerKey               = "pager"
+	promptKey              = "prompt"
+	preferEditorPromptKey  = "prefer_editor_prompt"
+	userKey                = "user"
+	usersKey               = "users"
+	versionKey             = "version"
 )
 
 func NewConfig() (gh.Config, error) {
@@ -113,11 +116,21 @@ func (c *cfg) Browser(hostname string) gh.ConfigEntry {
 	return c.GetOrDefault(hostname, browserKey).Unwrap()
 }
 
+func (c *cfg) CodespacesKeepAlive(hostname string) gh.ConfigEntry {
+	// Intentionally panic if there is no user provided value or default value (which would be a programmer error)
+	return c.GetOrDefault(hostname, codespacesKeepAliveKey).Unwrap()
+}
+
 func (c *cfg) Editor(hostname string) gh.ConfigEntry {
 	// Intentionally panic if there is no user provided value or default value (which would be a programmer error)
 	return c.GetOrDefault(hostname, editorKey).Unwrap()
 }
 
+func (c *cfg) GitOptimizations(hostname string) gh.ConfigEntry {
+	// Intentionally panic if there is no user provided value or default value (which would be a programmer error)
+	return c.GetOrDefault(hostname, gitOptimizationsKey).Unwrap()
+}
+
 func (c *cfg) GitProtocol(hostname string) gh.ConfigEntry {
 	// Intentionally panic if there is no user provided value or default value (which would be a programmer error)
 	return c.GetOrDefault(hostname, gitProtocolKey).Unwrap()
@@ -128,6 +141,11 @@ func (c *cfg) HTTPUnixSocket(hostname string) gh.ConfigEntry {
 	return c.GetOrDefault(hostname, httpUnixSocketKey).Unwrap()
 }
 
+func (c *cfg) Hyperlinks(hostname string) gh.ConfigEntry {
+	// Intentionally panic if there is no user provided value or default value (which would be a programmer error)
+	return c.GetOrDefault(hostname, hyperlinksKey).Unwrap()
+}
+
 func (c *cfg) Pager(hostname string) gh.ConfigEntry {
 	// Intentionally panic if there is no user provided value or default value (which would be a programmer error)
 	return c.GetOrDefault(hostname, pagerKey).Unwrap()
@@ -594,6 +612,15 @@ var Options = []ConfigOption{
 			return c.HTTPUnixSocket(hostname).Value
 		},
 	},
+	{
+		Key:           hyperlinksKey,
+		Description:   "whether to emit OSC 8 hyperlinks in terminal output",
+		DefaultValue:  "auto",
+		AllowedValues: []string{"always", "never", "auto"},
+		CurrentValue: func(c gh.Config, hostname string) string {
+			return c.Hyperlinks(hostname).Value
+		},
+	},
 	{
 		Key:          browserKey,
 		Description:  "the web browser to use for opening URLs",
@@ -602,6 +629,24 @@ var Options = []ConfigOption{
 			return c.Browser(hostname).Value
 		},
 	},
+	{
+		Key:           gitOptimizationsKey,
+		Description:   "whether to configure git maintenance, fsmonitor, and commit-graph in freshly cloned repositories",
+		DefaultValue:  "false",
+		AllowedValues: []string{"true", "false"},
+		CurrentValue: func(c gh.Config, hostname string) string {
+			return c.GitOptimizations(hostname).Value
+		},
+	},
+	{
+		Key:           codespacesKeepAliveKey,
+		Description:   "toggle sending keepalive activity signals during codespace SSH and port-forwarding sessions",
+		DefaultValue:  "enabled",
+		AllowedValues: []string{"enabled", "disabled"},
+		CurrentValue: func(c gh.Config, hostname string) string {
+			return c.CodespacesKeepAlive(hostname).Value
+		},
+	},
 }
 
 func HomeDirPath(subdir string) (string, error) {
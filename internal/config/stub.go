@@ -55,15 +55,24 @@ func NewFromString(cfgStr string) *ghmock.ConfigMock {
 	mock.BrowserFunc = func(hostname string) gh.ConfigEntry {
 		return cfg.Browser(hostname)
 	}
+	mock.CodespacesKeepAliveFunc = func(hostname string) gh.ConfigEntry {
+		return cfg.CodespacesKeepAlive(hostname)
+	}
 	mock.EditorFunc = func(hostname string) gh.ConfigEntry {
 		return cfg.Editor(hostname)
 	}
+	mock.GitOptimizationsFunc = func(hostname string) gh.ConfigEntry {
+		return cfg.GitOptimizations(hostname)
+	}
 	mock.GitProtocolFunc = func(hostname string) gh.ConfigEntry {
 		return cfg.GitProtocol(hostname)
 	}
 	mock.HTTPUnixSocketFunc = func(hostname string) gh.ConfigEntry {
 		return cfg.HTTPUnixSocket(hostname)
 	}
+	mock.HyperlinksFunc = func(hostname string) gh.ConfigEntry {
+		return cfg.Hyperlinks(hostname)
+	}
 	mock.PagerFunc = func(hostname string) gh.ConfigEntry {
 		return cfg.Pager(hostname)
 	}
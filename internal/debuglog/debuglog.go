@@ -0,0 +1,198 @@
+// Package debuglog interprets the GH_DEBUG environment variable as a set of
+// per-scope log levels and provides debug output helpers that redact secrets.
+package debuglog
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strings"
+)
+
+// Scopes recognized in GH_DEBUG values.
+const (
+	ScopeAPI       = "api"
+	ScopeOAuth     = "oauth"
+	ScopeLiveshare = "liveshare"
+	ScopeExtension = "extension"
+)
+
+// Level is the verbosity requested for a debug scope.
+type Level int
+
+const (
+	LevelOff Level = iota
+	LevelDebug
+	LevelTrace
+)
+
+var allScopes = []string{ScopeAPI, ScopeOAuth, ScopeLiveshare, ScopeExtension}
+
+// Spec maps debug scopes to the level they were requested at.
+type Spec map[string]Level
+
+// FromEnv parses the GH_DEBUG environment variable, falling back to the
+// deprecated DEBUG variable when GH_DEBUG is unset.
+func FromEnv() Spec {
+	value, isSet := os.LookupEnv("GH_DEBUG")
+	if !isSet {
+		value = os.Getenv("DEBUG")
+	}
+	return Parse(value)
+}
+
+// Parse interprets a GH_DEBUG value such as "api:trace,liveshare:debug" where
+// each entry is a scope name optionally followed by a level. For backwards
+// compatibility, any other truthy value enables every scope at the debug
+// level, and the value "api" additionally traces HTTP traffic.
+func Parse(value string) Spec {
+	spec := Spec{}
+	switch value {
+	case "false", "0", "no", "":
+		return spec
+	case "api":
+		for _, scope := range allScopes {
+			spec[scope] = LevelDebug
+		}
+		spec[ScopeAPI] = LevelTrace
+		return spec
+	}
+
+	if !strings.ContainsAny(value, ":,") {
+		for _, scope := range allScopes {
+			spec[scope] = LevelDebug
+		}
+		return spec
+	}
+
+	for _, entry := range strings.Split(value, ",") {
+		scope, level, hasLevel := strings.Cut(strings.TrimSpace(entry), ":")
+		if !isScope(scope) {
+			continue
+		}
+		if !hasLevel {
+			spec[scope] = LevelDebug
+			continue
+		}
+		switch level {
+		case "trace":
+			spec[scope] = LevelTrace
+		case "debug":
+			spec[scope] = LevelDebug
+		}
+	}
+	return spec
+}
+
+func isScope(name string) bool {
+	for _, scope := range allScopes {
+		if name == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// Any reports whether any scope is enabled.
+func (s Spec) Any() bool {
+	return len(s) > 0
+}
+
+// Enabled reports whether the scope was requested at any level.
+func (s Spec) Enabled(scope string) bool {
+	return s[scope] > LevelOff
+}
+
+// Level returns the level the scope was requested at.
+func (s Spec) Level(scope string) Level {
+	return s[scope]
+}
+
+var redactPatterns = []struct {
+	re   *regexp.Regexp
+	repl string
+}{
+	// personal access, OAuth, and installation tokens
+	{regexp.MustCompile(`\bgh[pousr]_[A-Za-z0-9_]{16,}`), "[REDACTED]"},
+	// Authorization and similar request headers
+	{regexp.MustCompile(`(?i)\b(authorization|x-oauth-scopes)(:\s*(?:token|bearer|basic)?)\s*\S+`), "$1$2 [REDACTED]"},
+	// credentials passed as form or query parameters
+	{regexp.MustCompile(`\b(client_secret|access_token|refresh_token)=[^&\s"]+`), "$1=[REDACTED]"},
+}
+
+// Redact masks tokens and other credentials that may appear in debug output.
+func Redact(s string) string {
+	for _, p := range redactPatterns {
+		s = p.re.ReplaceAllString(s, p.repl)
+	}
+	return s
+}
+
+// LogsToFile reports whether debug output is being diverted to the file named
+// by GH_DEBUG_FILE.
+func LogsToFile() bool {
+	return os.Getenv("GH_DEBUG_FILE") != ""
+}
+
+// Output returns a destination for debug output that redacts secrets. Output
+// goes to the file named by GH_DEBUG_FILE when set, and to fallback otherwise.
+func Output(fallback io.Writer) io.Writer {
+	out := fallback
+	if path := os.Getenv("GH_DEBUG_FILE"); path != "" {
+		if f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600); err == nil {
+			out = f
+		}
+	}
+	return &redactingWriter{out: out}
+}
+
+type redactingWriter struct {
+	out io.Writer
+}
+
+func (w *redactingWriter) Write(p []byte) (int, error) {
+	if _, err := w.out.Write([]byte(Redact(string(p)))); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// Logger writes leveled debug output for a single scope.
+type Logger struct {
+	scope string
+	level Level
+	out   io.Writer
+}
+
+// NewLogger returns a logger for the scope at the level requested by GH_DEBUG,
+// writing to the destination chosen by Output.
+func NewLogger(fallback io.Writer, scope string) *Logger {
+	return &Logger{
+		scope: scope,
+		level: FromEnv().Level(scope),
+		out:   Output(fallback),
+	}
+}
+
+// Enabled reports whether the logger emits any output.
+func (l *Logger) Enabled() bool {
+	return l.level > LevelOff
+}
+
+// Debugf logs a message at the debug level.
+func (l *Logger) Debugf(format string, args ...interface{}) {
+	l.logf(LevelDebug, format, args...)
+}
+
+// Tracef logs a message at the trace level.
+func (l *Logger) Tracef(format string, args ...interface{}) {
+	l.logf(LevelTrace, format, args...)
+}
+
+func (l *Logger) logf(level Level, format string, args ...interface{}) {
+	if l.level < level {
+		return
+	}
+	fmt.Fprintf(l.out, "[%s] %s\n", l.scope, fmt.Sprintf(format, args...))
+}
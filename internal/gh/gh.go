@@ -37,12 +37,18 @@ type Config interface {
 
 	// Browser returns the configured browser, optionally scoped by host.
 	Browser(hostname string) ConfigEntry
+	// CodespacesKeepAlive returns the configured codespace keepalive policy, optionally scoped by host.
+	CodespacesKeepAlive(hostname string) ConfigEntry
 	// Editor returns the configured editor, optionally scoped by host.
 	Editor(hostname string) ConfigEntry
+	// GitOptimizations returns the configured git optimizations policy, optionally scoped by host.
+	GitOptimizations(hostname string) ConfigEntry
 	// GitProtocol returns the configured git protocol, optionally scoped by host.
 	GitProtocol(hostname string) ConfigEntry
 	// HTTPUnixSocket returns the configured HTTP unix socket, optionally scoped by host.
 	HTTPUnixSocket(hostname string) ConfigEntry
+	// Hyperlinks returns the configured hyperlinks policy, optionally scoped by host.
+	Hyperlinks(hostname string) ConfigEntry
 	// Pager returns the configured Pager, optionally scoped by host.
 	Pager(hostname string) ConfigEntry
 	// Prompt returns the configured prompt, optionally scoped by host.
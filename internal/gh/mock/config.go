@@ -31,18 +31,27 @@ var _ gh.Config = &ConfigMock{}
 //			CacheDirFunc: func() string {
 //				panic("mock out the CacheDir method")
 //			},
+//			CodespacesKeepAliveFunc: func(hostname string) gh.ConfigEntry {
+//				panic("mock out the CodespacesKeepAlive method")
+//			},
 //			EditorFunc: func(hostname string) gh.ConfigEntry {
 //				panic("mock out the Editor method")
 //			},
 //			GetOrDefaultFunc: func(hostname string, key string) o.Option[gh.ConfigEntry] {
 //				panic("mock out the GetOrDefault method")
 //			},
+//			GitOptimizationsFunc: func(hostname string) gh.ConfigEntry {
+//				panic("mock out the GitOptimizations method")
+//			},
 //			GitProtocolFunc: func(hostname string) gh.ConfigEntry {
 //				panic("mock out the GitProtocol method")
 //			},
 //			HTTPUnixSocketFunc: func(hostname string) gh.ConfigEntry {
 //				panic("mock out the HTTPUnixSocket method")
 //			},
+//			HyperlinksFunc: func(hostname string) gh.ConfigEntry {
+//				panic("mock out the Hyperlinks method")
+//			},
 //			MigrateFunc: func(migration gh.Migration) error {
 //				panic("mock out the Migrate method")
 //			},
@@ -83,18 +92,27 @@ type ConfigMock struct {
 	// CacheDirFunc mocks the CacheDir method.
 	CacheDirFunc func() string
 
+	// CodespacesKeepAliveFunc mocks the CodespacesKeepAlive method.
+	CodespacesKeepAliveFunc func(hostname string) gh.ConfigEntry
+
 	// EditorFunc mocks the Editor method.
 	EditorFunc func(hostname string) gh.ConfigEntry
 
 	// GetOrDefaultFunc mocks the GetOrDefault method.
 	GetOrDefaultFunc func(hostname string, key string) o.Option[gh.ConfigEntry]
 
+	// GitOptimizationsFunc mocks the GitOptimizations method.
+	GitOptimizationsFunc func(hostname string) gh.ConfigEntry
+
 	// GitProtocolFunc mocks the GitProtocol method.
 	GitProtocolFunc func(hostname string) gh.ConfigEntry
 
 	// HTTPUnixSocketFunc mocks the HTTPUnixSocket method.
 	HTTPUnixSocketFunc func(hostname string) gh.ConfigEntry
 
+	// HyperlinksFunc mocks the Hyperlinks method.
+	HyperlinksFunc func(hostname string) gh.ConfigEntry
+
 	// MigrateFunc mocks the Migrate method.
 	MigrateFunc func(migration gh.Migration) error
 
@@ -132,6 +150,11 @@ type ConfigMock struct {
 		// CacheDir holds details about calls to the CacheDir method.
 		CacheDir []struct {
 		}
+		// CodespacesKeepAlive holds details about calls to the CodespacesKeepAlive method.
+		CodespacesKeepAlive []struct {
+			// Hostname is the hostname argument value.
+			Hostname string
+		}
 		// Editor holds details about calls to the Editor method.
 		Editor []struct {
 			// Hostname is the hostname argument value.
@@ -144,6 +167,11 @@ type ConfigMock struct {
 			// Key is the key argument value.
 			Key string
 		}
+		// GitOptimizations holds details about calls to the GitOptimizations method.
+		GitOptimizations []struct {
+			// Hostname is the hostname argument value.
+			Hostname string
+		}
 		// GitProtocol holds details about calls to the GitProtocol method.
 		GitProtocol []struct {
 			// Hostname is the hostname argument value.
@@ -154,6 +182,11 @@ type ConfigMock struct {
 			// Hostname is the hostname argument value.
 			Hostname string
 		}
+		// Hyperlinks holds details about calls to the Hyperlinks method.
+		Hyperlinks []struct {
+			// Hostname is the hostname argument value.
+			Hostname string
+		}
 		// Migrate holds details about calls to the Migrate method.
 		Migrate []struct {
 			// Migration is the migration argument value.
@@ -190,21 +223,24 @@ type ConfigMock struct {
 		Write []struct {
 		}
 	}
-	lockAliases            sync.RWMutex
-	lockAuthentication     sync.RWMutex
-	lockBrowser            sync.RWMutex
-	lockCacheDir           sync.RWMutex
-	lockEditor             sync.RWMutex
-	lockGetOrDefault       sync.RWMutex
-	lockGitProtocol        sync.RWMutex
-	lockHTTPUnixSocket     sync.RWMutex
-	lockMigrate            sync.RWMutex
-	lockPager              sync.RWMutex
-	lockPreferEditorPrompt sync.RWMutex
-	lockPrompt             sync.RWMutex
-	lockSet                sync.RWMutex
-	lockVersion            sync.RWMutex
-	lockWrite              sync.RWMutex
+	lockAliases             sync.RWMutex
+	lockAuthentication      sync.RWMutex
+	lockBrowser             sync.RWMutex
+	lockCacheDir            sync.RWMutex
+	lockCodespacesKeepAlive sync.RWMutex
+	lockEditor              sync.RWMutex
+	lockGetOrDefault        sync.RWMutex
+	lockGitOptimizations    sync.RWMutex
+	lockGitProtocol         sync.RWMutex
+	lockHTTPUnixSocket      sync.RWMutex
+	lockHyperlinks          sync.RWMutex
+	lockMigrate             sync.RWMutex
+	lockPager               sync.RWMutex
+	lockPreferEditorPrompt  sync.RWMutex
+	lockPrompt              sync.RWMutex
+	lockSet                 sync.RWMutex
+	lockVersion             sync.RWMutex
+	lockWrite               sync.RWMutex
 }
 
 // Aliases calls AliasesFunc.
@@ -320,6 +356,38 @@ func (mock *ConfigMock) CacheDirCalls() []struct {
 	return calls
 }
 
+// CodespacesKeepAlive calls CodespacesKeepAliveFunc.
+func (mock *ConfigMock) CodespacesKeepAlive(hostname string) gh.ConfigEntry {
+	if mock.CodespacesKeepAliveFunc == nil {
+		panic("ConfigMock.CodespacesKeepAliveFunc: method is nil but Config.CodespacesKeepAlive was just called")
+	}
+	callInfo := struct {
+		Hostname string
+	}{
+		Hostname: hostname,
+	}
+	mock.lockCodespacesKeepAlive.Lock()
+	mock.calls.CodespacesKeepAlive = append(mock.calls.CodespacesKeepAlive, callInfo)
+	mock.lockCodespacesKeepAlive.Unlock()
+	return mock.CodespacesKeepAliveFunc(hostname)
+}
+
+// CodespacesKeepAliveCalls gets all the calls that were made to CodespacesKeepAlive.
+// Check the length with:
+//
+//	len(mockedConfig.CodespacesKeepAliveCalls())
+func (mock *ConfigMock) CodespacesKeepAliveCalls() []struct {
+	Hostname string
+} {
+	var calls []struct {
+		Hostname string
+	}
+	mock.lockCodespacesKeepAlive.RLock()
+	calls = mock.calls.CodespacesKeepAlive
+	mock.lockCodespacesKeepAlive.RUnlock()
+	return calls
+}
+
 // Editor calls EditorFunc.
 func (mock *ConfigMock) Editor(hostname string) gh.ConfigEntry {
 	if mock.EditorFunc == nil {
@@ -388,6 +456,38 @@ func (mock *ConfigMock) GetOrDefaultCalls() []struct {
 	return calls
 }
 
+// GitOptimizations calls GitOptimizationsFunc.
+func (mock *ConfigMock) GitOptimizations(hostname string) gh.ConfigEntry {
+	if mock.GitOptimizationsFunc == nil {
+		panic("ConfigMock.GitOptimizationsFunc: method is nil but Config.GitOptimizations was just called")
+	}
+	callInfo := struct {
+		Hostname string
+	}{
+		Hostname: hostname,
+	}
+	mock.lockGitOptimizations.Lock()
+	mock.calls.GitOptimizations = append(mock.calls.GitOptimizations, callInfo)
+	mock.lockGitOptimizations.Unlock()
+	return mock.GitOptimizationsFunc(hostname)
+}
+
+// GitOptimizationsCalls gets all the calls that were made to GitOptimizations.
+// Check the length with:
+//
+//	len(mockedConfig.GitOptimizationsCalls())
+func (mock *ConfigMock) GitOptimizationsCalls() []struct {
+	Hostname string
+} {
+	var calls []struct {
+		Hostname string
+	}
+	mock.lockGitOptimizations.RLock()
+	calls = mock.calls.GitOptimizations
+	mock.lockGitOptimizations.RUnlock()
+	return calls
+}
+
 // GitProtocol calls GitProtocolFunc.
 func (mock *ConfigMock) GitProtocol(hostname string) gh.ConfigEntry {
 	if mock.GitProtocolFunc == nil {
@@ -452,6 +552,38 @@ func (mock *ConfigMock) HTTPUnixSocketCalls() []struct {
 	return calls
 }
 
+// Hyperlinks calls HyperlinksFunc.
+func (mock *ConfigMock) Hyperlinks(hostname string) gh.ConfigEntry {
+	if mock.HyperlinksFunc == nil {
+		panic("ConfigMock.HyperlinksFunc: method is nil but Config.Hyperlinks was just called")
+	}
+	callInfo := struct {
+		Hostname string
+	}{
+		Hostname: hostname,
+	}
+	mock.lockHyperlinks.Lock()
+	mock.calls.Hyperlinks = append(mock.calls.Hyperlinks, callInfo)
+	mock.lockHyperlinks.Unlock()
+	return mock.HyperlinksFunc(hostname)
+}
+
+// HyperlinksCalls gets all the calls that were made to Hyperlinks.
+// Check the length with:
+//
+//	len(mockedConfig.HyperlinksCalls())
+func (mock *ConfigMock) HyperlinksCalls() []struct {
+	Hostname string
+} {
+	var calls []struct {
+		Hostname string
+	}
+	mock.lockHyperlinks.RLock()
+	calls = mock.calls.Hyperlinks
+	mock.lockHyperlinks.RUnlock()
+	return calls
+}
+
 // Migrate calls MigrateFunc.
 func (mock *ConfigMock) Migrate(migration gh.Migration) error {
 	if mock.MigrateFunc == nil {
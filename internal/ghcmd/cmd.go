@@ -2,6 +2,7 @@ package ghcmd
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
@@ -142,6 +143,11 @@ func Main() exitCode {
 			return exitCode(extError.ExitCode())
 		}
 
+		if os.Getenv("GH_ERROR_FORMAT") == "json" {
+			printJSONError(stderr, err, int(exitError))
+			return exitError
+		}
+
 		printError(stderr, err, cmd, hasDebug)
 
 		if strings.Contains(err.Error(), "Incorrect function") {
@@ -194,6 +200,51 @@ func isExtensionCommand(rootCmd *cobra.Command, args []string) bool {
 	return err == nil && c != nil && c.GroupID == "extension"
 }
 
+// structuredError is the shape written to stderr when GH_ERROR_FORMAT=json
+// is set, so that wrapping tools can react to failures programmatically
+// instead of parsing human-readable messages.
+type structuredError struct {
+	Type       string `json:"type"`
+	Message    string `json:"message"`
+	StatusCode int    `json:"statusCode,omitempty"`
+	RequestID  string `json:"requestId,omitempty"`
+	Hint       string `json:"hint,omitempty"`
+	ExitCode   int    `json:"exitCode"`
+}
+
+func printJSONError(out io.Writer, err error, code int) {
+	structured := structuredError{
+		Type:     "error",
+		Message:  err.Error(),
+		ExitCode: code,
+	}
+
+	var flagError *cmdutil.FlagError
+	var dnsError *net.DNSError
+	var httpErr api.HTTPError
+	if errors.As(err, &httpErr) {
+		structured.Type = "http"
+		structured.StatusCode = httpErr.StatusCode
+		structured.RequestID = httpErr.Headers.Get("X-Github-Request-Id")
+		if httpErr.StatusCode == 401 {
+			structured.Hint = "Try authenticating with:  gh auth login"
+		} else if u := factory.SSOURL(); u != "" {
+			structured.Hint = fmt.Sprintf("Authorize in your web browser:  %s", u)
+		} else if msg := httpErr.ScopesSuggestion(); msg != "" {
+			structured.Hint = msg
+		}
+	} else if errors.As(err, &flagError) || strings.HasPrefix(err.Error(), "unknown command ") {
+		structured.Type = "usage"
+	} else if errors.As(err, &dnsError) {
+		structured.Type = "network"
+		structured.Hint = "check your internet connection or https://githubstatus.com"
+	}
+
+	encoder := json.NewEncoder(out)
+	encoder.SetEscapeHTML(false)
+	_ = encoder.Encode(structured)
+}
+
 func printError(out io.Writer, err error, cmd *cobra.Command, debug bool) {
 	var dnsError *net.DNSError
 	if errors.As(err, &dnsError) {
@@ -76,3 +76,39 @@ check your internet connection or https://githubstatus.com
 		})
 	}
 }
+
+func Test_printJSONError(t *testing.T) {
+	tests := []struct {
+		name    string
+		err     error
+		wantOut string
+	}{
+		{
+			name:    "generic error",
+			err:     errors.New("the app exploded"),
+			wantOut: `{"type":"error","message":"the app exploded","exitCode":1}` + "\n",
+		},
+		{
+			name:    "flag error",
+			err:     cmdutil.FlagErrorf("unknown flag --foo"),
+			wantOut: `{"type":"usage","message":"unknown flag --foo","exitCode":1}` + "\n",
+		},
+		{
+			name: "DNS error",
+			err: fmt.Errorf("DNS oopsie: %w", &net.DNSError{
+				Name: "api.github.com",
+			}),
+			wantOut: `{"type":"network","message":"DNS oopsie: lookup api.github.com: ","hint":"check your internet connection or https://githubstatus.com","exitCode":1}` + "\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out := &bytes.Buffer{}
+			printJSONError(out, tt.err, 1)
+			if gotOut := out.String(); gotOut != tt.wantOut {
+				t.Errorf("printJSONError() = %q, want %q", gotOut, tt.wantOut)
+			}
+		})
+	}
+}
@@ -0,0 +1,149 @@
+// Package ghversion detects the installed version of GitHub Enterprise
+// Server hosts and gates features on a minimum required version, so that
+// commands can surface a clear "requires GHES >= X" error instead of a
+// confusing 404 from a server that predates the feature.
+package ghversion
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/cli/cli/v2/api"
+
+	ghauth "github.com/cli/go-gh/v2/pkg/auth"
+)
+
+// Capability describes a feature that requires a minimum GitHub Enterprise
+// Server version. The zero MinVersion means the feature is available on
+// every supported version.
+type Capability struct {
+	Name       string
+	MinVersion string
+}
+
+var (
+	ProjectsV2   = Capability{Name: "Projects", MinVersion: "3.9"}
+	Attestations = Capability{Name: "Attestation verification", MinVersion: "3.15"}
+)
+
+// AllCapabilities lists every capability known to this build of gh, in the
+// order they should be displayed.
+func AllCapabilities() []Capability {
+	return []Capability{ProjectsV2, Attestations}
+}
+
+// Detector reports the installed version of a GitHub host. For github.com
+// and tenancy hosts, which always run the latest version, the reported
+// version is the empty string.
+type Detector interface {
+	InstalledVersion(hostname string) (string, error)
+}
+
+type detector struct {
+	httpClient *http.Client
+
+	mu    sync.Mutex
+	cache map[string]string
+}
+
+func NewDetector(httpClient *http.Client) Detector {
+	return &detector{
+		httpClient: httpClient,
+		cache:      map[string]string{},
+	}
+}
+
+func (d *detector) InstalledVersion(hostname string) (string, error) {
+	if !ghauth.IsEnterprise(hostname) {
+		return "", nil
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if v, ok := d.cache[hostname]; ok {
+		return v, nil
+	}
+
+	var response struct {
+		InstalledVersion string `json:"installed_version"`
+	}
+	apiClient := api.NewClientFromHTTP(d.httpClient)
+	if err := apiClient.REST(hostname, "GET", "meta", nil, &response); err != nil {
+		return "", err
+	}
+
+	d.cache[hostname] = response.InstalledVersion
+	return response.InstalledVersion, nil
+}
+
+// CapabilityError is returned when a host's installed version is older than
+// the minimum version a capability requires.
+type CapabilityError struct {
+	Capability Capability
+	Hostname   string
+	Version    string
+}
+
+func (e *CapabilityError) Error() string {
+	return fmt.Sprintf("%s requires GitHub Enterprise Server %s or later, but %s is running %s",
+		e.Capability.Name, e.Capability.MinVersion, e.Hostname, e.Version)
+}
+
+// CheckCapability returns a CapabilityError if hostname is a GitHub
+// Enterprise Server instance whose installed version does not support the
+// capability, and nil otherwise.
+func CheckCapability(d Detector, hostname string, c Capability) error {
+	version, err := d.InstalledVersion(hostname)
+	if err != nil {
+		return err
+	}
+	if Supports(version, c) {
+		return nil
+	}
+	return &CapabilityError{Capability: c, Hostname: hostname, Version: version}
+}
+
+// Supports reports whether an installed version satisfies the capability's
+// minimum version. The empty version represents github.com, which supports
+// everything.
+func Supports(version string, c Capability) bool {
+	if version == "" || c.MinVersion == "" {
+		return true
+	}
+	return compareVersions(version, c.MinVersion) >= 0
+}
+
+// compareVersions compares dotted numeric versions such as "3.12.4",
+// ignoring any non-numeric suffix on a segment.
+func compareVersions(a, b string) int {
+	as := strings.Split(a, ".")
+	bs := strings.Split(b, ".")
+	for i := 0; i < len(as) || i < len(bs); i++ {
+		var an, bn int
+		if i < len(as) {
+			an = numericPrefix(as[i])
+		}
+		if i < len(bs) {
+			bn = numericPrefix(bs[i])
+		}
+		if an != bn {
+			if an < bn {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
+
+func numericPrefix(s string) int {
+	end := 0
+	for end < len(s) && s[end] >= '0' && s[end] <= '9' {
+		end++
+	}
+	n, _ := strconv.Atoi(s[:end])
+	return n
+}
@@ -0,0 +1,125 @@
+package ghversion
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInstalledVersion(t *testing.T) {
+	tests := []struct {
+		name        string
+		hostname    string
+		httpStubs   func(*httpmock.Registry)
+		wantVersion string
+		wantErr     bool
+	}{
+		{
+			name:        "github.com reports no version",
+			hostname:    "github.com",
+			wantVersion: "",
+		},
+		{
+			name:        "tenancy host reports no version",
+			hostname:    "stampname.ghe.com",
+			wantVersion: "",
+		},
+		{
+			name:     "GHES reports installed version",
+			hostname: "git.my.org",
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "api/v3/meta"),
+					httpmock.StringResponse(`{"installed_version": "3.12.4"}`),
+				)
+			},
+			wantVersion: "3.12.4",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reg := &httpmock.Registry{}
+			defer reg.Verify(t)
+			if tt.httpStubs != nil {
+				tt.httpStubs(reg)
+			}
+
+			detector := NewDetector(&http.Client{Transport: reg})
+			version, err := detector.InstalledVersion(tt.hostname)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantVersion, version)
+
+			// A second lookup is served from the cache without another request.
+			version, err = detector.InstalledVersion(tt.hostname)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantVersion, version)
+		})
+	}
+}
+
+func TestCheckCapability(t *testing.T) {
+	tests := []struct {
+		name       string
+		version    string
+		capability Capability
+		wantErr    string
+	}{
+		{
+			name:       "github.com supports everything",
+			version:    "",
+			capability: Attestations,
+		},
+		{
+			name:       "version meets minimum",
+			version:    "3.15.0",
+			capability: Attestations,
+		},
+		{
+			name:       "version exceeds minimum",
+			version:    "3.16.1",
+			capability: Attestations,
+		},
+		{
+			name:       "version below minimum",
+			version:    "3.14.2",
+			capability: Attestations,
+			wantErr:    "Attestation verification requires GitHub Enterprise Server 3.15 or later, but git.my.org is running 3.14.2",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			detector := stubDetector{version: tt.version}
+			err := CheckCapability(detector, "git.my.org", tt.capability)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				var capErr *CapabilityError
+				assert.ErrorAs(t, err, &capErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestCompareVersions(t *testing.T) {
+	assert.Equal(t, 0, compareVersions("3.9", "3.9.0"))
+	assert.Equal(t, -1, compareVersions("3.9.7", "3.10"))
+	assert.Equal(t, 1, compareVersions("3.10", "3.9.7"))
+	assert.Equal(t, 1, compareVersions("3.12.0.rc1", "3.11"))
+}
+
+type stubDetector struct {
+	version string
+}
+
+func (d stubDetector) InstalledVersion(hostname string) (string, error) {
+	return d.version, nil
+}
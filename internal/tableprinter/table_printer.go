@@ -12,8 +12,9 @@ import (
 
 type TablePrinter struct {
 	tableprinter.TablePrinter
-	isTTY bool
-	cs    *iostreams.ColorScheme
+	isTTY     bool
+	cs        *iostreams.ColorScheme
+	hyperlink func(text, url string) string
 }
 
 // IsTTY gets whether the TablePrinter will render to a terminal.
@@ -33,11 +34,83 @@ func (tp *TablePrinter) AddTimeField(now, t time.Time, c func(string) string) {
 	tp.AddField(tf, WithColor(c))
 }
 
-var (
-	WithColor    = tableprinter.WithColor
-	WithPadding  = tableprinter.WithPadding
-	WithTruncate = tableprinter.WithTruncate
-)
+type fieldOption func(*tableField)
+
+type tableField struct {
+	truncateFunc func(int, string) string
+	paddingFunc  func(int, string) string
+	colorFunc    func(string) string
+}
+
+// WithColor sets the color function for the field. The function should transform a string value
+// by wrapping it in ANSI escape codes. It will not be used if the table was initialized in
+// non-terminal mode.
+func WithColor(fn func(string) string) fieldOption {
+	return func(f *tableField) {
+		f.colorFunc = fn
+	}
+}
+
+// WithPadding overrides the padding function for the field. The default behavior is to pad
+// fields with spaces except for the last field. Pass nil to restore the default.
+func WithPadding(fn func(int, string) string) fieldOption {
+	return func(f *tableField) {
+		f.paddingFunc = fn
+	}
+}
+
+// WithTruncate overrides the truncation function for the field. The default is text.Truncate,
+// which is aware of grapheme clusters and East Asian wide characters. Pass nil to disable
+// truncation for this value.
+func WithTruncate(fn func(int, string) string) fieldOption {
+	return func(f *tableField) {
+		f.truncateFunc = fn
+	}
+}
+
+// AddHeader adds the header row, applying the given options to every header field.
+func (tp *TablePrinter) AddHeader(columns []string, opts ...fieldOption) {
+	f := newTableField(opts)
+	tp.TablePrinter.AddHeader(columns,
+		tableprinter.WithTruncate(f.truncateFunc),
+		tableprinter.WithPadding(f.paddingFunc),
+		tableprinter.WithColor(f.colorFunc),
+	)
+}
+
+// AddURLField adds a field displaying url. When the terminal supports OSC 8
+// hyperlinks the displayed value links to the full URL, even if it was
+// truncated to fit the column. The escape sequence is applied after
+// truncation, like colors, so it never counts against the column width.
+func (tp *TablePrinter) AddURLField(url string) {
+	if tp.hyperlink == nil {
+		tp.AddField(url)
+		return
+	}
+	tp.AddField(url, WithColor(func(s string) string {
+		return tp.hyperlink(s, url)
+	}))
+}
+
+// AddField adds a field to the current row. Unless overridden with WithTruncate, values are
+// truncated with text.Truncate so that CJK characters and emoji in table cells are neither
+// split nor miscounted.
+func (tp *TablePrinter) AddField(s string, opts ...fieldOption) {
+	f := newTableField(opts)
+	tp.TablePrinter.AddField(s,
+		tableprinter.WithTruncate(f.truncateFunc),
+		tableprinter.WithPadding(f.paddingFunc),
+		tableprinter.WithColor(f.colorFunc),
+	)
+}
+
+func newTableField(opts []fieldOption) tableField {
+	f := tableField{truncateFunc: text.Truncate}
+	for _, opt := range opts {
+		opt(&f)
+	}
+	return f
+}
 
 type headerOption struct {
 	columns []string
@@ -51,7 +124,9 @@ func New(ios *iostreams.IOStreams, headers headerOption) *TablePrinter {
 		maxWidth = ios.TerminalWidth()
 	}
 
-	return NewWithWriter(ios.Out, isTTY, maxWidth, ios.ColorScheme(), headers)
+	tp := NewWithWriter(ios.Out, isTTY, maxWidth, ios.ColorScheme(), headers)
+	tp.hyperlink = ios.Hyperlink
+	return tp
 }
 
 // NewWithWriter creates a TablePrinter from a Writer, whether the output is a terminal, the terminal width, and more.
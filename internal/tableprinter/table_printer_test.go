@@ -1,6 +1,7 @@
 package tableprinter_test
 
 import (
+	"bytes"
 	"testing"
 
 	"github.com/cli/cli/v2/internal/tableprinter"
@@ -20,3 +21,50 @@ func TestHeadersAreNotMutated(t *testing.T) {
 	// The provided headers should not be mutated
 	require.Equal(t, []string{"one", "two", "three"}, headers)
 }
+
+func TestWideCharacterFieldsStayAligned(t *testing.T) {
+	// Given a narrow TTY table with a column containing CJK text
+	ios, _, _, _ := iostreams.Test()
+	buf := &bytes.Buffer{}
+	tp := tableprinter.NewWithWriter(buf, true, 14, ios.ColorScheme(), tableprinter.NoHeader)
+
+	tp.AddField("日本語のタイトル")
+	tp.AddField("v1")
+	tp.EndRow()
+	tp.AddField("ascii")
+	tp.AddField("v2")
+	tp.EndRow()
+	require.NoError(t, tp.Render())
+
+	// Wide characters should be truncated on character boundaries and padded
+	// to the same display width as the other rows
+	require.Equal(t, "日本語...   v1\nascii       v2\n", buf.String())
+}
+
+func TestAddURLFieldEmitsHyperlinks(t *testing.T) {
+	// Given a TTY table on a terminal where hyperlinks are enabled
+	ios, _, out, _ := iostreams.Test()
+	ios.SetStdoutTTY(true)
+	ios.SetHyperlinksPolicy("always")
+
+	tp := tableprinter.New(ios, tableprinter.NoHeader)
+	tp.AddURLField("https://example.com")
+	tp.EndRow()
+	require.NoError(t, tp.Render())
+
+	// The value should be wrapped in an OSC 8 hyperlink pointing at the URL
+	require.Equal(t, "\x1b]8;;https://example.com\x1b\\https://example.com\x1b]8;;\x1b\\\n", out.String())
+}
+
+func TestAddURLFieldIsPlainWhenHyperlinksDisabled(t *testing.T) {
+	ios, _, out, _ := iostreams.Test()
+	ios.SetStdoutTTY(true)
+	ios.SetHyperlinksPolicy("never")
+
+	tp := tableprinter.New(ios, tableprinter.NoHeader)
+	tp.AddURLField("https://example.com")
+	tp.EndRow()
+	require.NoError(t, tp.Render())
+
+	require.Equal(t, "https://example.com\n", out.String())
+}
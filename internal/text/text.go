@@ -10,12 +10,20 @@ import (
 	"time"
 
 	"github.com/cli/go-gh/v2/pkg/text"
+	"github.com/rivo/uniseg"
 	"golang.org/x/text/cases"
 	"golang.org/x/text/language"
 )
 
 var whitespaceRE = regexp.MustCompile(`\s+`)
 
+var ansiEscapeRE = regexp.MustCompile("\x1b\\[[0-9;]*m")
+
+const (
+	ellipsis            = "..."
+	minWidthForEllipsis = len(ellipsis) + 2
+)
+
 func Indent(s, indent string) string {
 	return text.Indent(s, indent)
 }
@@ -31,12 +39,50 @@ func RemoveExcessiveWhitespace(s string) string {
 	return whitespaceRE.ReplaceAllString(strings.TrimSpace(s), " ")
 }
 
+// DisplayWidth returns the number of terminal cells that s occupies, counting
+// grapheme clusters and East Asian wide characters rather than runes so that
+// CJK text and emoji sequences measure the way terminals render them. ANSI
+// escape sequences are ignored.
 func DisplayWidth(s string) int {
-	return text.DisplayWidth(s)
+	return uniseg.StringWidth(ansiEscapeRE.ReplaceAllString(s, ""))
 }
 
+// Truncate returns a copy of the string s that has been shortened to fit the
+// maximum display width, appending "..." when there is room for it. Grapheme
+// clusters are never split, so wide characters and emoji stay intact.
 func Truncate(maxWidth int, s string) string {
-	return text.Truncate(maxWidth, s)
+	if DisplayWidth(s) <= maxWidth {
+		return s
+	}
+	tail := ""
+	if maxWidth >= minWidthForEllipsis {
+		tail = ellipsis
+	}
+	r := truncateGraphemes(maxWidth-len(tail), s) + tail
+	if DisplayWidth(r) < maxWidth {
+		// A wide character did not fit in the last cell; pad so columns stay aligned.
+		r += " "
+	}
+	return r
+}
+
+// truncateGraphemes cuts s down to at most maxWidth display cells on a
+// grapheme cluster boundary.
+func truncateGraphemes(maxWidth int, s string) string {
+	var sb strings.Builder
+	width := 0
+	state := -1
+	for rest := s; rest != ""; {
+		var cluster string
+		var w int
+		cluster, rest, w, state = uniseg.FirstGraphemeClusterInString(rest, state)
+		if width+w > maxWidth {
+			break
+		}
+		sb.WriteString(cluster)
+		width += w
+	}
+	return sb.String()
 }
 
 func Pluralize(num int, thing string) string {
@@ -85,8 +131,13 @@ func RemoveDiacritics(value string) string {
 	return text.RemoveDiacritics(value)
 }
 
+// PadRight returns a copy of the string s padded on the right with whitespace
+// to the maximum display width, measured with DisplayWidth.
 func PadRight(maxWidth int, s string) string {
-	return text.PadRight(maxWidth, s)
+	if padWidth := maxWidth - DisplayWidth(s); padWidth > 0 {
+		s += strings.Repeat(" ", padWidth)
+	}
+	return s
 }
 
 // FormatSlice concatenates elements of the given string slice into a
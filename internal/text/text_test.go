@@ -32,6 +32,142 @@ func TestRemoveExcessiveWhitespace(t *testing.T) {
 	}
 }
 
+func TestDisplayWidth(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  int
+	}{
+		{
+			name:  "ascii",
+			input: "github",
+			want:  6,
+		},
+		{
+			name:  "cjk",
+			input: "日本語",
+			want:  6,
+		},
+		{
+			name:  "emoji",
+			input: "✅",
+			want:  2,
+		},
+		{
+			name:  "emoji zwj sequence",
+			input: "👩‍🚀",
+			want:  2,
+		},
+		{
+			name:  "combining mark",
+			input: "é",
+			want:  1,
+		},
+		{
+			name:  "ansi escape sequences are ignored",
+			input: "\x1b[0;31mred\x1b[0m",
+			want:  3,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, DisplayWidth(tt.input))
+		})
+	}
+}
+
+func TestTruncate(t *testing.T) {
+	tests := []struct {
+		name     string
+		maxWidth int
+		input    string
+		want     string
+	}{
+		{
+			name:     "short enough",
+			maxWidth: 10,
+			input:    "short",
+			want:     "short",
+		},
+		{
+			name:     "exact width",
+			maxWidth: 5,
+			input:    "short",
+			want:     "short",
+		},
+		{
+			name:     "ascii truncated with ellipsis",
+			maxWidth: 8,
+			input:    "long enough",
+			want:     "long ...",
+		},
+		{
+			name:     "no ellipsis below minimum width",
+			maxWidth: 4,
+			input:    "long enough",
+			want:     "long",
+		},
+		{
+			name:     "cjk truncated on character boundary",
+			maxWidth: 7,
+			input:    "日本語のタイトル",
+			want:     "日本...",
+		},
+		{
+			name:     "wide character does not fit in last cell",
+			maxWidth: 8,
+			input:    "日本語のタイトル",
+			want:     "日本... ",
+		},
+		{
+			name:     "emoji zwj sequence is not split",
+			maxWidth: 6,
+			input:    "👩‍🚀👩‍🚀👩‍🚀👩‍🚀",
+			want:     "👩‍🚀... ",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Truncate(tt.maxWidth, tt.input)
+			assert.Equal(t, tt.want, got)
+			assert.LessOrEqual(t, DisplayWidth(got), tt.maxWidth)
+		})
+	}
+}
+
+func TestPadRight(t *testing.T) {
+	tests := []struct {
+		name     string
+		maxWidth int
+		input    string
+		want     string
+	}{
+		{
+			name:     "ascii",
+			maxWidth: 6,
+			input:    "gh",
+			want:     "gh    ",
+		},
+		{
+			name:     "cjk",
+			maxWidth: 8,
+			input:    "日本語",
+			want:     "日本語  ",
+		},
+		{
+			name:     "wide enough",
+			maxWidth: 2,
+			input:    "text",
+			want:     "text",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, PadRight(tt.maxWidth, tt.input))
+		})
+	}
+}
+
 func TestFuzzyAgoAbbr(t *testing.T) {
 	const form = "2006-Jan-02 15:04:05"
 	now, _ := time.Parse(form, "2020-Nov-22 14:00:00")
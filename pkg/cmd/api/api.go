@@ -57,6 +57,7 @@ type ApiOptions struct {
 	Template            string
 	CacheTTL            time.Duration
 	FilterOutput        string
+	TableColumns        []string
 	Verbose             bool
 }
 
@@ -262,6 +263,14 @@ func NewCmdApi(f *cmdutil.Factory, runF func(*ApiOptions) error) *cobra.Command
 				return err
 			}
 
+			if err := cmdutil.MutuallyExclusive(
+				"the `--table` option is not supported with `--jq`, `--template`, `--silent`, `--verbose`, or `--slurp`",
+				len(opts.TableColumns) > 0,
+				opts.FilterOutput != "" || opts.Template != "" || opts.Silent || opts.Verbose || opts.Slurp,
+			); err != nil {
+				return err
+			}
+
 			if runF != nil {
 				return runF(&opts)
 			}
@@ -282,6 +291,7 @@ func NewCmdApi(f *cmdutil.Factory, runF func(*ApiOptions) error) *cobra.Command
 	cmd.Flags().BoolVar(&opts.Silent, "silent", false, "Do not print the response body")
 	cmd.Flags().StringVarP(&opts.Template, "template", "t", "", "Format JSON output using a Go template; see \"gh help formatting\"")
 	cmd.Flags().StringVarP(&opts.FilterOutput, "jq", "q", "", "Query to select values from the response using jq syntax")
+	cmd.Flags().StringSliceVar(&opts.TableColumns, "table", nil, "Flatten an array-of-objects response into a table with the given comma-separated `columns`; nested values are selected with dotted paths")
 	cmd.Flags().DurationVar(&opts.CacheTTL, "cache", 0, "Cache the response, e.g. \"3600s\", \"60m\", \"1h\"")
 	cmd.Flags().BoolVar(&opts.Verbose, "verbose", false, "Include full HTTP request and response in the output")
 	return cmd
@@ -400,6 +410,11 @@ func apiRun(opts *ApiOptions) error {
 		return err
 	}
 
+	var table *tableProjection
+	if len(opts.TableColumns) > 0 {
+		table = newTableProjection(opts.IO, opts.TableColumns)
+	}
+
 	isFirstPage := true
 	hasNextPage := true
 	for hasNextPage {
@@ -419,7 +434,7 @@ func apiRun(opts *ApiOptions) error {
 			return err
 		}
 
-		endCursor, err := processResponse(resp, opts, bodyWriter, headersWriter, tmpl, isFirstPage, !hasNextPage)
+		endCursor, err := processResponse(resp, opts, bodyWriter, headersWriter, tmpl, table, isFirstPage, !hasNextPage)
 		if err != nil {
 			return err
 		}
@@ -441,10 +456,16 @@ func apiRun(opts *ApiOptions) error {
 		}
 	}
 
+	if table != nil {
+		if err := table.Render(); err != nil {
+			return err
+		}
+	}
+
 	return tmpl.Flush()
 }
 
-func processResponse(resp *http.Response, opts *ApiOptions, bodyWriter, headersWriter io.Writer, template *template.Template, isFirstPage, isLastPage bool) (endCursor string, err error) {
+func processResponse(resp *http.Response, opts *ApiOptions, bodyWriter, headersWriter io.Writer, template *template.Template, table *tableProjection, isFirstPage, isLastPage bool) (endCursor string, err error) {
 	if opts.ShowResponseHeaders {
 		fmt.Fprintln(headersWriter, resp.Proto, resp.Status)
 		printHeaders(headersWriter, resp.Header, opts.IO.ColorEnabled())
@@ -474,7 +495,12 @@ func processResponse(resp *http.Response, opts *ApiOptions, bodyWriter, headersW
 		responseBody = io.TeeReader(responseBody, bodyCopy)
 	}
 
-	if opts.FilterOutput != "" && serverError == "" {
+	if table != nil && serverError == "" {
+		err = table.appendJSON(responseBody)
+		if err != nil {
+			return
+		}
+	} else if opts.FilterOutput != "" && serverError == "" {
 		// TODO: reuse parsed query across pagination invocations
 		indent := ""
 		if opts.IO.IsStdoutTTY() {
@@ -0,0 +1,102 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/cli/cli/v2/internal/tableprinter"
+	"github.com/cli/cli/v2/pkg/iostreams"
+)
+
+// tableProjection accumulates rows projected from JSON response pages and
+// renders them as a single table.
+type tableProjection struct {
+	tp      *tableprinter.TablePrinter
+	columns []string
+}
+
+func newTableProjection(ios *iostreams.IOStreams, columns []string) *tableProjection {
+	return &tableProjection{
+		tp:      tableprinter.New(ios, tableprinter.WithHeader(columns...)),
+		columns: columns,
+	}
+}
+
+// appendJSON projects a JSON response body onto the table columns. The body
+// must be an array of objects, or a single object that forms one row.
+func (t *tableProjection) appendJSON(body io.Reader) error {
+	dec := json.NewDecoder(body)
+	dec.UseNumber()
+	var data interface{}
+	if err := dec.Decode(&data); err != nil {
+		return fmt.Errorf("could not parse response body: %w", err)
+	}
+
+	var rows []interface{}
+	switch v := data.(type) {
+	case []interface{}:
+		rows = v
+	case map[string]interface{}:
+		rows = []interface{}{v}
+	default:
+		return fmt.Errorf("cannot use `--table` with a response that is neither an array nor an object")
+	}
+
+	for _, row := range rows {
+		obj, ok := row.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("cannot use `--table` with an array that contains non-object elements")
+		}
+		for _, col := range t.columns {
+			t.tp.AddField(formatTableValue(digTableValue(obj, col)))
+		}
+		t.tp.EndRow()
+	}
+	return nil
+}
+
+func (t *tableProjection) Render() error {
+	return t.tp.Render()
+}
+
+// digTableValue resolves a dotted-path column selector such as "owner.login"
+// against a decoded JSON object.
+func digTableValue(obj map[string]interface{}, path string) interface{} {
+	var value interface{} = obj
+	for _, key := range strings.Split(path, ".") {
+		m, ok := value.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		value = m[key]
+	}
+	return value
+}
+
+func formatTableValue(v interface{}) string {
+	switch vv := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return vv
+	case json.Number:
+		return vv.String()
+	case bool:
+		return strconv.FormatBool(vv)
+	case []interface{}:
+		parts := make([]string, len(vv))
+		for i, e := range vv {
+			parts[i] = formatTableValue(e)
+		}
+		return strings.Join(parts, ", ")
+	default:
+		b, err := json.Marshal(vv)
+		if err != nil {
+			return fmt.Sprintf("%v", vv)
+		}
+		return string(b)
+	}
+}
@@ -0,0 +1,96 @@
+package api
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_tableProjection_appendJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		columns []string
+		inputs  []string
+		output  string
+		errMsg  string
+	}{
+		{
+			name:    "array of objects",
+			columns: []string{"name", "owner.login", "private"},
+			inputs: []string{
+				`[{"name":"cli","owner":{"login":"cli"},"private":false},{"name":"hub","owner":{"login":"mislav"},"private":true}]`,
+			},
+			output: "cli\tcli\tfalse\nhub\tmislav\ttrue\n",
+		},
+		{
+			name:    "single object",
+			columns: []string{"name", "stargazers_count"},
+			inputs: []string{
+				`{"name":"cli","stargazers_count":12345}`,
+			},
+			output: "cli\t12345\n",
+		},
+		{
+			name:    "multiple pages",
+			columns: []string{"number"},
+			inputs: []string{
+				`[{"number":1},{"number":2}]`,
+				`[{"number":3}]`,
+			},
+			output: "1\n2\n3\n",
+		},
+		{
+			name:    "missing and complex values",
+			columns: []string{"title", "labels", "milestone.title"},
+			inputs: []string{
+				`[{"title":"bug","labels":["p1","needs-triage"]}]`,
+			},
+			output: "bug\tp1, needs-triage\t\n",
+		},
+		{
+			name:    "scalar response",
+			columns: []string{"name"},
+			inputs:  []string{`"hello"`},
+			errMsg:  "cannot use `--table` with a response that is neither an array nor an object",
+		},
+		{
+			name:    "array of scalars",
+			columns: []string{"name"},
+			inputs:  []string{`["one","two"]`},
+			errMsg:  "cannot use `--table` with an array that contains non-object elements",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ios, _, stdout, _ := iostreams.Test()
+			table := newTableProjection(ios, tt.columns)
+
+			var err error
+			for _, input := range tt.inputs {
+				err = table.appendJSON(strings.NewReader(input))
+				if err != nil {
+					break
+				}
+			}
+			if tt.errMsg != "" {
+				assert.EqualError(t, err, tt.errMsg)
+				return
+			}
+			require.NoError(t, err)
+
+			require.NoError(t, table.Render())
+			assert.Equal(t, tt.output, stdout.String())
+		})
+	}
+}
+
+func Test_formatTableValue(t *testing.T) {
+	assert.Equal(t, "", formatTableValue(nil))
+	assert.Equal(t, "open", formatTableValue("open"))
+	assert.Equal(t, "true", formatTableValue(true))
+	assert.Equal(t, `{"login":"monalisa"}`, formatTableValue(map[string]interface{}{"login": "monalisa"}))
+}
@@ -9,6 +9,7 @@ import (
 const (
 	GetAttestationByRepoAndSubjectDigestPath  = "repos/%s/attestations/%s"
 	GetAttestationByOwnerAndSubjectDigestPath = "orgs/%s/attestations/%s"
+	PostAttestationByRepoPath                 = "repos/%s/attestations"
 )
 
 type ErrNoAttestations struct {
@@ -1,6 +1,8 @@
 package api
 
 import (
+	"bytes"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
@@ -28,6 +30,7 @@ type Client interface {
 	GetByRepoAndDigest(repo, digest string, limit int) ([]*Attestation, error)
 	GetByOwnerAndDigest(owner, digest string, limit int) ([]*Attestation, error)
 	GetTrustDomain() (string, error)
+	PostAttestation(repo string, bundle json.RawMessage) error
 }
 
 type LiveClient struct {
@@ -72,6 +75,19 @@ func (c *LiveClient) GetTrustDomain() (string, error) {
 	return c.getTrustDomain(MetaPath)
 }
 
+// PostAttestation uploads a signed bundle for storage alongside the
+// repository's other attestations
+func (c *LiveClient) PostAttestation(repo string, bundle json.RawMessage) error {
+	body, err := json.Marshal(map[string]json.RawMessage{"bundle": bundle})
+	if err != nil {
+		return err
+	}
+
+	repo = strings.Trim(repo, "/")
+	url := fmt.Sprintf(PostAttestationByRepoPath, repo)
+	return c.api.REST(c.host, http.MethodPost, url, bytes.NewReader(body), nil)
+}
+
 // Allow injecting backoff interval in tests.
 var getAttestationRetryInterval = time.Millisecond * 200
 
@@ -1,6 +1,7 @@
 package api
 
 import (
+	"encoding/json"
 	"fmt"
 
 	"github.com/cli/cli/v2/pkg/cmd/attestation/test/data"
@@ -10,6 +11,7 @@ type MockClient struct {
 	OnGetByRepoAndDigest  func(repo, digest string, limit int) ([]*Attestation, error)
 	OnGetByOwnerAndDigest func(owner, digest string, limit int) ([]*Attestation, error)
 	OnGetTrustDomain      func() (string, error)
+	OnPostAttestation     func(repo string, bundle json.RawMessage) error
 }
 
 func (m MockClient) GetByRepoAndDigest(repo, digest string, limit int) ([]*Attestation, error) {
@@ -24,6 +26,10 @@ func (m MockClient) GetTrustDomain() (string, error) {
 	return m.OnGetTrustDomain()
 }
 
+func (m MockClient) PostAttestation(repo string, bundle json.RawMessage) error {
+	return m.OnPostAttestation(repo, bundle)
+}
+
 func makeTestAttestation() Attestation {
 	return Attestation{Bundle: data.SigstoreBundle(nil)}
 }
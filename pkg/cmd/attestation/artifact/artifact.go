@@ -66,6 +66,18 @@ func NewDigestedArtifact(client oci.Client, reference, digestAlg string) (artifa
 	return digestLocalFileArtifact(normalized, digestAlg)
 }
 
+// WithDigest returns a copy of the artifact that keeps its reference but
+// points at a different resolved digest, e.g. a platform manifest within an
+// image index
+func (a *DigestedArtifact) WithDigest(digestAlg, digest string) *DigestedArtifact {
+	return &DigestedArtifact{
+		URL:       a.URL,
+		digest:    digest,
+		digestAlg: digestAlg,
+		nameRef:   a.nameRef,
+	}
+}
+
 // Digest returns the artifact's digest
 func (a *DigestedArtifact) Digest() string {
 	return a.digest
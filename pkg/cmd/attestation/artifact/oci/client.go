@@ -22,9 +22,17 @@ var ErrRegistryAuthz = errors.New("remote registry authorization failed, please
 
 type Client interface {
 	GetImageDigest(imgName string) (*v1.Hash, name.Reference, error)
+	GetPlatformDigests(imgName string) ([]PlatformDigest, error)
 	GetAttestations(name name.Reference, digest string) ([]*api.Attestation, error)
 }
 
+// PlatformDigest pairs a platform in a multi-arch image index with the digest
+// of its platform manifest
+type PlatformDigest struct {
+	Platform string
+	Digest   v1.Hash
+}
+
 func checkForUnauthorizedOrDeniedErr(err transport.Error) error {
 	for _, diagnostic := range err.Errors {
 		switch diagnostic.Code {
@@ -69,6 +77,51 @@ func (c LiveClient) GetImageDigest(imgName string) (*v1.Hash, name.Reference, er
 	return &desc.Digest, name, nil
 }
 
+// GetPlatformDigests returns the platform manifest digests contained in a
+// multi-arch image index. A single-platform image yields no digests.
+func (c LiveClient) GetPlatformDigests(imgName string) ([]PlatformDigest, error) {
+	name, err := c.parseReference(imgName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create image tag: %v", err)
+	}
+	desc, err := c.get(name, remote.WithAuthFromKeychain(authn.DefaultKeychain))
+	if err != nil {
+		var transportErr *transport.Error
+		if errors.As(err, &transportErr) {
+			if accessErr := checkForUnauthorizedOrDeniedErr(*transportErr); accessErr != nil {
+				return nil, accessErr
+			}
+		}
+		return nil, fmt.Errorf("failed to fetch remote image: %v", err)
+	}
+
+	if !desc.MediaType.IsIndex() {
+		return nil, nil
+	}
+
+	idx, err := desc.ImageIndex()
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch image index: %v", err)
+	}
+	manifest, err := idx.IndexManifest()
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch index manifest: %v", err)
+	}
+
+	var platforms []PlatformDigest
+	for _, m := range manifest.Manifests {
+		// skip non-platform entries such as buildkit attestation manifests
+		if m.Platform == nil || m.Platform.OS == "unknown" {
+			continue
+		}
+		platforms = append(platforms, PlatformDigest{
+			Platform: m.Platform.String(),
+			Digest:   m.Digest,
+		})
+	}
+	return platforms, nil
+}
+
 type noncompliantRegistryTransport struct{}
 
 // RoundTrip will check if a request and associated response fulfill the following:
@@ -2,6 +2,7 @@ package oci
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/cli/cli/v2/pkg/cmd/attestation/api"
 	"github.com/cli/cli/v2/pkg/cmd/attestation/test/data"
@@ -22,6 +23,10 @@ func (c MockClient) GetImageDigest(imgName string) (*v1.Hash, name.Reference, er
 	}, nil, nil
 }
 
+func (c MockClient) GetPlatformDigests(imgName string) ([]PlatformDigest, error) {
+	return nil, nil
+}
+
 func (c MockClient) GetAttestations(name name.Reference, digest string) ([]*api.Attestation, error) {
 	att1 := makeTestAttestation()
 	att2 := makeTestAttestation()
@@ -34,6 +39,10 @@ func (c ReferenceFailClient) GetImageDigest(imgName string) (*v1.Hash, name.Refe
 	return nil, nil, fmt.Errorf("failed to parse reference")
 }
 
+func (c ReferenceFailClient) GetPlatformDigests(imgName string) ([]PlatformDigest, error) {
+	return nil, nil
+}
+
 func (c ReferenceFailClient) GetAttestations(name name.Reference, digest string) ([]*api.Attestation, error) {
 	return nil, nil
 }
@@ -44,6 +53,10 @@ func (c AuthFailClient) GetImageDigest(imgName string) (*v1.Hash, name.Reference
 	return nil, nil, ErrRegistryAuthz
 }
 
+func (c AuthFailClient) GetPlatformDigests(imgName string) ([]PlatformDigest, error) {
+	return nil, nil
+}
+
 func (c AuthFailClient) GetAttestations(name name.Reference, digest string) ([]*api.Attestation, error) {
 	return nil, nil
 }
@@ -54,6 +67,10 @@ func (c DeniedClient) GetImageDigest(imgName string) (*v1.Hash, name.Reference,
 	return nil, nil, ErrDenied
 }
 
+func (c DeniedClient) GetPlatformDigests(imgName string) ([]PlatformDigest, error) {
+	return nil, nil
+}
+
 func (c DeniedClient) GetAttestations(name name.Reference, digest string) ([]*api.Attestation, error) {
 	return nil, nil
 }
@@ -67,6 +84,10 @@ func (c NoAttestationsClient) GetImageDigest(imgName string) (*v1.Hash, name.Ref
 	}, nil, nil
 }
 
+func (c NoAttestationsClient) GetPlatformDigests(imgName string) ([]PlatformDigest, error) {
+	return nil, nil
+}
+
 func (c NoAttestationsClient) GetAttestations(name name.Reference, digest string) ([]*api.Attestation, error) {
 	return nil, nil
 }
@@ -80,6 +101,38 @@ func (c FailedToFetchAttestationsClient) GetImageDigest(imgName string) (*v1.Has
 	}, nil, nil
 }
 
+func (c FailedToFetchAttestationsClient) GetPlatformDigests(imgName string) ([]PlatformDigest, error) {
+	return nil, nil
+}
+
 func (c FailedToFetchAttestationsClient) GetAttestations(name name.Reference, digest string) ([]*api.Attestation, error) {
 	return nil, fmt.Errorf("failed to fetch attestations")
 }
+
+type MultiPlatformClient struct{}
+
+func (c MultiPlatformClient) GetImageDigest(imgName string) (*v1.Hash, name.Reference, error) {
+	return &v1.Hash{
+		Hex:       "1234567890abcdef",
+		Algorithm: "sha256",
+	}, nil, nil
+}
+
+func (c MultiPlatformClient) GetPlatformDigests(imgName string) ([]PlatformDigest, error) {
+	return []PlatformDigest{
+		{
+			Platform: "linux/amd64",
+			Digest:   v1.Hash{Algorithm: "sha256", Hex: strings.Repeat("aa", 32)},
+		},
+		{
+			Platform: "linux/arm64",
+			Digest:   v1.Hash{Algorithm: "sha256", Hex: strings.Repeat("bb", 32)},
+		},
+	}, nil
+}
+
+func (c MultiPlatformClient) GetAttestations(name name.Reference, digest string) ([]*api.Attestation, error) {
+	att1 := makeTestAttestation()
+	att2 := makeTestAttestation()
+	return []*api.Attestation{&att1, &att2}, nil
+}
@@ -4,6 +4,8 @@ import (
 	"github.com/MakeNowJust/heredoc"
 	"github.com/cli/cli/v2/pkg/cmd/attestation/download"
 	"github.com/cli/cli/v2/pkg/cmd/attestation/inspect"
+	"github.com/cli/cli/v2/pkg/cmd/attestation/list"
+	"github.com/cli/cli/v2/pkg/cmd/attestation/sign"
 	"github.com/cli/cli/v2/pkg/cmd/attestation/trustedroot"
 	"github.com/cli/cli/v2/pkg/cmd/attestation/verify"
 	"github.com/cli/cli/v2/pkg/cmdutil"
@@ -17,12 +19,14 @@ func NewCmdAttestation(f *cmdutil.Factory) *cobra.Command {
 		Short:   "Work with artifact attestations",
 		Aliases: []string{"at"},
 		Long: heredoc.Doc(`
-			Download and verify artifact attestations.
+			Sign, download, and verify artifact attestations.
 		`),
 	}
 
 	root.AddCommand(download.NewDownloadCmd(f, nil))
 	root.AddCommand(inspect.NewInspectCmd(f, nil))
+	root.AddCommand(list.NewListCmd(f, nil))
+	root.AddCommand(sign.NewSignCmd(f, nil))
 	root.AddCommand(verify.NewVerifyCmd(f, nil))
 	root.AddCommand(trustedroot.NewTrustedRootCmd(f, nil))
 
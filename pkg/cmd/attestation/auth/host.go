@@ -1,16 +1,19 @@
 package auth
 
 import (
-	"errors"
+	"net/http"
+
+	"github.com/cli/cli/v2/internal/ghversion"
 
 	ghauth "github.com/cli/go-gh/v2/pkg/auth"
 )
 
-var ErrUnsupportedHost = errors.New("An unsupported host was detected. Note that gh attestation does not currently support GHES")
-
-func IsHostSupported(host string) error {
-	if ghauth.IsEnterprise(host) {
-		return ErrUnsupportedHost
+// IsHostSupported returns an error when host is a GitHub Enterprise Server
+// instance whose installed version predates attestation support, so that
+// commands fail with a clear version requirement instead of a confusing 404.
+func IsHostSupported(httpClient *http.Client, host string) error {
+	if !ghauth.IsEnterprise(host) {
+		return nil
 	}
-	return nil
+	return ghversion.CheckCapability(ghversion.NewDetector(httpClient), host, ghversion.Attestations)
 }
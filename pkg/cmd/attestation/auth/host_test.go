@@ -1,8 +1,12 @@
 package auth
 
 import (
+	"net/http"
 	"testing"
 
+	"github.com/cli/cli/v2/internal/ghversion"
+	"github.com/cli/cli/v2/pkg/httpmock"
+
 	ghauth "github.com/cli/go-gh/v2/pkg/auth"
 
 	"github.com/stretchr/testify/require"
@@ -10,29 +14,37 @@ import (
 
 func TestIsHostSupported(t *testing.T) {
 	testcases := []struct {
-		name        string
-		expectedErr bool
-		host        string
+		name             string
+		host             string
+		installedVersion string
+		expectedErr      string
 	}{
 		{
-			name:        "Default github.com host",
-			expectedErr: false,
-			host:        "github.com",
+			name: "Default github.com host",
+			host: "github.com",
+		},
+		{
+			name: "Localhost",
+			host: "github.localhost",
 		},
 		{
-			name:        "Localhost",
-			expectedErr: false,
-			host:        "github.localhost",
+			name: "No host set",
+			host: "",
 		},
 		{
-			name:        "No host set",
-			expectedErr: false,
-			host:        "",
+			name: "GHE tenant host",
+			host: "some-tenant.ghe.com",
 		},
 		{
-			name:        "GHE tenant host",
-			expectedErr: false,
-			host:        "some-tenant.ghe.com",
+			name:             "GHES host with attestation support",
+			host:             "ghes.example.com",
+			installedVersion: "3.15.1",
+		},
+		{
+			name:             "GHES host predating attestation support",
+			host:             "ghes.example.com",
+			installedVersion: "3.14.0",
+			expectedErr:      "Attestation verification requires GitHub Enterprise Server 3.15 or later, but ghes.example.com is running 3.14.0",
 		},
 	}
 
@@ -40,10 +52,20 @@ func TestIsHostSupported(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			t.Setenv("GH_HOST", tc.host)
 
+			reg := &httpmock.Registry{}
+			defer reg.Verify(t)
+			if tc.installedVersion != "" {
+				reg.Register(
+					httpmock.REST("GET", "api/v3/meta"),
+					httpmock.StringResponse(`{"installed_version":"`+tc.installedVersion+`"}`))
+			}
+
 			host, _ := ghauth.DefaultHost()
-			err := IsHostSupported(host)
-			if tc.expectedErr {
-				require.ErrorIs(t, err, ErrUnsupportedHost)
+			err := IsHostSupported(&http.Client{Transport: reg}, host)
+			if tc.expectedErr != "" {
+				var capErr *ghversion.CapabilityError
+				require.ErrorAs(t, err, &capErr)
+				require.EqualError(t, err, tc.expectedErr)
 			} else {
 				require.NoError(t, err)
 			}
@@ -83,7 +83,7 @@ func NewDownloadCmd(f *cmdutil.Factory, runF func(*Options) error) *cobra.Comman
 			if opts.Hostname == "" {
 				opts.Hostname, _ = ghauth.DefaultHost()
 			}
-			if err := auth.IsHostSupported(opts.Hostname); err != nil {
+			if err := auth.IsHostSupported(hc, opts.Hostname); err != nil {
 				return err
 			}
 
@@ -73,7 +73,11 @@ func NewInspectCmd(f *cmdutil.Factory, runF func(*Options) error) *cobra.Command
 				opts.Hostname, _ = ghauth.DefaultHost()
 			}
 
-			if err := auth.IsHostSupported(opts.Hostname); err != nil {
+			hc, err := f.HttpClient()
+			if err != nil {
+				return err
+			}
+			if err := auth.IsHostSupported(hc, opts.Hostname); err != nil {
 				return err
 			}
 
@@ -86,10 +90,6 @@ func NewInspectCmd(f *cmdutil.Factory, runF func(*Options) error) *cobra.Command
 			}
 			// Prepare for tenancy if detected
 			if ghauth.IsTenancy(opts.Hostname) {
-				hc, err := f.HttpClient()
-				if err != nil {
-					return err
-				}
 				apiClient := api.NewLiveClient(hc, opts.Hostname, opts.Logger)
 				td, err := apiClient.GetTrustDomain()
 				if err != nil {
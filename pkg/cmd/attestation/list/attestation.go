@@ -0,0 +1,69 @@
+package list
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/cli/cli/v2/pkg/cmd/attestation/api"
+	"github.com/sigstore/sigstore-go/pkg/fulcio/certificate"
+)
+
+// AttestationDetail captures the details of a single attestation
+// that will be returned by the list command
+type AttestationDetail struct {
+	PredicateType    string `json:"predicateType"`
+	WorkflowIdentity string `json:"workflowIdentity"`
+	Timestamp        string `json:"timestamp"`
+	BundleID         string `json:"bundleId"`
+}
+
+type statement struct {
+	PredicateType string `json:"predicateType"`
+}
+
+func getAttestationDetail(att *api.Attestation) (AttestationDetail, error) {
+	var detail AttestationDetail
+
+	envelope := att.Bundle.Bundle.GetDsseEnvelope()
+	if envelope != nil {
+		var s statement
+		if err := json.Unmarshal(envelope.GetPayload(), &s); err != nil {
+			return detail, fmt.Errorf("failed to parse in-toto statement: %v", err)
+		}
+		detail.PredicateType = s.PredicateType
+	}
+
+	if vc, err := att.Bundle.VerificationContent(); err == nil {
+		if cert := vc.GetCertificate(); cert != nil {
+			summary, err := certificate.SummarizeCertificate(cert)
+			if err != nil {
+				return detail, fmt.Errorf("failed to parse signing certificate: %v", err)
+			}
+			detail.WorkflowIdentity = summary.SubjectAlternativeName
+		}
+	}
+
+	entries, err := att.Bundle.TlogEntries()
+	if err != nil {
+		return detail, fmt.Errorf("failed to parse transparency log entries: %v", err)
+	}
+	if len(entries) > 0 {
+		detail.Timestamp = entries[0].IntegratedTime().UTC().Format(time.RFC3339)
+		detail.BundleID = fmt.Sprintf("%d", entries[0].LogIndex())
+	}
+
+	return detail, nil
+}
+
+func getAttestationDetails(attestations []*api.Attestation) ([]AttestationDetail, error) {
+	details := make([]AttestationDetail, len(attestations))
+	for i, att := range attestations {
+		detail, err := getAttestationDetail(att)
+		if err != nil {
+			return nil, err
+		}
+		details[i] = detail
+	}
+	return details, nil
+}
@@ -0,0 +1,166 @@
+package list
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/cli/cli/v2/internal/tableprinter"
+	"github.com/cli/cli/v2/pkg/cmd/attestation/api"
+	"github.com/cli/cli/v2/pkg/cmd/attestation/auth"
+	"github.com/cli/cli/v2/pkg/cmd/attestation/io"
+	"github.com/cli/cli/v2/pkg/cmd/attestation/verification"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	ghauth "github.com/cli/go-gh/v2/pkg/auth"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+)
+
+func NewListCmd(f *cmdutil.Factory, runF func(*Options) error) *cobra.Command {
+	opts := &Options{}
+	listCmd := &cobra.Command{
+		Use:   "list --digest <algorithm>:<hex-digest> [--owner | --repo]",
+		Args:  cobra.NoArgs,
+		Short: "List attestations for a subject digest",
+		Long: heredoc.Docf(`
+			### NOTE: This feature is currently in public preview, and subject to change.
+
+			List the attestations associated with a subject digest.
+
+			The command requires the %[1]s--digest%[1]s flag, which provides the digest
+			of the subject to look up attestations for (e.g. %[1]ssha256:abc123%[1]s).
+
+			In addition, the command requires either:
+			* the %[1]s--repo%[1]s flag (e.g. --repo github/example).
+			* the %[1]s--owner%[1]s flag (e.g. --owner github), or
+
+			The %[1]s--repo%[1]s flag value must match the name of the GitHub repository
+			that the artifact is linked with.
+
+			The %[1]s--owner%[1]s flag value must match the name of the GitHub organization
+			that the artifact's linked repository belongs to.
+
+			For each attestation, the command prints its predicate type, the identity
+			of the workflow that signed it, its transparency log timestamp, and its
+			transparency log index, so you can see what exists before running the
+			%[1]sverify%[1]s command.
+		`, "`"),
+		Example: heredoc.Doc(`
+			# List attestations for a digest linked with an organization
+			$ gh attestation list --digest sha256:abc123 -o github
+
+			# List attestations for a digest linked with a repository
+			$ gh attestation list --digest sha256:abc123 -R github/example
+		`),
+		// PreRunE is used to validate flags before the command is run
+		// If an error is returned, its message will be printed to the terminal
+		// along with information about how use the command
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			// Create a logger for use throughout the list command
+			opts.Logger = io.NewHandler(f.IOStreams)
+
+			// check that the provided flags are valid
+			if err := opts.AreFlagsValid(); err != nil {
+				return err
+			}
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			hc, err := f.HttpClient()
+			if err != nil {
+				return err
+			}
+
+			if opts.Hostname == "" {
+				opts.Hostname, _ = ghauth.DefaultHost()
+			}
+			if err := auth.IsHostSupported(hc, opts.Hostname); err != nil {
+				return err
+			}
+
+			opts.APIClient = api.NewLiveClient(hc, opts.Hostname, opts.Logger)
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			if err := runList(opts); err != nil {
+				return fmt.Errorf("Failed to list attestations: %v", err)
+			}
+			return nil
+		},
+	}
+
+	listCmd.Flags().StringVarP(&opts.Digest, "digest", "", "", "The digest of the subject to list attestations for, in the format <algorithm>:<hex-digest>")
+	listCmd.MarkFlagRequired("digest") //nolint:errcheck
+	listCmd.Flags().StringVarP(&opts.Owner, "owner", "o", "", "a GitHub organization to scope attestation lookup by")
+	listCmd.Flags().StringVarP(&opts.Repo, "repo", "R", "", "Repository name in the format <owner>/<repo>")
+	listCmd.MarkFlagsMutuallyExclusive("owner", "repo")
+	listCmd.MarkFlagsOneRequired("owner", "repo")
+	listCmd.Flags().StringVarP(&opts.PredicateType, "predicate-type", "", "", "Filter attestations by provided predicate type")
+	listCmd.Flags().IntVarP(&opts.Limit, "limit", "L", api.DefaultLimit, "Maximum number of attestations to fetch")
+	listCmd.Flags().StringVarP(&opts.Hostname, "hostname", "", "", "Configure host to use")
+	cmdutil.AddFormatFlags(listCmd, &opts.exporter)
+
+	return listCmd
+}
+
+func runList(opts *Options) error {
+	params := verification.FetchRemoteAttestationsParams{
+		Digest: opts.Digest,
+		Limit:  opts.Limit,
+		Owner:  opts.Owner,
+		Repo:   opts.Repo,
+	}
+	attestations, err := verification.GetRemoteAttestations(opts.APIClient, params)
+	if err != nil {
+		if errors.Is(err, api.ErrNoAttestations{}) {
+			fmt.Fprintf(opts.Logger.IO.Out, "No attestations found for %s\n", opts.Digest)
+			return nil
+		}
+		return fmt.Errorf("failed to fetch attestations: %v", err)
+	}
+
+	// Apply predicate type filter to returned attestations
+	if opts.PredicateType != "" {
+		filteredAttestations := verification.FilterAttestations(opts.PredicateType, attestations)
+
+		if len(filteredAttestations) == 0 {
+			return fmt.Errorf("no attestations found with predicate type: %s", opts.PredicateType)
+		}
+
+		attestations = filteredAttestations
+	}
+
+	details, err := getAttestationDetails(attestations)
+	if err != nil {
+		return fmt.Errorf("failed to parse attestation details: %v", err)
+	}
+
+	// If the user provides the --format=json flag, print the results in JSON format
+	if opts.exporter != nil {
+		// print the results to the terminal as an array of JSON objects
+		if err := opts.exporter.Write(opts.Logger.IO, details); err != nil {
+			return fmt.Errorf("failed to write JSON output")
+		}
+		return nil
+	}
+
+	// otherwise, print results in a table
+	headers := []string{"Predicate Type", "Workflow Identity", "Timestamp", "Bundle ID"}
+	t := tableprinter.New(opts.Logger.IO, tableprinter.WithHeader(headers...))
+
+	for _, detail := range details {
+		t.AddField(detail.PredicateType, tableprinter.WithTruncate(nil))
+		t.AddField(detail.WorkflowIdentity, tableprinter.WithTruncate(nil))
+		t.AddField(detail.Timestamp, tableprinter.WithTruncate(nil))
+		t.AddField(detail.BundleID, tableprinter.WithTruncate(nil))
+		t.EndRow()
+	}
+
+	if err := t.Render(); err != nil {
+		return fmt.Errorf("failed to print output: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,171 @@
+package list
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/cli/cli/v2/pkg/cmd/attestation/api"
+	"github.com/cli/cli/v2/pkg/cmd/attestation/io"
+	"github.com/cli/cli/v2/pkg/cmd/attestation/test/data"
+	"github.com/cli/cli/v2/pkg/cmd/attestation/verification"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testDigest = "sha256:d962d9287de7d7837e1eb72d9acc9e033ab81b6996a1f1f26120cfd07b1be4bc"
+
+func TestNewListCmd(t *testing.T) {
+	testIO, _, _, _ := iostreams.Test()
+	f := &cmdutil.Factory{
+		IOStreams: testIO,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{}, nil
+		},
+	}
+
+	testcases := []struct {
+		name     string
+		cli      string
+		wants    Options
+		wantsErr bool
+	}{
+		{
+			name: "Defaults",
+			cli:  fmt.Sprintf("--digest %s --owner sigstore", testDigest),
+			wants: Options{
+				Digest:   testDigest,
+				Owner:    "sigstore",
+				Limit:    30,
+				Hostname: "github.com",
+			},
+		},
+		{
+			name: "Uses custom limit and repo flags",
+			cli:  fmt.Sprintf("--digest %s --repo sigstore/sigstore-js --limit 101", testDigest),
+			wants: Options{
+				Digest:   testDigest,
+				Repo:     "sigstore/sigstore-js",
+				Limit:    101,
+				Hostname: "github.com",
+			},
+		},
+		{
+			name:     "Missing digest flag",
+			cli:      "--owner sigstore",
+			wantsErr: true,
+		},
+		{
+			name:     "Invalid digest",
+			cli:      "--digest d962d928 --owner sigstore",
+			wantsErr: true,
+		},
+		{
+			name:     "Missing owner and repo flags",
+			cli:      fmt.Sprintf("--digest %s", testDigest),
+			wantsErr: true,
+		},
+		{
+			name:     "Has both owner and repo flags",
+			cli:      fmt.Sprintf("--digest %s --owner sigstore --repo sigstore/sigstore-js", testDigest),
+			wantsErr: true,
+		},
+		{
+			name:     "Invalid limit flag",
+			cli:      fmt.Sprintf("--digest %s --owner sigstore --limit 0", testDigest),
+			wantsErr: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			var opts *Options
+			cmd := NewListCmd(f, func(o *Options) error {
+				opts = o
+				return nil
+			})
+
+			argv := strings.Split(tc.cli, " ")
+			cmd.SetArgs(argv)
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+			_, err := cmd.ExecuteC()
+			if tc.wantsErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+
+			assert.Equal(t, tc.wants.Digest, opts.Digest)
+			assert.Equal(t, tc.wants.Owner, opts.Owner)
+			assert.Equal(t, tc.wants.Repo, opts.Repo)
+			assert.Equal(t, tc.wants.Limit, opts.Limit)
+			assert.Equal(t, tc.wants.Hostname, opts.Hostname)
+			assert.NotNil(t, opts.APIClient)
+			assert.NotNil(t, opts.Logger)
+		})
+	}
+}
+
+func TestGetAttestationDetail(t *testing.T) {
+	att := api.Attestation{Bundle: data.SigstoreBundle(t)}
+
+	detail, err := getAttestationDetail(&att)
+	require.NoError(t, err)
+
+	assert.Equal(t, verification.SLSAPredicateV1, detail.PredicateType)
+	assert.Equal(t, "https://github.com/sigstore/sigstore-js/.github/workflows/release.yml@refs/heads/main", detail.WorkflowIdentity)
+	assert.Equal(t, "2023-08-29T15:40:23Z", detail.Timestamp)
+	assert.Equal(t, "33351527", detail.BundleID)
+}
+
+func TestRunList(t *testing.T) {
+	t.Run("prints a table of attestation details", func(t *testing.T) {
+		testIO, _, stdout, _ := iostreams.Test()
+		opts := &Options{
+			APIClient: api.NewTestClient(),
+			Digest:    testDigest,
+			Owner:     "sigstore",
+			Limit:     30,
+			Logger:    io.NewHandler(testIO),
+		}
+
+		require.NoError(t, runList(opts))
+
+		assert.Contains(t, stdout.String(), verification.SLSAPredicateV1)
+		assert.Contains(t, stdout.String(), "33351527")
+	})
+
+	t.Run("with no matching predicate type", func(t *testing.T) {
+		opts := &Options{
+			APIClient:     api.NewTestClient(),
+			Digest:        testDigest,
+			Owner:         "sigstore",
+			Limit:         30,
+			PredicateType: "https://example.com/predicate/v1",
+			Logger:        io.NewTestHandler(),
+		}
+
+		err := runList(opts)
+		require.ErrorContains(t, err, "no attestations found with predicate type")
+	})
+
+	t.Run("with a failing API client", func(t *testing.T) {
+		opts := &Options{
+			APIClient: api.NewFailTestClient(),
+			Digest:    testDigest,
+			Owner:     "sigstore",
+			Limit:     30,
+			Logger:    io.NewTestHandler(),
+		}
+
+		err := runList(opts)
+		require.ErrorContains(t, err, "failed to fetch attestations")
+	})
+}
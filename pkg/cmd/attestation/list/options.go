@@ -0,0 +1,44 @@
+package list
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/cli/cli/v2/pkg/cmd/attestation/api"
+	"github.com/cli/cli/v2/pkg/cmd/attestation/io"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+)
+
+const (
+	minLimit = 1
+	maxLimit = 1000
+)
+
+var digestRegex = regexp.MustCompile(`^sha(256|512):[a-f0-9]+$`)
+
+// Options captures the options for the list command
+type Options struct {
+	APIClient     api.Client
+	Digest        string
+	Logger        *io.Handler
+	Limit         int
+	Owner         string
+	PredicateType string
+	Repo          string
+	Hostname      string
+	exporter      cmdutil.Exporter
+}
+
+// AreFlagsValid checks that the provided flag combination is valid
+func (opts *Options) AreFlagsValid() error {
+	if !digestRegex.MatchString(opts.Digest) {
+		return fmt.Errorf("invalid digest %s, must be in the format <algorithm>:<hex-digest>, e.g. sha256:abc123", opts.Digest)
+	}
+
+	// Check that limit is between 1 and 1000
+	if opts.Limit < minLimit || opts.Limit > maxLimit {
+		return fmt.Errorf("limit %d not allowed, must be between %d and %d", opts.Limit, minLimit, maxLimit)
+	}
+
+	return nil
+}
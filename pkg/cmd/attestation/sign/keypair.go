@@ -0,0 +1,122 @@
+package sign
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"os"
+
+	protocommon "github.com/sigstore/protobuf-specs/gen/pb-go/common/v1"
+	sigstoreSign "github.com/sigstore/sigstore-go/pkg/sign"
+)
+
+// staticKeypair implements the sigstore-go Keypair interface for a
+// caller-provided private key, mirroring the behavior of EphemeralKeypair
+type staticKeypair struct {
+	privateKey crypto.Signer
+	hint       []byte
+}
+
+// loadKeypair reads a PEM-encoded private key from disk for signing
+func loadKeypair(path string) (*staticKeypair, error) {
+	pemBytes, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read key file %s: %w", path, err)
+	}
+
+	block, _ := pem.Decode(pemBytes)
+	if block == nil {
+		return nil, fmt.Errorf("no PEM data found in key file %s", path)
+	}
+
+	var key any
+	switch block.Type {
+	case "PRIVATE KEY":
+		key, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	case "EC PRIVATE KEY":
+		key, err = x509.ParseECPrivateKey(block.Bytes)
+	case "RSA PRIVATE KEY":
+		key, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	default:
+		return nil, fmt.Errorf("unsupported PEM block type %q in key file %s", block.Type, path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse private key: %w", err)
+	}
+
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("key file %s does not contain a signing key", path)
+	}
+
+	// ed25519 keys cannot sign a pre-hashed message, which the bundle
+	// signing flow requires
+	switch signer.Public().(type) {
+	case *ecdsa.PublicKey, *rsa.PublicKey:
+	default:
+		return nil, fmt.Errorf("unsupported key type in %s: only ECDSA and RSA keys are supported", path)
+	}
+
+	pubKeyBytes, err := x509.MarshalPKIXPublicKey(signer.Public())
+	if err != nil {
+		return nil, err
+	}
+	hashedBytes := sha256.Sum256(pubKeyBytes)
+
+	return &staticKeypair{
+		privateKey: signer,
+		hint:       []byte(base64.StdEncoding.EncodeToString(hashedBytes[:])),
+	}, nil
+}
+
+func (k *staticKeypair) GetHashAlgorithm() protocommon.HashAlgorithm {
+	return protocommon.HashAlgorithm_SHA2_256
+}
+
+func (k *staticKeypair) GetHint() []byte {
+	return k.hint
+}
+
+func (k *staticKeypair) GetKeyAlgorithm() string {
+	switch k.privateKey.Public().(type) {
+	case *ecdsa.PublicKey:
+		return "ECDSA"
+	case *rsa.PublicKey:
+		return "RSA"
+	default:
+		return ""
+	}
+}
+
+func (k *staticKeypair) GetPublicKeyPem() (string, error) {
+	pubKeyBytes, err := x509.MarshalPKIXPublicKey(k.privateKey.Public())
+	if err != nil {
+		return "", err
+	}
+	return string(pem.EncodeToMemory(&pem.Block{
+		Type:  "PUBLIC KEY",
+		Bytes: pubKeyBytes,
+	})), nil
+}
+
+func (k *staticKeypair) SignData(data []byte) ([]byte, []byte, error) {
+	hasher := sha256.New()
+	hasher.Write(data)
+	digest := hasher.Sum(nil)
+
+	signature, err := k.privateKey.Sign(rand.Reader, digest, crypto.SHA256)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return signature, digest, nil
+}
+
+// staticKeypair must satisfy the interface expected by sigstore-go
+var _ sigstoreSign.Keypair = (*staticKeypair)(nil)
@@ -0,0 +1,54 @@
+package sign
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+)
+
+// actionsIDTokenAudience is the audience Fulcio expects in tokens minted by
+// the GitHub Actions OIDC provider
+const actionsIDTokenAudience = "sigstore"
+
+// actionsIDToken requests an OIDC token identifying the current workflow run
+// from the GitHub Actions runtime. This is the identity that Fulcio certifies
+// during keyless signing.
+func actionsIDToken() (string, error) {
+	requestURL := os.Getenv("ACTIONS_ID_TOKEN_REQUEST_URL")
+	requestToken := os.Getenv("ACTIONS_ID_TOKEN_REQUEST_TOKEN")
+	if requestURL == "" || requestToken == "" {
+		return "", errors.New("keyless signing requires the GitHub Actions OIDC environment (grant the workflow the `id-token: write` permission), or a signing key provided with --key")
+	}
+
+	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s&audience=%s", requestURL, actionsIDTokenAudience), nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Authorization", fmt.Sprintf("bearer %s", requestToken))
+
+	client := &http.Client{Timeout: 30 * time.Second}
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to request OIDC token: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("failed to request OIDC token: HTTP %d", resp.StatusCode)
+	}
+
+	var payload struct {
+		Value string `json:"value"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&payload); err != nil {
+		return "", fmt.Errorf("failed to decode OIDC token response: %w", err)
+	}
+	if payload.Value == "" {
+		return "", errors.New("the GitHub Actions OIDC provider returned an empty token")
+	}
+
+	return payload.Value, nil
+}
@@ -0,0 +1,77 @@
+package sign
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/cli/cli/v2/internal/ghinstance"
+	"github.com/cli/cli/v2/pkg/cmd/attestation/api"
+	"github.com/cli/cli/v2/pkg/cmd/attestation/io"
+)
+
+// Options captures the options for the sign command
+type Options struct {
+	ArtifactPath    string
+	BundlePath      string
+	DigestAlgorithm string
+	KeyPath         string
+	PredicatePath   string
+	PredicateType   string
+	Repo            string
+	Upload          bool
+	APIClient       api.Client
+	Logger          *io.Handler
+	Hostname        string
+}
+
+// Clean cleans the file path option values and fills in the default
+// bundle output path
+func (opts *Options) Clean() {
+	if opts.BundlePath == "" {
+		opts.BundlePath = fmt.Sprintf("%s.sigstore.json", opts.ArtifactPath)
+	}
+	opts.BundlePath = filepath.Clean(opts.BundlePath)
+
+	if opts.KeyPath != "" {
+		opts.KeyPath = filepath.Clean(opts.KeyPath)
+	}
+	if opts.PredicatePath != "" {
+		opts.PredicatePath = filepath.Clean(opts.PredicatePath)
+	}
+}
+
+// AreFlagsValid checks that the provided flag combination is valid
+// and returns an error otherwise
+func (opts *Options) AreFlagsValid() error {
+	// Only local artifacts can be signed; digesting an OCI image would
+	// require registry access and the bundle should travel with the artifact
+	if strings.HasPrefix(opts.ArtifactPath, "oci://") {
+		return fmt.Errorf("signing OCI images is not supported, provide a file path instead")
+	}
+
+	// If provided, check that the Repo option is in the expected format <OWNER>/<REPO>
+	if opts.Repo != "" && !isProvidedRepoValid(opts.Repo) {
+		return fmt.Errorf("invalid value provided for repo: %s", opts.Repo)
+	}
+
+	// Uploading requires knowing which repository stores the attestation
+	if opts.Upload && opts.Repo == "" {
+		return fmt.Errorf("upload flag requires the repo flag")
+	}
+
+	// Verify provided hostname
+	if opts.Hostname != "" {
+		if err := ghinstance.HostnameValidator(opts.Hostname); err != nil {
+			return fmt.Errorf("error parsing hostname: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func isProvidedRepoValid(repo string) bool {
+	// we expect a provided repository argument be in the format <OWNER>/<REPO>
+	splitRepo := strings.Split(repo, "/")
+	return len(splitRepo) == 2
+}
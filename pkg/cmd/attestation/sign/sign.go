@@ -0,0 +1,274 @@
+package sign
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/cli/cli/v2/pkg/cmd/attestation/api"
+	"github.com/cli/cli/v2/pkg/cmd/attestation/artifact"
+	"github.com/cli/cli/v2/pkg/cmd/attestation/auth"
+	"github.com/cli/cli/v2/pkg/cmd/attestation/io"
+	"github.com/cli/cli/v2/pkg/cmd/attestation/verification"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	ghauth "github.com/cli/go-gh/v2/pkg/auth"
+	"github.com/sigstore/sigstore-go/pkg/bundle"
+	sigstoreSign "github.com/sigstore/sigstore-go/pkg/sign"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// Public Good Sigstore instance endpoints used for keyless signing
+	fulcioURL = "https://fulcio.sigstore.dev"
+	rekorURL  = "https://rekor.sigstore.dev"
+
+	inTotoStatementType = "https://in-toto.io/Statement/v1"
+	inTotoPayloadType   = "application/vnd.in-toto+json"
+)
+
+func NewSignCmd(f *cmdutil.Factory, runF func(*Options) error) *cobra.Command {
+	opts := &Options{}
+	signCmd := &cobra.Command{
+		Use:   "sign <file-path>",
+		Args:  cmdutil.ExactArgs(1, "must specify file path of artifact to sign"),
+		Short: "Sign an artifact and produce a Sigstore bundle",
+		Long: heredoc.Docf(`
+			Sign a local artifact, producing a Sigstore bundle that can later be checked
+			with %[1]sgh attestation verify%[1]s.
+
+			The command wraps the artifact's digest in an in-toto statement, signs it, and
+			writes the resulting bundle to disk. By default the bundle is written next to
+			the artifact as %[1]s<file-path>.sigstore.json%[1]s; use %[1]s--bundle-path%[1]s to
+			choose a different location.
+
+			By default, signing is keyless: an ephemeral key is certified by Sigstore Fulcio
+			using the identity of the running GitHub Actions workflow, and the signature is
+			recorded in the Rekor transparency log. Keyless signing requires the workflow to
+			have the %[1]sid-token: write%[1]s permission.
+
+			Alternatively, provide a PEM-encoded private key with %[1]s--key%[1]s to sign
+			without contacting Sigstore. Bundles signed this way can be verified against a
+			custom trusted root that contains the corresponding public key.
+
+			The statement's predicate is empty by default. Use %[1]s--predicate%[1]s to
+			provide a JSON file with the predicate content, and %[1]s--predicate-type%[1]s to
+			label it.
+
+			With %[1]s--upload%[1]s, the signed bundle is also uploaded to the GitHub
+			attestations API for the repository given with %[1]s--repo%[1]s, making it
+			discoverable by %[1]sgh attestation verify%[1]s and %[1]sgh attestation download%[1]s.
+		`, "`"),
+		Example: heredoc.Doc(`
+			# Sign an artifact using the identity of the running workflow
+			$ gh attestation sign example.bin
+
+			# Sign an artifact with a private key and a custom predicate
+			$ gh attestation sign example.bin --key key.pem --predicate sbom.json --predicate-type https://spdx.dev/Document/v2.3
+
+			# Sign an artifact and upload the bundle to the repository's attestations
+			$ gh attestation sign example.bin --upload --repo github/example
+		`),
+		// PreRunE is used to validate flags before the command is run
+		// If an error is returned, its message will be printed to the terminal
+		// along with information about how use the command
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			// Create a logger for use throughout the sign command
+			opts.Logger = io.NewHandler(f.IOStreams)
+
+			// set the artifact path
+			opts.ArtifactPath = args[0]
+
+			// Check that the given flag combination is valid
+			if err := opts.AreFlagsValid(); err != nil {
+				return err
+			}
+
+			// Clean file path options
+			opts.Clean()
+
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			hc, err := f.HttpClient()
+			if err != nil {
+				return err
+			}
+
+			if opts.Hostname == "" {
+				opts.Hostname, _ = ghauth.DefaultHost()
+			}
+			err = auth.IsHostSupported(hc, opts.Hostname)
+			if err != nil {
+				return err
+			}
+
+			opts.APIClient = api.NewLiveClient(hc, opts.Hostname, opts.Logger)
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			if err := runSign(opts); err != nil {
+				return fmt.Errorf("\nError: %v", err)
+			}
+			return nil
+		},
+	}
+
+	signCmd.Flags().StringVarP(&opts.BundlePath, "bundle-path", "b", "", "Path to write the signed bundle to (default \"<file-path>.sigstore.json\")")
+	signCmd.Flags().StringVarP(&opts.KeyPath, "key", "k", "", "Path to a PEM-encoded private key to sign with instead of keyless signing")
+	cmdutil.DisableAuthCheckFlag(signCmd.Flags().Lookup("key"))
+	cmdutil.StringEnumFlag(signCmd, &opts.DigestAlgorithm, "digest-alg", "d", "sha256", []string{"sha256", "sha512"}, "The algorithm used to compute a digest of the artifact")
+	signCmd.Flags().StringVarP(&opts.PredicatePath, "predicate", "", "", "Path to a JSON file with the predicate content (defaults to an empty predicate)")
+	signCmd.Flags().StringVarP(&opts.PredicateType, "predicate-type", "", verification.SLSAPredicateV1, "Predicate type recorded in the signed statement")
+	signCmd.Flags().BoolVarP(&opts.Upload, "upload", "", false, "Upload the signed bundle to the GitHub attestations API")
+	signCmd.Flags().StringVarP(&opts.Repo, "repo", "R", "", "Repository name in the format <owner>/<repo>; required with --upload")
+	signCmd.Flags().StringVarP(&opts.Hostname, "hostname", "", "", "Configure host to use")
+
+	return signCmd
+}
+
+func runSign(opts *Options) error {
+	artifact, err := artifact.NewDigestedArtifact(nil, opts.ArtifactPath, opts.DigestAlgorithm)
+	if err != nil {
+		opts.Logger.Printf(opts.Logger.ColorScheme.Red("✗ Loading digest for %s failed\n"), opts.ArtifactPath)
+		return err
+	}
+
+	opts.Logger.Printf("Loaded digest %s for %s\n", artifact.DigestWithAlg(), artifact.URL)
+
+	statement, err := buildStatement(artifact, opts)
+	if err != nil {
+		opts.Logger.Println(opts.Logger.ColorScheme.Red("✗ Failed to build in-toto statement"))
+		return err
+	}
+
+	content := &sigstoreSign.DSSEData{
+		Data:        statement,
+		PayloadType: inTotoPayloadType,
+	}
+
+	keypair, bundleOpts, err := signingMaterial(opts)
+	if err != nil {
+		opts.Logger.Println(opts.Logger.ColorScheme.Red("✗ Failed to prepare signing material"))
+		return err
+	}
+
+	protoBundle, err := sigstoreSign.Bundle(content, keypair, bundleOpts)
+	if err != nil {
+		opts.Logger.Println(opts.Logger.ColorScheme.Red("✗ Signing failed"))
+		return err
+	}
+
+	signedBundle, err := bundle.NewBundle(protoBundle)
+	if err != nil {
+		opts.Logger.Println(opts.Logger.ColorScheme.Red("✗ Signing produced an invalid bundle"))
+		return err
+	}
+	bundleJSON, err := signedBundle.MarshalJSON()
+	if err != nil {
+		opts.Logger.Println(opts.Logger.ColorScheme.Red("✗ Failed to serialize bundle"))
+		return err
+	}
+
+	if err := os.WriteFile(opts.BundlePath, bundleJSON, 0644); err != nil {
+		opts.Logger.Printf(opts.Logger.ColorScheme.Red("✗ Failed to write bundle to %s\n"), opts.BundlePath)
+		return err
+	}
+
+	opts.Logger.Println(opts.Logger.ColorScheme.Greenf("✓ Wrote signed bundle to %s", opts.BundlePath))
+
+	if opts.Upload {
+		if err := opts.APIClient.PostAttestation(opts.Repo, bundleJSON); err != nil {
+			opts.Logger.Println(opts.Logger.ColorScheme.Red("✗ Failed to upload attestation"))
+			return err
+		}
+		opts.Logger.Println(opts.Logger.ColorScheme.Greenf("✓ Uploaded attestation to %s", opts.Repo))
+	}
+
+	return nil
+}
+
+// signingMaterial returns the keypair to sign with and the bundle options
+// that determine what verification material ends up in the bundle. Keyless
+// signing certifies an ephemeral key with Fulcio and records the signature
+// in Rekor; key-based signing stays entirely local.
+func signingMaterial(opts *Options) (sigstoreSign.Keypair, sigstoreSign.BundleOptions, error) {
+	if opts.KeyPath != "" {
+		keypair, err := loadKeypair(opts.KeyPath)
+		if err != nil {
+			return nil, sigstoreSign.BundleOptions{}, err
+		}
+		return keypair, sigstoreSign.BundleOptions{}, nil
+	}
+
+	keypair, err := sigstoreSign.NewEphemeralKeypair(nil)
+	if err != nil {
+		return nil, sigstoreSign.BundleOptions{}, err
+	}
+
+	idToken, err := actionsIDToken()
+	if err != nil {
+		return nil, sigstoreSign.BundleOptions{}, err
+	}
+
+	bundleOpts := sigstoreSign.BundleOptions{
+		CertificateProvider: sigstoreSign.NewFulcio(&sigstoreSign.FulcioOptions{
+			BaseURL: fulcioURL,
+			Retries: 2,
+		}),
+		CertificateProviderOptions: &sigstoreSign.CertificateProviderOptions{
+			IDToken: idToken,
+		},
+		TransparencyLogs: []sigstoreSign.Transparency{
+			sigstoreSign.NewRekor(&sigstoreSign.RekorOptions{
+				BaseURL: rekorURL,
+				Retries: 2,
+			}),
+		},
+	}
+	return keypair, bundleOpts, nil
+}
+
+type inTotoStatement struct {
+	Type          string          `json:"_type"`
+	Subject       []inTotoSubject `json:"subject"`
+	PredicateType string          `json:"predicateType"`
+	Predicate     json.RawMessage `json:"predicate"`
+}
+
+type inTotoSubject struct {
+	Name   string            `json:"name"`
+	Digest map[string]string `json:"digest"`
+}
+
+// buildStatement wraps the artifact's digest in an in-toto statement with
+// the configured predicate
+func buildStatement(art *artifact.DigestedArtifact, opts *Options) ([]byte, error) {
+	predicate := json.RawMessage("{}")
+	if opts.PredicatePath != "" {
+		raw, err := os.ReadFile(opts.PredicatePath)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read predicate file: %w", err)
+		}
+		if !json.Valid(raw) {
+			return nil, fmt.Errorf("predicate file %s does not contain valid JSON", opts.PredicatePath)
+		}
+		predicate = raw
+	}
+
+	return json.Marshal(inTotoStatement{
+		Type: inTotoStatementType,
+		Subject: []inTotoSubject{
+			{
+				Name:   filepath.Base(opts.ArtifactPath),
+				Digest: map[string]string{art.Algorithm(): art.Digest()},
+			},
+		},
+		PredicateType: opts.PredicateType,
+		Predicate:     predicate,
+	})
+}
@@ -0,0 +1,266 @@
+package sign
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/cli/cli/v2/pkg/cmd/attestation/api"
+	"github.com/cli/cli/v2/pkg/cmd/attestation/io"
+	"github.com/cli/cli/v2/pkg/cmd/attestation/test"
+	"github.com/cli/cli/v2/pkg/cmd/attestation/verification"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/sigstore/sigstore-go/pkg/bundle"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var artifactPath = test.NormalizeRelativePath("../test/data/sigstore-js-2.1.0.tgz")
+
+func TestNewSignCmd(t *testing.T) {
+	testIO, _, _, _ := iostreams.Test()
+	f := &cmdutil.Factory{
+		IOStreams: testIO,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{}, nil
+		},
+	}
+
+	testcases := []struct {
+		name     string
+		cli      string
+		wants    Options
+		wantsErr bool
+	}{
+		{
+			name: "Defaults",
+			cli:  artifactPath,
+			wants: Options{
+				ArtifactPath:    artifactPath,
+				BundlePath:      fmt.Sprintf("%s.sigstore.json", artifactPath),
+				DigestAlgorithm: "sha256",
+				PredicateType:   verification.SLSAPredicateV1,
+				Hostname:        "github.com",
+			},
+		},
+		{
+			name: "Custom bundle path and key",
+			cli:  fmt.Sprintf("%s --bundle-path out.json --key key.pem", artifactPath),
+			wants: Options{
+				ArtifactPath:    artifactPath,
+				BundlePath:      "out.json",
+				DigestAlgorithm: "sha256",
+				KeyPath:         "key.pem",
+				PredicateType:   verification.SLSAPredicateV1,
+				Hostname:        "github.com",
+			},
+		},
+		{
+			name: "Upload with repo",
+			cli:  fmt.Sprintf("%s --upload --repo github/example", artifactPath),
+			wants: Options{
+				ArtifactPath:    artifactPath,
+				BundlePath:      fmt.Sprintf("%s.sigstore.json", artifactPath),
+				DigestAlgorithm: "sha256",
+				PredicateType:   verification.SLSAPredicateV1,
+				Repo:            "github/example",
+				Upload:          true,
+				Hostname:        "github.com",
+			},
+		},
+		{
+			name:     "Upload without repo",
+			cli:      fmt.Sprintf("%s --upload", artifactPath),
+			wantsErr: true,
+		},
+		{
+			name:     "Invalid repo value",
+			cli:      fmt.Sprintf("%s --upload --repo github", artifactPath),
+			wantsErr: true,
+		},
+		{
+			name:     "OCI artifact path",
+			cli:      "oci://ghcr.io/github/example --key key.pem",
+			wantsErr: true,
+		},
+		{
+			name:     "Invalid custom host",
+			cli:      fmt.Sprintf("%s --hostname foo.bar.com", artifactPath),
+			wantsErr: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			var opts *Options
+			cmd := NewSignCmd(f, func(o *Options) error {
+				opts = o
+				return nil
+			})
+
+			argv := strings.Split(tc.cli, " ")
+			cmd.SetArgs(argv)
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+			_, err := cmd.ExecuteC()
+			if tc.wantsErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+
+			assert.Equal(t, tc.wants.ArtifactPath, opts.ArtifactPath)
+			assert.Equal(t, tc.wants.BundlePath, opts.BundlePath)
+			assert.Equal(t, tc.wants.DigestAlgorithm, opts.DigestAlgorithm)
+			assert.Equal(t, tc.wants.KeyPath, opts.KeyPath)
+			assert.Equal(t, tc.wants.PredicateType, opts.PredicateType)
+			assert.Equal(t, tc.wants.Repo, opts.Repo)
+			assert.Equal(t, tc.wants.Upload, opts.Upload)
+			assert.Equal(t, tc.wants.Hostname, opts.Hostname)
+			assert.NotNil(t, opts.APIClient)
+			assert.NotNil(t, opts.Logger)
+		})
+	}
+}
+
+func TestRunSignWithKey(t *testing.T) {
+	tempDir := t.TempDir()
+	keyPath := writeTestKey(t, tempDir)
+	bundlePath := filepath.Join(tempDir, "bundle.sigstore.json")
+
+	opts := &Options{
+		ArtifactPath:    artifactPath,
+		BundlePath:      bundlePath,
+		DigestAlgorithm: "sha256",
+		KeyPath:         keyPath,
+		PredicateType:   verification.SLSAPredicateV1,
+		Logger:          io.NewTestHandler(),
+	}
+
+	require.NoError(t, runSign(opts))
+
+	bundleJSON, err := os.ReadFile(bundlePath)
+	require.NoError(t, err)
+
+	var b bundle.Bundle
+	require.NoError(t, b.UnmarshalJSON(bundleJSON))
+
+	envelope := b.Bundle.GetDsseEnvelope()
+	require.NotNil(t, envelope)
+	require.Equal(t, inTotoPayloadType, envelope.PayloadType)
+
+	var statement inTotoStatement
+	require.NoError(t, json.Unmarshal(envelope.Payload, &statement))
+	assert.Equal(t, inTotoStatementType, statement.Type)
+	assert.Equal(t, verification.SLSAPredicateV1, statement.PredicateType)
+	require.Len(t, statement.Subject, 1)
+	assert.Equal(t, "sigstore-js-2.1.0.tgz", statement.Subject[0].Name)
+	assert.NotEmpty(t, statement.Subject[0].Digest["sha256"])
+}
+
+func TestRunSignWithPredicate(t *testing.T) {
+	tempDir := t.TempDir()
+	keyPath := writeTestKey(t, tempDir)
+	bundlePath := filepath.Join(tempDir, "bundle.sigstore.json")
+	predicatePath := filepath.Join(tempDir, "predicate.json")
+	require.NoError(t, os.WriteFile(predicatePath, []byte(`{"foo":"bar"}`), 0644))
+
+	opts := &Options{
+		ArtifactPath:    artifactPath,
+		BundlePath:      bundlePath,
+		DigestAlgorithm: "sha256",
+		KeyPath:         keyPath,
+		PredicatePath:   predicatePath,
+		PredicateType:   "https://example.com/predicate/v1",
+		Logger:          io.NewTestHandler(),
+	}
+
+	require.NoError(t, runSign(opts))
+
+	bundleJSON, err := os.ReadFile(bundlePath)
+	require.NoError(t, err)
+
+	var b bundle.Bundle
+	require.NoError(t, b.UnmarshalJSON(bundleJSON))
+
+	var statement inTotoStatement
+	require.NoError(t, json.Unmarshal(b.Bundle.GetDsseEnvelope().Payload, &statement))
+	assert.Equal(t, "https://example.com/predicate/v1", statement.PredicateType)
+	assert.JSONEq(t, `{"foo":"bar"}`, string(statement.Predicate))
+}
+
+func TestRunSignUploads(t *testing.T) {
+	tempDir := t.TempDir()
+	keyPath := writeTestKey(t, tempDir)
+	bundlePath := filepath.Join(tempDir, "bundle.sigstore.json")
+
+	var uploadedRepo string
+	var uploadedBundle json.RawMessage
+	client := api.NewTestClient()
+	client.OnPostAttestation = func(repo string, bundle json.RawMessage) error {
+		uploadedRepo = repo
+		uploadedBundle = bundle
+		return nil
+	}
+
+	opts := &Options{
+		ArtifactPath:    artifactPath,
+		BundlePath:      bundlePath,
+		DigestAlgorithm: "sha256",
+		KeyPath:         keyPath,
+		PredicateType:   verification.SLSAPredicateV1,
+		Repo:            "github/example",
+		Upload:          true,
+		APIClient:       client,
+		Logger:          io.NewTestHandler(),
+	}
+
+	require.NoError(t, runSign(opts))
+	assert.Equal(t, "github/example", uploadedRepo)
+	assert.NotEmpty(t, uploadedBundle)
+}
+
+func TestRunSignKeylessOutsideActions(t *testing.T) {
+	t.Setenv("ACTIONS_ID_TOKEN_REQUEST_URL", "")
+	t.Setenv("ACTIONS_ID_TOKEN_REQUEST_TOKEN", "")
+
+	opts := &Options{
+		ArtifactPath:    artifactPath,
+		BundlePath:      filepath.Join(t.TempDir(), "bundle.sigstore.json"),
+		DigestAlgorithm: "sha256",
+		PredicateType:   verification.SLSAPredicateV1,
+		Logger:          io.NewTestHandler(),
+	}
+
+	err := runSign(opts)
+	require.Error(t, err)
+	require.ErrorContains(t, err, "keyless signing requires the GitHub Actions OIDC environment")
+}
+
+func writeTestKey(t *testing.T, dir string) string {
+	t.Helper()
+
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	keyBytes, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	require.NoError(t, err)
+
+	keyPath := filepath.Join(dir, "key.pem")
+	pemBytes := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyBytes})
+	require.NoError(t, os.WriteFile(keyPath, pemBytes, 0600))
+
+	return keyPath
+}
@@ -63,8 +63,14 @@ func NewTrustedRootCmd(f *cmdutil.Factory, runF func(*Options) error) *cobra.Com
 				opts.Hostname, _ = ghauth.DefaultHost()
 			}
 
-			if err := auth.IsHostSupported(opts.Hostname); err != nil {
-				return err
+			if ghauth.IsEnterprise(opts.Hostname) {
+				hc, err := f.HttpClient()
+				if err != nil {
+					return err
+				}
+				if err := auth.IsHostSupported(hc, opts.Hostname); err != nil {
+					return err
+				}
 			}
 
 			if ghauth.IsTenancy(opts.Hostname) {
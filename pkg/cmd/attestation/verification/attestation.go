@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/cli/cli/v2/pkg/cmd/attestation/api"
 	"github.com/cli/cli/v2/pkg/cmd/attestation/artifact"
@@ -59,6 +60,67 @@ func GetLocalAttestations(path string) ([]*api.Attestation, error) {
 	return nil, ErrUnrecognisedBundleExtension
 }
 
+// GetLocalAttestationsFromDir returns attestations read from the *.sigstore.json
+// bundle files in dir whose subjects include the given digest.
+func GetLocalAttestationsFromDir(dir, digest string) ([]*api.Attestation, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("bundles could not be loaded from directory %s", dir)
+	}
+
+	attestations := []*api.Attestation{}
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".sigstore.json") {
+			continue
+		}
+		loaded, err := loadBundleFromJSONFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			if errors.Is(err, bundle.ErrValidation) {
+				return nil, err
+			}
+			return nil, fmt.Errorf("bundle content of %s could not be parsed", entry.Name())
+		}
+		for _, a := range loaded {
+			if bundleMatchesDigest(a, digest) {
+				attestations = append(attestations, a)
+			}
+		}
+	}
+
+	if len(attestations) == 0 {
+		return nil, fmt.Errorf("no bundles in %s have a subject matching the digest %s", dir, digest)
+	}
+
+	return attestations, nil
+}
+
+type intotoSubjects struct {
+	Subject []struct {
+		Digest map[string]string `json:"digest"`
+	} `json:"subject"`
+}
+
+// bundleMatchesDigest reports whether the digest is among the subjects of the
+// bundle's in-toto statement.
+func bundleMatchesDigest(a *api.Attestation, digest string) bool {
+	dsseEnvelope := a.Bundle.GetDsseEnvelope()
+	if dsseEnvelope == nil {
+		return false
+	}
+	var statement intotoSubjects
+	if err := json.Unmarshal([]byte(dsseEnvelope.Payload), &statement); err != nil {
+		return false
+	}
+	for _, subject := range statement.Subject {
+		for alg, dgst := range subject.Digest {
+			if fmt.Sprintf("%s:%s", alg, dgst) == digest {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func loadBundleFromJSONFile(path string) ([]*api.Attestation, error) {
 	localAttestation, err := bundle.LoadJSONFromPath(path)
 	if err != nil {
@@ -164,3 +164,32 @@ func TestFilterAttestations(t *testing.T) {
 	filtered = FilterAttestations("NonExistentPredicate", attestations)
 	require.Len(t, filtered, 0)
 }
+
+func TestGetLocalAttestationsFromDir(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"reusable-workflow-attestation.sigstore.json", "custom-issuer.sigstore.json"} {
+		content, err := os.ReadFile(filepath.Join("../test/data", name))
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), content, 0644))
+	}
+	// files without the .sigstore.json suffix are ignored
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "README.md"), []byte("not a bundle"), 0644))
+
+	t.Run("matches bundles to the subject by digest", func(t *testing.T) {
+		attestations, err := GetLocalAttestationsFromDir(dir, "sha256:49a3aa6075e0f49f82843e74b5baa614ad2a588e6675612bf108a0a008c5ac25")
+		require.NoError(t, err)
+		require.Len(t, attestations, 1)
+	})
+
+	t.Run("errors when no bundle matches the digest", func(t *testing.T) {
+		attestations, err := GetLocalAttestationsFromDir(dir, "sha256:deadbeef")
+		require.ErrorContains(t, err, "no bundles in")
+		require.Nil(t, attestations)
+	})
+
+	t.Run("errors when the directory cannot be read", func(t *testing.T) {
+		attestations, err := GetLocalAttestationsFromDir(filepath.Join(dir, "missing"), "sha256:deadbeef")
+		require.ErrorContains(t, err, "bundles could not be loaded from directory")
+		require.Nil(t, attestations)
+	})
+}
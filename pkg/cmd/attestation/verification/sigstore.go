@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"sync"
 
 	"github.com/cli/cli/v2/pkg/cmd/attestation/api"
 	"github.com/cli/cli/v2/pkg/cmd/attestation/io"
@@ -34,6 +35,9 @@ type SigstoreConfig struct {
 	NoPublicGood bool
 	// If tenancy mode is not used, trust domain is empty
 	TrustDomain string
+	// Jobs is the number of attestations verified in parallel;
+	// values below 2 verify serially
+	Jobs int
 }
 
 type SigstoreVerifier interface {
@@ -42,6 +46,11 @@ type SigstoreVerifier interface {
 
 type LiveSigstoreVerifier struct {
 	config SigstoreConfig
+
+	// chooseVerifier builds the same verifier for every bundle signed by
+	// the same issuer, so built verifiers are shared across attestations
+	mu        sync.Mutex
+	verifiers map[string]*verify.SignedEntityVerifier
 }
 
 var ErrNoAttestationsVerified = errors.New("no attestations were verified")
@@ -55,22 +64,56 @@ func NewLiveSigstoreVerifier(config SigstoreConfig) *LiveSigstoreVerifier {
 	}
 }
 
-func (v *LiveSigstoreVerifier) chooseVerifier(b *bundle.Bundle) (*verify.SignedEntityVerifier, string, error) {
+// bundleIssuer returns the organization of the bundle's leaf certificate
+// issuer.
+func bundleIssuer(b *bundle.Bundle) (string, error) {
 	if !b.MinVersion("0.2") {
-		return nil, "", fmt.Errorf("unsupported bundle version: %s", b.MediaType)
+		return "", fmt.Errorf("unsupported bundle version: %s", b.MediaType)
 	}
 	verifyContent, err := b.VerificationContent()
 	if err != nil {
-		return nil, "", fmt.Errorf("failed to get bundle verification content: %v", err)
+		return "", fmt.Errorf("failed to get bundle verification content: %v", err)
 	}
 	leafCert := verifyContent.GetCertificate()
 	if leafCert == nil {
-		return nil, "", fmt.Errorf("leaf cert not found")
+		return "", fmt.Errorf("leaf cert not found")
 	}
 	if len(leafCert.Issuer.Organization) != 1 {
-		return nil, "", fmt.Errorf("expected the leaf certificate issuer to only have one organization")
+		return "", fmt.Errorf("expected the leaf certificate issuer to only have one organization")
+	}
+	return leafCert.Issuer.Organization[0], nil
+}
+
+// verifierFor returns the verifier for the bundle's issuer, building it on
+// the first use and reusing it for every later bundle with the same issuer.
+func (v *LiveSigstoreVerifier) verifierFor(b *bundle.Bundle) (*verify.SignedEntityVerifier, string, error) {
+	issuer, err := bundleIssuer(b)
+	if err != nil {
+		return nil, "", err
+	}
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	if verifier, ok := v.verifiers[issuer]; ok {
+		return verifier, issuer, nil
+	}
+
+	verifier, issuer, err := v.chooseVerifier(b)
+	if err != nil {
+		return nil, "", err
+	}
+	if v.verifiers == nil {
+		v.verifiers = map[string]*verify.SignedEntityVerifier{}
+	}
+	v.verifiers[issuer] = verifier
+	return verifier, issuer, nil
+}
+
+func (v *LiveSigstoreVerifier) chooseVerifier(b *bundle.Bundle) (*verify.SignedEntityVerifier, string, error) {
+	issuer, err := bundleIssuer(b)
+	if err != nil {
+		return nil, "", err
 	}
-	issuer := leafCert.Issuer.Organization[0]
 
 	if v.config.TrustedRoot != "" {
 		customTrustRoots, err := os.ReadFile(v.config.TrustedRoot)
@@ -136,14 +179,14 @@ func (v *LiveSigstoreVerifier) chooseVerifier(b *bundle.Bundle) (*verify.SignedE
 		return nil, "", fmt.Errorf("unable to use provided trusted roots")
 	}
 
-	if leafCert.Issuer.Organization[0] == PublicGoodIssuerOrg && !v.config.NoPublicGood {
+	if issuer == PublicGoodIssuerOrg && !v.config.NoPublicGood {
 		publicGoodVerifier, err := newPublicGoodVerifier()
 		if err != nil {
 			return nil, "", fmt.Errorf("failed to create Public Good Sigstore verifier: %v", err)
 		}
 
 		return publicGoodVerifier, issuer, nil
-	} else if leafCert.Issuer.Organization[0] == GitHubIssuerOrg || v.config.NoPublicGood {
+	} else if issuer == GitHubIssuerOrg || v.config.NoPublicGood {
 		ghVerifier, err := newGitHubVerifier(v.config.TrustDomain)
 		if err != nil {
 			return nil, "", fmt.Errorf("failed to create GitHub Sigstore verifier: %v", err)
@@ -169,7 +212,7 @@ func getLowestCertInChain(ca *root.CertificateAuthority) (*x509.Certificate, err
 
 func (v *LiveSigstoreVerifier) verify(attestation *api.Attestation, policy verify.PolicyBuilder) (*AttestationProcessingResult, error) {
 	// determine which verifier should attempt verification against the bundle
-	verifier, issuer, err := v.chooseVerifier(attestation.Bundle)
+	verifier, issuer, err := v.verifierFor(attestation.Bundle)
 	if err != nil {
 		return nil, fmt.Errorf("failed to find recognized issuer from bundle content: %v", err)
 	}
@@ -203,32 +246,58 @@ func (v *LiveSigstoreVerifier) Verify(attestations []*api.Attestation, policy ve
 		return nil, ErrNoAttestationsVerified
 	}
 
-	results := make([]*AttestationProcessingResult, len(attestations))
-	var verifyCount int
-	var lastError error
 	totalAttestations := len(attestations)
-	for i, a := range attestations {
-		v.config.Logger.VerbosePrintf("Verifying attestation %d/%d against the configured Sigstore trust roots\n", i+1, totalAttestations)
+	results := make([]*AttestationProcessingResult, totalAttestations)
+	errs := make([]error, totalAttestations)
 
-		apr, err := v.verify(a, policy)
-		if err != nil {
-			lastError = err
-			// move onto the next attestation in the for loop if verification fails
+	jobs := v.config.Jobs
+	if jobs > totalAttestations {
+		jobs = totalAttestations
+	}
+
+	if jobs > 1 {
+		indices := make(chan int)
+		var wg sync.WaitGroup
+		for w := 0; w < jobs; w++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for i := range indices {
+					results[i], errs[i] = v.verify(attestations[i], policy)
+				}
+			}()
+		}
+		for i := range attestations {
+			v.config.Logger.VerbosePrintf("Verifying attestation %d/%d against the configured Sigstore trust roots\n", i+1, totalAttestations)
+			indices <- i
+		}
+		close(indices)
+		wg.Wait()
+	} else {
+		for i, a := range attestations {
+			v.config.Logger.VerbosePrintf("Verifying attestation %d/%d against the configured Sigstore trust roots\n", i+1, totalAttestations)
+
+			results[i], errs[i] = v.verify(a, policy)
+		}
+	}
+
+	// keep only the verified attestations, in their original order
+	verified := results[:0]
+	var lastError error
+	for i, apr := range results {
+		if errs[i] != nil {
+			lastError = errs[i]
+			// move onto the next attestation if verification failed
 			continue
 		}
-		// otherwise, add the result to the results slice and increment verifyCount
-		results[verifyCount] = apr
-		verifyCount++
+		verified = append(verified, apr)
 	}
 
-	if verifyCount == 0 {
+	if len(verified) == 0 {
 		return nil, lastError
 	}
 
-	// truncate the results slice to only include verified attestations
-	results = results[:verifyCount]
-
-	return results, nil
+	return verified, nil
 }
 
 func newCustomVerifier(trustedRoot *root.TrustedRoot) (*verify.SignedEntityVerifier, error) {
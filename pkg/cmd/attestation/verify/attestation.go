@@ -2,6 +2,7 @@ package verify
 
 import (
 	"fmt"
+	"os"
 
 	"github.com/cli/cli/v2/internal/text"
 	"github.com/cli/cli/v2/pkg/cmd/attestation/api"
@@ -11,6 +12,18 @@ import (
 
 func getAttestations(o *Options, a artifact.DigestedArtifact) ([]*api.Attestation, string, error) {
 	if o.BundlePath != "" {
+		// a directory of bundles is matched to the artifact by its digest
+		if info, err := os.Stat(o.BundlePath); err == nil && info.IsDir() {
+			attestations, err := verification.GetLocalAttestationsFromDir(o.BundlePath, a.DigestWithAlg())
+			if err != nil {
+				msg := fmt.Sprintf("✗ Loading attestations from %s failed", o.BundlePath)
+				return nil, msg, err
+			}
+			pluralAttestation := text.Pluralize(len(attestations), "attestation")
+			msg := fmt.Sprintf("Loaded %s from %s", pluralAttestation, o.BundlePath)
+			return attestations, msg, nil
+		}
+
 		attestations, err := verification.GetLocalAttestations(o.BundlePath)
 		if err != nil {
 			msg := fmt.Sprintf("✗ Loading attestations from %s failed", a.URL)
@@ -0,0 +1,181 @@
+package verify
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/cli/cli/v2/internal/text"
+	"github.com/cli/cli/v2/pkg/cmd/attestation/artifact"
+	"github.com/cli/cli/v2/pkg/cmd/attestation/verification"
+)
+
+// expandArtifactPaths turns the artifact arguments into the list of artifact
+// paths to verify, expanding glob patterns and directories.
+func expandArtifactPaths(args []string) ([]string, error) {
+	var paths []string
+	for _, arg := range args {
+		// OCI references are never paths on disk
+		if strings.HasPrefix(arg, "oci://") {
+			paths = append(paths, arg)
+			continue
+		}
+
+		// expand glob patterns that were not already expanded by the shell
+		if strings.ContainsAny(arg, "*?[") {
+			matches, err := filepath.Glob(arg)
+			if err != nil {
+				return nil, fmt.Errorf("invalid glob pattern %s: %v", arg, err)
+			}
+			var files []string
+			for _, match := range matches {
+				if info, err := os.Stat(match); err == nil && info.IsDir() {
+					continue
+				}
+				files = append(files, match)
+			}
+			if len(files) == 0 {
+				return nil, fmt.Errorf("no artifacts match %s", arg)
+			}
+			sort.Strings(files)
+			paths = append(paths, files...)
+			continue
+		}
+
+		// a directory argument expands to the files it directly contains
+		if info, err := os.Stat(arg); err == nil && info.IsDir() {
+			entries, err := os.ReadDir(arg)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read directory %s: %v", arg, err)
+			}
+			var files []string
+			for _, entry := range entries {
+				if entry.IsDir() {
+					continue
+				}
+				files = append(files, filepath.Join(arg, entry.Name()))
+			}
+			if len(files) == 0 {
+				return nil, fmt.Errorf("no artifacts found in directory %s", arg)
+			}
+			paths = append(paths, files...)
+			continue
+		}
+
+		paths = append(paths, arg)
+	}
+	return paths, nil
+}
+
+// artifactResult captures the outcome of verifying a single artifact when
+// multiple artifacts are verified in one run
+type artifactResult struct {
+	path     string
+	artifact *artifact.DigestedArtifact
+	verified []*verification.AttestationProcessingResult
+	err      error
+}
+
+// runVerifyAll verifies every artifact path with a bounded worker pool and
+// prints a summary table with one row per artifact
+func runVerifyAll(opts *Options) error {
+	ec, err := newEnforcementCriteria(opts)
+	if err != nil {
+		opts.Logger.Println(opts.Logger.ColorScheme.Red("✗ Failed to build verification policy"))
+		return err
+	}
+
+	if err := ec.Valid(); err != nil {
+		opts.Logger.Println(opts.Logger.ColorScheme.Red("✗ Invalid verification policy"))
+		return err
+	}
+
+	totalArtifacts := len(opts.ArtifactPaths)
+	results := make([]artifactResult, totalArtifacts)
+
+	jobs := opts.Jobs
+	if jobs < 1 {
+		jobs = 1
+	}
+	if jobs > totalArtifacts {
+		jobs = totalArtifacts
+	}
+
+	indices := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < jobs; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indices {
+				results[i] = verifyArtifactPath(opts, ec, opts.ArtifactPaths[i])
+			}
+		}()
+	}
+	for i := range opts.ArtifactPaths {
+		indices <- i
+	}
+	close(indices)
+	wg.Wait()
+
+	var failed int
+	content := make([][]string, totalArtifacts)
+	for i, res := range results {
+		digest := "-"
+		if res.artifact != nil {
+			digest = res.artifact.DigestWithAlg()
+		}
+		if res.err != nil {
+			failed++
+			content[i] = []string{res.path, digest, "-", opts.Logger.ColorScheme.Redf("failed: %v", res.err)}
+			continue
+		}
+		content[i] = []string{res.path, digest, fmt.Sprintf("%d", len(res.verified)), opts.Logger.ColorScheme.Green("verified")}
+	}
+
+	headers := []string{"artifact", "digest", "attestations", "result"}
+	if err := opts.Logger.PrintTable(headers, content); err != nil {
+		opts.Logger.Println(opts.Logger.ColorScheme.Red("failed to print verification results to table"))
+		return err
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%s of %d failed verification", text.Pluralize(failed, "artifact"), totalArtifacts)
+	}
+
+	opts.Logger.Println(opts.Logger.ColorScheme.Greenf("✓ Verification succeeded for all %d artifacts!", totalArtifacts))
+	return nil
+}
+
+// verifyArtifactPath runs the verification pipeline for one artifact path
+func verifyArtifactPath(opts *Options, ec verification.EnforcementCriteria, path string) artifactResult {
+	art, err := artifact.NewDigestedArtifact(opts.OCIClient, path, opts.DigestAlgorithm)
+	if err != nil {
+		return artifactResult{path: path, err: fmt.Errorf("loading digest failed: %v", err)}
+	}
+	return verifyDigestedArtifact(opts, ec, path, art)
+}
+
+// verifyDigestedArtifact runs the verification pipeline for an artifact whose
+// digest has already been resolved
+func verifyDigestedArtifact(opts *Options, ec verification.EnforcementCriteria, label string, art *artifact.DigestedArtifact) artifactResult {
+	attestations, _, err := getAttestations(opts, *art)
+	if err != nil {
+		return artifactResult{path: label, artifact: art, err: err}
+	}
+
+	filtered := verification.FilterAttestations(ec.PredicateType, attestations)
+	if len(filtered) == 0 {
+		return artifactResult{path: label, artifact: art, err: fmt.Errorf("no attestations found with predicate type: %s", ec.PredicateType)}
+	}
+
+	verified, _, err := verifyAttestations(*art, filtered, opts.SigstoreVerifier, ec)
+	if err != nil {
+		return artifactResult{path: label, artifact: art, err: err}
+	}
+
+	return artifactResult{path: label, artifact: art, verified: verified}
+}
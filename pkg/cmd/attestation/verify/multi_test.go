@@ -0,0 +1,108 @@
+package verify
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/cli/cli/v2/pkg/cmd/attestation/api"
+	"github.com/cli/cli/v2/pkg/cmd/attestation/artifact/oci"
+	"github.com/cli/cli/v2/pkg/cmd/attestation/io"
+	"github.com/cli/cli/v2/pkg/cmd/attestation/verification"
+	"github.com/cli/cli/v2/pkg/iostreams"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpandArtifactPaths(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"a.tar.gz", "b.tar.gz", "c.bin"} {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(name), 0644))
+	}
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "sub"), 0755))
+
+	t.Run("keeps plain file paths", func(t *testing.T) {
+		paths, err := expandArtifactPaths([]string{filepath.Join(dir, "c.bin")})
+		require.NoError(t, err)
+		assert.Equal(t, []string{filepath.Join(dir, "c.bin")}, paths)
+	})
+
+	t.Run("keeps OCI references", func(t *testing.T) {
+		paths, err := expandArtifactPaths([]string{"oci://ghcr.io/github/test"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"oci://ghcr.io/github/test"}, paths)
+	})
+
+	t.Run("expands glob patterns", func(t *testing.T) {
+		paths, err := expandArtifactPaths([]string{filepath.Join(dir, "*.tar.gz")})
+		require.NoError(t, err)
+		assert.Equal(t, []string{filepath.Join(dir, "a.tar.gz"), filepath.Join(dir, "b.tar.gz")}, paths)
+	})
+
+	t.Run("errors when a glob matches nothing", func(t *testing.T) {
+		_, err := expandArtifactPaths([]string{filepath.Join(dir, "*.zip")})
+		require.ErrorContains(t, err, "no artifacts match")
+	})
+
+	t.Run("expands directories to their files", func(t *testing.T) {
+		paths, err := expandArtifactPaths([]string{dir})
+		require.NoError(t, err)
+		assert.Equal(t, []string{
+			filepath.Join(dir, "a.tar.gz"),
+			filepath.Join(dir, "b.tar.gz"),
+			filepath.Join(dir, "c.bin"),
+		}, paths)
+	})
+
+	t.Run("errors when a directory contains no files", func(t *testing.T) {
+		_, err := expandArtifactPaths([]string{filepath.Join(dir, "sub")})
+		require.ErrorContains(t, err, "no artifacts found in directory")
+	})
+
+	t.Run("combines multiple arguments", func(t *testing.T) {
+		paths, err := expandArtifactPaths([]string{filepath.Join(dir, "c.bin"), "oci://ghcr.io/github/test"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{filepath.Join(dir, "c.bin"), "oci://ghcr.io/github/test"}, paths)
+	})
+}
+
+func TestRunVerifyAll(t *testing.T) {
+	newOpts := func(t *testing.T, ios *iostreams.IOStreams) Options {
+		return Options{
+			BundlePath:       bundlePath,
+			DigestAlgorithm:  "sha512",
+			Jobs:             2,
+			APIClient:        api.NewTestClient(),
+			Logger:           io.NewHandler(ios),
+			OCIClient:        oci.MockClient{},
+			OIDCIssuer:       verification.GitHubOIDCIssuer,
+			Owner:            "sigstore",
+			PredicateType:    verification.SLSAPredicateV1,
+			SANRegex:         "^https://github.com/sigstore/",
+			SigstoreVerifier: verification.NewMockSigstoreVerifier(t),
+		}
+	}
+
+	t.Run("with all artifacts verified", func(t *testing.T) {
+		testIO, _, out, errOut := iostreams.Test()
+		testIO.SetStdoutTTY(true)
+		opts := newOpts(t, testIO)
+		opts.ArtifactPaths = []string{artifactPath, artifactPath}
+
+		require.NoError(t, runVerify(&opts))
+		assert.Contains(t, out.String(), "verified")
+		assert.Contains(t, errOut.String(), "✓ Verification succeeded for all 2 artifacts!")
+	})
+
+	t.Run("with one artifact failing verification", func(t *testing.T) {
+		testIO, _, out, _ := iostreams.Test()
+		testIO.SetStdoutTTY(true)
+		opts := newOpts(t, testIO)
+		opts.ArtifactPaths = []string{artifactPath, "../test/data/non-existent-artifact.zip"}
+
+		err := runVerify(&opts)
+		require.ErrorContains(t, err, "1 artifact of 2 failed verification")
+		assert.Contains(t, out.String(), "failed")
+	})
+}
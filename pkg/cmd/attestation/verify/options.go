@@ -16,17 +16,23 @@ import (
 
 // Options captures the options for the verify command
 type Options struct {
-	ArtifactPath          string
+	ArtifactPath string
+	// ArtifactPaths holds every artifact path when more than one is provided
+	ArtifactPaths         []string
 	BundlePath            string
 	UseBundleFromRegistry bool
 	Config                func() (gh.Config, error)
 	TrustedRoot           string
 	DenySelfHostedRunner  bool
 	DigestAlgorithm       string
+	Jobs                  int
 	Limit                 int
 	NoPublicGood          bool
+	Offline               bool
 	OIDCIssuer            string
 	Owner                 string
+	Platform              string
+	PolicyPath            string
 	PredicateType         string
 	Repo                  string
 	SAN                   string
@@ -38,6 +44,7 @@ type Options struct {
 	OCIClient             oci.Client
 	SigstoreVerifier      verification.SigstoreVerifier
 	exporter              cmdutil.Exporter
+	format                string
 	Hostname              string
 	// Tenant is only set when tenancy is used
 	Tenant string
@@ -48,6 +55,9 @@ func (opts *Options) Clean() {
 	if opts.BundlePath != "" {
 		opts.BundlePath = filepath.Clean(opts.BundlePath)
 	}
+	if opts.PolicyPath != "" {
+		opts.PolicyPath = filepath.Clean(opts.PolicyPath)
+	}
 }
 
 func (opts *Options) SetPolicyFlags() {
@@ -88,9 +98,35 @@ func (opts *Options) AreFlagsValid() error {
 		return fmt.Errorf("limit %d not allowed, must be between 1 and 1000", opts.Limit)
 	}
 
+	// Check that jobs is at least 1
+	if opts.Jobs < 1 {
+		return fmt.Errorf("jobs %d not allowed, must be at least 1", opts.Jobs)
+	}
+
+	artifactPaths := opts.ArtifactPaths
+	if len(artifactPaths) == 0 {
+		artifactPaths = []string{opts.ArtifactPath}
+	}
+
+	// Check that multiple artifacts are not combined with a single-report format
+	if len(artifactPaths) > 1 && opts.format != "" {
+		return fmt.Errorf("format can only be used when verifying a single artifact")
+	}
+
 	// Check that the bundle-from-oci flag is only used with OCI artifact paths
-	if opts.UseBundleFromRegistry && !strings.HasPrefix(opts.ArtifactPath, "oci://") {
-		return fmt.Errorf("bundle-from-oci flag can only be used with OCI artifact paths")
+	for _, artifactPath := range artifactPaths {
+		if opts.UseBundleFromRegistry && !strings.HasPrefix(artifactPath, "oci://") {
+			return fmt.Errorf("bundle-from-oci flag can only be used with OCI artifact paths")
+		}
+	}
+
+	// Check that the platform flag is only used with OCI artifact paths
+	if opts.Platform != "" {
+		for _, artifactPath := range artifactPaths {
+			if !strings.HasPrefix(artifactPath, "oci://") {
+				return fmt.Errorf("platform flag can only be used with OCI artifact paths")
+			}
+		}
 	}
 
 	// Check that both the bundle-from-oci and bundle-path flags are not used together
@@ -98,6 +134,24 @@ func (opts *Options) AreFlagsValid() error {
 		return fmt.Errorf("bundle-from-oci flag cannot be used with bundle-path flag")
 	}
 
+	// Check that offline verification has all of its inputs on disk
+	if opts.Offline {
+		if opts.BundlePath == "" {
+			return fmt.Errorf("offline flag requires the bundle flag")
+		}
+		if opts.TrustedRoot == "" {
+			return fmt.Errorf("offline flag requires the custom-trusted-root flag")
+		}
+		if opts.UseBundleFromRegistry {
+			return fmt.Errorf("offline flag cannot be used with bundle-from-oci flag")
+		}
+		for _, artifactPath := range artifactPaths {
+			if strings.HasPrefix(artifactPath, "oci://") {
+				return fmt.Errorf("offline flag cannot be used with OCI artifact paths, which require registry access to compute a digest")
+			}
+		}
+	}
+
 	// Verify provided hostname
 	if opts.Hostname != "" {
 		if err := ghinstance.HostnameValidator(opts.Hostname); err != nil {
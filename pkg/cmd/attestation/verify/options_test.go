@@ -17,6 +17,7 @@ var baseOptions = Options{
 	ArtifactPath:    publicGoodArtifactPath,
 	BundlePath:      publicGoodBundlePath,
 	DigestAlgorithm: "sha512",
+	Jobs:            1,
 	Limit:           1,
 	Owner:           "sigstore",
 	OIDCIssuer:      "some issuer",
@@ -41,6 +42,15 @@ func TestAreFlagsValid(t *testing.T) {
 		require.ErrorContains(t, err, "limit 0 not allowed, must be between 1 and 1000")
 	})
 
+	t.Run("invalid jobs == 0", func(t *testing.T) {
+		opts := baseOptions
+		opts.Jobs = 0
+
+		err := opts.AreFlagsValid()
+		require.Error(t, err)
+		require.ErrorContains(t, err, "jobs 0 not allowed, must be at least 1")
+	})
+
 	t.Run("invalid limit > 1000", func(t *testing.T) {
 		opts := baseOptions
 		opts.Limit = 1001
@@ -50,6 +60,15 @@ func TestAreFlagsValid(t *testing.T) {
 		require.ErrorContains(t, err, "limit 1001 not allowed, must be between 1 and 1000")
 	})
 
+	t.Run("returns error when Platform is provided and ArtifactPath is not an OCI path", func(t *testing.T) {
+		opts := baseOptions
+		opts.Platform = "linux/amd64"
+
+		err := opts.AreFlagsValid()
+		require.Error(t, err)
+		require.ErrorContains(t, err, "platform flag can only be used with OCI artifact paths")
+	})
+
 	t.Run("returns error when UseBundleFromRegistry is true and ArtifactPath is not an OCI path", func(t *testing.T) {
 		opts := baseOptions
 		opts.BundlePath = ""
@@ -79,6 +98,45 @@ func TestAreFlagsValid(t *testing.T) {
 		require.Error(t, err)
 		require.ErrorContains(t, err, "bundle-from-oci flag cannot be used with bundle-path flag")
 	})
+
+	t.Run("returns error when Offline is true and BundlePath is not provided", func(t *testing.T) {
+		opts := baseOptions
+		opts.BundlePath = ""
+		opts.Offline = true
+
+		err := opts.AreFlagsValid()
+		require.Error(t, err)
+		require.ErrorContains(t, err, "offline flag requires the bundle flag")
+	})
+
+	t.Run("returns error when Offline is true and TrustedRoot is not provided", func(t *testing.T) {
+		opts := baseOptions
+		opts.Offline = true
+
+		err := opts.AreFlagsValid()
+		require.Error(t, err)
+		require.ErrorContains(t, err, "offline flag requires the custom-trusted-root flag")
+	})
+
+	t.Run("returns error when Offline is true and ArtifactPath is an OCI path", func(t *testing.T) {
+		opts := baseOptions
+		opts.ArtifactPath = "oci://sigstore/sigstore-js:2.1.0"
+		opts.TrustedRoot = "trusted_root.jsonl"
+		opts.Offline = true
+
+		err := opts.AreFlagsValid()
+		require.Error(t, err)
+		require.ErrorContains(t, err, "offline flag cannot be used with OCI artifact paths")
+	})
+
+	t.Run("does not return error when Offline is true with BundlePath and TrustedRoot", func(t *testing.T) {
+		opts := baseOptions
+		opts.TrustedRoot = "trusted_root.jsonl"
+		opts.Offline = true
+
+		err := opts.AreFlagsValid()
+		require.NoError(t, err)
+	})
 }
 
 func TestSetPolicyFlags(t *testing.T) {
@@ -0,0 +1,183 @@
+package verify
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/cli/cli/v2/pkg/cmd/attestation/artifact"
+	"github.com/cli/cli/v2/pkg/cmd/attestation/io"
+	"github.com/cli/cli/v2/pkg/cmd/attestation/verification"
+)
+
+const (
+	sarifSchemaURI   = "https://json.schemastore.org/sarif-2.1.0.json"
+	sarifVersion     = "2.1.0"
+	sarifToolName    = "gh attestation verify"
+	sarifToolInfoURI = "https://cli.github.com"
+
+	vsaStatementType = "https://in-toto.io/Statement/v1"
+	vsaPredicateType = "https://slsa.dev/verification_summary/v1"
+	vsaVerifierID    = "https://cli.github.com"
+	vsaResultPassed  = "PASSED"
+)
+
+// sarifReport is the subset of the SARIF 2.1.0 schema emitted by the verify
+// command, one result per verified attestation.
+type sarifReport struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string      `json:"name"`
+	InformationURI string      `json:"informationUri"`
+	Rules          []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID string `json:"id"`
+}
+
+type sarifResult struct {
+	RuleID    string          `json:"ruleId"`
+	Level     string          `json:"level"`
+	Message   sarifMessage    `json:"message"`
+	Locations []sarifLocation `json:"locations"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+func buildSarifReport(tenant string, art artifact.DigestedArtifact, results []*verification.AttestationProcessingResult) (sarifReport, error) {
+	content, err := buildTableVerifyContent(tenant, results)
+	if err != nil {
+		return sarifReport{}, err
+	}
+
+	var rules []sarifRule
+	seenRules := make(map[string]bool)
+	sarifResults := make([]sarifResult, len(content))
+	for i, row := range content {
+		repo, predicateType, workflow := row[0], row[1], row[2]
+		if !seenRules[predicateType] {
+			seenRules[predicateType] = true
+			rules = append(rules, sarifRule{ID: predicateType})
+		}
+		sarifResults[i] = sarifResult{
+			RuleID: predicateType,
+			Level:  "note",
+			Message: sarifMessage{
+				Text: fmt.Sprintf("%s was attested by workflow %s in %s", art.DigestWithAlg(), workflow, repo),
+			},
+			Locations: []sarifLocation{
+				{
+					PhysicalLocation: sarifPhysicalLocation{
+						ArtifactLocation: sarifArtifactLocation{URI: art.URL},
+					},
+				},
+			},
+		}
+	}
+
+	return sarifReport{
+		Schema:  sarifSchemaURI,
+		Version: sarifVersion,
+		Runs: []sarifRun{
+			{
+				Tool: sarifTool{
+					Driver: sarifDriver{
+						Name:           sarifToolName,
+						InformationURI: sarifToolInfoURI,
+						Rules:          rules,
+					},
+				},
+				Results: sarifResults,
+			},
+		},
+	}, nil
+}
+
+// vsaStatement is an in-toto statement carrying a SLSA Verification Summary
+// Attestation predicate.
+type vsaStatement struct {
+	Type          string       `json:"_type"`
+	Subject       []vsaSubject `json:"subject"`
+	PredicateType string       `json:"predicateType"`
+	Predicate     vsaPredicate `json:"predicate"`
+}
+
+type vsaSubject struct {
+	Name   string            `json:"name"`
+	Digest map[string]string `json:"digest"`
+}
+
+type vsaPredicate struct {
+	Verifier           vsaVerifier `json:"verifier"`
+	TimeVerified       string      `json:"timeVerified"`
+	ResourceURI        string      `json:"resourceUri"`
+	VerificationResult string      `json:"verificationResult"`
+	VerifiedLevels     []string    `json:"verifiedLevels"`
+}
+
+type vsaVerifier struct {
+	ID string `json:"id"`
+}
+
+func buildVSAStatement(art artifact.DigestedArtifact, results []*verification.AttestationProcessingResult) (vsaStatement, error) {
+	// a VSA only makes sense for attestations that passed verification
+	if len(results) == 0 {
+		return vsaStatement{}, fmt.Errorf("no verified attestations to summarize")
+	}
+
+	return vsaStatement{
+		Type: vsaStatementType,
+		Subject: []vsaSubject{
+			{
+				Name:   art.URL,
+				Digest: map[string]string{art.Algorithm(): art.Digest()},
+			},
+		},
+		PredicateType: vsaPredicateType,
+		Predicate: vsaPredicate{
+			Verifier:           vsaVerifier{ID: vsaVerifierID},
+			TimeVerified:       time.Now().UTC().Format(time.RFC3339),
+			ResourceURI:        art.URL,
+			VerificationResult: vsaResultPassed,
+			VerifiedLevels:     []string{},
+		},
+	}, nil
+}
+
+// writeFormattedOutput marshals v as indented JSON to the logger's stdout.
+func writeFormattedOutput(logger *io.Handler, v interface{}) error {
+	output, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(logger.IO.Out, string(output))
+	return err
+}
@@ -0,0 +1,93 @@
+package verify
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/cli/cli/v2/internal/text"
+	"github.com/cli/cli/v2/pkg/cmd/attestation/artifact"
+	"github.com/cli/cli/v2/pkg/cmd/attestation/artifact/oci"
+)
+
+// selectPlatform picks the platform manifest matching the platform flag
+func selectPlatform(platforms []oci.PlatformDigest, platform string) (oci.PlatformDigest, error) {
+	for _, p := range platforms {
+		if p.Platform == platform {
+			return p, nil
+		}
+	}
+	return oci.PlatformDigest{}, fmt.Errorf("platform %s not found in image index", platform)
+}
+
+// runVerifyPlatforms verifies every platform manifest of an image index with
+// a bounded worker pool and prints a summary table with one row per platform
+func runVerifyPlatforms(opts *Options, art *artifact.DigestedArtifact, platforms []oci.PlatformDigest) error {
+	ec, err := newEnforcementCriteria(opts)
+	if err != nil {
+		opts.Logger.Println(opts.Logger.ColorScheme.Red("✗ Failed to build verification policy"))
+		return err
+	}
+
+	if err := ec.Valid(); err != nil {
+		opts.Logger.Println(opts.Logger.ColorScheme.Red("✗ Invalid verification policy"))
+		return err
+	}
+
+	totalPlatforms := len(platforms)
+	results := make([]artifactResult, totalPlatforms)
+
+	jobs := opts.Jobs
+	if jobs < 1 {
+		jobs = 1
+	}
+	if jobs > totalPlatforms {
+		jobs = totalPlatforms
+	}
+
+	indices := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < jobs; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indices {
+				p := platforms[i]
+				platformArtifact := art.WithDigest(p.Digest.Algorithm, p.Digest.Hex)
+				results[i] = verifyDigestedArtifact(opts, ec, p.Platform, platformArtifact)
+			}
+		}()
+	}
+	for i := range platforms {
+		indices <- i
+	}
+	close(indices)
+	wg.Wait()
+
+	var failed int
+	content := make([][]string, totalPlatforms)
+	for i, res := range results {
+		digest := "-"
+		if res.artifact != nil {
+			digest = res.artifact.DigestWithAlg()
+		}
+		if res.err != nil {
+			failed++
+			content[i] = []string{res.path, digest, "-", opts.Logger.ColorScheme.Redf("failed: %v", res.err)}
+			continue
+		}
+		content[i] = []string{res.path, digest, fmt.Sprintf("%d", len(res.verified)), opts.Logger.ColorScheme.Green("verified")}
+	}
+
+	headers := []string{"platform", "digest", "attestations", "result"}
+	if err := opts.Logger.PrintTable(headers, content); err != nil {
+		opts.Logger.Println(opts.Logger.ColorScheme.Red("failed to print verification results to table"))
+		return err
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%s of %d failed verification", text.Pluralize(failed, "platform"), totalPlatforms)
+	}
+
+	opts.Logger.Println(opts.Logger.ColorScheme.Greenf("✓ Verification succeeded for all %d platforms!", totalPlatforms))
+	return nil
+}
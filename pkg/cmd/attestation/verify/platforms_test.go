@@ -0,0 +1,97 @@
+package verify
+
+import (
+	"testing"
+
+	"github.com/cli/cli/v2/pkg/cmd/attestation/api"
+	"github.com/cli/cli/v2/pkg/cmd/attestation/artifact/oci"
+	"github.com/cli/cli/v2/pkg/cmd/attestation/io"
+	"github.com/cli/cli/v2/pkg/cmd/attestation/verification"
+	"github.com/cli/cli/v2/pkg/iostreams"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSelectPlatform(t *testing.T) {
+	platforms, err := oci.MultiPlatformClient{}.GetPlatformDigests("ghcr.io/github/test")
+	require.NoError(t, err)
+
+	t.Run("with a matching platform", func(t *testing.T) {
+		platform, err := selectPlatform(platforms, "linux/arm64")
+		require.NoError(t, err)
+		assert.Equal(t, "linux/arm64", platform.Platform)
+	})
+
+	t.Run("with an unknown platform", func(t *testing.T) {
+		_, err := selectPlatform(platforms, "windows/amd64")
+		require.ErrorContains(t, err, "platform windows/amd64 not found in image index")
+	})
+}
+
+func TestRunVerifyPlatforms(t *testing.T) {
+	newOpts := func(t *testing.T, ios *iostreams.IOStreams) Options {
+		return Options{
+			ArtifactPath:     "oci://ghcr.io/github/test",
+			DigestAlgorithm:  "sha256",
+			Jobs:             2,
+			APIClient:        api.NewTestClient(),
+			Logger:           io.NewHandler(ios),
+			OCIClient:        oci.MultiPlatformClient{},
+			OIDCIssuer:       verification.GitHubOIDCIssuer,
+			Owner:            "sigstore",
+			PredicateType:    verification.SLSAPredicateV1,
+			SANRegex:         "^https://github.com/sigstore/",
+			SigstoreVerifier: verification.NewMockSigstoreVerifier(t),
+		}
+	}
+
+	t.Run("verifies every platform in the index", func(t *testing.T) {
+		testIO, _, out, errOut := iostreams.Test()
+		testIO.SetStdoutTTY(true)
+		opts := newOpts(t, testIO)
+
+		require.NoError(t, runVerify(&opts))
+		assert.Contains(t, out.String(), "linux/amd64")
+		assert.Contains(t, out.String(), "linux/arm64")
+		assert.Contains(t, errOut.String(), "✓ Verification succeeded for all 2 platforms!")
+	})
+
+	t.Run("verifies a single selected platform", func(t *testing.T) {
+		testIO, _, _, errOut := iostreams.Test()
+		testIO.SetStdoutTTY(true)
+		opts := newOpts(t, testIO)
+		opts.Platform = "linux/arm64"
+
+		require.NoError(t, runVerify(&opts))
+		assert.Contains(t, errOut.String(), "✓ Verification succeeded!")
+	})
+
+	t.Run("errors when the selected platform is not in the index", func(t *testing.T) {
+		testIO, _, _, _ := iostreams.Test()
+		opts := newOpts(t, testIO)
+		opts.Platform = "windows/amd64"
+
+		err := runVerify(&opts)
+		require.ErrorContains(t, err, "platform windows/amd64 not found in image index")
+	})
+
+	t.Run("errors when a platform is selected for a single-platform image", func(t *testing.T) {
+		testIO, _, _, _ := iostreams.Test()
+		opts := newOpts(t, testIO)
+		opts.OCIClient = oci.MockClient{}
+		opts.Platform = "linux/amd64"
+
+		err := runVerify(&opts)
+		require.ErrorContains(t, err, "not a multi-platform image index")
+	})
+
+	t.Run("errors when format is combined with an unselected index", func(t *testing.T) {
+		testIO, _, _, _ := iostreams.Test()
+		opts := newOpts(t, testIO)
+		opts.format = "json"
+
+		err := runVerify(&opts)
+		require.ErrorContains(t, err, "format can only be used with a single platform")
+	})
+}
@@ -0,0 +1,112 @@
+package verify
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// policyFile expresses a verification policy as a file, so organizations can
+// share one policy instead of repeating signer identity flags on every
+// invocation.
+type policyFile struct {
+	// PredicateType filters attestations by predicate type.
+	PredicateType string `yaml:"predicateType"`
+	// OIDCIssuer is the expected issuer of the OIDC token.
+	OIDCIssuer string `yaml:"oidcIssuer"`
+	// SignerIdentities are the allowed certificate subject alternative
+	// names; any match passes.
+	SignerIdentities []string `yaml:"signerIdentities"`
+	// SignerIdentityRegex matches the certificate subject alternative name
+	// against a regular expression.
+	SignerIdentityRegex string `yaml:"signerIdentityRegex"`
+	// SignerRepo is the repository of the reusable workflow that signed the
+	// attestation, in the format <owner>/<repo>.
+	SignerRepo string `yaml:"signerRepo"`
+	// SignerWorkflow is the workflow that signed the attestation, in the
+	// format [host/]<owner>/<repo>/<path>/<to>/<workflow>.
+	SignerWorkflow string `yaml:"signerWorkflow"`
+	// DenySelfHostedRunners fails verification for attestations generated
+	// on self-hosted runners.
+	DenySelfHostedRunners bool `yaml:"denySelfHostedRunners"`
+}
+
+func loadPolicyFile(path string) (policyFile, error) {
+	var policy policyFile
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return policy, fmt.Errorf("failed to read policy file %s: %w", path, err)
+	}
+	if err := yaml.Unmarshal(contents, &policy); err != nil {
+		return policy, fmt.Errorf("failed to parse policy file %s: %w", path, err)
+	}
+	if err := policy.validate(); err != nil {
+		return policy, fmt.Errorf("invalid policy file %s: %w", path, err)
+	}
+	return policy, nil
+}
+
+func (p policyFile) validate() error {
+	provided := 0
+	if len(p.SignerIdentities) > 0 {
+		provided++
+	}
+	if p.SignerIdentityRegex != "" {
+		provided++
+	}
+	if p.SignerRepo != "" {
+		provided++
+	}
+	if p.SignerWorkflow != "" {
+		provided++
+	}
+	if provided > 1 {
+		return fmt.Errorf("only one of signerIdentities, signerIdentityRegex, signerRepo, or signerWorkflow may be provided")
+	}
+
+	if p.SignerRepo != "" && !isProvidedRepoValid(p.SignerRepo) {
+		return fmt.Errorf("invalid value provided for signerRepo: %s", p.SignerRepo)
+	}
+
+	if p.SignerIdentityRegex != "" {
+		if _, err := regexp.Compile(p.SignerIdentityRegex); err != nil {
+			return fmt.Errorf("invalid value provided for signerIdentityRegex: %v", err)
+		}
+	}
+
+	return nil
+}
+
+// applyTo maps the policy file onto the equivalent verification options, as
+// if the matching flags had been provided.
+func (p policyFile) applyTo(opts *Options) {
+	if p.PredicateType != "" {
+		opts.PredicateType = p.PredicateType
+	}
+	if p.OIDCIssuer != "" {
+		opts.OIDCIssuer = p.OIDCIssuer
+	}
+	if p.DenySelfHostedRunners {
+		opts.DenySelfHostedRunner = true
+	}
+
+	switch {
+	case len(p.SignerIdentities) == 1:
+		opts.SAN = p.SignerIdentities[0]
+	case len(p.SignerIdentities) > 1:
+		quoted := make([]string, len(p.SignerIdentities))
+		for i, identity := range p.SignerIdentities {
+			quoted[i] = regexp.QuoteMeta(identity)
+		}
+		opts.SANRegex = fmt.Sprintf("^(?:%s)$", strings.Join(quoted, "|"))
+	case p.SignerIdentityRegex != "":
+		opts.SANRegex = p.SignerIdentityRegex
+	case p.SignerRepo != "":
+		opts.SignerRepo = p.SignerRepo
+	case p.SignerWorkflow != "":
+		opts.SignerWorkflow = p.SignerWorkflow
+	}
+}
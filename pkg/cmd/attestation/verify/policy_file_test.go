@@ -0,0 +1,163 @@
+package verify
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/cli/cli/v2/pkg/cmd/attestation/verification"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writePolicyFile(t *testing.T, contents string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "policy.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(contents), 0644))
+	return path
+}
+
+func TestLoadPolicyFile(t *testing.T) {
+	t.Run("with a full policy", func(t *testing.T) {
+		path := writePolicyFile(t, heredocPolicy)
+
+		policy, err := loadPolicyFile(path)
+		require.NoError(t, err)
+		assert.Equal(t, "https://slsa.dev/provenance/v1", policy.PredicateType)
+		assert.Equal(t, "https://token.actions.githubusercontent.com", policy.OIDCIssuer)
+		assert.Equal(t, []string{"https://github.com/github/example/.github/workflows/release.yml@refs/heads/main"}, policy.SignerIdentities)
+		assert.True(t, policy.DenySelfHostedRunners)
+	})
+
+	t.Run("with a missing file", func(t *testing.T) {
+		_, err := loadPolicyFile(filepath.Join(t.TempDir(), "missing.yaml"))
+		require.ErrorContains(t, err, "failed to read policy file")
+	})
+
+	t.Run("with malformed yaml", func(t *testing.T) {
+		path := writePolicyFile(t, "signerIdentities: [")
+		_, err := loadPolicyFile(path)
+		require.ErrorContains(t, err, "failed to parse policy file")
+	})
+
+	t.Run("with multiple identity sources", func(t *testing.T) {
+		path := writePolicyFile(t, "signerRepo: github/example\nsignerWorkflow: github/example/.github/workflows/release.yml\n")
+		_, err := loadPolicyFile(path)
+		require.ErrorContains(t, err, "only one of signerIdentities, signerIdentityRegex, signerRepo, or signerWorkflow may be provided")
+	})
+
+	t.Run("with an invalid signer repo", func(t *testing.T) {
+		path := writePolicyFile(t, "signerRepo: github\n")
+		_, err := loadPolicyFile(path)
+		require.ErrorContains(t, err, "invalid value provided for signerRepo")
+	})
+
+	t.Run("with an invalid identity regex", func(t *testing.T) {
+		path := writePolicyFile(t, "signerIdentityRegex: '['\n")
+		_, err := loadPolicyFile(path)
+		require.ErrorContains(t, err, "invalid value provided for signerIdentityRegex")
+	})
+}
+
+const heredocPolicy = `predicateType: https://slsa.dev/provenance/v1
+oidcIssuer: https://token.actions.githubusercontent.com
+signerIdentities:
+  - https://github.com/github/example/.github/workflows/release.yml@refs/heads/main
+denySelfHostedRunners: true
+`
+
+func TestPolicyFileApplyTo(t *testing.T) {
+	t.Run("a single signer identity becomes an exact SAN", func(t *testing.T) {
+		opts := &Options{}
+		policyFile{
+			SignerIdentities: []string{"https://github.com/github/example/.github/workflows/release.yml@refs/heads/main"},
+		}.applyTo(opts)
+		assert.Equal(t, "https://github.com/github/example/.github/workflows/release.yml@refs/heads/main", opts.SAN)
+		assert.Empty(t, opts.SANRegex)
+	})
+
+	t.Run("multiple signer identities become an anchored alternation", func(t *testing.T) {
+		opts := &Options{}
+		policyFile{
+			SignerIdentities: []string{"https://example.com/a", "https://example.com/b"},
+		}.applyTo(opts)
+		assert.Empty(t, opts.SAN)
+		assert.Equal(t, `^(?:https://example\.com/a|https://example\.com/b)$`, opts.SANRegex)
+	})
+
+	t.Run("remaining fields map onto the matching options", func(t *testing.T) {
+		opts := &Options{
+			OIDCIssuer:    verification.GitHubOIDCIssuer,
+			PredicateType: verification.SLSAPredicateV1,
+		}
+		policyFile{
+			PredicateType:         "https://example.com/predicate/v1",
+			OIDCIssuer:            "https://example.com/issuer",
+			SignerRepo:            "github/example",
+			DenySelfHostedRunners: true,
+		}.applyTo(opts)
+		assert.Equal(t, "https://example.com/predicate/v1", opts.PredicateType)
+		assert.Equal(t, "https://example.com/issuer", opts.OIDCIssuer)
+		assert.Equal(t, "github/example", opts.SignerRepo)
+		assert.True(t, opts.DenySelfHostedRunner)
+	})
+}
+
+func TestVerifyCmdWithPolicyFile(t *testing.T) {
+	testIO, _, _, _ := iostreams.Test()
+	f := &cmdutil.Factory{
+		IOStreams: testIO,
+		HttpClient: func() (*http.Client, error) {
+			reg := &httpmock.Registry{}
+			client := &http.Client{}
+			httpmock.ReplaceTripper(client, reg)
+			return client, nil
+		},
+	}
+
+	t.Run("policy file values are applied to the options", func(t *testing.T) {
+		path := writePolicyFile(t, heredocPolicy)
+
+		var opts *Options
+		cmd := NewVerifyCmd(f, func(o *Options) error {
+			opts = o
+			return nil
+		})
+
+		argv := strings.Split(fmt.Sprintf("%s --owner sigstore --policy %s", artifactPath, path), " ")
+		cmd.SetArgs(argv)
+		cmd.SetIn(&bytes.Buffer{})
+		cmd.SetOut(&bytes.Buffer{})
+		cmd.SetErr(&bytes.Buffer{})
+		_, err := cmd.ExecuteC()
+		require.NoError(t, err)
+
+		assert.Equal(t, path, opts.PolicyPath)
+		assert.Equal(t, "https://slsa.dev/provenance/v1", opts.PredicateType)
+		assert.Equal(t, "https://token.actions.githubusercontent.com", opts.OIDCIssuer)
+		assert.Equal(t, "https://github.com/github/example/.github/workflows/release.yml@refs/heads/main", opts.SAN)
+		assert.True(t, opts.DenySelfHostedRunner)
+	})
+
+	t.Run("policy flag cannot be combined with signer identity flags", func(t *testing.T) {
+		path := writePolicyFile(t, heredocPolicy)
+
+		cmd := NewVerifyCmd(f, func(o *Options) error { return nil })
+
+		argv := strings.Split(fmt.Sprintf("%s --owner sigstore --policy %s --signer-repo github/example", artifactPath, path), " ")
+		cmd.SetArgs(argv)
+		cmd.SetIn(&bytes.Buffer{})
+		cmd.SetOut(&bytes.Buffer{})
+		cmd.SetErr(&bytes.Buffer{})
+		_, err := cmd.ExecuteC()
+		require.Error(t, err)
+	})
+}
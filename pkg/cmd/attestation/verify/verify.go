@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"regexp"
+	"strings"
 
 	"github.com/cli/cli/v2/internal/ghinstance"
 	"github.com/cli/cli/v2/pkg/cmd/attestation/api"
@@ -22,8 +23,8 @@ import (
 func NewVerifyCmd(f *cmdutil.Factory, runF func(*Options) error) *cobra.Command {
 	opts := &Options{}
 	verifyCmd := &cobra.Command{
-		Use:   "verify [<file-path> | oci://<image-uri>] [--owner | --repo]",
-		Args:  cmdutil.ExactArgs(1, "must specify file path or container image URI, as well as one of --owner or --repo"),
+		Use:   "verify [<file-path> | oci://<image-uri>]... [--owner | --repo]",
+		Args:  cmdutil.MinimumArgs(1, "must specify at least one file path or container image URI, as well as one of --owner or --repo"),
 		Short: "Verify an artifact's integrity using attestations",
 		Long: heredoc.Docf(`
 			Verify the integrity and provenance of an artifact using its associated
@@ -40,6 +41,17 @@ func NewVerifyCmd(f *cmdutil.Factory, runF func(*Options) error) *cobra.Command
 			  * (note that if you provide an OCI URL, you must already be authenticated with
 			its container registry)
 
+			When the artifact is a multi-arch image index, every platform manifest in
+			the index is resolved and verified individually, with one row per platform
+			in a summary table. Use the %[1]s--platform%[1]s flag to verify a single
+			platform (e.g. %[1]s--platform linux/amd64%[1]s) instead.
+
+			More than one artifact can be provided: arguments may be repeated, and a
+			glob pattern (e.g. %[1]sdist/*.tar.gz%[1]s) or a directory expands to the files it
+			matches. Artifacts are then verified concurrently (c.f. the %[1]s--jobs%[1]s flag),
+			one row per artifact is printed in a summary table, and the command exits
+			non-zero if any artifact fails verification.
+
 			To fetch the attestation, and validate the identity of the signer, the command
 			requires either:
 			* the %[1]s--repo%[1]s flag (e.g. --repo github/example).
@@ -60,6 +72,14 @@ func NewVerifyCmd(f *cmdutil.Factory, runF func(*Options) error) *cobra.Command
 			To use your artifact's OCI registry instead of GitHub's API, use the
 			%[1]s--bundle-from-oci%[1]s flag. For offline verification, using attestations
 			stored on desk (c.f. the download command), provide a path to the %[1]s--bundle%[1]s flag.
+			The %[1]s--bundle%[1]s flag also accepts a directory containing %[1]s*.sigstore.json%[1]s
+			bundles, which are matched to the artifact by its digest.
+
+			In air-gapped environments, add the %[1]s--offline%[1]s flag. It requires both
+			%[1]s--bundle%[1]s and %[1]s--custom-trusted-root%[1]s, and guarantees that verification
+			happens without any network access: no GitHub API lookups and no refresh of the
+			Sigstore TUF trust repository. Flag combinations that would require the network
+			are rejected before verification begins.
 
 			To see the full results that are generated upon successful verification, i.e.
 			for use with a policy engine, provide the %[1]s--format=json%[1]s flag.
@@ -79,6 +99,18 @@ func NewVerifyCmd(f *cmdutil.Factory, runF func(*Options) error) *cobra.Command
 			When using reusable workflows, use the %[1]s--signer-repo%[1]s, %[1]s--signer-workflow%[1]s,
 			or %[1]s--cert-identity%[1]s flags to validate the signer workflow's identity.
 
+			Instead of repeating identity flags on every invocation, the %[1]s--policy%[1]s flag
+			accepts a YAML policy file expressing the allowed signer identities, OIDC issuer,
+			predicate type, and runner requirements, e.g.:
+
+				predicateType: https://slsa.dev/provenance/v1
+				oidcIssuer: https://token.actions.githubusercontent.com
+				signerIdentities:
+				  - https://github.com/github/example/.github/workflows/release.yml@refs/heads/main
+				denySelfHostedRunners: true
+
+			The policy file cannot be combined with the individual policy flags it replaces.
+
 			For more policy verification options, see the other available flags.
 			`, "`"),
 		Example: heredoc.Doc(`
@@ -88,14 +120,32 @@ func NewVerifyCmd(f *cmdutil.Factory, runF func(*Options) error) *cobra.Command
 			# Verify an artifact linked with an organization
 			$ gh attestation verify example.bin --owner github
 
+			# Verify every artifact in a directory
+			$ gh attestation verify dist/ --owner github
+
 			# Verify an artifact and output the full verification result
 			$ gh attestation verify example.bin --owner github --format json
 
+			# Verify an artifact and output a SARIF report for code scanning
+			$ gh attestation verify example.bin --owner github --format sarif
+
+			# Verify an artifact and output a SLSA verification summary attestation
+			$ gh attestation verify example.bin --owner github --format vsa
+
+			# Verify a single platform of a multi-arch image index
+			$ gh attestation verify oci://<image-uri> --owner github --platform linux/amd64
+
 			# Verify an OCI image using attestations stored on disk
 			$ gh attestation verify oci://<image-uri> --owner github --bundle sha256:foo.jsonl
 
+			# Verify an artifact in an air-gapped environment
+			$ gh attestation verify example.bin --owner github --offline --bundle attestations.jsonl --custom-trusted-root trusted_root.jsonl
+
 			# Verify an artifact signed with a reusable workflow
 			$ gh attestation verify example.bin --owner github --signer-repo actions/example
+
+			# Verify an artifact against a shared policy file
+			$ gh attestation verify example.bin --owner github --policy policy.yaml
 		`),
 		// PreRunE is used to validate flags before the command is run
 		// If an error is returned, its message will be printed to the terminal
@@ -104,8 +154,13 @@ func NewVerifyCmd(f *cmdutil.Factory, runF func(*Options) error) *cobra.Command
 			// Create a logger for use throughout the verify command
 			opts.Logger = io.NewHandler(f.IOStreams)
 
-			// set the artifact path
-			opts.ArtifactPath = args[0]
+			// set the artifact paths, expanding glob patterns and directories
+			paths, err := expandArtifactPaths(args)
+			if err != nil {
+				return err
+			}
+			opts.ArtifactPaths = paths
+			opts.ArtifactPath = paths[0]
 
 			// Check that the given flag combination is valid
 			if err := opts.AreFlagsValid(); err != nil {
@@ -115,46 +170,62 @@ func NewVerifyCmd(f *cmdutil.Factory, runF func(*Options) error) *cobra.Command
 			// Clean file path options
 			opts.Clean()
 
+			// Apply a policy file as if the matching flags had been provided
+			if opts.PolicyPath != "" {
+				policy, err := loadPolicyFile(opts.PolicyPath)
+				if err != nil {
+					return err
+				}
+				policy.applyTo(opts)
+			}
+
 			return nil
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			hc, err := f.HttpClient()
-			if err != nil {
-				return err
-			}
-
 			opts.OCIClient = oci.NewLiveClient()
 
 			if opts.Hostname == "" {
 				opts.Hostname, _ = ghauth.DefaultHost()
 			}
-			err = auth.IsHostSupported(opts.Hostname)
-			if err != nil {
-				return err
+			// In offline mode the API is never consulted: attestations come
+			// from the bundle flag and trust material from the
+			// custom-trusted-root flag
+			if !opts.Offline {
+				hc, err := f.HttpClient()
+				if err != nil {
+					return err
+				}
+				if err := auth.IsHostSupported(hc, opts.Hostname); err != nil {
+					return err
+				}
+				opts.APIClient = api.NewLiveClient(hc, opts.Hostname, opts.Logger)
 			}
 
-			opts.APIClient = api.NewLiveClient(hc, opts.Hostname, opts.Logger)
-
 			config := verification.SigstoreConfig{
 				TrustedRoot:  opts.TrustedRoot,
 				Logger:       opts.Logger,
 				NoPublicGood: opts.NoPublicGood,
+				Jobs:         opts.Jobs,
 			}
 
 			// Prepare for tenancy if detected
 			if ghauth.IsTenancy(opts.Hostname) {
-				td, err := opts.APIClient.GetTrustDomain()
-				if err != nil {
-					return fmt.Errorf("error getting trust domain, make sure you are authenticated against the host: %w", err)
-				}
-
 				tenant, found := ghinstance.TenantName(opts.Hostname)
 				if !found {
 					return fmt.Errorf("invalid hostname provided: '%s'",
 						opts.Hostname)
 				}
-				config.TrustDomain = td
 				opts.Tenant = tenant
+
+				// The trust domain is only needed to pick a TUF target, and
+				// offline verification never queries TUF
+				if !opts.Offline {
+					td, err := opts.APIClient.GetTrustDomain()
+					if err != nil {
+						return fmt.Errorf("error getting trust domain, make sure you are authenticated against the host: %w", err)
+					}
+					config.TrustDomain = td
+				}
 			}
 
 			// set policy flags based on what has been provided
@@ -175,7 +246,7 @@ func NewVerifyCmd(f *cmdutil.Factory, runF func(*Options) error) *cobra.Command
 	}
 
 	// general flags
-	verifyCmd.Flags().StringVarP(&opts.BundlePath, "bundle", "b", "", "Path to bundle on disk, either a single bundle in a JSON file or a JSON lines file with multiple bundles")
+	verifyCmd.Flags().StringVarP(&opts.BundlePath, "bundle", "b", "", "Path to bundle on disk, either a single bundle in a JSON file, a JSON lines file with multiple bundles, or a directory of *.sigstore.json bundles")
 	cmdutil.DisableAuthCheckFlag(verifyCmd.Flags().Lookup("bundle"))
 	verifyCmd.Flags().BoolVarP(&opts.UseBundleFromRegistry, "bundle-from-oci", "", false, "When verifying an OCI image, fetch the attestation bundle from the OCI registry instead of from GitHub")
 	cmdutil.StringEnumFlag(verifyCmd, &opts.DigestAlgorithm, "digest-alg", "d", "sha256", []string{"sha256", "sha512"}, "The algorithm used to compute a digest of the artifact")
@@ -183,11 +254,15 @@ func NewVerifyCmd(f *cmdutil.Factory, runF func(*Options) error) *cobra.Command
 	verifyCmd.Flags().StringVarP(&opts.Repo, "repo", "R", "", "Repository name in the format <owner>/<repo>")
 	verifyCmd.MarkFlagsMutuallyExclusive("owner", "repo")
 	verifyCmd.MarkFlagsOneRequired("owner", "repo")
+	verifyCmd.Flags().StringVarP(&opts.Platform, "platform", "", "", "Verify only the provided platform of a multi-arch image index, in the format <os>/<arch>")
 	verifyCmd.Flags().StringVarP(&opts.PredicateType, "predicate-type", "", verification.SLSAPredicateV1, "Filter attestations by provided predicate type")
 	verifyCmd.Flags().BoolVarP(&opts.NoPublicGood, "no-public-good", "", false, "Do not verify attestations signed with Sigstore public good instance")
 	verifyCmd.Flags().StringVarP(&opts.TrustedRoot, "custom-trusted-root", "", "", "Path to a trusted_root.jsonl file; likely for offline verification")
+	verifyCmd.Flags().BoolVarP(&opts.Offline, "offline", "", false, "Verify without any network access; requires the bundle and custom-trusted-root flags")
+	cmdutil.DisableAuthCheckFlag(verifyCmd.Flags().Lookup("offline"))
 	verifyCmd.Flags().IntVarP(&opts.Limit, "limit", "L", api.DefaultLimit, "Maximum number of attestations to fetch")
-	cmdutil.AddFormatFlags(verifyCmd, &opts.exporter)
+	verifyCmd.Flags().IntVarP(&opts.Jobs, "jobs", "j", 1, "Number of attestations to verify in parallel")
+	cmdutil.AddFormatFlagsWithFormats(verifyCmd, &opts.exporter, &opts.format, []string{"json", "sarif", "vsa"})
 	// policy enforcement flags
 	verifyCmd.Flags().BoolVarP(&opts.DenySelfHostedRunner, "deny-self-hosted-runners", "", false, "Fail verification for attestations generated on self-hosted runners")
 	verifyCmd.Flags().StringVarP(&opts.SAN, "cert-identity", "", "", "Enforce that the certificate's subject alternative name matches the provided value exactly")
@@ -196,12 +271,24 @@ func NewVerifyCmd(f *cmdutil.Factory, runF func(*Options) error) *cobra.Command
 	verifyCmd.Flags().StringVarP(&opts.SignerWorkflow, "signer-workflow", "", "", "Workflow that signed attestation in the format [host/]<owner>/<repo>/<path>/<to>/<workflow>")
 	verifyCmd.MarkFlagsMutuallyExclusive("cert-identity", "cert-identity-regex", "signer-repo", "signer-workflow")
 	verifyCmd.Flags().StringVarP(&opts.OIDCIssuer, "cert-oidc-issuer", "", verification.GitHubOIDCIssuer, "Issuer of the OIDC token")
+	verifyCmd.Flags().StringVarP(&opts.PolicyPath, "policy", "", "", "Path to a policy file expressing allowed signer identities, OIDC issuers, and predicate types")
+	verifyCmd.MarkFlagsMutuallyExclusive("policy", "cert-identity")
+	verifyCmd.MarkFlagsMutuallyExclusive("policy", "cert-identity-regex")
+	verifyCmd.MarkFlagsMutuallyExclusive("policy", "signer-repo")
+	verifyCmd.MarkFlagsMutuallyExclusive("policy", "signer-workflow")
+	verifyCmd.MarkFlagsMutuallyExclusive("policy", "cert-oidc-issuer")
+	verifyCmd.MarkFlagsMutuallyExclusive("policy", "predicate-type")
 	verifyCmd.Flags().StringVarP(&opts.Hostname, "hostname", "", "", "Configure host to use")
 
 	return verifyCmd
 }
 
 func runVerify(opts *Options) error {
+	// multiple artifacts are verified concurrently and summarized in a table
+	if len(opts.ArtifactPaths) > 1 {
+		return runVerifyAll(opts)
+	}
+
 	ec, err := newEnforcementCriteria(opts)
 	if err != nil {
 		opts.Logger.Println(opts.Logger.ColorScheme.Red("✗ Failed to build verification policy"))
@@ -221,6 +308,34 @@ func runVerify(opts *Options) error {
 
 	opts.Logger.Printf("Loaded digest %s for %s\n", artifact.DigestWithAlg(), artifact.URL)
 
+	// a multi-arch image index is resolved to its platform manifests, which
+	// are verified individually
+	if strings.HasPrefix(opts.ArtifactPath, "oci://") {
+		platforms, err := opts.OCIClient.GetPlatformDigests(strings.TrimPrefix(opts.ArtifactPath, "oci://"))
+		if err != nil {
+			opts.Logger.Println(opts.Logger.ColorScheme.Red("✗ Resolving image index platforms failed"))
+			return err
+		}
+
+		if opts.Platform != "" {
+			if len(platforms) == 0 {
+				return fmt.Errorf("platform %s requested, but %s is not a multi-platform image index", opts.Platform, artifact.URL)
+			}
+			platform, err := selectPlatform(platforms, opts.Platform)
+			if err != nil {
+				return err
+			}
+			// continue single-artifact verification against the platform manifest
+			artifact = artifact.WithDigest(platform.Digest.Algorithm, platform.Digest.Hex)
+			opts.Logger.Printf("Resolved platform %s to digest %s\n", platform.Platform, artifact.DigestWithAlg())
+		} else if len(platforms) > 0 {
+			if opts.format != "" {
+				return fmt.Errorf("format can only be used with a single platform of an image index; use the platform flag to select one")
+			}
+			return runVerifyPlatforms(opts, artifact, platforms)
+		}
+	}
+
 	attestations, logMsg, err := getAttestations(opts, *artifact)
 	if err != nil {
 		if ok := errors.Is(err, api.ErrNoAttestations{}); ok {
@@ -252,6 +367,23 @@ func runVerify(opts *Options) error {
 
 	opts.Logger.Println(opts.Logger.ColorScheme.Green("✓ Verification succeeded!\n"))
 
+	switch opts.format {
+	case "sarif":
+		report, err := buildSarifReport(opts.Tenant, *artifact, verified)
+		if err != nil {
+			opts.Logger.Println(opts.Logger.ColorScheme.Red("✗ Failed to build SARIF report"))
+			return err
+		}
+		return writeFormattedOutput(opts.Logger, report)
+	case "vsa":
+		statement, err := buildVSAStatement(*artifact, verified)
+		if err != nil {
+			opts.Logger.Println(opts.Logger.ColorScheme.Red("✗ Failed to build verification summary attestation"))
+			return err
+		}
+		return writeFormattedOutput(opts.Logger, statement)
+	}
+
 	// If an exporter is provided with the --json flag, write the results to the terminal in JSON format
 	if opts.exporter != nil {
 		// print the results to the terminal as an array of JSON objects
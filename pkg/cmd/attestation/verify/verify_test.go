@@ -71,6 +71,7 @@ func TestNewVerifyCmd(t *testing.T) {
 				BundlePath:       test.NormalizeRelativePath("../test/data/sigstore-js-2.1.0-bundle.json"),
 				DigestAlgorithm:  "sha384",
 				Hostname:         "github.com",
+				Jobs:             1,
 				Limit:            30,
 				OIDCIssuer:       verification.GitHubOIDCIssuer,
 				Owner:            "sigstore",
@@ -87,6 +88,7 @@ func TestNewVerifyCmd(t *testing.T) {
 				BundlePath:       test.NormalizeRelativePath("../test/data/sigstore-js-2.1.0-bundle.json"),
 				DigestAlgorithm:  "sha256",
 				Hostname:         "github.com",
+				Jobs:             1,
 				Limit:            30,
 				OIDCIssuer:       verification.GitHubOIDCIssuer,
 				Owner:            "sigstore",
@@ -104,6 +106,7 @@ func TestNewVerifyCmd(t *testing.T) {
 				BundlePath:       test.NormalizeRelativePath("../test/data/sigstore-js-2.1.0-bundle.json"),
 				DigestAlgorithm:  "sha256",
 				Hostname:         "foo.ghe.com",
+				Jobs:             1,
 				Limit:            30,
 				OIDCIssuer:       verification.GitHubOIDCIssuer,
 				Owner:            "sigstore",
@@ -121,6 +124,7 @@ func TestNewVerifyCmd(t *testing.T) {
 				BundlePath:       test.NormalizeRelativePath("../test/data/sigstore-js-2.1.0-bundle.json"),
 				DigestAlgorithm:  "sha256",
 				Hostname:         "foo.ghe.com",
+				Jobs:             1,
 				Limit:            30,
 				OIDCIssuer:       verification.GitHubOIDCIssuer,
 				Owner:            "sigstore",
@@ -138,6 +142,7 @@ func TestNewVerifyCmd(t *testing.T) {
 				BundlePath:       test.NormalizeRelativePath("../test/data/sigstore-js-2.1.0-bundle.json"),
 				DigestAlgorithm:  "sha512",
 				Hostname:         "github.com",
+				Jobs:             1,
 				Limit:            30,
 				OIDCIssuer:       verification.GitHubOIDCIssuer,
 				Owner:            "sigstore",
@@ -154,6 +159,7 @@ func TestNewVerifyCmd(t *testing.T) {
 				ArtifactPath:     test.NormalizeRelativePath("../test/data/sigstore-js-2.1.0.tgz"),
 				DigestAlgorithm:  "sha256",
 				Hostname:         "github.com",
+				Jobs:             1,
 				Limit:            30,
 				OIDCIssuer:       verification.GitHubOIDCIssuer,
 				Owner:            "sigstore",
@@ -170,6 +176,7 @@ func TestNewVerifyCmd(t *testing.T) {
 				ArtifactPath:     artifactPath,
 				DigestAlgorithm:  "sha256",
 				Hostname:         "github.com",
+				Jobs:             1,
 				Limit:            30,
 				OIDCIssuer:       verification.GitHubOIDCIssuer,
 				Owner:            "sigstore",
@@ -186,6 +193,7 @@ func TestNewVerifyCmd(t *testing.T) {
 				ArtifactPath:     artifactPath,
 				DigestAlgorithm:  "sha256",
 				Hostname:         "github.com",
+				Jobs:             1,
 				Limit:            30,
 				OIDCIssuer:       verification.GitHubOIDCIssuer,
 				Owner:            "sigstore",
@@ -202,6 +210,7 @@ func TestNewVerifyCmd(t *testing.T) {
 				ArtifactPath:     artifactPath,
 				DigestAlgorithm:  "sha256",
 				Hostname:         "github.com",
+				Jobs:             1,
 				Limit:            101,
 				OIDCIssuer:       verification.GitHubOIDCIssuer,
 				Owner:            "sigstore",
@@ -218,6 +227,7 @@ func TestNewVerifyCmd(t *testing.T) {
 				ArtifactPath:     artifactPath,
 				DigestAlgorithm:  "sha256",
 				Hostname:         "github.com",
+				Jobs:             1,
 				Limit:            0,
 				OIDCIssuer:       verification.GitHubOIDCIssuer,
 				Owner:            "sigstore",
@@ -227,6 +237,41 @@ func TestNewVerifyCmd(t *testing.T) {
 			},
 			wantsErr: true,
 		},
+		{
+			name: "Uses custom jobs flag",
+			cli:  fmt.Sprintf("%s --bundle %s --owner sigstore --jobs 4", artifactPath, bundlePath),
+			wants: Options{
+				ArtifactPath:     artifactPath,
+				BundlePath:       bundlePath,
+				DigestAlgorithm:  "sha256",
+				Hostname:         "github.com",
+				Jobs:             4,
+				Limit:            30,
+				OIDCIssuer:       verification.GitHubOIDCIssuer,
+				Owner:            "sigstore",
+				PredicateType:    verification.SLSAPredicateV1,
+				SANRegex:         "(?i)^https://github.com/sigstore/",
+				SigstoreVerifier: verification.NewMockSigstoreVerifier(t),
+			},
+			wantsErr: false,
+		},
+		{
+			name: "Uses invalid jobs flag",
+			cli:  fmt.Sprintf("%s --owner sigstore --jobs 0", artifactPath),
+			wants: Options{
+				ArtifactPath:     artifactPath,
+				DigestAlgorithm:  "sha256",
+				Hostname:         "github.com",
+				Jobs:             0,
+				Limit:            30,
+				OIDCIssuer:       verification.GitHubOIDCIssuer,
+				Owner:            "sigstore",
+				PredicateType:    verification.SLSAPredicateV1,
+				SANRegex:         "(?i)^https://github.com/sigstore/",
+				SigstoreVerifier: verification.NewMockSigstoreVerifier(t),
+			},
+			wantsErr: true,
+		},
 		{
 			name: "Has both cert-identity and cert-identity-regex flags",
 			cli:  fmt.Sprintf("%s --owner sigstore --cert-identity https://github.com/sigstore/ --cert-identity-regex ^https://github.com/sigstore/", artifactPath),
@@ -234,6 +279,7 @@ func TestNewVerifyCmd(t *testing.T) {
 				ArtifactPath:     artifactPath,
 				DigestAlgorithm:  "sha256",
 				Hostname:         "github.com",
+				Jobs:             1,
 				Limit:            30,
 				OIDCIssuer:       verification.GitHubOIDCIssuer,
 				Owner:            "sigstore",
@@ -252,6 +298,7 @@ func TestNewVerifyCmd(t *testing.T) {
 				BundlePath:       bundlePath,
 				DigestAlgorithm:  "sha256",
 				Hostname:         "github.com",
+				Jobs:             1,
 				Limit:            30,
 				OIDCIssuer:       verification.GitHubOIDCIssuer,
 				Owner:            "sigstore",
@@ -269,6 +316,7 @@ func TestNewVerifyCmd(t *testing.T) {
 				BundlePath:       bundlePath,
 				DigestAlgorithm:  "sha256",
 				Hostname:         "github.com",
+				Jobs:             1,
 				Limit:            30,
 				OIDCIssuer:       verification.GitHubOIDCIssuer,
 				Owner:            "sigstore",
@@ -305,6 +353,7 @@ func TestNewVerifyCmd(t *testing.T) {
 			assert.Equal(t, tc.wants.DenySelfHostedRunner, opts.DenySelfHostedRunner)
 			assert.Equal(t, tc.wants.DigestAlgorithm, opts.DigestAlgorithm)
 			assert.Equal(t, tc.wants.Hostname, opts.Hostname)
+			assert.Equal(t, tc.wants.Jobs, opts.Jobs)
 			assert.Equal(t, tc.wants.Limit, opts.Limit)
 			assert.Equal(t, tc.wants.NoPublicGood, opts.NoPublicGood)
 			assert.Equal(t, tc.wants.OIDCIssuer, opts.OIDCIssuer)
@@ -350,6 +399,19 @@ func TestVerifyCmdAuthChecks(t *testing.T) {
 		require.NoError(t, cmd.ParseFlags([]string{"--bundle", "not-important"}))
 		require.False(t, cmdutil.IsAuthCheckEnabled(cmd), "expected auth check not to be required due to --bundle flag")
 	})
+
+	t.Run("when --offline flag is provided, auth check is not required", func(t *testing.T) {
+		cmd := NewVerifyCmd(f, func(o *Options) error {
+			return nil
+		})
+
+		// IsAuthCheckEnabled assumes commands under test are subcommands
+		parent := &cobra.Command{Use: "root"}
+		parent.AddCommand(cmd)
+
+		require.NoError(t, cmd.ParseFlags([]string{"--offline"}))
+		require.False(t, cmdutil.IsAuthCheckEnabled(cmd), "expected auth check not to be required due to --offline flag")
+	})
 }
 
 func TestJSONOutput(t *testing.T) {
@@ -375,6 +437,63 @@ func TestJSONOutput(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestSarifOutput(t *testing.T) {
+	testIO, _, out, _ := iostreams.Test()
+	opts := Options{
+		ArtifactPath:     artifactPath,
+		BundlePath:       bundlePath,
+		DigestAlgorithm:  "sha512",
+		APIClient:        api.NewTestClient(),
+		Logger:           io.NewHandler(testIO),
+		OCIClient:        oci.MockClient{},
+		OIDCIssuer:       verification.GitHubOIDCIssuer,
+		Owner:            "sigstore",
+		PredicateType:    verification.SLSAPredicateV1,
+		SANRegex:         "^https://github.com/sigstore/",
+		SigstoreVerifier: verification.NewMockSigstoreVerifier(t),
+		format:           "sarif",
+	}
+	require.NoError(t, runVerify(&opts))
+
+	var report sarifReport
+	require.NoError(t, json.Unmarshal(out.Bytes(), &report))
+	require.Equal(t, "2.1.0", report.Version)
+	require.Len(t, report.Runs, 1)
+	require.NotEmpty(t, report.Runs[0].Results)
+	result := report.Runs[0].Results[0]
+	require.Equal(t, verification.SLSAPredicateV1, result.RuleID)
+	require.Equal(t, "note", result.Level)
+	require.Contains(t, result.Message.Text, "was attested by workflow")
+}
+
+func TestVSAOutput(t *testing.T) {
+	testIO, _, out, _ := iostreams.Test()
+	opts := Options{
+		ArtifactPath:     artifactPath,
+		BundlePath:       bundlePath,
+		DigestAlgorithm:  "sha512",
+		APIClient:        api.NewTestClient(),
+		Logger:           io.NewHandler(testIO),
+		OCIClient:        oci.MockClient{},
+		OIDCIssuer:       verification.GitHubOIDCIssuer,
+		Owner:            "sigstore",
+		PredicateType:    verification.SLSAPredicateV1,
+		SANRegex:         "^https://github.com/sigstore/",
+		SigstoreVerifier: verification.NewMockSigstoreVerifier(t),
+		format:           "vsa",
+	}
+	require.NoError(t, runVerify(&opts))
+
+	var statement vsaStatement
+	require.NoError(t, json.Unmarshal(out.Bytes(), &statement))
+	require.Equal(t, vsaStatementType, statement.Type)
+	require.Equal(t, vsaPredicateType, statement.PredicateType)
+	require.Len(t, statement.Subject, 1)
+	require.NotEmpty(t, statement.Subject[0].Digest["sha512"])
+	require.Equal(t, "PASSED", statement.Predicate.VerificationResult)
+	require.NotEmpty(t, statement.Predicate.TimeVerified)
+}
+
 func TestRunVerify(t *testing.T) {
 	logger := io.NewTestHandler()
 
@@ -0,0 +1,153 @@
+package env
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type EnvOptions struct {
+	IO *iostreams.IOStreams
+
+	Shell string
+}
+
+// hookFunction walks up from the working directory looking for a .gh-profile
+// file and exports GH_HOST and GH_TOKEN for the profile it describes. It is
+// POSIX shell and is shared by the bash and zsh hooks.
+const hookFunction = `_gh_auth_env_hook() {
+  local dir="$PWD" profile=""
+  while [ -n "$dir" ]; do
+    if [ -f "$dir/.gh-profile" ]; then
+      profile="$(cat "$dir/.gh-profile")"
+      break
+    fi
+    dir="${dir%/*}"
+  done
+  if [ -z "$profile" ]; then
+    if [ -n "$_GH_AUTH_ENV_ACTIVE" ]; then
+      unset GH_HOST GH_TOKEN _GH_AUTH_ENV_ACTIVE
+    fi
+    return
+  fi
+  if [ "$profile" = "$_GH_AUTH_ENV_ACTIVE" ]; then
+    return
+  fi
+  local host="${profile%%:*}" user="${profile#*:}"
+  export GH_HOST="$host"
+  if [ "$user" != "$profile" ] && [ -n "$user" ]; then
+    GH_TOKEN="$(GH_TOKEN='' gh auth token --hostname "$host" --user "$user" 2>/dev/null)" && export GH_TOKEN
+  else
+    GH_TOKEN="$(GH_TOKEN='' gh auth token --hostname "$host" 2>/dev/null)" && export GH_TOKEN
+  fi
+  _GH_AUTH_ENV_ACTIVE="$profile"
+}
+`
+
+const bashHook = hookFunction + `if [[ ";$PROMPT_COMMAND;" != *";_gh_auth_env_hook;"* ]]; then
+  PROMPT_COMMAND="_gh_auth_env_hook${PROMPT_COMMAND:+;$PROMPT_COMMAND}"
+fi
+_gh_auth_env_hook
+`
+
+const zshHook = hookFunction + `autoload -Uz add-zsh-hook
+add-zsh-hook chpwd _gh_auth_env_hook
+_gh_auth_env_hook
+`
+
+const fishHook = `function _gh_auth_env_hook --on-variable PWD
+  set -l dir $PWD
+  set -l profile ""
+  while test -n "$dir"
+    if test -f "$dir/.gh-profile"
+      set profile (cat "$dir/.gh-profile")
+      break
+    end
+    set dir (string replace -r '/[^/]*$' '' -- $dir)
+  end
+  if test -z "$profile"
+    if set -q _GH_AUTH_ENV_ACTIVE
+      set -e GH_HOST GH_TOKEN _GH_AUTH_ENV_ACTIVE
+    end
+    return
+  end
+  if test "$profile" = "$_GH_AUTH_ENV_ACTIVE"
+    return
+  end
+  set -l parts (string split -m1 ':' -- $profile)
+  set -gx GH_HOST $parts[1]
+  if test (count $parts) -gt 1; and test -n "$parts[2]"
+    set -gx GH_TOKEN (env GH_TOKEN= gh auth token --hostname $parts[1] --user $parts[2] 2>/dev/null)
+  else
+    set -gx GH_TOKEN (env GH_TOKEN= gh auth token --hostname $parts[1] 2>/dev/null)
+  end
+  set -g _GH_AUTH_ENV_ACTIVE $profile
+end
+_gh_auth_env_hook
+`
+
+func NewCmdEnv(f *cmdutil.Factory, runF func(*EnvOptions) error) *cobra.Command {
+	opts := &EnvOptions{
+		IO: f.IOStreams,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "env --shell <shell>",
+		Short: "Print a shell hook that switches accounts per directory",
+		Long: heredoc.Docf(`
+			Print shell code that switches the active GitHub account and host based on
+			the current directory.
+
+			The hook looks for a %[1]s.gh-profile%[1]s file in the working directory or any of
+			its parents. The file contains a profile in the form %[1]shost%[1]s or %[1]shost:username%[1]s,
+			e.g. %[1]sgithub.com:monalisa%[1]s or %[1]sgithub.example.com%[1]s. When a profile is found,
+			the hook exports %[1]sGH_HOST%[1]s and a %[1]sGH_TOKEN%[1]s obtained with %[1]sgh auth token%[1]s for
+			that host and account; when none is found, both variables are unset again.
+
+			The account named in a profile must already be logged in, e.g. with
+			%[1]sgh auth login%[1]s. See %[1]sgh auth switch%[1]s for switching accounts globally instead.
+
+			To install the hook, add the following to your shell configuration:
+
+				# ~/.bash_profile
+				eval "$(gh auth env --shell bash)"
+
+				# ~/.zshrc
+				eval "$(gh auth env --shell zsh)"
+
+				# ~/.config/fish/config.fish
+				gh auth env --shell fish | source
+		`, "`"),
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return envRun(opts)
+		},
+	}
+
+	cmdutil.StringEnumFlag(cmd, &opts.Shell, "shell", "s", "", []string{"bash", "zsh", "fish"}, "Shell to generate the hook for")
+	_ = cmd.MarkFlagRequired("shell")
+
+	return cmd
+}
+
+func envRun(opts *EnvOptions) error {
+	var hook string
+	switch opts.Shell {
+	case "bash":
+		hook = bashHook
+	case "zsh":
+		hook = zshHook
+	case "fish":
+		hook = fishHook
+	}
+
+	_, err := fmt.Fprint(opts.IO.Out, hook)
+	return err
+}
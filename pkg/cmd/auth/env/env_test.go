@@ -0,0 +1,116 @@
+package env
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCmdEnv(t *testing.T) {
+	tests := []struct {
+		name     string
+		cli      string
+		wants    EnvOptions
+		wantsErr string
+	}{
+		{
+			name:  "bash",
+			cli:   "--shell bash",
+			wants: EnvOptions{Shell: "bash"},
+		},
+		{
+			name:  "shorthand",
+			cli:   "-s fish",
+			wants: EnvOptions{Shell: "fish"},
+		},
+		{
+			name:     "no shell",
+			cli:      "",
+			wantsErr: `required flag(s) "shell" not set`,
+		},
+		{
+			name:     "unsupported shell",
+			cli:      "--shell pwsh",
+			wantsErr: "invalid argument \"pwsh\" for \"-s, --shell\" flag: valid values are {bash|zsh|fish}",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := &cmdutil.Factory{}
+
+			argv, err := shlex.Split(tt.cli)
+			assert.NoError(t, err)
+
+			var gotOpts *EnvOptions
+			cmd := NewCmdEnv(f, func(opts *EnvOptions) error {
+				gotOpts = opts
+				return nil
+			})
+			cmd.SetArgs(argv)
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+
+			_, err = cmd.ExecuteC()
+			if tt.wantsErr != "" {
+				assert.EqualError(t, err, tt.wantsErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wants.Shell, gotOpts.Shell)
+		})
+	}
+}
+
+func TestEnvRun(t *testing.T) {
+	tests := []struct {
+		name         string
+		shell        string
+		wantContains []string
+	}{
+		{
+			name:  "bash",
+			shell: "bash",
+			wantContains: []string{
+				"_gh_auth_env_hook() {",
+				`PROMPT_COMMAND="_gh_auth_env_hook`,
+			},
+		},
+		{
+			name:  "zsh",
+			shell: "zsh",
+			wantContains: []string{
+				"_gh_auth_env_hook() {",
+				"add-zsh-hook chpwd _gh_auth_env_hook",
+			},
+		},
+		{
+			name:  "fish",
+			shell: "fish",
+			wantContains: []string{
+				"function _gh_auth_env_hook --on-variable PWD",
+				"set -gx GH_HOST",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ios, _, stdout, _ := iostreams.Test()
+			err := envRun(&EnvOptions{IO: ios, Shell: tt.shell})
+			assert.NoError(t, err)
+
+			for _, want := range tt.wantContains {
+				assert.Contains(t, stdout.String(), want)
+			}
+			assert.Contains(t, stdout.String(), ".gh-profile")
+			assert.Contains(t, stdout.String(), "gh auth token --hostname")
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/MakeNowJust/heredoc"
 	"github.com/cli/cli/v2/git"
@@ -29,14 +30,29 @@ type RefreshOptions struct {
 
 	MainExecutable string
 
-	Hostname     string
-	Scopes       []string
-	RemoveScopes []string
-	ResetScopes  bool
-	AuthFlow     func(*iostreams.IOStreams, string, []string, bool) (token, username, error)
+	Hostname       string
+	Scopes         []string
+	RemoveScopes   []string
+	ResetScopes    bool
+	AuthFlow       func(*iostreams.IOStreams, string, []string, bool) (token, username, error)
+	DeviceAuthFlow func(*iostreams.IOStreams, string, []string, time.Duration) (token, username, error)
 
 	Interactive     bool
+	DeviceCodeOnly  bool
+	Wait            time.Duration
 	InsecureStorage bool
+	Exporter        cmdutil.Exporter
+}
+
+// refreshedCredentials is the payload of the --json output.
+type refreshedCredentials struct {
+	Hostname string   `json:"hostname"`
+	Username string   `json:"username"`
+	Scopes   []string `json:"scopes"`
+}
+
+func (c refreshedCredentials) ExportData(fields []string) map[string]interface{} {
+	return cmdutil.StructExportData(c, fields)
 }
 
 func NewCmdRefresh(f *cmdutil.Factory, runF func(*RefreshOptions) error) *cobra.Command {
@@ -47,6 +63,10 @@ func NewCmdRefresh(f *cmdutil.Factory, runF func(*RefreshOptions) error) *cobra.
 			t, u, err := authflow.AuthFlow(hostname, io, "", scopes, interactive, f.Browser)
 			return token(t), username(u), err
 		},
+		DeviceAuthFlow: func(io *iostreams.IOStreams, hostname string, scopes []string, wait time.Duration) (token, username, error) {
+			t, u, err := authflow.DeviceAuthFlow(hostname, io, scopes, wait)
+			return token(t), username(u), err
+		},
 		HttpClient: &http.Client{},
 		GitClient:  f.GitClient,
 		Prompter:   f.Prompter,
@@ -72,6 +92,10 @@ func NewCmdRefresh(f *cmdutil.Factory, runF func(*RefreshOptions) error) *cobra.
 			If you have multiple accounts in %[1]sgh auth status%[1]s and want to refresh the credentials for an
 			inactive account, you will have to use %[1]sgh auth switch%[1]s to that account first before using
 			this command, and then switch back when you are done.
+
+			On a headless server, use %[1]s--device-code-only%[1]s to print a one-time code and
+			verification URL without opening a browser; the command then polls until the
+			device is authorized from another machine, or until %[1]s--wait%[1]s elapses.
 		`, "`"),
 		Example: heredoc.Doc(`
 			$ gh auth refresh --scopes write:org,read:public_key
@@ -85,10 +109,17 @@ func NewCmdRefresh(f *cmdutil.Factory, runF func(*RefreshOptions) error) *cobra.
 
 			$ gh auth refresh --reset-scopes
 			# => open a browser to re-authenticate with the default minimum scopes
+
+			$ gh auth refresh --hostname github.com --device-code-only
+			# => print a one-time code and poll until the device is authorized from another machine
 		`),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.Interactive = opts.IO.CanPrompt()
 
+			if opts.Wait != 0 && !opts.DeviceCodeOnly {
+				return cmdutil.FlagErrorf("`--wait` requires `--device-code-only`")
+			}
+
 			if !opts.Interactive && opts.Hostname == "" {
 				return cmdutil.FlagErrorf("--hostname required when not running interactively")
 			}
@@ -112,6 +143,10 @@ func NewCmdRefresh(f *cmdutil.Factory, runF func(*RefreshOptions) error) *cobra.
 
 	cmd.Flags().BoolVarP(&opts.InsecureStorage, "insecure-storage", "", false, "Save authentication credentials in plain text instead of credential store")
 
+	cmd.Flags().BoolVar(&opts.DeviceCodeOnly, "device-code-only", false, "Print a one-time device code and poll for authorization instead of opening a browser")
+	cmd.Flags().DurationVar(&opts.Wait, "wait", 0, "Maximum time to poll for device authorization, e.g. \"5m\" (default: until the device code expires)")
+	cmdutil.AddJSONFlags(cmd, &opts.Exporter, []string{"hostname", "username", "scopes"})
+
 	return cmd
 }
 
@@ -184,7 +219,7 @@ func refreshRun(opts *RefreshOptions) error {
 		},
 	}
 	gitProtocol := cfg.GitProtocol(hostname).Value
-	if opts.Interactive && gitProtocol == "https" {
+	if opts.Interactive && !opts.DeviceCodeOnly && gitProtocol == "https" {
 		if err := credentialFlow.Prompt(hostname); err != nil {
 			return err
 		}
@@ -195,7 +230,13 @@ func refreshRun(opts *RefreshOptions) error {
 
 	additionalScopes.RemoveValues(opts.RemoveScopes)
 
-	authedToken, authedUser, err := opts.AuthFlow(opts.IO, hostname, additionalScopes.ToSlice(), opts.Interactive)
+	var authedToken token
+	var authedUser username
+	if opts.DeviceCodeOnly {
+		authedToken, authedUser, err = opts.DeviceAuthFlow(opts.IO, hostname, additionalScopes.ToSlice(), opts.Wait)
+	} else {
+		authedToken, authedUser, err = opts.AuthFlow(opts.IO, hostname, additionalScopes.ToSlice(), opts.Interactive)
+	}
 	if err != nil {
 		return err
 	}
@@ -210,6 +251,26 @@ func refreshRun(opts *RefreshOptions) error {
 	cs := opts.IO.ColorScheme()
 	fmt.Fprintf(opts.IO.ErrOut, "%s Authentication complete.\n", cs.SuccessIcon())
 
+	if opts.Exporter != nil {
+		newScopes, err := shared.GetScopes(opts.HttpClient, hostname, string(authedToken))
+		if err != nil {
+			return fmt.Errorf("failed to list scopes of the refreshed credentials: %w", err)
+		}
+		creds := refreshedCredentials{
+			Hostname: hostname,
+			Username: string(authedUser),
+			Scopes:   []string{},
+		}
+		for _, s := range strings.Split(newScopes, ",") {
+			if s = strings.TrimSpace(s); s != "" {
+				creds.Scopes = append(creds.Scopes, s)
+			}
+		}
+		if err := opts.Exporter.Write(opts.IO, creds); err != nil {
+			return err
+		}
+	}
+
 	if credentialFlow.ShouldSetup() {
 		username, _ := authCfg.ActiveUser(hostname)
 		password, _ := authCfg.ActiveToken(hostname)
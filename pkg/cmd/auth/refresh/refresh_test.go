@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/cli/cli/v2/internal/config"
 	"github.com/cli/cli/v2/internal/gh"
@@ -130,6 +131,21 @@ func Test_NewCmdRefresh(t *testing.T) {
 				RemoveScopes: []string{"read:public_key"},
 			},
 		},
+		{
+			name: "device code only",
+			tty:  true,
+			cli:  "--device-code-only --wait 5m",
+			wants: RefreshOptions{
+				DeviceCodeOnly: true,
+				Wait:           5 * time.Minute,
+			},
+		},
+		{
+			name:     "wait without device code only",
+			tty:      true,
+			cli:      "--wait 5m",
+			wantsErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -166,6 +182,8 @@ func Test_NewCmdRefresh(t *testing.T) {
 			require.NoError(t, err)
 			require.Equal(t, tt.wants.Hostname, gotOpts.Hostname)
 			require.Equal(t, tt.wants.Scopes, gotOpts.Scopes)
+			require.Equal(t, tt.wants.DeviceCodeOnly, gotOpts.DeviceCodeOnly)
+			require.Equal(t, tt.wants.Wait, gotOpts.Wait)
 		})
 	}
 }
@@ -497,3 +515,64 @@ func Test_refreshRun(t *testing.T) {
 		})
 	}
 }
+
+func Test_refreshRun_deviceCodeOnly(t *testing.T) {
+	var gotHostname string
+	var gotScopes []string
+	var gotWait time.Duration
+	opts := &RefreshOptions{
+		Hostname:       "github.com",
+		DeviceCodeOnly: true,
+		Wait:           5 * time.Minute,
+		AuthFlow: func(_ *iostreams.IOStreams, _ string, _ []string, _ bool) (token, username, error) {
+			t.Error("unexpected browser auth flow")
+			return "", "", nil
+		},
+		DeviceAuthFlow: func(_ *iostreams.IOStreams, hostname string, scopes []string, wait time.Duration) (token, username, error) {
+			gotHostname = hostname
+			gotScopes = scopes
+			gotWait = wait
+			return token("xyz456"), username("test-user"), nil
+		},
+	}
+	exporter := cmdutil.NewJSONExporter()
+	exporter.SetFields([]string{"hostname", "username", "scopes"})
+	opts.Exporter = exporter
+
+	cfg, _ := config.NewIsolatedTestConfig(t)
+	_, err := cfg.Authentication().Login("github.com", "test-user", "abc123", "https", false)
+	require.NoError(t, err)
+	opts.Config = func() (gh.Config, error) {
+		return cfg, nil
+	}
+
+	ios, _, stdout, _ := iostreams.Test()
+	opts.IO = ios
+
+	// the old credentials carry no scopes; the refreshed ones carry two
+	scopesStub := func(req *http.Request) (*http.Response, error) {
+		scopes := ""
+		if req.Header.Get("Authorization") == "token xyz456" {
+			scopes = "repo, read:org"
+		}
+		return &http.Response{
+			Request:    req,
+			StatusCode: 200,
+			Body:       io.NopCloser(strings.NewReader(``)),
+			Header: http.Header{
+				"X-Oauth-Scopes": {scopes},
+			},
+		}, nil
+	}
+	httpReg := &httpmock.Registry{}
+	httpReg.Register(httpmock.REST("GET", ""), scopesStub)
+	httpReg.Register(httpmock.REST("GET", ""), scopesStub)
+	opts.HttpClient = &http.Client{Transport: httpReg}
+
+	require.NoError(t, refreshRun(opts))
+
+	require.Equal(t, "github.com", gotHostname)
+	require.Equal(t, []string{}, gotScopes)
+	require.Equal(t, 5*time.Minute, gotWait)
+	require.Equal(t, "{\"hostname\":\"github.com\",\"scopes\":[\"repo\",\"read:org\"],\"username\":\"test-user\"}\n", stdout.String())
+}
@@ -13,20 +13,29 @@ import (
 	"github.com/cli/cli/v2/api"
 	"github.com/cli/cli/v2/internal/config"
 	"github.com/cli/cli/v2/internal/gh"
+	"github.com/cli/cli/v2/internal/ghversion"
 	"github.com/cli/cli/v2/pkg/cmd/auth/shared"
 	"github.com/cli/cli/v2/pkg/cmdutil"
 	"github.com/cli/cli/v2/pkg/iostreams"
 	"github.com/spf13/cobra"
 )
 
+type capabilityEntry struct {
+	name       string
+	supported  bool
+	minVersion string
+}
+
 type validEntry struct {
-	active      bool
-	host        string
-	user        string
-	token       string
-	tokenSource string
-	gitProtocol string
-	scopes      string
+	active       bool
+	host         string
+	user         string
+	token        string
+	tokenSource  string
+	gitProtocol  string
+	scopes       string
+	ghesVersion  string
+	capabilities []capabilityEntry
 }
 
 func (e validEntry) String(cs *iostreams.ColorScheme) string {
@@ -55,6 +64,20 @@ func (e validEntry) String(cs *iostreams.ColorScheme) string {
 		}
 	}
 
+	if len(e.capabilities) > 0 {
+		if e.ghesVersion != "" {
+			sb.WriteString(fmt.Sprintf("  - GitHub Enterprise Server version: %s\n", cs.Bold(e.ghesVersion)))
+		}
+		sb.WriteString("  - Capabilities:\n")
+		for _, c := range e.capabilities {
+			if c.supported {
+				sb.WriteString(fmt.Sprintf("    %s %s\n", cs.SuccessIcon(), c.name))
+			} else {
+				sb.WriteString(fmt.Sprintf("    %s %s (requires GHES %s or later)\n", cs.Red("X"), c.name, c.minVersion))
+			}
+		}
+	}
+
 	return sb.String()
 }
 
@@ -127,9 +150,10 @@ type StatusOptions struct {
 	IO         *iostreams.IOStreams
 	Config     func() (gh.Config, error)
 
-	Hostname  string
-	ShowToken bool
-	Active    bool
+	Hostname     string
+	ShowToken    bool
+	Active       bool
+	Capabilities bool
 }
 
 func NewCmdStatus(f *cmdutil.Factory, runF func(*StatusOptions) error) *cobra.Command {
@@ -165,6 +189,7 @@ func NewCmdStatus(f *cmdutil.Factory, runF func(*StatusOptions) error) *cobra.Co
 	cmd.Flags().StringVarP(&opts.Hostname, "hostname", "h", "", "Check only a specific hostname's auth status")
 	cmd.Flags().BoolVarP(&opts.ShowToken, "show-token", "t", false, "Display the auth token")
 	cmd.Flags().BoolVarP(&opts.Active, "active", "a", false, "Display the active account only")
+	cmd.Flags().BoolVar(&opts.Capabilities, "capabilities", false, "Display which version-gated features each host supports")
 
 	return cmd
 }
@@ -200,6 +225,11 @@ func statusRun(opts *StatusOptions) error {
 		return err
 	}
 
+	var versionDetector ghversion.Detector
+	if opts.Capabilities {
+		versionDetector = ghversion.NewDetector(httpClient)
+	}
+
 	for _, hostname := range hostnames {
 		if opts.Hostname != "" && opts.Hostname != hostname {
 			continue
@@ -212,13 +242,14 @@ func statusRun(opts *StatusOptions) error {
 			activeUser, _ = authCfg.ActiveUser(hostname)
 		}
 		entry := buildEntry(httpClient, buildEntryOptions{
-			active:      true,
-			gitProtocol: gitProtocol,
-			hostname:    hostname,
-			showToken:   opts.ShowToken,
-			token:       activeUserToken,
-			tokenSource: activeUserTokenSource,
-			username:    activeUser,
+			active:          true,
+			gitProtocol:     gitProtocol,
+			hostname:        hostname,
+			showToken:       opts.ShowToken,
+			token:           activeUserToken,
+			tokenSource:     activeUserTokenSource,
+			username:        activeUser,
+			versionDetector: versionDetector,
 		})
 		statuses[hostname] = append(statuses[hostname], entry)
 
@@ -237,13 +268,14 @@ func statusRun(opts *StatusOptions) error {
 			}
 			token, tokenSource, _ := authCfg.TokenForUser(hostname, username)
 			entry := buildEntry(httpClient, buildEntryOptions{
-				active:      false,
-				gitProtocol: gitProtocol,
-				hostname:    hostname,
-				showToken:   opts.ShowToken,
-				token:       token,
-				tokenSource: tokenSource,
-				username:    username,
+				active:          false,
+				gitProtocol:     gitProtocol,
+				hostname:        hostname,
+				showToken:       opts.ShowToken,
+				token:           token,
+				tokenSource:     tokenSource,
+				username:        username,
+				versionDetector: versionDetector,
 			})
 			statuses[hostname] = append(statuses[hostname], entry)
 
@@ -305,13 +337,14 @@ func expectScopes(token string) bool {
 }
 
 type buildEntryOptions struct {
-	active      bool
-	gitProtocol string
-	hostname    string
-	showToken   bool
-	token       string
-	tokenSource string
-	username    string
+	active          bool
+	gitProtocol     string
+	hostname        string
+	showToken       bool
+	token           string
+	tokenSource     string
+	username        string
+	versionDetector ghversion.Detector
 }
 
 func buildEntry(httpClient *http.Client, opts buildEntryOptions) Entry {
@@ -364,7 +397,7 @@ func buildEntry(httpClient *http.Client, opts buildEntryOptions) Entry {
 		}
 	}
 
-	return validEntry{
+	entry := validEntry{
 		active:      opts.active,
 		gitProtocol: opts.gitProtocol,
 		host:        opts.hostname,
@@ -373,6 +406,23 @@ func buildEntry(httpClient *http.Client, opts buildEntryOptions) Entry {
 		tokenSource: opts.tokenSource,
 		user:        opts.username,
 	}
+
+	if opts.versionDetector != nil {
+		// Version detection failures shouldn't invalidate an otherwise
+		// healthy entry; the capabilities section is simply omitted.
+		if version, err := opts.versionDetector.InstalledVersion(opts.hostname); err == nil {
+			entry.ghesVersion = version
+			for _, c := range ghversion.AllCapabilities() {
+				entry.capabilities = append(entry.capabilities, capabilityEntry{
+					name:       c.Name,
+					supported:  ghversion.Supports(version, c),
+					minVersion: c.MinVersion,
+				})
+			}
+		}
+	}
+
+	return entry
 }
 
 func authTokenWriteable(src string) bool {
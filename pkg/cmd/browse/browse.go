@@ -196,12 +196,12 @@ func runBrowse(opts *BrowseOptions) error {
 		if !exist {
 			return fmt.Errorf("%s doesn't exist", text.DisplayURL(url))
 		}
-		_, err = fmt.Fprintln(opts.IO.Out, url)
+		_, err = fmt.Fprintln(opts.IO.Out, opts.IO.Hyperlink(url, url))
 		return err
 	}
 
 	if opts.IO.IsStdoutTTY() {
-		fmt.Fprintf(opts.IO.Out, "Opening %s in your browser.\n", text.DisplayURL(url))
+		fmt.Fprintf(opts.IO.Out, "Opening %s in your browser.\n", opts.IO.Hyperlink(text.DisplayURL(url), url))
 	}
 	return opts.Browser.Browse(url)
 }
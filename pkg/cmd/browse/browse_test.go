@@ -713,3 +713,30 @@ func Test_parsePathFromFileArg(t *testing.T) {
 		assert.Equal(t, tt.expectedPath, path, tt.name)
 	}
 }
+
+func Test_runBrowse_hyperlink(t *testing.T) {
+	ios, _, stdout, stderr := iostreams.Test()
+	ios.SetHyperlinksPolicy("always")
+
+	reg := httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("HEAD", "repos/OWNER/REPO"),
+		httpmock.StringResponse("{}"))
+
+	opts := BrowseOptions{
+		NoBrowserFlag: true,
+		IO:            ios,
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.New("OWNER", "REPO"), nil
+		},
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: &reg}, nil
+		},
+		PathFromRepoRoot: func() string { return "" },
+	}
+
+	assert.NoError(t, runBrowse(&opts))
+	assert.Equal(t, "\x1b]8;;https://github.com/OWNER/REPO\x1b\\https://github.com/OWNER/REPO\x1b]8;;\x1b\\\n", stdout.String())
+	assert.Equal(t, "", stderr.String())
+}
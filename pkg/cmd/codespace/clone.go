@@ -0,0 +1,87 @@
+package codespace
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/codespaces/api"
+	"github.com/spf13/cobra"
+)
+
+type cloneOptions struct {
+	selector    *CodespaceSelector
+	machine     string
+	displayName string
+}
+
+func newCloneCmd(app *App) *cobra.Command {
+	opts := cloneOptions{}
+
+	cloneCmd := &cobra.Command{
+		Use:   "clone",
+		Short: "Create a codespace from the same repo, branch, and configuration as an existing one",
+		Long: heredoc.Doc(`
+			Clone creates a new codespace using the repository, branch, devcontainer path,
+			and machine type of an existing codespace. This is useful for forking an
+			environment to test a risky change without disturbing the original.
+
+			Uncommitted changes in the source codespace are not copied; push them to a
+			branch first if the clone needs them.
+		`),
+		Args: noArgsConstraint,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return app.Clone(cmd.Context(), opts)
+		},
+	}
+
+	opts.selector = AddCodespaceSelector(cloneCmd, app.apiClient)
+	cloneCmd.Flags().StringVarP(&opts.machine, "machine", "m", "", "Hardware specifications for the new VM (defaults to the source codespace's machine)")
+	cloneCmd.Flags().StringVarP(&opts.displayName, "display-name", "d", "", fmt.Sprintf("Display name for the new codespace (%d characters or less)", displayNameMaxLength))
+
+	return cloneCmd
+}
+
+// Clone creates a new codespace with the same configuration as an existing one.
+func (a *App) Clone(ctx context.Context, opts cloneOptions) error {
+	source, err := opts.selector.Select(ctx)
+	if err != nil {
+		return err
+	}
+
+	if len(opts.displayName) > displayNameMaxLength {
+		return fmt.Errorf("error creating codespace: display name should contain a maximum of %d characters", displayNameMaxLength)
+	}
+
+	machine := opts.machine
+	if machine == "" {
+		machine = source.Machine.Name
+	}
+
+	createParams := &api.CreateCodespaceParams{
+		RepositoryID:     source.Repository.ID,
+		Branch:           source.GitStatus.Ref,
+		Machine:          machine,
+		Location:         source.Location,
+		DevContainerPath: source.DevContainerPath,
+		DisplayName:      opts.displayName,
+	}
+
+	var codespace *api.Codespace
+	err = a.RunWithProgress("Creating codespace", func() (err error) {
+		codespace, err = a.apiClient.CreateCodespace(ctx, createParams)
+		return
+	})
+	if err != nil {
+		return fmt.Errorf("error creating codespace: %w", err)
+	}
+
+	if source.GitStatus.HasUncommittedChanges || source.GitStatus.HasUnpushedChanges {
+		cs := a.io.ColorScheme()
+		fmt.Fprintf(a.io.ErrOut, "%s Unsaved changes in %s were not copied to the new codespace\n", cs.WarningIcon(), source.Name)
+	}
+
+	fmt.Fprintln(a.io.Out, codespace.Name)
+
+	return nil
+}
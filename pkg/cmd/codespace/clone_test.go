@@ -0,0 +1,140 @@
+package codespace
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/codespaces/api"
+	"github.com/cli/cli/v2/pkg/iostreams"
+)
+
+func TestApp_Clone(t *testing.T) {
+	source := &api.Codespace{
+		Name: "source-codespace",
+		Repository: api.Repository{
+			ID:       1234,
+			FullName: "monalisa/spoon-knife",
+		},
+		GitStatus: api.CodespaceGitStatus{
+			Ref: "feature-branch",
+		},
+		Machine: api.CodespaceMachine{
+			Name: "standardLinux32gb",
+		},
+		Location:         "EastUs",
+		DevContainerPath: ".devcontainer/devcontainer.json",
+	}
+
+	tests := []struct {
+		name       string
+		opts       cloneOptions
+		source     *api.Codespace
+		wantParams *api.CreateCodespaceParams
+		wantStdout string
+		wantStderr string
+		wantErr    bool
+	}{
+		{
+			name: "clone with source configuration",
+			opts: cloneOptions{
+				selector: &CodespaceSelector{codespaceName: "source-codespace"},
+			},
+			source: source,
+			wantParams: &api.CreateCodespaceParams{
+				RepositoryID:     1234,
+				Branch:           "feature-branch",
+				Machine:          "standardLinux32gb",
+				Location:         "EastUs",
+				DevContainerPath: ".devcontainer/devcontainer.json",
+			},
+			wantStdout: "clone-codespace\n",
+		},
+		{
+			name: "clone with machine override",
+			opts: cloneOptions{
+				selector: &CodespaceSelector{codespaceName: "source-codespace"},
+				machine:  "premiumLinux",
+			},
+			source: source,
+			wantParams: &api.CreateCodespaceParams{
+				RepositoryID:     1234,
+				Branch:           "feature-branch",
+				Machine:          "premiumLinux",
+				Location:         "EastUs",
+				DevContainerPath: ".devcontainer/devcontainer.json",
+			},
+			wantStdout: "clone-codespace\n",
+		},
+		{
+			name: "warns about unsaved changes",
+			opts: cloneOptions{
+				selector: &CodespaceSelector{codespaceName: "source-codespace"},
+			},
+			source: &api.Codespace{
+				Name:       "source-codespace",
+				Repository: source.Repository,
+				GitStatus: api.CodespaceGitStatus{
+					Ref:                   "feature-branch",
+					HasUncommittedChanges: true,
+				},
+				Machine:  source.Machine,
+				Location: source.Location,
+			},
+			wantStdout: "clone-codespace\n",
+			wantStderr: "! Unsaved changes in source-codespace were not copied to the new codespace\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotParams *api.CreateCodespaceParams
+			apiMock := &apiClientMock{
+				GetCodespaceFunc: func(_ context.Context, name string, _ bool) (*api.Codespace, error) {
+					if name != tt.source.Name {
+						return nil, fmt.Errorf("unexpected codespace name %q", name)
+					}
+					return tt.source, nil
+				},
+				CreateCodespaceFunc: func(_ context.Context, params *api.CreateCodespaceParams) (*api.Codespace, error) {
+					gotParams = params
+					return &api.Codespace{Name: "clone-codespace"}, nil
+				},
+			}
+
+			ios, _, stdout, stderr := iostreams.Test()
+			ios.SetStdoutTTY(true)
+			ios.SetStderrTTY(true)
+			a := NewApp(ios, nil, apiMock, nil, nil, nil)
+
+			tt.opts.selector.api = apiMock
+			err := a.Clone(context.Background(), tt.opts)
+
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("Clone() expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Clone() unexpected error: %v", err)
+			}
+
+			if tt.wantParams != nil {
+				if gotParams == nil {
+					t.Fatal("CreateCodespace() never called")
+				}
+				if *gotParams != *tt.wantParams {
+					t.Errorf("create params = %+v, want %+v", *gotParams, *tt.wantParams)
+				}
+			}
+
+			if out := stdout.String(); out != tt.wantStdout {
+				t.Errorf("stdout = %q, want %q", out, tt.wantStdout)
+			}
+			if out := stderr.String(); out != tt.wantStderr {
+				t.Errorf("stderr = %q, want %q", out, tt.wantStderr)
+			}
+		})
+	}
+}
@@ -5,42 +5,64 @@ import (
 	"fmt"
 	"net/url"
 
+	"github.com/cli/cli/v2/pkg/cmdutil"
 	"github.com/spf13/cobra"
 )
 
+const (
+	editorVSCode         = "vscode"
+	editorVSCodeInsiders = "vscode-insiders"
+	editorJetBrains      = "jetbrains"
+	editorWeb            = "web"
+)
+
 func newCodeCmd(app *App) *cobra.Command {
 	var (
 		selector    *CodespaceSelector
+		editor      string
 		useInsiders bool
 		useWeb      bool
 	)
 
 	codeCmd := &cobra.Command{
 		Use:   "code",
-		Short: "Open a codespace in Visual Studio Code",
+		Short: "Open a codespace in an editor",
 		Args:  noArgsConstraint,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return app.VSCode(cmd.Context(), selector, useInsiders, useWeb)
+			if cmd.Flags().Changed("editor") && (useInsiders || useWeb) {
+				return cmdutil.FlagErrorf("--editor cannot be combined with --insiders or --web")
+			}
+			if !cmd.Flags().Changed("editor") {
+				switch {
+				case useWeb:
+					editor = editorWeb
+				case useInsiders:
+					editor = editorVSCodeInsiders
+				}
+			}
+			return app.OpenInEditor(cmd.Context(), selector, editor, useInsiders)
 		},
 	}
 
 	selector = AddCodespaceSelector(codeCmd, app.apiClient)
 
+	cmdutil.StringEnumFlag(codeCmd, &editor, "editor", "", editorVSCode, []string{editorVSCode, editorVSCodeInsiders, editorJetBrains, editorWeb}, "The editor to open the codespace in")
 	codeCmd.Flags().BoolVar(&useInsiders, "insiders", false, "Use the insiders version of Visual Studio Code")
 	codeCmd.Flags().BoolVarP(&useWeb, "web", "w", false, "Use the web version of Visual Studio Code")
 
 	return codeCmd
 }
 
-// VSCode opens a codespace in the local VS VSCode application.
-func (a *App) VSCode(ctx context.Context, selector *CodespaceSelector, useInsiders bool, useWeb bool) error {
+// OpenInEditor opens a codespace in the chosen editor via its deep link.
+func (a *App) OpenInEditor(ctx context.Context, selector *CodespaceSelector, editor string, useInsiders bool) error {
 	codespace, err := selector.Select(ctx)
 	if err != nil {
 		return err
 	}
 
-	browseURL := vscodeProtocolURL(codespace.Name, useInsiders)
-	if useWeb {
+	var browseURL string
+	switch editor {
+	case editorWeb:
 		browseURL = codespace.WebURL
 		if useInsiders {
 			u, err := url.Parse(browseURL)
@@ -52,10 +74,14 @@ func (a *App) VSCode(ctx context.Context, selector *CodespaceSelector, useInside
 			u.RawQuery = q.Encode()
 			browseURL = u.String()
 		}
+	case editorJetBrains:
+		browseURL = jetbrainsGatewayURL(codespace.Name)
+	default:
+		browseURL = vscodeProtocolURL(codespace.Name, editor == editorVSCodeInsiders)
 	}
 
 	if err := a.browser.Browse(browseURL); err != nil {
-		return fmt.Errorf("error opening Visual Studio Code: %w", err)
+		return fmt.Errorf("error opening editor: %w", err)
 	}
 
 	return nil
@@ -68,3 +94,7 @@ func vscodeProtocolURL(codespaceName string, useInsiders bool) string {
 	}
 	return fmt.Sprintf("%s://github.codespaces/connect?name=%s&windowId=_blank", application, url.QueryEscape(codespaceName))
 }
+
+func jetbrainsGatewayURL(codespaceName string) string {
+	return fmt.Sprintf("jetbrains-gateway://connect#type=codespaces&codespaceName=%s", url.QueryEscape(codespaceName))
+}
@@ -9,11 +9,11 @@ import (
 	"github.com/cli/cli/v2/pkg/iostreams"
 )
 
-func TestApp_VSCode(t *testing.T) {
+func TestApp_OpenInEditor(t *testing.T) {
 	type args struct {
 		codespaceName string
+		editor        string
 		useInsiders   bool
-		useWeb        bool
 	}
 	tests := []struct {
 		name    string
@@ -25,7 +25,7 @@ func TestApp_VSCode(t *testing.T) {
 			name: "open VS Code",
 			args: args{
 				codespaceName: "monalisa-cli-cli-abcdef",
-				useInsiders:   false,
+				editor:        editorVSCode,
 			},
 			wantErr: false,
 			wantURL: "vscode://github.codespaces/connect?name=monalisa-cli-cli-abcdef&windowId=_blank",
@@ -34,17 +34,25 @@ func TestApp_VSCode(t *testing.T) {
 			name: "open VS Code Insiders",
 			args: args{
 				codespaceName: "monalisa-cli-cli-abcdef",
-				useInsiders:   true,
+				editor:        editorVSCodeInsiders,
 			},
 			wantErr: false,
 			wantURL: "vscode-insiders://github.codespaces/connect?name=monalisa-cli-cli-abcdef&windowId=_blank",
 		},
+		{
+			name: "open JetBrains Gateway",
+			args: args{
+				codespaceName: "monalisa-cli-cli-abcdef",
+				editor:        editorJetBrains,
+			},
+			wantErr: false,
+			wantURL: "jetbrains-gateway://connect#type=codespaces&codespaceName=monalisa-cli-cli-abcdef",
+		},
 		{
 			name: "open VS Code web",
 			args: args{
 				codespaceName: "monalisa-cli-cli-abcdef",
-				useInsiders:   false,
-				useWeb:        true,
+				editor:        editorWeb,
 			},
 			wantErr: false,
 			wantURL: "https://monalisa-cli-cli-abcdef.github.dev",
@@ -53,8 +61,8 @@ func TestApp_VSCode(t *testing.T) {
 			name: "open VS Code web with Insiders",
 			args: args{
 				codespaceName: "monalisa-cli-cli-abcdef",
+				editor:        editorWeb,
 				useInsiders:   true,
-				useWeb:        true,
 			},
 			wantErr: false,
 			wantURL: "https://monalisa-cli-cli-abcdef.github.dev?vscodeChannel=insiders",
@@ -71,8 +79,8 @@ func TestApp_VSCode(t *testing.T) {
 			}
 			selector := &CodespaceSelector{api: a.apiClient, codespaceName: tt.args.codespaceName}
 
-			if err := a.VSCode(context.Background(), selector, tt.args.useInsiders, tt.args.useWeb); (err != nil) != tt.wantErr {
-				t.Errorf("App.VSCode() error = %v, wantErr %v", err, tt.wantErr)
+			if err := a.OpenInEditor(context.Background(), selector, tt.args.editor, tt.args.useInsiders); (err != nil) != tt.wantErr {
+				t.Errorf("App.OpenInEditor() error = %v, wantErr %v", err, tt.wantErr)
 			}
 			b.Verify(t, tt.wantURL)
 			if got := stdout.String(); got != "" {
@@ -89,7 +97,7 @@ func TestPendingOperationDisallowsCode(t *testing.T) {
 	app := testingCodeApp()
 	selector := &CodespaceSelector{api: app.apiClient, codespaceName: "disabledCodespace"}
 
-	if err := app.VSCode(context.Background(), selector, false, false); err != nil {
+	if err := app.OpenInEditor(context.Background(), selector, editorVSCode, false); err != nil {
 		if err.Error() != "codespace is disabled while it has a pending operation: Some pending operation" {
 			t.Errorf("expected pending operation error, but got: %v", err)
 		}
@@ -100,7 +108,7 @@ func TestPendingOperationDisallowsCode(t *testing.T) {
 
 func testingCodeApp() *App {
 	ios, _, _, _ := iostreams.Test()
-	return NewApp(ios, nil, testCodeApiMock(), nil, nil)
+	return NewApp(ios, nil, testCodeApiMock(), nil, nil, nil)
 }
 
 func testCodeApiMock() *apiClientMock {
@@ -18,6 +18,7 @@ import (
 	clicontext "github.com/cli/cli/v2/context"
 	"github.com/cli/cli/v2/internal/browser"
 	"github.com/cli/cli/v2/internal/codespaces/api"
+	"github.com/cli/cli/v2/internal/gh"
 	"github.com/cli/cli/v2/pkg/iostreams"
 	"github.com/spf13/cobra"
 	"golang.org/x/term"
@@ -34,9 +35,10 @@ type App struct {
 	executable executable
 	browser    browser.Browser
 	remotes    func() (clicontext.Remotes, error)
+	config     func() (gh.Config, error)
 }
 
-func NewApp(io *iostreams.IOStreams, exe executable, apiClient apiClient, browser browser.Browser, remotes func() (clicontext.Remotes, error)) *App {
+func NewApp(io *iostreams.IOStreams, exe executable, apiClient apiClient, browser browser.Browser, remotes func() (clicontext.Remotes, error), config func() (gh.Config, error)) *App {
 	errLogger := log.New(io.ErrOut, "", 0)
 
 	return &App{
@@ -46,9 +48,25 @@ func NewApp(io *iostreams.IOStreams, exe executable, apiClient apiClient, browse
 		executable: exe,
 		browser:    browser,
 		remotes:    remotes,
+		config:     config,
 	}
 }
 
+// keepAliveEnabled reports whether keepalive activity signals should be sent
+// for connections to the codespace, as controlled by the
+// codespaces_keep_alive config key. Keepalives default to enabled when no
+// configuration is available.
+func (a *App) keepAliveEnabled() bool {
+	if a.config == nil {
+		return true
+	}
+	cfg, err := a.config()
+	if err != nil {
+		return true
+	}
+	return cfg.CodespacesKeepAlive("").Value != "disabled"
+}
+
 // StartProgressIndicatorWithLabel starts a progress indicator with a message.
 func (a *App) StartProgressIndicatorWithLabel(s string) {
 	a.io.StartProgressIndicatorWithLabel(s)
@@ -79,9 +97,15 @@ type apiClient interface {
 	GetCodespacesMachines(ctx context.Context, repoID int, branch string, location string, devcontainerPath string) ([]*api.Machine, error)
 	GetCodespacesPermissionsCheck(ctx context.Context, repoID int, branch string, devcontainerPath string) (bool, error)
 	GetCodespaceRepositoryContents(ctx context.Context, codespace *api.Codespace, path string) ([]byte, error)
+	GetRepositoryContents(ctx context.Context, nwo string, path string, ref string) ([]byte, error)
 	ListDevContainers(ctx context.Context, repoID int, branch string, limit int) (devcontainers []api.DevContainerEntry, err error)
 	GetCodespaceRepoSuggestions(ctx context.Context, partialSearch string, params api.RepoSearchParameters) ([]string, error)
 	GetCodespaceBillableOwner(ctx context.Context, nwo string) (*api.User, error)
+	GetCodespacesUsage(ctx context.Context, userName string, orgName string, startDate string, endDate string) ([]*api.CodespacesUsageItem, error)
+	ListUserSecrets(ctx context.Context) ([]*api.UserSecret, error)
+	GetUserSecretPublicKey(ctx context.Context) (*api.UserSecretPublicKey, error)
+	CreateUserSecret(ctx context.Context, name string, params api.CreateUserSecretParams) error
+	DeleteUserSecret(ctx context.Context, name string) error
 	HTTPClient() (*http.Client, error)
 }
 
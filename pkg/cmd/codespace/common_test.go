@@ -1,12 +1,52 @@
 package codespace
 
 import (
+	"errors"
 	"reflect"
 	"testing"
 
 	"github.com/cli/cli/v2/internal/codespaces/api"
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/internal/gh"
 )
 
+func Test_app_keepAliveEnabled(t *testing.T) {
+	tests := []struct {
+		name   string
+		config func() (gh.Config, error)
+		want   bool
+	}{
+		{
+			name:   "no config available",
+			config: nil,
+			want:   true,
+		},
+		{
+			name:   "config error",
+			config: func() (gh.Config, error) { return nil, errors.New("config error") },
+			want:   true,
+		},
+		{
+			name:   "default",
+			config: func() (gh.Config, error) { return config.NewBlankConfig(), nil },
+			want:   true,
+		},
+		{
+			name:   "disabled",
+			config: func() (gh.Config, error) { return config.NewFromString("codespaces_keep_alive: disabled"), nil },
+			want:   false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := &App{config: tt.config}
+			if got := a.keepAliveEnabled(); got != tt.want {
+				t.Errorf("App.keepAliveEnabled() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func Test_codespace_displayName(t *testing.T) {
 	type fields struct {
 		Codespace *api.Codespace
@@ -2,9 +2,13 @@ package codespace
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"os"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/AlecAivazis/survey/v2"
@@ -78,6 +82,10 @@ type createOptions struct {
 	retentionPeriod   NullableDuration
 	displayName       string
 	useWeb            bool
+	stopAt            string
+	dotfiles          string
+	noDotfiles        bool
+	cheapest          bool
 }
 
 func newCreateCmd(app *App) *cobra.Command {
@@ -88,11 +96,28 @@ func newCreateCmd(app *App) *cobra.Command {
 		Short: "Create a codespace",
 		Args:  noArgsConstraint,
 		PreRunE: func(cmd *cobra.Command, args []string) error {
-			return cmdutil.MutuallyExclusive(
+			if err := cmdutil.MutuallyExclusive(
 				"using --web with --display-name, --idle-timeout, or --retention-period is not supported",
 				opts.useWeb,
 				opts.displayName != "" || opts.idleTimeout != 0 || opts.retentionPeriod.Duration != nil,
-			)
+			); err != nil {
+				return err
+			}
+
+			if opts.dotfiles != "" && !strings.Contains(opts.dotfiles, "/") {
+				return cmdutil.FlagErrorf("invalid dotfiles repository %q: expected the \"OWNER/REPO\" format", opts.dotfiles)
+			}
+
+			if opts.stopAt != "" {
+				stopAt, err := time.Parse(time.RFC3339, opts.stopAt)
+				if err != nil {
+					return cmdutil.FlagErrorf("invalid stop time %q: expected RFC3339 format, e.g. \"2023-08-01T18:00:00Z\"", opts.stopAt)
+				}
+				if !stopAt.After(time.Now()) {
+					return cmdutil.FlagErrorf("stop time %q is in the past", opts.stopAt)
+				}
+			}
+			return nil
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return app.Create(cmd.Context(), opts)
@@ -109,12 +134,18 @@ func newCreateCmd(app *App) *cobra.Command {
 	createCmd.Flags().StringVarP(&opts.branch, "branch", "b", "", "repository branch")
 	createCmd.Flags().StringVarP(&opts.location, "location", "l", "", "location: {EastUs|SouthEastAsia|WestEurope|WestUs2} (determined automatically if not provided)")
 	createCmd.Flags().StringVarP(&opts.machine, "machine", "m", "", "hardware specifications for the VM")
+	createCmd.Flags().BoolVar(&opts.cheapest, "cheapest", false, "select the cheapest available machine type without prompting")
+	createCmd.MarkFlagsMutuallyExclusive("machine", "cheapest")
 	createCmd.Flags().BoolVarP(&opts.permissionsOptOut, "default-permissions", "", false, "do not prompt to accept additional permissions requested by the codespace")
 	createCmd.Flags().BoolVarP(&opts.showStatus, "status", "s", false, "show status of post-create command and dotfiles")
 	createCmd.Flags().DurationVar(&opts.idleTimeout, "idle-timeout", 0, "allowed inactivity before codespace is stopped, e.g. \"10m\", \"1h\"")
 	createCmd.Flags().Var(&opts.retentionPeriod, "retention-period", "allowed time after shutting down before the codespace is automatically deleted (maximum 30 days), e.g. \"1h\", \"72h\"")
 	createCmd.Flags().StringVar(&opts.devContainerPath, "devcontainer-path", "", "path to the devcontainer.json file to use when creating codespace")
 	createCmd.Flags().StringVarP(&opts.displayName, "display-name", "d", "", fmt.Sprintf("display name for the codespace (%d characters or less)", displayNameMaxLength))
+	createCmd.Flags().StringVar(&opts.stopAt, "stop-at", "", "schedule a server-side stop of the codespace at this time (RFC3339), e.g. \"2023-08-01T18:00:00Z\"")
+	createCmd.Flags().StringVar(&opts.dotfiles, "dotfiles", "", "dotfiles repository to use for this codespace instead of the account setting: user/repo")
+	createCmd.Flags().BoolVar(&opts.noDotfiles, "no-dotfiles", false, "do not install any dotfiles in this codespace, regardless of the account setting")
+	createCmd.MarkFlagsMutuallyExclusive("dotfiles", "no-dotfiles")
 
 	return createCmd
 }
@@ -272,13 +303,28 @@ func (a *App) Create(ctx context.Context, opts createOptions) error {
 		}
 	}
 
+	// honor hostRequirements from the chosen devcontainer.json, like the web
+	// creation flow does, by narrowing the machine types offered
+	var hostReqs *hostRequirements
+	if devContainerPath != "" && !opts.useWeb {
+		content, err := a.apiClient.GetRepositoryContents(ctx, repository.FullName, devContainerPath, branch)
+		if err != nil {
+			fmt.Fprintf(a.io.ErrOut, "warning: failed to read %s: %v\n", devContainerPath, err)
+		} else if content != nil {
+			hostReqs, err = parseHostRequirements(content)
+			if err != nil {
+				fmt.Fprintf(a.io.ErrOut, "warning: failed to parse %s: %v\n", devContainerPath, err)
+			}
+		}
+	}
+
 	machine := opts.machine
 	// skip this if we have useWeb and no machine name provided,
 	// because web UI will select default machine type if none is provided
 	// web UI also provide a way to select machine type
 	// therefore we let the user choose from the web UI instead of prompting from CLI
 	if !(opts.useWeb && opts.machine == "") {
-		machine, err = getMachineName(ctx, a.apiClient, prompter, repository.ID, opts.machine, branch, userInputs.Location, devContainerPath)
+		machine, err = getMachineName(ctx, a.apiClient, prompter, a.io.ErrOut, repository.ID, opts.machine, opts.cheapest, branch, userInputs.Location, devContainerPath, hostReqs)
 		if err != nil {
 			return fmt.Errorf("error getting machine type: %w", err)
 		}
@@ -303,6 +349,14 @@ func (a *App) Create(ctx context.Context, opts createOptions) error {
 		DevContainerPath:       devContainerPath,
 		PermissionsOptOut:      opts.permissionsOptOut,
 		DisplayName:            opts.displayName,
+		ScheduledStopAt:        opts.stopAt,
+	}
+
+	if opts.noDotfiles {
+		noDotfiles := ""
+		createParams.DotfilesRepository = &noDotfiles
+	} else if opts.dotfiles != "" {
+		createParams.DotfilesRepository = &opts.dotfiles
 	}
 
 	if opts.useWeb {
@@ -511,12 +565,21 @@ func (a *App) showStatus(ctx context.Context, codespace *api.Codespace) error {
 }
 
 // getMachineName prompts the user to select the machine type, or validates the machine if non-empty.
-func getMachineName(ctx context.Context, apiClient apiClient, prompter SurveyPrompter, repoID int, machine, branch, location string, devcontainerPath string) (string, error) {
+func getMachineName(ctx context.Context, apiClient apiClient, prompter SurveyPrompter, warningOut io.Writer, repoID int, machine string, cheapest bool, branch, location string, devcontainerPath string, hostReqs *hostRequirements) (string, error) {
 	machines, err := apiClient.GetCodespacesMachines(ctx, repoID, branch, location, devcontainerPath)
 	if err != nil {
 		return "", fmt.Errorf("error requesting machine instance types: %w", err)
 	}
 
+	if machine == "" && hostReqs != nil {
+		satisfying := filterMachinesByHostRequirements(machines, hostReqs)
+		if len(satisfying) == 0 {
+			fmt.Fprintf(warningOut, "warning: no machine types satisfy the hostRequirements in %s; showing all machine types\n", devcontainerPath)
+		} else {
+			machines = satisfying
+		}
+	}
+
 	// if user supplied a machine type, it must be valid
 	// if no machine type was supplied, we don't error if there are no machine types for the current repo
 	if machine != "" {
@@ -536,6 +599,10 @@ func getMachineName(ctx context.Context, apiClient apiClient, prompter SurveyPro
 		return "", nil
 	}
 
+	if cheapest {
+		return cheapestMachine(machines).Name, nil
+	}
+
 	if len(machines) == 1 {
 		// VS Code does not prompt for machine if there is only one, this makes us consistent with that behavior
 		return machines[0].Name, nil
@@ -544,7 +611,7 @@ func getMachineName(ctx context.Context, apiClient apiClient, prompter SurveyPro
 	machineNames := make([]string, 0, len(machines))
 	machineByName := make(map[string]*api.Machine)
 	for _, m := range machines {
-		machineName := buildDisplayName(m.DisplayName, m.PrebuildAvailability)
+		machineName := buildDisplayName(m)
 		machineNames = append(machineNames, machineName)
 		machineByName[machineName] = m
 	}
@@ -587,9 +654,19 @@ func getRepoSuggestions(ctx context.Context, apiClient apiClient, partialSearch
 }
 
 // buildDisplayName returns display name to be used in the machine survey prompt.
-// prebuildAvailability will be migrated to use enum values: "none", "ready", "in_progress" before Prebuild GA
-func buildDisplayName(displayName string, prebuildAvailability string) string {
-	switch prebuildAvailability {
+// PrebuildAvailability will be migrated to use enum values: "none", "ready", "in_progress" before Prebuild GA
+func buildDisplayName(m *api.Machine) string {
+	displayName := m.DisplayName
+	if displayName == "" && m.CPUCount > 0 {
+		const gigabyte = 1024 * 1024 * 1024
+		displayName = fmt.Sprintf("%d cores, %d GB RAM, %d GB storage", m.CPUCount, m.MemoryInBytes/gigabyte, m.StorageInBytes/gigabyte)
+	}
+
+	if m.HourlyPrice > 0 {
+		displayName = fmt.Sprintf("%s - $%.2f/hr", displayName, m.HourlyPrice)
+	}
+
+	switch m.PrebuildAvailability {
 	case "ready":
 		return displayName + " (Prebuild ready)"
 	case "in_progress":
@@ -599,6 +676,146 @@ func buildDisplayName(displayName string, prebuildAvailability string) string {
 	}
 }
 
+// cheapestMachine returns the machine with the lowest hourly price, falling
+// back to the smallest core count when the API does not report pricing.
+func cheapestMachine(machines []*api.Machine) *api.Machine {
+	cheapest := machines[0]
+	for _, m := range machines[1:] {
+		if m.HourlyPrice < cheapest.HourlyPrice ||
+			(m.HourlyPrice == cheapest.HourlyPrice && m.CPUCount < cheapest.CPUCount) {
+			cheapest = m
+		}
+	}
+	return cheapest
+}
+
+// hostRequirements is the minimum machine specification requested by a
+// devcontainer.json file.
+// See https://containers.dev/implementors/json_reference/#min-host-reqs
+type hostRequirements struct {
+	CPUCount       int
+	MemoryInBytes  uint64
+	StorageInBytes uint64
+}
+
+// parseHostRequirements extracts the hostRequirements property from the raw
+// contents of a devcontainer.json file, which may contain comments.
+func parseHostRequirements(content []byte) (*hostRequirements, error) {
+	var devcontainer struct {
+		HostRequirements struct {
+			CPUs    int    `json:"cpus"`
+			Memory  string `json:"memory"`
+			Storage string `json:"storage"`
+		} `json:"hostRequirements"`
+	}
+	if err := json.Unmarshal(stripJSONComments(content), &devcontainer); err != nil {
+		return nil, err
+	}
+
+	reqs := &hostRequirements{
+		CPUCount: devcontainer.HostRequirements.CPUs,
+	}
+	var err error
+	if reqs.MemoryInBytes, err = parseSizeToBytes(devcontainer.HostRequirements.Memory); err != nil {
+		return nil, fmt.Errorf("invalid memory requirement: %w", err)
+	}
+	if reqs.StorageInBytes, err = parseSizeToBytes(devcontainer.HostRequirements.Storage); err != nil {
+		return nil, fmt.Errorf("invalid storage requirement: %w", err)
+	}
+
+	if reqs.CPUCount == 0 && reqs.MemoryInBytes == 0 && reqs.StorageInBytes == 0 {
+		return nil, nil
+	}
+	return reqs, nil
+}
+
+// parseSizeToBytes converts devcontainer size strings such as "8gb" or
+// "512mb" to bytes. The empty string parses to zero.
+func parseSizeToBytes(size string) (uint64, error) {
+	if size == "" {
+		return 0, nil
+	}
+
+	normalized := strings.ToLower(strings.TrimSpace(size))
+	var multiplier uint64
+	switch {
+	case strings.HasSuffix(normalized, "tb"):
+		multiplier = 1024 * 1024 * 1024 * 1024
+	case strings.HasSuffix(normalized, "gb"):
+		multiplier = 1024 * 1024 * 1024
+	case strings.HasSuffix(normalized, "mb"):
+		multiplier = 1024 * 1024
+	default:
+		return 0, fmt.Errorf("unrecognized size %q", size)
+	}
+
+	quantity, err := strconv.ParseUint(strings.TrimSpace(normalized[:len(normalized)-2]), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("unrecognized size %q", size)
+	}
+	return quantity * multiplier, nil
+}
+
+// stripJSONComments removes // and /* */ comments from JSONC content so it
+// can be parsed with encoding/json, leaving string contents untouched.
+func stripJSONComments(content []byte) []byte {
+	var out []byte
+	inString := false
+	for i := 0; i < len(content); i++ {
+		c := content[i]
+		if inString {
+			out = append(out, c)
+			if c == '\\' && i+1 < len(content) {
+				out = append(out, content[i+1])
+				i++
+			} else if c == '"' {
+				inString = false
+			}
+			continue
+		}
+		switch {
+		case c == '"':
+			inString = true
+			out = append(out, c)
+		case c == '/' && i+1 < len(content) && content[i+1] == '/':
+			for i < len(content) && content[i] != '\n' {
+				i++
+			}
+			if i < len(content) {
+				out = append(out, '\n')
+			}
+		case c == '/' && i+1 < len(content) && content[i+1] == '*':
+			i += 2
+			for i+1 < len(content) && !(content[i] == '*' && content[i+1] == '/') {
+				i++
+			}
+			i++
+		default:
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
+// filterMachinesByHostRequirements returns the machines that satisfy the
+// given minimum host requirements.
+func filterMachinesByHostRequirements(machines []*api.Machine, reqs *hostRequirements) []*api.Machine {
+	var satisfying []*api.Machine
+	for _, m := range machines {
+		if m.CPUCount < reqs.CPUCount {
+			continue
+		}
+		if m.MemoryInBytes < reqs.MemoryInBytes {
+			continue
+		}
+		if m.StorageInBytes < reqs.StorageInBytes {
+			continue
+		}
+		satisfying = append(satisfying, m)
+	}
+	return satisfying
+}
+
 func stringInSlice(a string, slice []string) bool {
 	for _, b := range slice {
 		if b == a {
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"reflect"
 	"testing"
 	"time"
 
@@ -32,6 +33,16 @@ func TestCreateCmdFlagError(t *testing.T) {
 			args:     "--web --idle-timeout 30m",
 			wantsErr: fmt.Errorf("using --web with --display-name, --idle-timeout, or --retention-period is not supported"),
 		},
+		{
+			name:     "return error when the dotfiles repository is not owner/repo",
+			args:     "--dotfiles dotfiles",
+			wantsErr: fmt.Errorf(`invalid dotfiles repository "dotfiles": expected the "OWNER/REPO" format`),
+		},
+		{
+			name:     "return error when combining dotfiles and no-dotfiles flags",
+			args:     "--dotfiles monalisa/dotfiles --no-dotfiles",
+			wantsErr: fmt.Errorf("if any flags in the group [dotfiles no-dotfiles] are set none of the others can be; [dotfiles no-dotfiles] were all set"),
+		},
 	}
 
 	for _, tt := range tests {
@@ -670,6 +681,7 @@ func TestBuildDisplayName(t *testing.T) {
 	tests := []struct {
 		name                 string
 		prebuildAvailability string
+		hourlyPrice          float64
 		expectedDisplayName  string
 	}{
 		{
@@ -692,10 +704,20 @@ func TestBuildDisplayName(t *testing.T) {
 			prebuildAvailability: "in_progress",
 			expectedDisplayName:  "4 cores, 8 GB RAM, 32 GB storage (Prebuild in progress)",
 		},
+		{
+			name:                 "hourly price is reported",
+			prebuildAvailability: "ready",
+			hourlyPrice:          0.36,
+			expectedDisplayName:  "4 cores, 8 GB RAM, 32 GB storage - $0.36/hr (Prebuild ready)",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			displayName := buildDisplayName("4 cores, 8 GB RAM, 32 GB storage", tt.prebuildAvailability)
+			displayName := buildDisplayName(&api.Machine{
+				DisplayName:          "4 cores, 8 GB RAM, 32 GB storage",
+				PrebuildAvailability: tt.prebuildAvailability,
+				HourlyPrice:          tt.hourlyPrice,
+			})
 
 			if displayName != tt.expectedDisplayName {
 				t.Errorf("displayName = %q, expectedDisplayName %q", displayName, tt.expectedDisplayName)
@@ -704,6 +726,25 @@ func TestBuildDisplayName(t *testing.T) {
 	}
 }
 
+func TestCheapestMachine(t *testing.T) {
+	priced := []*api.Machine{
+		{Name: "premiumLinux", CPUCount: 8, HourlyPrice: 1.44},
+		{Name: "basicLinux32gb", CPUCount: 2, HourlyPrice: 0.18},
+		{Name: "standardLinux32gb", CPUCount: 4, HourlyPrice: 0.36},
+	}
+	if got := cheapestMachine(priced).Name; got != "basicLinux32gb" {
+		t.Errorf("cheapestMachine = %q, want %q", got, "basicLinux32gb")
+	}
+
+	unpriced := []*api.Machine{
+		{Name: "premiumLinux", CPUCount: 8},
+		{Name: "basicLinux32gb", CPUCount: 2},
+	}
+	if got := cheapestMachine(unpriced).Name; got != "basicLinux32gb" {
+		t.Errorf("cheapestMachine without pricing = %q, want %q", got, "basicLinux32gb")
+	}
+}
+
 type MockSurveyPrompter struct {
 	AskFunc func(qs []*survey.Question, response interface{}) error
 }
@@ -858,9 +899,86 @@ func apiCreateDefaults(c *apiClientMock) *apiClientMock {
 			}, nil
 		}
 	}
+	if c.GetRepositoryContentsFunc == nil {
+		c.GetRepositoryContentsFunc = func(ctx context.Context, nwo string, path string, ref string) ([]byte, error) {
+			return nil, nil
+		}
+	}
 	return c
 }
 
 func durationPtr(d time.Duration) *time.Duration {
 	return &d
 }
+
+func TestParseHostRequirements(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		wantReqs *hostRequirements
+		wantErr  bool
+	}{
+		{
+			name:     "no host requirements",
+			content:  `{"image": "mcr.microsoft.com/devcontainers/universal"}`,
+			wantReqs: nil,
+		},
+		{
+			name: "full host requirements with comments",
+			content: `{
+				// minimum spec for the monorepo
+				"hostRequirements": {
+					"cpus": 8,
+					"memory": "16gb", /* upgraded for the build */
+					"storage": "64gb"
+				}
+			}`,
+			wantReqs: &hostRequirements{
+				CPUCount:       8,
+				MemoryInBytes:  16 * 1024 * 1024 * 1024,
+				StorageInBytes: 64 * 1024 * 1024 * 1024,
+			},
+		},
+		{
+			name:    "invalid memory requirement",
+			content: `{"hostRequirements": {"memory": "lots"}}`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reqs, err := parseHostRequirements([]byte(tt.content))
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected an error, got %+v", reqs)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(reqs, tt.wantReqs) {
+				t.Errorf("got %+v, want %+v", reqs, tt.wantReqs)
+			}
+		})
+	}
+}
+
+func TestFilterMachinesByHostRequirements(t *testing.T) {
+	machines := []*api.Machine{
+		{Name: "basicLinux32gb", CPUCount: 2, MemoryInBytes: 8 * 1024 * 1024 * 1024, StorageInBytes: 32 * 1024 * 1024 * 1024},
+		{Name: "standardLinux32gb", CPUCount: 4, MemoryInBytes: 16 * 1024 * 1024 * 1024, StorageInBytes: 32 * 1024 * 1024 * 1024},
+		{Name: "premiumLinux", CPUCount: 8, MemoryInBytes: 32 * 1024 * 1024 * 1024, StorageInBytes: 64 * 1024 * 1024 * 1024},
+	}
+
+	satisfying := filterMachinesByHostRequirements(machines, &hostRequirements{CPUCount: 4})
+	if len(satisfying) != 2 || satisfying[0].Name != "standardLinux32gb" {
+		t.Errorf("unexpected machines for cpu filter: %+v", satisfying)
+	}
+
+	satisfying = filterMachinesByHostRequirements(machines, &hostRequirements{StorageInBytes: 128 * 1024 * 1024 * 1024})
+	if len(satisfying) != 0 {
+		t.Errorf("expected no machines, got %+v", satisfying)
+	}
+}
@@ -81,7 +81,7 @@ func newDeleteCmd(app *App) *cobra.Command {
 	}
 
 	deleteCmd.Flags().BoolVar(&opts.deleteAll, "all", false, "Delete all codespaces")
-	deleteCmd.Flags().BoolVarP(&opts.skipConfirm, "force", "f", false, "Skip confirmation for codespaces that contain unsaved changes")
+	deleteCmd.Flags().BoolVarP(&opts.skipConfirm, "force", "f", false, "Skip confirmation for codespaces that contain unsaved changes or belong to other users")
 	deleteCmd.Flags().Uint16Var(&opts.keepDays, "days", 0, "Delete codespaces older than `N` days")
 	deleteCmd.Flags().StringVarP(&opts.orgName, "org", "o", "", "The `login` handle of the organization (admin-only)")
 	deleteCmd.Flags().StringVarP(&opts.userName, "user", "u", "", "The `username` to delete codespaces for (used with --org)")
@@ -91,16 +91,22 @@ func newDeleteCmd(app *App) *cobra.Command {
 
 func (a *App) Delete(ctx context.Context, opts deleteOptions) (err error) {
 	var codespaces []*api.Codespace
+	// currentUserLogin is only resolved for org admins, who may be deleting
+	// codespaces that belong to other members
+	var currentUserLogin string
 	nameFilter := opts.codespaceName
 	if nameFilter == "" {
 		err = a.RunWithProgress("Fetching codespaces", func() (fetchErr error) {
 			userName := opts.userName
-			if userName == "" && opts.orgName != "" {
+			if opts.orgName != "" {
 				currentUser, fetchErr := a.apiClient.GetUser(ctx)
 				if fetchErr != nil {
 					return fetchErr
 				}
-				userName = currentUser.Login
+				currentUserLogin = currentUser.Login
+				if userName == "" {
+					userName = currentUserLogin
+				}
 			}
 			codespaces, fetchErr = a.apiClient.ListCodespaces(ctx, api.ListCodespacesOptions{OrgName: opts.orgName, UserName: userName})
 			if opts.repoOwner != "" {
@@ -126,7 +132,13 @@ func (a *App) Delete(ctx context.Context, opts deleteOptions) (err error) {
 			if opts.orgName == "" || opts.userName == "" {
 				codespace, fetchErr = a.apiClient.GetCodespace(ctx, nameFilter, false)
 			} else {
+				currentUser, fetchErr := a.apiClient.GetUser(ctx)
+				if fetchErr != nil {
+					return fetchErr
+				}
+				currentUserLogin = currentUser.Login
 				codespace, fetchErr = a.apiClient.GetOrgMemberCodespace(ctx, opts.orgName, opts.userName, opts.codespaceName)
+				return fetchErr
 			}
 			return
 		})
@@ -156,6 +168,15 @@ func (a *App) Delete(ctx context.Context, opts deleteOptions) (err error) {
 				continue
 			}
 		}
+		if opts.orgName != "" && c.Owner.Login != currentUserLogin && !opts.skipConfirm {
+			confirmed, err := confirmAdminDeletion(opts.prompter, c, opts.isInteractive)
+			if err != nil {
+				return fmt.Errorf("unable to confirm: %w", err)
+			}
+			if !confirmed {
+				continue
+			}
+		}
 		if !opts.skipConfirm {
 			confirmed, err := confirmDeletion(opts.prompter, c, opts.isInteractive)
 			if err != nil {
@@ -206,6 +227,15 @@ func (a *App) Delete(ctx context.Context, opts deleteOptions) (err error) {
 	return err
 }
 
+// confirmAdminDeletion guards an org admin against deleting another member's
+// codespace by accident.
+func confirmAdminDeletion(p prompter, apiCodespace *api.Codespace, isInteractive bool) (bool, error) {
+	if !isInteractive {
+		return false, fmt.Errorf("codespace %s belongs to %s (use --force to delete it without confirmation)", apiCodespace.Name, apiCodespace.Owner.Login)
+	}
+	return p.Confirm(fmt.Sprintf("Codespace %s belongs to %s. OK to delete?", apiCodespace.Name, apiCodespace.Owner.Login))
+}
+
 func confirmDeletion(p prompter, apiCodespace *api.Codespace, isInteractive bool) (bool, error) {
 	cs := codespace{apiCodespace}
 	if !cs.hasUnsavedChanges() {
@@ -219,6 +219,79 @@ func TestDelete(t *testing.T) {
 			wantDeleted: []string{"monalisa-spoonknife-123"},
 			wantStderr:  "1 codespace(s) deleted successfully\n",
 		},
+		{
+			name: "deletion of another member's codespace requires confirmation",
+			opts: deleteOptions{
+				isInteractive: true,
+				orgName:       "bookish",
+				userName:      "monalisa2",
+				codespaceName: "monalisa-spoonknife-123",
+			},
+			codespaces: []*api.Codespace{
+				{
+					Name:  "monalisa-spoonknife-123",
+					Owner: api.User{Login: "monalisa2"},
+				},
+			},
+			confirms: map[string]bool{
+				"Codespace monalisa-spoonknife-123 belongs to monalisa2. OK to delete?": true,
+			},
+			wantDeleted: []string{"monalisa-spoonknife-123"},
+			wantStderr:  "1 codespace(s) deleted successfully\n",
+		},
+		{
+			name: "deletion of another member's codespace is declined",
+			opts: deleteOptions{
+				isInteractive: true,
+				orgName:       "bookish",
+				userName:      "monalisa2",
+				codespaceName: "monalisa-spoonknife-123",
+			},
+			codespaces: []*api.Codespace{
+				{
+					Name:  "monalisa-spoonknife-123",
+					Owner: api.User{Login: "monalisa2"},
+				},
+			},
+			confirms: map[string]bool{
+				"Codespace monalisa-spoonknife-123 belongs to monalisa2. OK to delete?": false,
+			},
+			wantErr:     "no codespaces to delete",
+			wantDeleted: []string{},
+		},
+		{
+			name: "non-interactive deletion of another member's codespace fails without --force",
+			opts: deleteOptions{
+				orgName:       "bookish",
+				userName:      "monalisa2",
+				codespaceName: "monalisa-spoonknife-123",
+			},
+			codespaces: []*api.Codespace{
+				{
+					Name:  "monalisa-spoonknife-123",
+					Owner: api.User{Login: "monalisa2"},
+				},
+			},
+			wantErr:     "unable to confirm: codespace monalisa-spoonknife-123 belongs to monalisa2 (use --force to delete it without confirmation)",
+			wantDeleted: []string{},
+		},
+		{
+			name: "deletion of another member's codespace with --force",
+			opts: deleteOptions{
+				skipConfirm:   true,
+				orgName:       "bookish",
+				userName:      "monalisa2",
+				codespaceName: "monalisa-spoonknife-123",
+			},
+			codespaces: []*api.Codespace{
+				{
+					Name:  "monalisa-spoonknife-123",
+					Owner: api.User{Login: "monalisa2"},
+				},
+			},
+			wantDeleted: []string{"monalisa-spoonknife-123"},
+			wantStderr:  "1 codespace(s) deleted successfully\n",
+		},
 		{
 			name: "by repo owner",
 			opts: deleteOptions{
@@ -307,7 +380,7 @@ func TestDelete(t *testing.T) {
 			ios, _, stdout, stderr := iostreams.Test()
 			ios.SetStdinTTY(true)
 			ios.SetStdoutTTY(true)
-			app := NewApp(ios, nil, apiMock, nil, nil)
+			app := NewApp(ios, nil, apiMock, nil, nil, nil)
 			err := app.Delete(context.Background(), opts)
 			if (err != nil) && tt.wantErr != err.Error() {
 				t.Errorf("delete() error = %v, wantErr = %v", err, tt.wantErr)
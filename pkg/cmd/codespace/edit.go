@@ -11,9 +11,10 @@ import (
 )
 
 type editOptions struct {
-	selector    *CodespaceSelector
-	displayName string
-	machine     string
+	selector        *CodespaceSelector
+	displayName     string
+	machine         string
+	retentionPeriod NullableDuration
 }
 
 func newEditCmd(app *App) *cobra.Command {
@@ -24,8 +25,8 @@ func newEditCmd(app *App) *cobra.Command {
 		Short: "Edit a codespace",
 		Args:  noArgsConstraint,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			if opts.displayName == "" && opts.machine == "" {
-				return cmdutil.FlagErrorf("must provide `--display-name` or `--machine`")
+			if opts.displayName == "" && opts.machine == "" && opts.retentionPeriod.Duration == nil {
+				return cmdutil.FlagErrorf("must provide `--display-name`, `--machine`, or `--retention-period`")
 			}
 
 			return app.Edit(cmd.Context(), opts)
@@ -39,6 +40,7 @@ func newEditCmd(app *App) *cobra.Command {
 		fmt.Fprintf(app.io.ErrOut, "error marking flag as deprecated: %v\n", err)
 	}
 	editCmd.Flags().StringVarP(&opts.machine, "machine", "m", "", "Set hardware specifications for the VM")
+	editCmd.Flags().Var(&opts.retentionPeriod, "retention-period", "Set the allowed time after shutting down before the codespace is automatically deleted (maximum 30 days), e.g. \"1h\", \"72h\"")
 
 	return editCmd
 }
@@ -56,8 +58,9 @@ func (a *App) Edit(ctx context.Context, opts editOptions) error {
 
 	err = a.RunWithProgress("Editing codespace", func() (err error) {
 		_, err = a.apiClient.EditCodespace(ctx, codespaceName, &api.EditCodespaceParams{
-			DisplayName: opts.displayName,
-			Machine:     opts.machine,
+			DisplayName:            opts.displayName,
+			RetentionPeriodMinutes: opts.retentionPeriod.Minutes(),
+			Machine:                opts.machine,
 		})
 		return
 	})
@@ -8,6 +8,10 @@ import (
 	"github.com/cli/cli/v2/pkg/iostreams"
 )
 
+func intPtr(i int) *int {
+	return &i
+}
+
 func TestEdit(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -70,11 +74,24 @@ func TestEdit(t *testing.T) {
 			wantStdout: "",
 			wantErr:    false,
 		},
+		{
+			name:    "edit codespace retention period",
+			cliArgs: []string{"--codespace", "hubot", "--retention-period", "72h"},
+			wantEdits: &api.EditCodespaceParams{
+				RetentionPeriodMinutes: intPtr(72 * 60),
+			},
+			mockCodespace: &api.Codespace{
+				Name:                   "hubot",
+				RetentionPeriodMinutes: 72 * 60,
+			},
+			wantStdout: "",
+			wantErr:    false,
+		},
 		{
 			name:    "no CLI arguments",
 			cliArgs: []string{},
 			wantErr: true,
-			errMsg:  "must provide `--display-name` or `--machine`",
+			errMsg:  "must provide `--display-name`, `--machine`, or `--retention-period`",
 		},
 	}
 	for _, tt := range tests {
@@ -88,7 +105,7 @@ func TestEdit(t *testing.T) {
 			}
 
 			ios, _, stdout, stderr := iostreams.Test()
-			a := NewApp(ios, nil, apiMock, nil, nil)
+			a := NewApp(ios, nil, apiMock, nil, nil, nil)
 
 			var err error
 			if tt.cliArgs == nil {
@@ -138,6 +155,13 @@ func TestEdit(t *testing.T) {
 				if tt.wantEdits.IdleTimeoutMinutes != gotEdits.IdleTimeoutMinutes {
 					t.Errorf("edited idle timeout minutes %d, want %d", gotEdits.IdleTimeoutMinutes, tt.wantEdits.IdleTimeoutMinutes)
 				}
+				if tt.wantEdits.RetentionPeriodMinutes != nil {
+					if gotEdits.RetentionPeriodMinutes == nil {
+						t.Errorf("edited retention period minutes missing, want %d", *tt.wantEdits.RetentionPeriodMinutes)
+					} else if *tt.wantEdits.RetentionPeriodMinutes != *gotEdits.RetentionPeriodMinutes {
+						t.Errorf("edited retention period minutes %d, want %d", *gotEdits.RetentionPeriodMinutes, *tt.wantEdits.RetentionPeriodMinutes)
+					}
+				}
 			}
 		})
 	}
@@ -0,0 +1,183 @@
+package codespace
+
+// This file defines the 'gh cs features' subcommand, which inspects the
+// devcontainer features a codespace was created with.
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/tableprinter"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/muhammadmuzzammil1998/jsonc"
+	"github.com/spf13/cobra"
+)
+
+func newFeaturesCmd(app *App) *cobra.Command {
+	var (
+		selector *CodespaceSelector
+		exporter cmdutil.Exporter
+	)
+
+	featuresCmd := &cobra.Command{
+		Use:   "features",
+		Short: "List devcontainer features of a codespace",
+		Long: heredoc.Doc(`
+			List the devcontainer features a codespace was created with, as resolved from
+			the devcontainer configuration the codespace uses, so you can verify which
+			toolchains the codespace actually got.
+		`),
+		Args: noArgsConstraint,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return app.ListFeatures(cmd.Context(), selector, exporter)
+		},
+	}
+
+	selector = AddCodespaceSelector(featuresCmd, app.apiClient)
+
+	cmdutil.AddJSONFlags(featuresCmd, &exporter, featureFields)
+
+	return featuresCmd
+}
+
+var featureFields = []string{
+	"id",
+	"version",
+	"options",
+}
+
+type codespaceFeature struct {
+	ID      string            `json:"id"`
+	Version string            `json:"version"`
+	Options map[string]string `json:"options"`
+}
+
+func (f *codespaceFeature) ExportData(fields []string) map[string]interface{} {
+	v := map[string]interface{}{}
+	for _, field := range fields {
+		switch field {
+		case "id":
+			v[field] = f.ID
+		case "version":
+			v[field] = f.Version
+		case "options":
+			v[field] = f.Options
+		}
+	}
+	return v
+}
+
+// ListFeatures lists devcontainer features configured for a codespace.
+func (a *App) ListFeatures(ctx context.Context, selector *CodespaceSelector, exporter cmdutil.Exporter) error {
+	codespace, err := selector.Select(ctx)
+	if err != nil {
+		return err
+	}
+
+	devContainerPath := codespace.DevContainerPath
+	if devContainerPath == "" {
+		devContainerPath = ".devcontainer/devcontainer.json"
+	}
+
+	var contents []byte
+	err = a.RunWithProgress("Fetching devcontainer configuration", func() (err error) {
+		contents, err = a.apiClient.GetCodespaceRepositoryContents(ctx, codespace, devContainerPath)
+		return
+	})
+	if err != nil {
+		return fmt.Errorf("error getting devcontainer configuration: %w", err)
+	}
+	if contents == nil {
+		return fmt.Errorf("no devcontainer configuration found at %s", devContainerPath)
+	}
+
+	features, err := parseDevContainerFeatures(contents)
+	if err != nil {
+		return err
+	}
+
+	if exporter != nil {
+		return exporter.Write(a.io, features)
+	}
+
+	if len(features) == 0 {
+		fmt.Fprintf(a.io.ErrOut, "No features configured in %s\n", devContainerPath)
+		return nil
+	}
+
+	table := tableprinter.New(a.io, tableprinter.WithHeader("FEATURE", "VERSION", "OPTIONS"))
+	for _, f := range features {
+		table.AddField(f.ID)
+		table.AddField(f.Version)
+		table.AddField(formatFeatureOptions(f.Options))
+		table.EndRow()
+	}
+	return table.Render()
+}
+
+// parseDevContainerFeatures extracts the features map from devcontainer.json
+// contents. Each feature value is either a version string or an object of
+// options, where the "version" option pins the feature version; otherwise the
+// version is the tag of the feature reference itself.
+func parseDevContainerFeatures(contents []byte) ([]*codespaceFeature, error) {
+	convertedJSON := normalizeJSON(jsonc.ToJSON(contents))
+	if !jsonc.Valid(convertedJSON) {
+		return nil, errors.New("failed to convert json to standard json")
+	}
+
+	var container struct {
+		Features map[string]json.RawMessage `json:"features"`
+	}
+	if err := json.Unmarshal(convertedJSON, &container); err != nil {
+		return nil, fmt.Errorf("error unmarshalling: %w", err)
+	}
+
+	features := make([]*codespaceFeature, 0, len(container.Features))
+	for ref, raw := range container.Features {
+		f := &codespaceFeature{ID: ref, Options: map[string]string{}}
+
+		// A trailing ":tag" or "@digest" on the reference selects the version.
+		if i := strings.LastIndexAny(ref, ":@"); i > strings.LastIndex(ref, "/") {
+			f.ID = ref[:i]
+			f.Version = strings.TrimPrefix(ref[i:], ":")
+		}
+
+		var version string
+		if err := json.Unmarshal(raw, &version); err == nil {
+			f.Version = version
+		} else {
+			var options map[string]interface{}
+			if err := json.Unmarshal(raw, &options); err != nil {
+				return nil, fmt.Errorf("error unmarshalling feature %q: %w", ref, err)
+			}
+			for name, value := range options {
+				if name == "version" {
+					f.Version = fmt.Sprintf("%v", value)
+					continue
+				}
+				f.Options[name] = fmt.Sprintf("%v", value)
+			}
+		}
+
+		features = append(features, f)
+	}
+
+	sort.Slice(features, func(i, j int) bool {
+		return features[i].ID < features[j].ID
+	})
+	return features, nil
+}
+
+func formatFeatureOptions(options map[string]string) string {
+	pairs := make([]string, 0, len(options))
+	for name, value := range options {
+		pairs = append(pairs, fmt.Sprintf("%s=%s", name, value))
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ", ")
+}
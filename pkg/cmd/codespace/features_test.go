@@ -0,0 +1,63 @@
+package codespace
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/codespaces/api"
+	"github.com/cli/cli/v2/pkg/iostreams"
+)
+
+func TestListFeatures(t *testing.T) {
+	devcontainer := `{
+		// a comment, since devcontainer.json allows them
+		"features": {
+			"ghcr.io/devcontainers/features/go:1": {},
+			"ghcr.io/devcontainers/features/node": {
+				"version": "20",
+				"nodeGypDependencies": true
+			},
+			"ghcr.io/devcontainers/features/sshd:1": "latest"
+		}
+	}`
+
+	apiMock := &apiClientMock{
+		GetCodespaceFunc: func(_ context.Context, name string, _ bool) (*api.Codespace, error) {
+			return &api.Codespace{Name: name, State: api.CodespaceStateAvailable}, nil
+		},
+		GetCodespaceRepositoryContentsFunc: func(_ context.Context, _ *api.Codespace, path string) ([]byte, error) {
+			if path != ".devcontainer/devcontainer.json" {
+				t.Errorf("unexpected path: %s", path)
+			}
+			return []byte(devcontainer), nil
+		},
+	}
+
+	ios, _, stdout, _ := iostreams.Test()
+	a := &App{
+		io:        ios,
+		apiClient: apiMock,
+	}
+
+	selector := &CodespaceSelector{api: apiMock, codespaceName: "codespace-name"}
+	if err := a.ListFeatures(context.Background(), selector, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := "ghcr.io/devcontainers/features/go\t1\t\n" +
+		"ghcr.io/devcontainers/features/node\t20\tnodeGypDependencies=true\n" +
+		"ghcr.io/devcontainers/features/sshd\tlatest\t\n"
+	if got := stdout.String(); got != want {
+		t.Errorf("stdout = %q, want %q", got, want)
+	}
+}
+
+func TestParseDevContainerFeaturesEmpty(t *testing.T) {
+	features, err := parseDevContainerFeatures([]byte(`{"image": "ubuntu"}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(features) != 0 {
+		t.Errorf("expected no features, got %d", len(features))
+	}
+}
@@ -37,5 +37,5 @@ func testingLogsApp() *App {
 	}
 
 	ios, _, _, _ := iostreams.Test()
-	return NewApp(ios, nil, apiMock, nil, nil)
+	return NewApp(ios, nil, apiMock, nil, nil, nil)
 }
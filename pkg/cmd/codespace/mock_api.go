@@ -20,9 +20,15 @@ import (
 //			CreateCodespaceFunc: func(ctx context.Context, params *codespacesAPI.CreateCodespaceParams) (*codespacesAPI.Codespace, error) {
 //				panic("mock out the CreateCodespace method")
 //			},
+//			CreateUserSecretFunc: func(ctx context.Context, name string, params codespacesAPI.CreateUserSecretParams) error {
+//				panic("mock out the CreateUserSecret method")
+//			},
 //			DeleteCodespaceFunc: func(ctx context.Context, name string, orgName string, userName string) error {
 //				panic("mock out the DeleteCodespace method")
 //			},
+//			DeleteUserSecretFunc: func(ctx context.Context, name string) error {
+//				panic("mock out the DeleteUserSecret method")
+//			},
 //			EditCodespaceFunc: func(ctx context.Context, codespaceName string, params *codespacesAPI.EditCodespaceParams) (*codespacesAPI.Codespace, error) {
 //				panic("mock out the EditCodespace method")
 //			},
@@ -44,15 +50,24 @@ import (
 //			GetCodespacesPermissionsCheckFunc: func(ctx context.Context, repoID int, branch string, devcontainerPath string) (bool, error) {
 //				panic("mock out the GetCodespacesPermissionsCheck method")
 //			},
+//			GetCodespacesUsageFunc: func(ctx context.Context, userName string, orgName string, startDate string, endDate string) ([]*codespacesAPI.CodespacesUsageItem, error) {
+//				panic("mock out the GetCodespacesUsage method")
+//			},
 //			GetOrgMemberCodespaceFunc: func(ctx context.Context, orgName string, userName string, codespaceName string) (*codespacesAPI.Codespace, error) {
 //				panic("mock out the GetOrgMemberCodespace method")
 //			},
 //			GetRepositoryFunc: func(ctx context.Context, nwo string) (*codespacesAPI.Repository, error) {
 //				panic("mock out the GetRepository method")
 //			},
+//			GetRepositoryContentsFunc: func(ctx context.Context, nwo string, path string, ref string) ([]byte, error) {
+//				panic("mock out the GetRepositoryContents method")
+//			},
 //			GetUserFunc: func(ctx context.Context) (*codespacesAPI.User, error) {
 //				panic("mock out the GetUser method")
 //			},
+//			GetUserSecretPublicKeyFunc: func(ctx context.Context) (*codespacesAPI.UserSecretPublicKey, error) {
+//				panic("mock out the GetUserSecretPublicKey method")
+//			},
 //			HTTPClientFunc: func() (*http.Client, error) {
 //				panic("mock out the HTTPClient method")
 //			},
@@ -62,6 +77,9 @@ import (
 //			ListDevContainersFunc: func(ctx context.Context, repoID int, branch string, limit int) ([]codespacesAPI.DevContainerEntry, error) {
 //				panic("mock out the ListDevContainers method")
 //			},
+//			ListUserSecretsFunc: func(ctx context.Context) ([]*codespacesAPI.UserSecret, error) {
+//				panic("mock out the ListUserSecrets method")
+//			},
 //			ServerURLFunc: func() string {
 //				panic("mock out the ServerURL method")
 //			},
@@ -81,9 +99,15 @@ type apiClientMock struct {
 	// CreateCodespaceFunc mocks the CreateCodespace method.
 	CreateCodespaceFunc func(ctx context.Context, params *codespacesAPI.CreateCodespaceParams) (*codespacesAPI.Codespace, error)
 
+	// CreateUserSecretFunc mocks the CreateUserSecret method.
+	CreateUserSecretFunc func(ctx context.Context, name string, params codespacesAPI.CreateUserSecretParams) error
+
 	// DeleteCodespaceFunc mocks the DeleteCodespace method.
 	DeleteCodespaceFunc func(ctx context.Context, name string, orgName string, userName string) error
 
+	// DeleteUserSecretFunc mocks the DeleteUserSecret method.
+	DeleteUserSecretFunc func(ctx context.Context, name string) error
+
 	// EditCodespaceFunc mocks the EditCodespace method.
 	EditCodespaceFunc func(ctx context.Context, codespaceName string, params *codespacesAPI.EditCodespaceParams) (*codespacesAPI.Codespace, error)
 
@@ -105,15 +129,24 @@ type apiClientMock struct {
 	// GetCodespacesPermissionsCheckFunc mocks the GetCodespacesPermissionsCheck method.
 	GetCodespacesPermissionsCheckFunc func(ctx context.Context, repoID int, branch string, devcontainerPath string) (bool, error)
 
+	// GetCodespacesUsageFunc mocks the GetCodespacesUsage method.
+	GetCodespacesUsageFunc func(ctx context.Context, userName string, orgName string, startDate string, endDate string) ([]*codespacesAPI.CodespacesUsageItem, error)
+
 	// GetOrgMemberCodespaceFunc mocks the GetOrgMemberCodespace method.
 	GetOrgMemberCodespaceFunc func(ctx context.Context, orgName string, userName string, codespaceName string) (*codespacesAPI.Codespace, error)
 
 	// GetRepositoryFunc mocks the GetRepository method.
 	GetRepositoryFunc func(ctx context.Context, nwo string) (*codespacesAPI.Repository, error)
 
+	// GetRepositoryContentsFunc mocks the GetRepositoryContents method.
+	GetRepositoryContentsFunc func(ctx context.Context, nwo string, path string, ref string) ([]byte, error)
+
 	// GetUserFunc mocks the GetUser method.
 	GetUserFunc func(ctx context.Context) (*codespacesAPI.User, error)
 
+	// GetUserSecretPublicKeyFunc mocks the GetUserSecretPublicKey method.
+	GetUserSecretPublicKeyFunc func(ctx context.Context) (*codespacesAPI.UserSecretPublicKey, error)
+
 	// HTTPClientFunc mocks the HTTPClient method.
 	HTTPClientFunc func() (*http.Client, error)
 
@@ -123,6 +156,9 @@ type apiClientMock struct {
 	// ListDevContainersFunc mocks the ListDevContainers method.
 	ListDevContainersFunc func(ctx context.Context, repoID int, branch string, limit int) ([]codespacesAPI.DevContainerEntry, error)
 
+	// ListUserSecretsFunc mocks the ListUserSecrets method.
+	ListUserSecretsFunc func(ctx context.Context) ([]*codespacesAPI.UserSecret, error)
+
 	// ServerURLFunc mocks the ServerURL method.
 	ServerURLFunc func() string
 
@@ -141,6 +177,15 @@ type apiClientMock struct {
 			// Params is the params argument value.
 			Params *codespacesAPI.CreateCodespaceParams
 		}
+		// CreateUserSecret holds details about calls to the CreateUserSecret method.
+		CreateUserSecret []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Name is the name argument value.
+			Name string
+			// Params is the params argument value.
+			Params codespacesAPI.CreateUserSecretParams
+		}
 		// DeleteCodespace holds details about calls to the DeleteCodespace method.
 		DeleteCodespace []struct {
 			// Ctx is the ctx argument value.
@@ -152,6 +197,13 @@ type apiClientMock struct {
 			// UserName is the userName argument value.
 			UserName string
 		}
+		// DeleteUserSecret holds details about calls to the DeleteUserSecret method.
+		DeleteUserSecret []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Name is the name argument value.
+			Name string
+		}
 		// EditCodespace holds details about calls to the EditCodespace method.
 		EditCodespace []struct {
 			// Ctx is the ctx argument value.
@@ -219,6 +271,19 @@ type apiClientMock struct {
 			// DevcontainerPath is the devcontainerPath argument value.
 			DevcontainerPath string
 		}
+		// GetCodespacesUsage holds details about calls to the GetCodespacesUsage method.
+		GetCodespacesUsage []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UserName is the userName argument value.
+			UserName string
+			// OrgName is the orgName argument value.
+			OrgName string
+			// StartDate is the startDate argument value.
+			StartDate string
+			// EndDate is the endDate argument value.
+			EndDate string
+		}
 		// GetOrgMemberCodespace holds details about calls to the GetOrgMemberCodespace method.
 		GetOrgMemberCodespace []struct {
 			// Ctx is the ctx argument value.
@@ -237,11 +302,27 @@ type apiClientMock struct {
 			// Nwo is the nwo argument value.
 			Nwo string
 		}
+		// GetRepositoryContents holds details about calls to the GetRepositoryContents method.
+		GetRepositoryContents []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Nwo is the nwo argument value.
+			Nwo string
+			// Path is the path argument value.
+			Path string
+			// Ref is the ref argument value.
+			Ref string
+		}
 		// GetUser holds details about calls to the GetUser method.
 		GetUser []struct {
 			// Ctx is the ctx argument value.
 			Ctx context.Context
 		}
+		// GetUserSecretPublicKey holds details about calls to the GetUserSecretPublicKey method.
+		GetUserSecretPublicKey []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
 		// HTTPClient holds details about calls to the HTTPClient method.
 		HTTPClient []struct {
 		}
@@ -263,6 +344,11 @@ type apiClientMock struct {
 			// Limit is the limit argument value.
 			Limit int
 		}
+		// ListUserSecrets holds details about calls to the ListUserSecrets method.
+		ListUserSecrets []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
 		// ServerURL holds details about calls to the ServerURL method.
 		ServerURL []struct {
 		}
@@ -286,7 +372,9 @@ type apiClientMock struct {
 		}
 	}
 	lockCreateCodespace                sync.RWMutex
+	lockCreateUserSecret               sync.RWMutex
 	lockDeleteCodespace                sync.RWMutex
+	lockDeleteUserSecret               sync.RWMutex
 	lockEditCodespace                  sync.RWMutex
 	lockGetCodespace                   sync.RWMutex
 	lockGetCodespaceBillableOwner      sync.RWMutex
@@ -294,12 +382,16 @@ type apiClientMock struct {
 	lockGetCodespaceRepositoryContents sync.RWMutex
 	lockGetCodespacesMachines          sync.RWMutex
 	lockGetCodespacesPermissionsCheck  sync.RWMutex
+	lockGetCodespacesUsage             sync.RWMutex
 	lockGetOrgMemberCodespace          sync.RWMutex
 	lockGetRepository                  sync.RWMutex
+	lockGetRepositoryContents          sync.RWMutex
 	lockGetUser                        sync.RWMutex
+	lockGetUserSecretPublicKey         sync.RWMutex
 	lockHTTPClient                     sync.RWMutex
 	lockListCodespaces                 sync.RWMutex
 	lockListDevContainers              sync.RWMutex
+	lockListUserSecrets                sync.RWMutex
 	lockServerURL                      sync.RWMutex
 	lockStartCodespace                 sync.RWMutex
 	lockStopCodespace                  sync.RWMutex
@@ -341,6 +433,46 @@ func (mock *apiClientMock) CreateCodespaceCalls() []struct {
 	return calls
 }
 
+// CreateUserSecret calls CreateUserSecretFunc.
+func (mock *apiClientMock) CreateUserSecret(ctx context.Context, name string, params codespacesAPI.CreateUserSecretParams) error {
+	if mock.CreateUserSecretFunc == nil {
+		panic("apiClientMock.CreateUserSecretFunc: method is nil but apiClient.CreateUserSecret was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Name   string
+		Params codespacesAPI.CreateUserSecretParams
+	}{
+		Ctx:    ctx,
+		Name:   name,
+		Params: params,
+	}
+	mock.lockCreateUserSecret.Lock()
+	mock.calls.CreateUserSecret = append(mock.calls.CreateUserSecret, callInfo)
+	mock.lockCreateUserSecret.Unlock()
+	return mock.CreateUserSecretFunc(ctx, name, params)
+}
+
+// CreateUserSecretCalls gets all the calls that were made to CreateUserSecret.
+// Check the length with:
+//
+//	len(mockedapiClient.CreateUserSecretCalls())
+func (mock *apiClientMock) CreateUserSecretCalls() []struct {
+	Ctx    context.Context
+	Name   string
+	Params codespacesAPI.CreateUserSecretParams
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Name   string
+		Params codespacesAPI.CreateUserSecretParams
+	}
+	mock.lockCreateUserSecret.RLock()
+	calls = mock.calls.CreateUserSecret
+	mock.lockCreateUserSecret.RUnlock()
+	return calls
+}
+
 // DeleteCodespace calls DeleteCodespaceFunc.
 func (mock *apiClientMock) DeleteCodespace(ctx context.Context, name string, orgName string, userName string) error {
 	if mock.DeleteCodespaceFunc == nil {
@@ -385,6 +517,42 @@ func (mock *apiClientMock) DeleteCodespaceCalls() []struct {
 	return calls
 }
 
+// DeleteUserSecret calls DeleteUserSecretFunc.
+func (mock *apiClientMock) DeleteUserSecret(ctx context.Context, name string) error {
+	if mock.DeleteUserSecretFunc == nil {
+		panic("apiClientMock.DeleteUserSecretFunc: method is nil but apiClient.DeleteUserSecret was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Name string
+	}{
+		Ctx:  ctx,
+		Name: name,
+	}
+	mock.lockDeleteUserSecret.Lock()
+	mock.calls.DeleteUserSecret = append(mock.calls.DeleteUserSecret, callInfo)
+	mock.lockDeleteUserSecret.Unlock()
+	return mock.DeleteUserSecretFunc(ctx, name)
+}
+
+// DeleteUserSecretCalls gets all the calls that were made to DeleteUserSecret.
+// Check the length with:
+//
+//	len(mockedapiClient.DeleteUserSecretCalls())
+func (mock *apiClientMock) DeleteUserSecretCalls() []struct {
+	Ctx  context.Context
+	Name string
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Name string
+	}
+	mock.lockDeleteUserSecret.RLock()
+	calls = mock.calls.DeleteUserSecret
+	mock.lockDeleteUserSecret.RUnlock()
+	return calls
+}
+
 // EditCodespace calls EditCodespaceFunc.
 func (mock *apiClientMock) EditCodespace(ctx context.Context, codespaceName string, params *codespacesAPI.EditCodespaceParams) (*codespacesAPI.Codespace, error) {
 	if mock.EditCodespaceFunc == nil {
@@ -673,6 +841,54 @@ func (mock *apiClientMock) GetCodespacesPermissionsCheckCalls() []struct {
 	return calls
 }
 
+// GetCodespacesUsage calls GetCodespacesUsageFunc.
+func (mock *apiClientMock) GetCodespacesUsage(ctx context.Context, userName string, orgName string, startDate string, endDate string) ([]*codespacesAPI.CodespacesUsageItem, error) {
+	if mock.GetCodespacesUsageFunc == nil {
+		panic("apiClientMock.GetCodespacesUsageFunc: method is nil but apiClient.GetCodespacesUsage was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		UserName  string
+		OrgName   string
+		StartDate string
+		EndDate   string
+	}{
+		Ctx:       ctx,
+		UserName:  userName,
+		OrgName:   orgName,
+		StartDate: startDate,
+		EndDate:   endDate,
+	}
+	mock.lockGetCodespacesUsage.Lock()
+	mock.calls.GetCodespacesUsage = append(mock.calls.GetCodespacesUsage, callInfo)
+	mock.lockGetCodespacesUsage.Unlock()
+	return mock.GetCodespacesUsageFunc(ctx, userName, orgName, startDate, endDate)
+}
+
+// GetCodespacesUsageCalls gets all the calls that were made to GetCodespacesUsage.
+// Check the length with:
+//
+//	len(mockedapiClient.GetCodespacesUsageCalls())
+func (mock *apiClientMock) GetCodespacesUsageCalls() []struct {
+	Ctx       context.Context
+	UserName  string
+	OrgName   string
+	StartDate string
+	EndDate   string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		UserName  string
+		OrgName   string
+		StartDate string
+		EndDate   string
+	}
+	mock.lockGetCodespacesUsage.RLock()
+	calls = mock.calls.GetCodespacesUsage
+	mock.lockGetCodespacesUsage.RUnlock()
+	return calls
+}
+
 // GetOrgMemberCodespace calls GetOrgMemberCodespaceFunc.
 func (mock *apiClientMock) GetOrgMemberCodespace(ctx context.Context, orgName string, userName string, codespaceName string) (*codespacesAPI.Codespace, error) {
 	if mock.GetOrgMemberCodespaceFunc == nil {
@@ -753,6 +969,50 @@ func (mock *apiClientMock) GetRepositoryCalls() []struct {
 	return calls
 }
 
+// GetRepositoryContents calls GetRepositoryContentsFunc.
+func (mock *apiClientMock) GetRepositoryContents(ctx context.Context, nwo string, path string, ref string) ([]byte, error) {
+	if mock.GetRepositoryContentsFunc == nil {
+		panic("apiClientMock.GetRepositoryContentsFunc: method is nil but apiClient.GetRepositoryContents was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Nwo  string
+		Path string
+		Ref  string
+	}{
+		Ctx:  ctx,
+		Nwo:  nwo,
+		Path: path,
+		Ref:  ref,
+	}
+	mock.lockGetRepositoryContents.Lock()
+	mock.calls.GetRepositoryContents = append(mock.calls.GetRepositoryContents, callInfo)
+	mock.lockGetRepositoryContents.Unlock()
+	return mock.GetRepositoryContentsFunc(ctx, nwo, path, ref)
+}
+
+// GetRepositoryContentsCalls gets all the calls that were made to GetRepositoryContents.
+// Check the length with:
+//
+//	len(mockedapiClient.GetRepositoryContentsCalls())
+func (mock *apiClientMock) GetRepositoryContentsCalls() []struct {
+	Ctx  context.Context
+	Nwo  string
+	Path string
+	Ref  string
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Nwo  string
+		Path string
+		Ref  string
+	}
+	mock.lockGetRepositoryContents.RLock()
+	calls = mock.calls.GetRepositoryContents
+	mock.lockGetRepositoryContents.RUnlock()
+	return calls
+}
+
 // GetUser calls GetUserFunc.
 func (mock *apiClientMock) GetUser(ctx context.Context) (*codespacesAPI.User, error) {
 	if mock.GetUserFunc == nil {
@@ -785,6 +1045,38 @@ func (mock *apiClientMock) GetUserCalls() []struct {
 	return calls
 }
 
+// GetUserSecretPublicKey calls GetUserSecretPublicKeyFunc.
+func (mock *apiClientMock) GetUserSecretPublicKey(ctx context.Context) (*codespacesAPI.UserSecretPublicKey, error) {
+	if mock.GetUserSecretPublicKeyFunc == nil {
+		panic("apiClientMock.GetUserSecretPublicKeyFunc: method is nil but apiClient.GetUserSecretPublicKey was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockGetUserSecretPublicKey.Lock()
+	mock.calls.GetUserSecretPublicKey = append(mock.calls.GetUserSecretPublicKey, callInfo)
+	mock.lockGetUserSecretPublicKey.Unlock()
+	return mock.GetUserSecretPublicKeyFunc(ctx)
+}
+
+// GetUserSecretPublicKeyCalls gets all the calls that were made to GetUserSecretPublicKey.
+// Check the length with:
+//
+//	len(mockedapiClient.GetUserSecretPublicKeyCalls())
+func (mock *apiClientMock) GetUserSecretPublicKeyCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockGetUserSecretPublicKey.RLock()
+	calls = mock.calls.GetUserSecretPublicKey
+	mock.lockGetUserSecretPublicKey.RUnlock()
+	return calls
+}
+
 // HTTPClient calls HTTPClientFunc.
 func (mock *apiClientMock) HTTPClient() (*http.Client, error) {
 	if mock.HTTPClientFunc == nil {
@@ -892,6 +1184,38 @@ func (mock *apiClientMock) ListDevContainersCalls() []struct {
 	return calls
 }
 
+// ListUserSecrets calls ListUserSecretsFunc.
+func (mock *apiClientMock) ListUserSecrets(ctx context.Context) ([]*codespacesAPI.UserSecret, error) {
+	if mock.ListUserSecretsFunc == nil {
+		panic("apiClientMock.ListUserSecretsFunc: method is nil but apiClient.ListUserSecrets was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockListUserSecrets.Lock()
+	mock.calls.ListUserSecrets = append(mock.calls.ListUserSecrets, callInfo)
+	mock.lockListUserSecrets.Unlock()
+	return mock.ListUserSecretsFunc(ctx)
+}
+
+// ListUserSecretsCalls gets all the calls that were made to ListUserSecrets.
+// Check the length with:
+//
+//	len(mockedapiClient.ListUserSecretsCalls())
+func (mock *apiClientMock) ListUserSecretsCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockListUserSecrets.RLock()
+	calls = mock.calls.ListUserSecrets
+	mock.lockListUserSecrets.RUnlock()
+	return calls
+}
+
 // ServerURL calls ServerURLFunc.
 func (mock *apiClientMock) ServerURL() string {
 	if mock.ServerURLFunc == nil {
@@ -296,17 +296,23 @@ func (a *App) parsePortVisibilities(args []string) ([]portVisibility, error) {
 // NewPortsForwardCmd returns a Cobra "ports forward" subcommand, which forwards a set of
 // port pairs from the codespace to localhost.
 func newPortsForwardCmd(app *App, selector *CodespaceSelector) *cobra.Command {
-	return &cobra.Command{
+	var printStats bool
+
+	forwardCmd := &cobra.Command{
 		Use:   "forward <remote-port>:<local-port>...",
 		Short: "Forward ports",
 		Args:  cobra.MinimumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return app.ForwardPorts(cmd.Context(), selector, args)
+			return app.ForwardPorts(cmd.Context(), selector, args, printStats)
 		},
 	}
+
+	forwardCmd.Flags().BoolVar(&printStats, "stats", false, "Periodically report tunnel transfer rates to stderr")
+
+	return forwardCmd
 }
 
-func (a *App) ForwardPorts(ctx context.Context, selector *CodespaceSelector, ports []string) (err error) {
+func (a *App) ForwardPorts(ctx context.Context, selector *CodespaceSelector, ports []string, printStats bool) (err error) {
 	portPairs, err := getPortPairs(ports)
 	if err != nil {
 		return fmt.Errorf("get port pairs: %w", err)
@@ -322,9 +328,20 @@ func (a *App) ForwardPorts(ctx context.Context, selector *CodespaceSelector, por
 		return fmt.Errorf("error connecting to codespace: %w", err)
 	}
 
+	var stats *portforwarder.ConnectionStats
+	if printStats {
+		stats = portforwarder.NewConnectionStats()
+		defer func() {
+			fmt.Fprintf(a.io.ErrOut, "Tunnel summary: %s\n", stats.Summary())
+		}()
+	}
+
 	// Run forwarding of all ports concurrently, aborting all of
 	// them at the first failure, including cancellation of the context.
 	group, ctx := errgroup.WithContext(ctx)
+	if stats != nil {
+		go reportStats(ctx, a.io.ErrOut, stats)
+	}
 	for _, pair := range portPairs {
 		pair := pair
 		group.Go(func() error {
@@ -341,8 +358,13 @@ func (a *App) ForwardPorts(ctx context.Context, selector *CodespaceSelector, por
 			}
 			defer safeClose(fwd, &err)
 
+			if stats != nil {
+				fwd.SetStats(stats)
+			}
+
 			opts := portforwarder.ForwardPortOpts{
-				Port: pair.remote,
+				Port:      pair.remote,
+				KeepAlive: a.keepAliveEnabled(),
 			}
 			return fwd.ForwardPortToListener(ctx, opts, listen)
 		})
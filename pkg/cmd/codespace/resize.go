@@ -0,0 +1,157 @@
+package codespace
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/codespaces/api"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// resizePollInterval is how often the codespace is polled while waiting
+	// for a stop, start, or machine change to complete.
+	resizePollInterval = 2 * time.Second
+	resizePollTimeout  = 5 * time.Minute
+)
+
+type resizeOptions struct {
+	selector *CodespaceSelector
+	machine  string
+}
+
+func newResizeCmd(app *App) *cobra.Command {
+	opts := resizeOptions{}
+
+	resizeCmd := &cobra.Command{
+		Use:   "resize",
+		Short: "Resize a codespace to a different machine type",
+		Long: heredoc.Doc(`
+			Resizing changes the machine type backing a codespace.
+
+			A running codespace is stopped before the machine change is applied and
+			started again once the new machine is in place. The target machine type
+			must be available for the codespace's repository; use "gh codespace view"
+			to see the current machine type.
+		`),
+		Args: noArgsConstraint,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.machine == "" {
+				return cmdutil.FlagErrorf("must provide `--machine`")
+			}
+
+			return app.Resize(cmd.Context(), opts)
+		},
+	}
+
+	opts.selector = AddCodespaceSelector(resizeCmd, app.apiClient)
+	resizeCmd.Flags().StringVarP(&opts.machine, "machine", "m", "", "Set hardware specifications for the VM")
+
+	return resizeCmd
+}
+
+// Resize changes the machine type of a codespace, stopping and restarting it as needed.
+func (a *App) Resize(ctx context.Context, opts resizeOptions) error {
+	codespace, err := opts.selector.Select(ctx)
+	if err != nil {
+		return err
+	}
+
+	if codespace.Machine.Name == opts.machine {
+		fmt.Fprintf(a.io.Out, "%s is already using machine type %s\n", codespace.Name, opts.machine)
+		return nil
+	}
+
+	var machines []*api.Machine
+	err = a.RunWithProgress("Fetching machine types", func() (err error) {
+		machines, err = a.apiClient.GetCodespacesMachines(ctx, codespace.Repository.ID, codespace.GitStatus.Ref, codespace.Location, codespace.DevContainerPath)
+		return
+	})
+	if err != nil {
+		return fmt.Errorf("error getting machine types: %w", err)
+	}
+
+	available := make([]string, 0, len(machines))
+	found := false
+	for _, machine := range machines {
+		available = append(available, machine.Name)
+		if machine.Name == opts.machine {
+			found = true
+		}
+	}
+	if !found {
+		return fmt.Errorf("machine type %q is not available for this codespace; available machine types: %s", opts.machine, strings.Join(available, ", "))
+	}
+
+	wasRunning := codespace.State == api.CodespaceStateAvailable
+	if wasRunning {
+		err = a.RunWithProgress("Stopping codespace", func() error {
+			if err := a.apiClient.StopCodespace(ctx, codespace.Name, "", ""); err != nil {
+				return err
+			}
+			return a.pollCodespace(ctx, codespace.Name, func(c *api.Codespace) bool {
+				return c.State == api.CodespaceStateShutdown
+			})
+		})
+		if err != nil {
+			return fmt.Errorf("error stopping codespace: %w", err)
+		}
+	}
+
+	err = a.RunWithProgress("Changing machine type", func() error {
+		if _, err := a.apiClient.EditCodespace(ctx, codespace.Name, &api.EditCodespaceParams{Machine: opts.machine}); err != nil {
+			return err
+		}
+		return a.pollCodespace(ctx, codespace.Name, func(c *api.Codespace) bool {
+			return c.Machine.Name == opts.machine
+		})
+	})
+	if err != nil {
+		return fmt.Errorf("error changing machine type: %w", err)
+	}
+
+	if wasRunning {
+		err = a.RunWithProgress("Starting codespace", func() error {
+			if err := a.apiClient.StartCodespace(ctx, codespace.Name); err != nil {
+				return err
+			}
+			return a.pollCodespace(ctx, codespace.Name, func(c *api.Codespace) bool {
+				return c.State == api.CodespaceStateAvailable
+			})
+		})
+		if err != nil {
+			return fmt.Errorf("error starting codespace: %w", err)
+		}
+	}
+
+	fmt.Fprintf(a.io.Out, "Resized %s to machine type %s\n", codespace.Name, opts.machine)
+	return nil
+}
+
+// pollCodespace fetches the codespace until done reports that it has reached
+// the desired state.
+func (a *App) pollCodespace(ctx context.Context, name string, done func(*api.Codespace) bool) error {
+	deadline := time.Now().Add(resizePollTimeout)
+	for {
+		codespace, err := a.apiClient.GetCodespace(ctx, name, false)
+		if err != nil {
+			return err
+		}
+		if done(codespace) {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("timed out waiting for codespace %s", name)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(resizePollInterval):
+		}
+	}
+}
@@ -0,0 +1,142 @@
+package codespace
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/codespaces/api"
+	"github.com/cli/cli/v2/pkg/iostreams"
+)
+
+func TestResize(t *testing.T) {
+	machines := []*api.Machine{
+		{Name: "basicLinux32gb"},
+		{Name: "premiumLinux"},
+	}
+
+	tests := []struct {
+		name        string
+		machine     string
+		state       string
+		cliArgs     []string // alternative to opts; will test command dispatcher
+		wantStdout  string
+		wantErr     bool
+		errMsg      string
+		wantStopped bool
+		wantStarted bool
+		wantEdited  bool
+	}{
+		{
+			name:       "resize stopped codespace",
+			machine:    "premiumLinux",
+			state:      api.CodespaceStateShutdown,
+			wantStdout: "Resized hubot to machine type premiumLinux\n",
+			wantEdited: true,
+		},
+		{
+			name:        "resize running codespace stops and restarts",
+			machine:     "premiumLinux",
+			state:       api.CodespaceStateAvailable,
+			wantStdout:  "Resized hubot to machine type premiumLinux\n",
+			wantStopped: true,
+			wantStarted: true,
+			wantEdited:  true,
+		},
+		{
+			name:       "already using machine type",
+			machine:    "basicLinux32gb",
+			state:      api.CodespaceStateShutdown,
+			wantStdout: "hubot is already using machine type basicLinux32gb\n",
+		},
+		{
+			name:    "unavailable machine type",
+			machine: "xxlLinux",
+			state:   api.CodespaceStateShutdown,
+			wantErr: true,
+			errMsg:  `machine type "xxlLinux" is not available for this codespace; available machine types: basicLinux32gb, premiumLinux`,
+		},
+		{
+			name:    "no machine flag",
+			cliArgs: []string{"--codespace", "hubot"},
+			wantErr: true,
+			errMsg:  "must provide `--machine`",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			codespace := &api.Codespace{
+				Name:    "hubot",
+				State:   tt.state,
+				Machine: api.CodespaceMachine{Name: "basicLinux32gb"},
+			}
+
+			var stopped, started, edited bool
+			apiMock := &apiClientMock{
+				GetCodespaceFunc: func(_ context.Context, name string, _ bool) (*api.Codespace, error) {
+					return codespace, nil
+				},
+				GetCodespacesMachinesFunc: func(_ context.Context, _ int, _, _, _ string) ([]*api.Machine, error) {
+					return machines, nil
+				},
+				StopCodespaceFunc: func(_ context.Context, _ string, _, _ string) error {
+					stopped = true
+					codespace.State = api.CodespaceStateShutdown
+					return nil
+				},
+				EditCodespaceFunc: func(_ context.Context, _ string, params *api.EditCodespaceParams) (*api.Codespace, error) {
+					edited = true
+					codespace.Machine.Name = params.Machine
+					return codespace, nil
+				},
+				StartCodespaceFunc: func(_ context.Context, _ string) error {
+					started = true
+					codespace.State = api.CodespaceStateAvailable
+					return nil
+				},
+			}
+
+			ios, _, stdout, _ := iostreams.Test()
+			a := NewApp(ios, nil, apiMock, nil, nil, nil)
+
+			var err error
+			if tt.cliArgs == nil {
+				opts := resizeOptions{
+					selector: &CodespaceSelector{api: apiMock, codespaceName: "hubot"},
+					machine:  tt.machine,
+				}
+				err = a.Resize(context.Background(), opts)
+			} else {
+				cmd := newResizeCmd(a)
+				cmd.SilenceUsage = true
+				cmd.SilenceErrors = true
+				cmd.SetOut(ios.ErrOut)
+				cmd.SetErr(ios.ErrOut)
+				cmd.SetArgs(tt.cliArgs)
+				_, err = cmd.ExecuteC()
+			}
+
+			if tt.wantErr {
+				if err == nil {
+					t.Error("Resize() expected error, got nil")
+				} else if err.Error() != tt.errMsg {
+					t.Errorf("Resize() error = %q, want %q", err, tt.errMsg)
+				}
+			} else if err != nil {
+				t.Errorf("Resize() expected no error, got %v", err)
+			}
+
+			if out := stdout.String(); out != tt.wantStdout {
+				t.Errorf("stdout = %q, want %q", out, tt.wantStdout)
+			}
+			if stopped != tt.wantStopped {
+				t.Errorf("StopCodespace called = %v, want %v", stopped, tt.wantStopped)
+			}
+			if started != tt.wantStarted {
+				t.Errorf("StartCodespace called = %v, want %v", started, tt.wantStarted)
+			}
+			if edited != tt.wantEdited {
+				t.Errorf("EditCodespace called = %v, want %v", edited, tt.wantEdited)
+			}
+		})
+	}
+}
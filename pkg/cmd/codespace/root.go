@@ -21,11 +21,14 @@ func NewCmdCodespace(f *cmdutil.Factory) *cobra.Command {
 		codespacesAPI.New(f),
 		f.Browser,
 		f.Remotes,
+		f.Config,
 	)
 
 	root.AddCommand(newCodeCmd(app))
+	root.AddCommand(newCloneCmd(app))
 	root.AddCommand(newCreateCmd(app))
 	root.AddCommand(newEditCmd(app))
+	root.AddCommand(newFeaturesCmd(app))
 	root.AddCommand(newDeleteCmd(app))
 	root.AddCommand(newJupyterCmd(app))
 	root.AddCommand(newListCmd(app))
@@ -34,9 +37,13 @@ func NewCmdCodespace(f *cmdutil.Factory) *cobra.Command {
 	root.AddCommand(newPortsCmd(app))
 	root.AddCommand(newSSHCmd(app))
 	root.AddCommand(newCpCmd(app))
+	root.AddCommand(newSftpCmd(app))
 	root.AddCommand(newStopCmd(app))
 	root.AddCommand(newSelectCmd(app))
 	root.AddCommand(newRebuildCmd(app))
+	root.AddCommand(newResizeCmd(app))
+	root.AddCommand(newUsageCmd(app))
+	root.AddCommand(newSecretCmd(app))
 
 	return root
 }
@@ -0,0 +1,256 @@
+package codespace
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/codespaces/api"
+	"github.com/cli/cli/v2/internal/tableprinter"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/joho/godotenv"
+	"github.com/spf13/cobra"
+	"golang.org/x/crypto/nacl/box"
+)
+
+func newSecretCmd(app *App) *cobra.Command {
+	secretCmd := &cobra.Command{
+		Use:   "secret <command>",
+		Short: "Manage user-level Codespaces secrets",
+		Long: heredoc.Doc(`
+			Manage secrets that are available to codespaces of the authenticated user,
+			including the repositories each secret is available to.
+		`),
+	}
+
+	secretCmd.AddCommand(newSecretListCmd(app))
+	secretCmd.AddCommand(newSecretSetCmd(app))
+	secretCmd.AddCommand(newSecretDeleteCmd(app))
+
+	return secretCmd
+}
+
+func newSecretListCmd(app *App) *cobra.Command {
+	var exporter cmdutil.Exporter
+
+	listCmd := &cobra.Command{
+		Use:     "list",
+		Short:   "List user-level Codespaces secrets",
+		Aliases: []string{"ls"},
+		Args:    noArgsConstraint,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return app.ListSecrets(cmd.Context(), exporter)
+		},
+	}
+
+	cmdutil.AddJSONFlags(listCmd, &exporter, api.UserSecretFields)
+
+	return listCmd
+}
+
+func (a *App) ListSecrets(ctx context.Context, exporter cmdutil.Exporter) error {
+	var secrets []*api.UserSecret
+	err := a.RunWithProgress("Fetching secrets", func() (err error) {
+		secrets, err = a.apiClient.ListUserSecrets(ctx)
+		return
+	})
+	if err != nil {
+		return fmt.Errorf("error getting secrets: %w", err)
+	}
+
+	if exporter != nil {
+		return exporter.Write(a.io, secrets)
+	}
+
+	if len(secrets) == 0 {
+		return cmdutil.NewNoResultsError("no codespaces secrets found")
+	}
+
+	cs := a.io.ColorScheme()
+	tp := tableprinter.New(a.io, tableprinter.WithHeader("NAME", "VISIBILITY", "UPDATED AT"))
+	now := time.Now()
+	for _, secret := range secrets {
+		tp.AddField(secret.Name)
+		tp.AddField(secret.Visibility)
+		if ut, err := time.Parse(time.RFC3339, secret.UpdatedAt); err == nil {
+			tp.AddTimeField(now, ut, cs.Gray)
+		} else {
+			tp.AddField(secret.UpdatedAt, tableprinter.WithColor(cs.Gray))
+		}
+		tp.EndRow()
+	}
+
+	return tp.Render()
+}
+
+type secretSetOptions struct {
+	name      string
+	body      string
+	envFile   string
+	repoNames []string
+}
+
+func newSecretSetCmd(app *App) *cobra.Command {
+	opts := &secretSetOptions{}
+
+	setCmd := &cobra.Command{
+		Use:   "set [<secret-name>]",
+		Short: "Create or update a user-level Codespaces secret",
+		Long: heredoc.Doc(`
+			Create or update a secret available to codespaces of the authenticated user.
+
+			The secret value is read from the --body flag, or from standard input when
+			the flag is omitted. Multiple secrets can be imported from a ".env" file in
+			one invocation with --env-file.
+
+			Secret values are locally encrypted before being sent to GitHub.
+		`),
+		Example: heredoc.Doc(`
+			$ gh codespace secret set MYSECRET --body "$ENV_VALUE"
+			$ gh codespace secret set MYSECRET --repos octo-org/api,octo-org/web < myfile.txt
+			$ gh codespace secret set --env-file .env
+		`),
+		Args: cobra.MaximumNArgs(1),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				opts.name = args[0]
+			}
+			if opts.envFile == "" && opts.name == "" {
+				return cmdutil.FlagErrorf("a secret name is required when --env-file is not used")
+			}
+			if opts.envFile != "" && opts.name != "" {
+				return cmdutil.FlagErrorf("a secret name cannot be combined with --env-file")
+			}
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return app.SetSecret(cmd.Context(), opts)
+		},
+	}
+
+	setCmd.Flags().StringVarP(&opts.body, "body", "b", "", "The value for the secret (reads from standard input if not specified)")
+	setCmd.Flags().StringVarP(&opts.envFile, "env-file", "f", "", "Load secret names and values from a dotenv-formatted `file`")
+	setCmd.Flags().StringSliceVarP(&opts.repoNames, "repos", "r", nil, "List of `repositories` the secret is available to: user/repo")
+
+	return setCmd
+}
+
+func (a *App) SetSecret(ctx context.Context, opts *secretSetOptions) error {
+	secrets := map[string]string{}
+	if opts.envFile != "" {
+		var err error
+		secrets, err = godotenv.Read(opts.envFile)
+		if err != nil {
+			return fmt.Errorf("error reading env file %q: %w", opts.envFile, err)
+		}
+		if len(secrets) == 0 {
+			return fmt.Errorf("no secrets found in %q", opts.envFile)
+		}
+	} else {
+		body := opts.body
+		if body == "" {
+			b, err := io.ReadAll(a.io.In)
+			if err != nil {
+				return fmt.Errorf("error reading secret value from standard input: %w", err)
+			}
+			body = strings.TrimRight(string(b), "\r\n")
+		}
+		secrets[opts.name] = body
+	}
+
+	var repositoryIDs []int
+	for _, nwo := range opts.repoNames {
+		repo, err := a.apiClient.GetRepository(ctx, nwo)
+		if err != nil {
+			return fmt.Errorf("error resolving repository %q: %w", nwo, err)
+		}
+		repositoryIDs = append(repositoryIDs, repo.ID)
+	}
+
+	publicKey, err := a.apiClient.GetUserSecretPublicKey(ctx)
+	if err != nil {
+		return fmt.Errorf("error getting public key: %w", err)
+	}
+
+	names := make([]string, 0, len(secrets))
+	for name := range secrets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		encryptedValue, err := sealSecret(publicKey.Key, secrets[name])
+		if err != nil {
+			return fmt.Errorf("error encrypting secret %q: %w", name, err)
+		}
+
+		err = a.RunWithProgress(fmt.Sprintf("Setting secret %s", name), func() error {
+			return a.apiClient.CreateUserSecret(ctx, name, api.CreateUserSecretParams{
+				EncryptedValue:        encryptedValue,
+				KeyID:                 publicKey.KeyID,
+				SelectedRepositoryIDs: repositoryIDs,
+			})
+		})
+		if err != nil {
+			return fmt.Errorf("error setting secret %q: %w", name, err)
+		}
+
+		cs := a.io.ColorScheme()
+		fmt.Fprintf(a.io.ErrOut, "%s Set Codespaces secret %s for your user\n", cs.SuccessIcon(), cs.Bold(name))
+	}
+
+	return nil
+}
+
+// sealSecret encrypts a secret value with the base64-encoded public key of
+// the sodium sealed box it is uploaded into.
+func sealSecret(publicKey, value string) (string, error) {
+	decodedKey, err := base64.StdEncoding.DecodeString(publicKey)
+	if err != nil {
+		return "", fmt.Errorf("failed to decode public key: %w", err)
+	}
+	if len(decodedKey) != 32 {
+		return "", fmt.Errorf("unexpected public key length %d", len(decodedKey))
+	}
+
+	var peersPubKey [32]byte
+	copy(peersPubKey[:], decodedKey[0:32])
+	encrypted, err := box.SealAnonymous(nil, []byte(value), &peersPubKey, rand.Reader)
+	if err != nil {
+		return "", fmt.Errorf("failed to encrypt body: %w", err)
+	}
+
+	return base64.StdEncoding.EncodeToString(encrypted), nil
+}
+
+func newSecretDeleteCmd(app *App) *cobra.Command {
+	deleteCmd := &cobra.Command{
+		Use:   "delete <secret-name>",
+		Short: "Delete a user-level Codespaces secret",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return app.DeleteSecret(cmd.Context(), args[0])
+		},
+	}
+
+	return deleteCmd
+}
+
+func (a *App) DeleteSecret(ctx context.Context, name string) error {
+	err := a.RunWithProgress("Deleting secret", func() error {
+		return a.apiClient.DeleteUserSecret(ctx, name)
+	})
+	if err != nil {
+		return fmt.Errorf("error deleting secret %q: %w", name, err)
+	}
+
+	cs := a.io.ColorScheme()
+	fmt.Fprintf(a.io.ErrOut, "%s Deleted Codespaces secret %s from your user\n", cs.SuccessIcon(), cs.Bold(name))
+	return nil
+}
@@ -0,0 +1,132 @@
+package codespace
+
+import (
+	"context"
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/codespaces/api"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+)
+
+// testPublicKey is a random 32-byte key, base64-encoded.
+var testPublicKey = base64.StdEncoding.EncodeToString(make([]byte, 32))
+
+func TestApp_SetSecret(t *testing.T) {
+	envFile := filepath.Join(t.TempDir(), "test.env")
+	err := os.WriteFile(envFile, []byte("API_TOKEN=one\nDB_PASSWORD=two\n"), 0600)
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		opts      *secretSetOptions
+		wantNames []string
+		wantRepos []int
+		wantErr   string
+	}{
+		{
+			name:      "set single secret from body",
+			opts:      &secretSetOptions{name: "MYSECRET", body: "s3cret"},
+			wantNames: []string{"MYSECRET"},
+		},
+		{
+			name:      "set secret with repository access list",
+			opts:      &secretSetOptions{name: "MYSECRET", body: "s3cret", repoNames: []string{"octo-org/api"}},
+			wantNames: []string{"MYSECRET"},
+			wantRepos: []int{1234},
+		},
+		{
+			name:      "bulk import from env file",
+			opts:      &secretSetOptions{envFile: envFile},
+			wantNames: []string{"API_TOKEN", "DB_PASSWORD"},
+		},
+		{
+			name:    "missing env file",
+			opts:    &secretSetOptions{envFile: filepath.Join(t.TempDir(), "absent.env")},
+			wantErr: "error reading env file",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotNames []string
+			var gotRepos []int
+			apiMock := &apiClientMock{
+				GetRepositoryFunc: func(ctx context.Context, nwo string) (*api.Repository, error) {
+					return &api.Repository{ID: 1234, FullName: nwo}, nil
+				},
+				GetUserSecretPublicKeyFunc: func(ctx context.Context) (*api.UserSecretPublicKey, error) {
+					return &api.UserSecretPublicKey{KeyID: "key-1", Key: testPublicKey}, nil
+				},
+				CreateUserSecretFunc: func(ctx context.Context, name string, params api.CreateUserSecretParams) error {
+					assert.Equal(t, "key-1", params.KeyID)
+					assert.NotEmpty(t, params.EncryptedValue)
+					gotNames = append(gotNames, name)
+					gotRepos = params.SelectedRepositoryIDs
+					return nil
+				},
+			}
+
+			ios, _, _, _ := iostreams.Test()
+			a := &App{
+				io:        ios,
+				apiClient: apiMock,
+			}
+
+			err := a.SetSecret(context.Background(), tt.opts)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantNames, gotNames)
+			assert.Equal(t, tt.wantRepos, gotRepos)
+		})
+	}
+}
+
+func TestApp_ListSecrets(t *testing.T) {
+	apiMock := &apiClientMock{
+		ListUserSecretsFunc: func(ctx context.Context) ([]*api.UserSecret, error) {
+			return []*api.UserSecret{
+				{Name: "API_TOKEN", Visibility: "all", UpdatedAt: "2023-08-01T12:00:00Z"},
+				{Name: "DB_PASSWORD", Visibility: "selected", UpdatedAt: "2023-08-02T12:00:00Z"},
+			}, nil
+		},
+	}
+
+	ios, _, stdout, _ := iostreams.Test()
+	a := &App{
+		io:        ios,
+		apiClient: apiMock,
+	}
+
+	err := a.ListSecrets(context.Background(), nil)
+	assert.NoError(t, err)
+	assert.Contains(t, stdout.String(), "API_TOKEN")
+	assert.Contains(t, stdout.String(), "DB_PASSWORD")
+}
+
+func TestApp_DeleteSecret(t *testing.T) {
+	var deleted string
+	apiMock := &apiClientMock{
+		DeleteUserSecretFunc: func(ctx context.Context, name string) error {
+			deleted = name
+			return nil
+		},
+	}
+
+	ios, _, _, stderr := iostreams.Test()
+	a := &App{
+		io:        ios,
+		apiClient: apiMock,
+	}
+
+	err := a.DeleteSecret(context.Background(), "API_TOKEN")
+	assert.NoError(t, err)
+	assert.Equal(t, "API_TOKEN", deleted)
+	assert.Contains(t, stderr.String(), "Deleted Codespaces secret API_TOKEN")
+}
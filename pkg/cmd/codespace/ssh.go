@@ -3,7 +3,10 @@ package codespace
 // This file defines the 'gh cs ssh' and 'gh cs cp' subcommands.
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"io"
@@ -11,9 +14,11 @@ import (
 	"os/exec"
 	"path"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"sync"
 	"text/template"
+	"time"
 
 	"github.com/MakeNowJust/heredoc"
 	"github.com/cli/cli/v2/internal/codespaces"
@@ -43,7 +48,17 @@ type sshOptions struct {
 	debugFile        string
 	stdio            bool
 	config           bool
+	waitTimeout      time.Duration
+	reconnectTries   int
+	printStats       bool
+	forwardAgent     bool
+	noForwardAgent   bool
 	scpArgs          []string // scp arguments, for 'cs cp' (nil for 'cs ssh')
+	sftpArgs         []string // sftp arguments, for 'cs sftp' (nil for 'cs ssh')
+
+	// afterTransfer, if set, runs after a successful scp transfer while the
+	// tunnel to the codespace is still open.
+	afterTransfer func(ctx context.Context, port int, destination string) error
 }
 
 func newSSHCmd(app *App) *cobra.Command {
@@ -114,6 +129,15 @@ func newSSHCmd(app *App) *cobra.Command {
 					return errors.New("cannot use `--config` with `--server-port`")
 				}
 			}
+			if opts.waitTimeout <= 0 {
+				return errors.New("`--wait-timeout` must be a positive duration")
+			}
+			if opts.reconnectTries < 0 {
+				return errors.New("`--reconnect-attempts` must not be negative")
+			}
+			if opts.forwardAgent && opts.noForwardAgent {
+				return errors.New("cannot use `--forward-agent` with `--no-forward-agent`")
+			}
 			return nil
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -136,6 +160,11 @@ func newSSHCmd(app *App) *cobra.Command {
 	sshCmd.Flags().StringVarP(&opts.debugFile, "debug-file", "", "", "Path of the file log to")
 	sshCmd.Flags().BoolVarP(&opts.config, "config", "", false, "Write OpenSSH configuration to stdout")
 	sshCmd.Flags().BoolVar(&opts.stdio, "stdio", false, "Proxy sshd connection to stdio")
+	sshCmd.Flags().DurationVar(&opts.waitTimeout, "wait-timeout", codespaces.DefaultConnectionTimeout, "How long to wait for the codespace to become connectable, e.g. \"90s\", \"10m\"")
+	sshCmd.Flags().IntVar(&opts.reconnectTries, "reconnect-attempts", 3, "How many times to try reconnecting the tunnel after a network blip (0 to disable)")
+	sshCmd.Flags().BoolVar(&opts.printStats, "stats", false, "Periodically report tunnel transfer rates to stderr")
+	sshCmd.Flags().BoolVarP(&opts.forwardAgent, "forward-agent", "A", false, "Forward the local ssh-agent to the codespace")
+	sshCmd.Flags().BoolVar(&opts.noForwardAgent, "no-forward-agent", false, "Disable ssh-agent forwarding, overriding ssh configuration")
 	if err := sshCmd.Flags().MarkHidden("stdio"); err != nil {
 		fmt.Fprintf(os.Stderr, "%v\n", err)
 	}
@@ -143,6 +172,21 @@ func newSSHCmd(app *App) *cobra.Command {
 	return sshCmd
 }
 
+// reportStats periodically writes the current tunnel transfer rates to w
+// until the context is cancelled.
+func reportStats(ctx context.Context, w io.Writer, stats *portforwarder.ConnectionStats) {
+	ticker := time.NewTicker(10 * time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			fmt.Fprintf(w, "Tunnel stats: %s\n", stats.Summary())
+		}
+	}
+}
+
 type combinedReadWriteHalfCloser struct {
 	io.ReadCloser
 	io.WriteCloser
@@ -170,6 +214,15 @@ func (a *App) SSH(ctx context.Context, sshArgs []string, opts sshOptions) (err e
 	args := sshArgs
 	if opts.scpArgs != nil {
 		args = opts.scpArgs
+	} else if opts.sftpArgs != nil {
+		args = opts.sftpArgs
+	} else if opts.forwardAgent {
+		// The sshd in the codespace relays the local agent socket over the
+		// ssh connection itself, so git operations inside the codespace can
+		// use local keys.
+		args = append([]string{"-A"}, args...)
+	} else if opts.noForwardAgent {
+		args = append([]string{"-o", "ForwardAgent=no"}, args...)
 	}
 
 	sshContext := ssh.Context{}
@@ -192,17 +245,37 @@ func (a *App) SSH(ctx context.Context, sshArgs []string, opts sshOptions) (err e
 		return err
 	}
 
-	codespaceConnection, err := codespaces.GetCodespaceConnection(ctx, a, a.apiClient, codespace)
+	codespaceConnection, err := codespaces.GetCodespaceConnectionWithTimeout(ctx, a, a.apiClient, codespace, opts.waitTimeout)
 	if err != nil {
+		var timeoutErr *codespaces.TimeoutError
+		if errors.As(err, &timeoutErr) {
+			return fmt.Errorf("%w\nTo retry, run: gh codespace ssh --codespace %s", err, timeoutErr.CodespaceName)
+		}
 		return fmt.Errorf("error connecting to codespace: %w", err)
 	}
 
-	fwd, err := portforwarder.NewPortForwarder(ctx, codespaceConnection)
+	var fwd portforwarder.PortForwarder
+	if opts.reconnectTries > 0 {
+		fwd, err = portforwarder.NewPortForwarderWithReconnect(ctx, codespaceConnection, opts.reconnectTries, func(attempt int, _ error) {
+			fmt.Fprintf(a.io.ErrOut, "Connection lost; reconnecting (attempt %d/%d)\n", attempt, opts.reconnectTries)
+		})
+	} else {
+		fwd, err = portforwarder.NewPortForwarder(ctx, codespaceConnection)
+	}
 	if err != nil {
 		return fmt.Errorf("failed to create port forwarder: %w", err)
 	}
 	defer safeClose(fwd, &err)
 
+	if opts.printStats {
+		stats := portforwarder.NewConnectionStats()
+		fwd.SetStats(stats)
+		defer func() {
+			fmt.Fprintf(a.io.ErrOut, "Tunnel summary: %s\n", stats.Summary())
+		}()
+		go reportStats(ctx, a.io.ErrOut, stats)
+	}
+
 	var (
 		invoker             rpc.Invoker
 		remoteSSHServerPort int
@@ -224,12 +297,14 @@ func (a *App) SSH(ctx context.Context, sshArgs []string, opts sshOptions) (err e
 		return fmt.Errorf("error getting ssh server details: %w", err)
 	}
 
+	keepAlive := a.keepAliveEnabled()
+
 	if opts.stdio {
 		stdio := &combinedReadWriteHalfCloser{os.Stdin, os.Stdout}
 		opts := portforwarder.ForwardPortOpts{
 			Port:      remoteSSHServerPort,
 			Internal:  true,
-			KeepAlive: true,
+			KeepAlive: keepAlive,
 		}
 
 		// Forward the port
@@ -268,7 +343,7 @@ func (a *App) SSH(ctx context.Context, sshArgs []string, opts sshOptions) (err e
 		opts := portforwarder.ForwardPortOpts{
 			Port:      remoteSSHServerPort,
 			Internal:  true,
-			KeepAlive: true,
+			KeepAlive: keepAlive,
 		}
 		tunnelClosed <- fwd.ForwardPortToListener(ctx, opts, listen)
 	}()
@@ -277,7 +352,13 @@ func (a *App) SSH(ctx context.Context, sshArgs []string, opts sshOptions) (err e
 	go func() {
 		if opts.scpArgs != nil {
 			// args is the correct variable to use here, we just use scpArgs as the check for which command to run
-			shellClosed <- codespaces.Copy(ctx, args, localSSHServerPort, connectDestination)
+			err := codespaces.Copy(ctx, args, localSSHServerPort, connectDestination)
+			if err == nil && opts.afterTransfer != nil {
+				err = opts.afterTransfer(ctx, localSSHServerPort, connectDestination)
+			}
+			shellClosed <- err
+		} else if opts.sftpArgs != nil {
+			shellClosed <- codespaces.Transfer(ctx, args, localSSHServerPort, connectDestination)
 		} else {
 			// Parse the ssh args to determine if the user specified a command
 			args, command, err := codespaces.ParseSSHArgs(args)
@@ -289,8 +370,9 @@ func (a *App) SSH(ctx context.Context, sshArgs []string, opts sshOptions) (err e
 			// If the user specified a command, we need to keep the shell alive
 			// since it will be non-interactive and the codespace might shut down
 			// before the command finishes
-			if command != nil {
+			if command != nil && keepAlive {
 				invoker.KeepAlive()
+				fmt.Fprintln(a.io.ErrOut, "Sending keepalive signals so the codespace does not idle-timeout while the command runs")
 			}
 
 			shellClosed <- codespaces.Shell(
@@ -704,8 +786,10 @@ func automaticPrivateKeyPath(sshContext ssh.Context) (string, error) {
 
 type cpOptions struct {
 	sshOptions
-	recursive bool // -r
-	expand    bool // -e
+	recursive bool   // -r
+	expand    bool   // -e
+	limitRate string // --limit-rate
+	checksum  bool   // --checksum
 }
 
 func newCpCmd(app *App) *cobra.Command {
@@ -733,8 +817,18 @@ func newCpCmd(app *App) *cobra.Command {
 			environment variables, and backticks. For security, do not use this flag with arguments
 			provided by untrusted users; see <https://lwn.net/Articles/835962/> for discussion.
 			
-			By default, the %[1]scp%[1]s command will create a public/private ssh key pair to authenticate with 
+			By default, the %[1]scp%[1]s command will create a public/private ssh key pair to authenticate with
 			the codespace inside the %[1]s~/.ssh directory%[1]s.
+
+			On a terminal, %[1]sscp%[1]s reports the progress of each file as it is transferred, and a
+			summary of the whole transfer is printed once it completes. The %[1]s--limit-rate%[1]s flag
+			caps the transfer bandwidth; the rate is given in bytes per second with an optional
+			%[1]sK%[1]s, %[1]sM%[1]s or %[1]sG%[1]s suffix.
+
+			With the %[1]s--checksum%[1]s flag, the SHA-256 checksum of every copied file is compared
+			between the local and remote file systems after the transfer, and the command fails
+			if any of them differ. Checksums cannot be verified for directories or expanded
+			remote patterns, so this flag cannot be combined with %[1]s--recursive%[1]s or %[1]s--expand%[1]s.
 		`, "`"),
 		Example: heredoc.Doc(`
 			$ gh codespace cp -e README.md 'remote:/workspaces/$RepositoryName/'
@@ -751,11 +845,53 @@ func newCpCmd(app *App) *cobra.Command {
 	// We don't expose all sshOptions.
 	cpCmd.Flags().BoolVarP(&opts.recursive, "recursive", "r", false, "Recursively copy directories")
 	cpCmd.Flags().BoolVarP(&opts.expand, "expand", "e", false, "Expand remote file names on remote shell")
+	cpCmd.Flags().StringVar(&opts.limitRate, "limit-rate", "", "Limit the transfer `rate`, in bytes per second with an optional K, M or G suffix")
+	cpCmd.Flags().BoolVar(&opts.checksum, "checksum", false, "Verify SHA-256 checksums of the copied files after the transfer")
 	opts.selector = AddCodespaceSelector(cpCmd, app.apiClient)
 	cpCmd.Flags().StringVarP(&opts.profile, "profile", "p", "", "Name of the SSH profile to use")
 	return cpCmd
 }
 
+func newSftpCmd(app *App) *cobra.Command {
+	var opts sshOptions
+
+	sftpCmd := &cobra.Command{
+		Use:   "sftp [-- [<sftp flags>...]]",
+		Short: "Open an interactive SFTP session to a codespace",
+		Long: heredoc.Docf(`
+			The %[1]ssftp%[1]s command opens an interactive SFTP session against the remote file
+			system of a codespace, using the same tunnel and key selection as %[1]sgh cs ssh%[1]s.
+
+			The SSH server in the codespace advertises the sftp subsystem, so other SFTP
+			clients (such as FileZilla or rsync-over-sftp) can connect through a tunnel
+			opened with %[1]sgh cs ssh --server-port%[1]s.
+		`, "`"),
+		Example: heredoc.Doc(`
+			$ gh codespace sftp
+			$ gh codespace sftp -- -b batchfile.txt
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return app.SFTP(cmd.Context(), args, opts)
+		},
+		DisableFlagsInUseLine: true,
+	}
+
+	// We don't expose all sshOptions.
+	opts.selector = AddCodespaceSelector(sftpCmd, app.apiClient)
+	sftpCmd.Flags().StringVarP(&opts.profile, "profile", "p", "", "Name of the SSH profile to use")
+	return sftpCmd
+}
+
+// SFTP opens an interactive sftp session in a codespace.
+// The mechanics are similar to 'ssh' but using 'sftp'.
+func (a *App) SFTP(ctx context.Context, args []string, opts sshOptions) error {
+	opts.sftpArgs = args
+	if opts.sftpArgs == nil {
+		opts.sftpArgs = []string{}
+	}
+	return a.SSH(ctx, nil, opts)
+}
+
 // Copy copies files between the local and remote file systems.
 // The mechanics are similar to 'ssh' but using 'scp'.
 func (a *App) Copy(ctx context.Context, args []string, opts cpOptions) error {
@@ -765,6 +901,21 @@ func (a *App) Copy(ctx context.Context, args []string, opts cpOptions) error {
 	if opts.recursive {
 		opts.scpArgs = append(opts.scpArgs, "-r")
 	}
+	if opts.limitRate != "" {
+		kbits, err := parseLimitRate(opts.limitRate)
+		if err != nil {
+			return cmdutil.FlagErrorf("invalid `--limit-rate` value %q: %v", opts.limitRate, err)
+		}
+		opts.scpArgs = append(opts.scpArgs, "-l", strconv.Itoa(kbits))
+	}
+	if opts.checksum {
+		if opts.recursive {
+			return cmdutil.FlagErrorf("cannot use `--checksum` with `--recursive`")
+		}
+		if opts.expand {
+			return cmdutil.FlagErrorf("cannot use `--checksum` with `--expand`")
+		}
+	}
 
 	hasRemote := false
 	for _, arg := range args {
@@ -795,5 +946,193 @@ func (a *App) Copy(ctx context.Context, args []string, opts cpOptions) error {
 	if !hasRemote {
 		return cmdutil.FlagErrorf("at least one argument must have a 'remote:' prefix")
 	}
-	return a.SSH(ctx, nil, opts.sshOptions)
+
+	if opts.checksum {
+		pairs, err := checksumPairs(args)
+		if err != nil {
+			return err
+		}
+		opts.afterTransfer = func(ctx context.Context, port int, destination string) error {
+			return a.verifyChecksums(ctx, pairs, port, destination)
+		}
+	}
+
+	start := time.Now()
+	if err := a.SSH(ctx, nil, opts.sshOptions); err != nil {
+		return err
+	}
+
+	if a.io.IsStdoutTTY() {
+		sources := args[:len(args)-1]
+		if size, ok := localSize(sources); ok {
+			fmt.Fprintf(a.io.ErrOut, "Copied %d file(s) (%s) in %s\n", len(sources), humanFileSize(size), time.Since(start).Round(time.Second))
+		} else {
+			fmt.Fprintf(a.io.ErrOut, "Copied %d file(s) in %s\n", len(sources), time.Since(start).Round(time.Second))
+		}
+	}
+	return nil
+}
+
+// parseLimitRate converts a human-readable rate in bytes per second, such as
+// "512K" or "10M", to the whole kilobits per second expected by scp's -l flag.
+func parseLimitRate(rate string) (int, error) {
+	multiplier := int64(1)
+	v := strings.TrimSuffix(strings.ToUpper(rate), "B")
+	switch {
+	case strings.HasSuffix(v, "K"):
+		multiplier = 1024
+		v = strings.TrimSuffix(v, "K")
+	case strings.HasSuffix(v, "M"):
+		multiplier = 1024 * 1024
+		v = strings.TrimSuffix(v, "M")
+	case strings.HasSuffix(v, "G"):
+		multiplier = 1024 * 1024 * 1024
+		v = strings.TrimSuffix(v, "G")
+	}
+
+	n, err := strconv.ParseInt(v, 10, 64)
+	if err != nil || n <= 0 {
+		return 0, errors.New("expected a positive rate such as \"512K\" or \"10M\"")
+	}
+
+	kbits := n * multiplier * 8 / 1000
+	if kbits < 1 {
+		kbits = 1
+	}
+	return int(kbits), nil
+}
+
+// localSize returns the combined size of the named files, or false if any of
+// them is remote or cannot be inspected.
+func localSize(sources []string) (int64, bool) {
+	var total int64
+	for _, src := range sources {
+		if strings.HasPrefix(src, "remote:") {
+			return 0, false
+		}
+		fi, err := os.Stat(src)
+		if err != nil {
+			return 0, false
+		}
+		total += fi.Size()
+	}
+	return total, true
+}
+
+// checksumPair names the same file on the local and remote file systems.
+type checksumPair struct {
+	local  string
+	remote string
+}
+
+// checksumPairs maps each source argument to its copy at the destination.
+// All sources must be on the opposite side of the destination for the
+// mapping to be unambiguous.
+func checksumPairs(args []string) ([]checksumPair, error) {
+	dest := args[len(args)-1]
+	sources := args[:len(args)-1]
+	destPath, destRemote := strings.CutPrefix(dest, "remote:")
+
+	// when copying into a directory, the destination keeps the source's base name
+	destIsDir := len(sources) > 1 || strings.HasSuffix(destPath, "/")
+	if !destRemote && !destIsDir {
+		if fi, err := os.Stat(destPath); err == nil && fi.IsDir() {
+			destIsDir = true
+		}
+	}
+
+	var pairs []checksumPair
+	for _, src := range sources {
+		srcPath, srcRemote := strings.CutPrefix(src, "remote:")
+		if srcRemote == destRemote {
+			return nil, cmdutil.FlagErrorf("`--checksum` requires copying between the local and remote file systems")
+		}
+
+		local, remote := srcPath, destPath
+		if srcRemote {
+			local, remote = destPath, srcPath
+		}
+		if destIsDir {
+			if srcRemote {
+				local = filepath.Join(destPath, path.Base(srcPath))
+			} else {
+				remote = path.Join(destPath, filepath.Base(srcPath))
+			}
+		}
+		pairs = append(pairs, checksumPair{local: local, remote: remote})
+	}
+	return pairs, nil
+}
+
+// verifyChecksums compares the SHA-256 checksum of each file pair, computing
+// the remote checksums over the still-open tunnel to the codespace.
+func (a *App) verifyChecksums(ctx context.Context, pairs []checksumPair, port int, destination string) error {
+	remoteArgs := []string{"sha256sum", "--"}
+	for _, p := range pairs {
+		// disable shell expansion of the remote path, as Copy does
+		remoteArgs = append(remoteArgs, `'`+strings.Replace(p.remote, `'`, `'\''`, -1)+`'`)
+	}
+
+	cmd, err := codespaces.NewRemoteCommand(ctx, port, destination, remoteArgs...)
+	if err != nil {
+		return fmt.Errorf("failed to create remote command: %w", err)
+	}
+	var stdout bytes.Buffer
+	cmd.Stdout = &stdout
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("failed to compute remote checksums: %w", err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(stdout.String()), "\n")
+	if len(lines) != len(pairs) {
+		return fmt.Errorf("expected %d remote checksums, got %d", len(pairs), len(lines))
+	}
+
+	for i, p := range pairs {
+		remoteSum := strings.Fields(lines[i])[0]
+		localSum, err := fileSHA256(p.local)
+		if err != nil {
+			return fmt.Errorf("failed to digest %s: %w", p.local, err)
+		}
+		if !strings.EqualFold(localSum, remoteSum) {
+			return fmt.Errorf("checksum mismatch for %s: local %s, remote %s", p.local, localSum, remoteSum)
+		}
+	}
+
+	if a.io.IsStdoutTTY() {
+		fmt.Fprintf(a.io.ErrOut, "Verified checksums for %d file(s)\n", len(pairs))
+	}
+	return nil
+}
+
+func fileSHA256(name string) (string, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+func humanFileSize(s int64) string {
+	if s < 1024 {
+		return fmt.Sprintf("%d B", s)
+	}
+
+	kb := float64(s) / 1024
+	if kb < 1024 {
+		return fmt.Sprintf("%.2f KiB", kb)
+	}
+
+	mb := kb / 1024
+	if mb < 1024 {
+		return fmt.Sprintf("%.2f MiB", mb)
+	}
+
+	return fmt.Sprintf("%.2f GiB", mb/1024)
 }
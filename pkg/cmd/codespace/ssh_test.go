@@ -6,6 +6,7 @@ import (
 	"os"
 	"path"
 	"path/filepath"
+	"reflect"
 	"strings"
 	"testing"
 
@@ -300,5 +301,100 @@ func testingSSHApp() *App {
 	}
 
 	ios, _, _, _ := iostreams.Test()
-	return NewApp(ios, nil, apiMock, nil, nil)
+	return NewApp(ios, nil, apiMock, nil, nil, nil)
+}
+
+func TestParseLimitRate(t *testing.T) {
+	tests := []struct {
+		rate    string
+		want    int
+		wantErr bool
+	}{
+		{rate: "125000", want: 1000},
+		{rate: "512K", want: 4194},
+		{rate: "512KB", want: 4194},
+		{rate: "10M", want: 83886},
+		{rate: "1G", want: 8589934},
+		{rate: "100", want: 1},
+		{rate: "0", wantErr: true},
+		{rate: "-1M", wantErr: true},
+		{rate: "fast", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.rate, func(t *testing.T) {
+			got, err := parseLimitRate(tt.rate)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("parseLimitRate(%q) expected error, got %d", tt.rate, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseLimitRate(%q) returned error: %v", tt.rate, err)
+			}
+			if got != tt.want {
+				t.Errorf("parseLimitRate(%q) = %d, want %d", tt.rate, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestChecksumPairs(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		want    []checksumPair
+		wantErr string
+	}{
+		{
+			name: "local to remote file",
+			args: []string{"a.txt", "remote:/workspaces/a.txt"},
+			want: []checksumPair{{local: "a.txt", remote: "/workspaces/a.txt"}},
+		},
+		{
+			name: "local to remote directory",
+			args: []string{"a.txt", "remote:/workspaces/"},
+			want: []checksumPair{{local: "a.txt", remote: "/workspaces/a.txt"}},
+		},
+		{
+			name: "multiple sources",
+			args: []string{"a.txt", "b.txt", "remote:/workspaces"},
+			want: []checksumPair{
+				{local: "a.txt", remote: "/workspaces/a.txt"},
+				{local: "b.txt", remote: "/workspaces/b.txt"},
+			},
+		},
+		{
+			name: "remote to local file",
+			args: []string{"remote:/workspaces/a.txt", "b.txt"},
+			want: []checksumPair{{local: "b.txt", remote: "/workspaces/a.txt"}},
+		},
+		{
+			name:    "both local",
+			args:    []string{"a.txt", "b.txt"},
+			wantErr: "`--checksum` requires copying between the local and remote file systems",
+		},
+		{
+			name:    "both remote",
+			args:    []string{"remote:a.txt", "remote:b.txt"},
+			wantErr: "`--checksum` requires copying between the local and remote file systems",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := checksumPairs(tt.args)
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Fatalf("checksumPairs(%v) error = %v, want %q", tt.args, err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("checksumPairs(%v) returned error: %v", tt.args, err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("checksumPairs(%v) = %v, want %v", tt.args, got, tt.want)
+			}
+		})
+	}
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/cli/cli/v2/internal/codespaces/api"
 	"github.com/cli/cli/v2/pkg/cmdutil"
@@ -11,13 +12,21 @@ import (
 )
 
 type stopOptions struct {
-	selector *CodespaceSelector
-	orgName  string
-	userName string
+	selector    *CodespaceSelector
+	orgName     string
+	userName    string
+	after       time.Duration
+	skipConfirm bool
+
+	isInteractive bool
+	prompter      prompter
 }
 
 func newStopCmd(app *App) *cobra.Command {
-	opts := &stopOptions{}
+	opts := &stopOptions{
+		isInteractive: hasTTY,
+		prompter:      &surveyPrompter{},
+	}
 
 	stopCmd := &cobra.Command{
 		Use:   "stop",
@@ -33,6 +42,8 @@ func newStopCmd(app *App) *cobra.Command {
 	opts.selector = AddCodespaceSelector(stopCmd, app.apiClient)
 	stopCmd.Flags().StringVarP(&opts.orgName, "org", "o", "", "The `login` handle of the organization (admin-only)")
 	stopCmd.Flags().StringVarP(&opts.userName, "user", "u", "", "The `username` to stop codespace for (used with --org)")
+	stopCmd.Flags().DurationVar(&opts.after, "after", 0, "Wait this long before stopping the codespace, e.g. \"2h\", \"45m\"")
+	stopCmd.Flags().BoolVarP(&opts.skipConfirm, "force", "f", false, "Skip confirmation when stopping another user's codespace")
 
 	return stopCmd
 }
@@ -97,6 +108,41 @@ func (a *App) StopCodespace(ctx context.Context, opts *stopOptions) error {
 		}
 	}
 
+	// Stopping another member's codespace as an org admin should be
+	// deliberate, so ask first
+	if opts.orgName != "" && !opts.skipConfirm {
+		var currentUser *api.User
+		err := a.RunWithProgress("Fetching current user", func() (err error) {
+			currentUser, err = a.apiClient.GetUser(ctx)
+			return
+		})
+		if err != nil {
+			return fmt.Errorf("failed to get current user: %w", err)
+		}
+
+		if ownerName != currentUser.Login {
+			if !opts.isInteractive {
+				return fmt.Errorf("codespace %s belongs to %s (use --force to stop it without confirmation)", codespaceName, ownerName)
+			}
+			confirmed, err := opts.prompter.Confirm(fmt.Sprintf("Codespace %s belongs to %s. OK to stop?", codespaceName, ownerName))
+			if err != nil {
+				return fmt.Errorf("unable to confirm: %w", err)
+			}
+			if !confirmed {
+				return nil
+			}
+		}
+	}
+
+	if opts.after > 0 {
+		fmt.Fprintf(a.io.ErrOut, "Waiting %s before stopping codespace %q...\n", opts.after, codespaceName)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(opts.after):
+		}
+	}
+
 	err := a.RunWithProgress("Stopping codespace", func() (err error) {
 		err = a.apiClient.StopCodespace(ctx, codespaceName, opts.orgName, ownerName)
 		return
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/cli/cli/v2/internal/codespaces/api"
 	"github.com/cli/cli/v2/pkg/iostreams"
@@ -15,9 +16,10 @@ func TestApp_StopCodespace(t *testing.T) {
 		apiClient apiClient
 	}
 	tests := []struct {
-		name   string
-		fields fields
-		opts   *stopOptions
+		name    string
+		fields  fields
+		opts    *stopOptions
+		wantErr string
 	}{
 		{
 			name: "Stop a codespace I own",
@@ -58,6 +60,9 @@ func TestApp_StopCodespace(t *testing.T) {
 			},
 			fields: fields{
 				apiClient: &apiClientMock{
+					GetUserFunc: func(ctx context.Context) (*api.User, error) {
+						return &api.User{Login: "test-user"}, nil
+					},
 					GetOrgMemberCodespaceFunc: func(ctx context.Context, orgName string, userName string, codespaceName string) (*api.Codespace, error) {
 						if codespaceName != "test-codespace" {
 							return nil, fmt.Errorf("got codespace name %s, wanted %s", codespaceName, "test-codespace")
@@ -89,6 +94,99 @@ func TestApp_StopCodespace(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Stop another member's codespace after confirmation",
+			opts: &stopOptions{
+				selector:      &CodespaceSelector{codespaceName: "test-codespace"},
+				orgName:       "test-org",
+				userName:      "test-user",
+				isInteractive: true,
+				prompter: &prompterMock{
+					ConfirmFunc: func(msg string) (bool, error) {
+						if msg != "Codespace test-codespace belongs to test-user. OK to stop?" {
+							return false, fmt.Errorf("unexpected prompt %q", msg)
+						}
+						return true, nil
+					},
+				},
+			},
+			fields: fields{
+				apiClient: &apiClientMock{
+					GetUserFunc: func(ctx context.Context) (*api.User, error) {
+						return &api.User{Login: "admin-user"}, nil
+					},
+					GetOrgMemberCodespaceFunc: func(ctx context.Context, orgName string, userName string, codespaceName string) (*api.Codespace, error) {
+						return &api.Codespace{
+							State: api.CodespaceStateAvailable,
+						}, nil
+					},
+					StopCodespaceFunc: func(ctx context.Context, codespaceName string, orgName string, userName string) error {
+						return nil
+					},
+				},
+			},
+		},
+		{
+			name: "Stop another member's codespace non-interactively without --force",
+			opts: &stopOptions{
+				selector: &CodespaceSelector{codespaceName: "test-codespace"},
+				orgName:  "test-org",
+				userName: "test-user",
+			},
+			fields: fields{
+				apiClient: &apiClientMock{
+					GetUserFunc: func(ctx context.Context) (*api.User, error) {
+						return &api.User{Login: "admin-user"}, nil
+					},
+					GetOrgMemberCodespaceFunc: func(ctx context.Context, orgName string, userName string, codespaceName string) (*api.Codespace, error) {
+						return &api.Codespace{
+							State: api.CodespaceStateAvailable,
+						}, nil
+					},
+				},
+			},
+			wantErr: "codespace test-codespace belongs to test-user (use --force to stop it without confirmation)",
+		},
+		{
+			name: "Stop another member's codespace with --force",
+			opts: &stopOptions{
+				selector:    &CodespaceSelector{codespaceName: "test-codespace"},
+				orgName:     "test-org",
+				userName:    "test-user",
+				skipConfirm: true,
+			},
+			fields: fields{
+				apiClient: &apiClientMock{
+					GetOrgMemberCodespaceFunc: func(ctx context.Context, orgName string, userName string, codespaceName string) (*api.Codespace, error) {
+						return &api.Codespace{
+							State: api.CodespaceStateAvailable,
+						}, nil
+					},
+					StopCodespaceFunc: func(ctx context.Context, codespaceName string, orgName string, userName string) error {
+						return nil
+					},
+				},
+			},
+		},
+		{
+			name: "Stop a codespace after a delay",
+			opts: &stopOptions{
+				selector: &CodespaceSelector{codespaceName: "test-codespace"},
+				after:    10 * time.Millisecond,
+			},
+			fields: fields{
+				apiClient: &apiClientMock{
+					GetCodespaceFunc: func(ctx context.Context, name string, includeConnection bool) (*api.Codespace, error) {
+						return &api.Codespace{
+							State: api.CodespaceStateAvailable,
+						}, nil
+					},
+					StopCodespaceFunc: func(ctx context.Context, name string, orgName string, userName string) error {
+						return nil
+					},
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -99,6 +197,10 @@ func TestApp_StopCodespace(t *testing.T) {
 				apiClient: tt.fields.apiClient,
 			}
 			err := a.StopCodespace(context.Background(), tt.opts)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
 			assert.NoError(t, err)
 		})
 	}
@@ -0,0 +1,153 @@
+package codespace
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/codespaces/api"
+	"github.com/cli/cli/v2/internal/tableprinter"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+type usageOptions struct {
+	orgName   string
+	startDate string
+	endDate   string
+}
+
+func newUsageCmd(app *App) *cobra.Command {
+	opts := &usageOptions{}
+	var exporter cmdutil.Exporter
+
+	usageCmd := &cobra.Command{
+		Use:   "usage",
+		Short: "Report codespaces compute and storage usage with estimated cost",
+		Long: heredoc.Doc(`
+			Report metered codespaces usage (compute hours, storage, and estimated cost)
+			per machine type over a date range.
+
+			By default usage billed to the authenticated user for the current month is
+			reported. Organization administrators may report usage billed to the
+			organization with the --org flag.
+		`),
+		Args: noArgsConstraint,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			for _, date := range []string{opts.startDate, opts.endDate} {
+				if date == "" {
+					continue
+				}
+				if _, err := time.Parse("2006-01-02", date); err != nil {
+					return cmdutil.FlagErrorf("invalid date %q: expected YYYY-MM-DD", date)
+				}
+			}
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return app.Usage(cmd.Context(), opts, exporter)
+		},
+	}
+
+	usageCmd.Flags().StringVarP(&opts.orgName, "org", "o", "", "The `login` handle of the organization to report usage for (admin-only)")
+	usageCmd.Flags().StringVar(&opts.startDate, "start-date", "", "Beginning of the date range (YYYY-MM-DD), defaults to the first of the current month")
+	usageCmd.Flags().StringVar(&opts.endDate, "end-date", "", "End of the date range (YYYY-MM-DD), defaults to today")
+	cmdutil.AddJSONFlags(usageCmd, &exporter, api.CodespacesUsageFields)
+
+	return usageCmd
+}
+
+func (a *App) Usage(ctx context.Context, opts *usageOptions, exporter cmdutil.Exporter) error {
+	now := time.Now()
+	startDate := opts.startDate
+	if startDate == "" {
+		startDate = time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location()).Format("2006-01-02")
+	}
+	endDate := opts.endDate
+	if endDate == "" {
+		endDate = now.Format("2006-01-02")
+	}
+
+	var userName string
+	if opts.orgName == "" {
+		user, err := a.apiClient.GetUser(ctx)
+		if err != nil {
+			return fmt.Errorf("error getting user: %w", err)
+		}
+		userName = user.Login
+	}
+
+	var items []*api.CodespacesUsageItem
+	err := a.RunWithProgress("Fetching usage", func() (err error) {
+		items, err = a.apiClient.GetCodespacesUsage(ctx, userName, opts.orgName, startDate, endDate)
+		return
+	})
+	if err != nil {
+		return fmt.Errorf("error getting codespaces usage: %w", err)
+	}
+
+	if err := a.io.StartPager(); err != nil {
+		a.errLogger.Printf("error starting pager: %v", err)
+	}
+	defer a.io.StopPager()
+
+	if exporter != nil {
+		return exporter.Write(a.io, items)
+	}
+
+	if len(items) == 0 {
+		return cmdutil.NewNoResultsError(fmt.Sprintf("no codespaces usage between %s and %s", startDate, endDate))
+	}
+
+	type skuTotal struct {
+		sku      string
+		quantity float64
+		unitType string
+		netCost  float64
+	}
+	totalsBySKU := map[string]*skuTotal{}
+	for _, item := range items {
+		total, ok := totalsBySKU[item.SKU]
+		if !ok {
+			total = &skuTotal{sku: item.SKU, unitType: item.UnitType}
+			totalsBySKU[item.SKU] = total
+		}
+		total.quantity += item.Quantity
+		total.netCost += item.NetAmount
+	}
+
+	totals := make([]*skuTotal, 0, len(totalsBySKU))
+	for _, total := range totalsBySKU {
+		totals = append(totals, total)
+	}
+	sort.Slice(totals, func(i, j int) bool {
+		return totals[i].netCost > totals[j].netCost
+	})
+
+	cs := a.io.ColorScheme()
+	if a.io.IsStdoutTTY() {
+		fmt.Fprintf(a.io.Out, "Codespaces usage from %s to %s\n\n", startDate, endDate)
+	}
+
+	tp := tableprinter.New(a.io, tableprinter.WithHeader("SKU", "QUANTITY", "UNIT", "COST"))
+	var grandTotal float64
+	for _, total := range totals {
+		tp.AddField(total.sku)
+		tp.AddField(fmt.Sprintf("%.2f", total.quantity))
+		tp.AddField(total.unitType, tableprinter.WithColor(cs.Gray))
+		tp.AddField(fmt.Sprintf("$%.2f", total.netCost))
+		tp.EndRow()
+		grandTotal += total.netCost
+	}
+	if err := tp.Render(); err != nil {
+		return err
+	}
+
+	if a.io.IsStdoutTTY() {
+		fmt.Fprintf(a.io.Out, "\nEstimated total: %s\n", cs.Bold(fmt.Sprintf("$%.2f", grandTotal)))
+	}
+
+	return nil
+}
@@ -0,0 +1,75 @@
+package codespace
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/codespaces/api"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApp_Usage(t *testing.T) {
+	tests := []struct {
+		name       string
+		opts       *usageOptions
+		usageItems []*api.CodespacesUsageItem
+		wantError  string
+		wantOrg    string
+	}{
+		{
+			name: "user usage",
+			opts: &usageOptions{},
+			usageItems: []*api.CodespacesUsageItem{
+				{Date: "2023-08-01", SKU: "codespaces linux 2 core", Quantity: 10, UnitType: "hours", NetAmount: 1.8},
+				{Date: "2023-08-02", SKU: "codespaces linux 2 core", Quantity: 5, UnitType: "hours", NetAmount: 0.9},
+				{Date: "2023-08-01", SKU: "codespaces storage", Quantity: 0.5, UnitType: "gb-month", NetAmount: 0.04},
+			},
+		},
+		{
+			name:    "org usage",
+			opts:    &usageOptions{orgName: "megacorp"},
+			wantOrg: "megacorp",
+			usageItems: []*api.CodespacesUsageItem{
+				{Date: "2023-08-01", SKU: "codespaces linux 4 core", Quantity: 3, UnitType: "hours", NetAmount: 1.08},
+			},
+		},
+		{
+			name:      "no usage",
+			opts:      &usageOptions{},
+			wantError: "no codespaces usage",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotOrg string
+			apiMock := &apiClientMock{
+				GetUserFunc: func(ctx context.Context) (*api.User, error) {
+					return &api.User{Login: "monalisa"}, nil
+				},
+				GetCodespacesUsageFunc: func(ctx context.Context, userName, orgName, startDate, endDate string) ([]*api.CodespacesUsageItem, error) {
+					gotOrg = orgName
+					return tt.usageItems, nil
+				},
+			}
+
+			ios, _, stdout, _ := iostreams.Test()
+			a := &App{
+				io:        ios,
+				apiClient: apiMock,
+			}
+
+			err := a.Usage(context.Background(), tt.opts, nil)
+			if tt.wantError != "" {
+				assert.ErrorContains(t, err, tt.wantError)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantOrg, gotOrg)
+			for _, item := range tt.usageItems {
+				assert.Contains(t, stdout.String(), item.SKU)
+			}
+		})
+	}
+}
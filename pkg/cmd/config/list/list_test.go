@@ -97,7 +97,10 @@ prompt=disabled
 prefer_editor_prompt=enabled
 pager=less
 http_unix_socket=
+hyperlinks=auto
 browser=brave
+git_optimizations=false
+codespaces_keep_alive=enabled
 `,
 		},
 	}
@@ -15,6 +15,7 @@ import (
 )
 
 const manifestName = "manifest.yml"
+const capabilitiesName = "gh-extension.yml"
 
 type ExtensionKind int
 
@@ -33,11 +34,21 @@ type Extension struct {
 	mu sync.RWMutex
 
 	// These fields get resolved dynamically:
-	url            string
-	isPinned       *bool
-	currentVersion string
-	latestVersion  string
-	owner          string
+	url                   string
+	isPinned              *bool
+	currentVersion        string
+	latestVersion         string
+	owner                 string
+	supportsExporterFlags *bool
+}
+
+// capabilities are optional behaviors an extension declares in a
+// gh-extension.yml file next to its executable.
+type capabilities struct {
+	ExporterFlags bool `yaml:"exporterFlags"`
+	// MinGhVersion is the minimum gh version the extension is compatible
+	// with, e.g. "2.40.0"
+	MinGhVersion string `yaml:"minGhVersion"`
 }
 
 func (e *Extension) Name() string {
@@ -220,6 +231,51 @@ func (e *Extension) UpdateAvailable() bool {
 	return true
 }
 
+func (e *Extension) SupportsExporterFlags() bool {
+	e.mu.RLock()
+	if e.supportsExporterFlags != nil {
+		defer e.mu.RUnlock()
+		return *e.supportsExporterFlags
+	}
+	e.mu.RUnlock()
+
+	var supported bool
+	if caps, err := e.loadCapabilities(); err == nil {
+		supported = caps.ExporterFlags
+	}
+
+	e.mu.Lock()
+	e.supportsExporterFlags = &supported
+	e.mu.Unlock()
+
+	return *e.supportsExporterFlags
+}
+
+// MinGhVersion returns the minimum gh version the extension declares it is
+// compatible with, or the empty string when it declares none
+func (e *Extension) MinGhVersion() string {
+	caps, err := e.loadCapabilities()
+	if err != nil {
+		return ""
+	}
+	return caps.MinGhVersion
+}
+
+func (e *Extension) loadCapabilities() (capabilities, error) {
+	var caps capabilities
+	dir, _ := filepath.Split(e.Path())
+	capabilitiesPath := filepath.Join(dir, capabilitiesName)
+	contents, err := os.ReadFile(capabilitiesPath)
+	if err != nil {
+		return caps, fmt.Errorf("could not open %s for reading: %w", capabilitiesPath, err)
+	}
+	err = yaml.Unmarshal(contents, &caps)
+	if err != nil {
+		return caps, fmt.Errorf("could not parse %s: %w", capabilitiesPath, err)
+	}
+	return caps, nil
+}
+
 func (e *Extension) loadManifest() (binManifest, error) {
 	var bm binManifest
 	dir, _ := filepath.Split(e.Path())
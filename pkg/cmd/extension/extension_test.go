@@ -1,6 +1,7 @@
 package extension
 
 import (
+	"os"
 	"path/filepath"
 	"testing"
 
@@ -102,3 +103,32 @@ func TestOwnerCached(t *testing.T) {
 
 	assert.Equal(t, "cli", e.Owner())
 }
+
+func TestSupportsExporterFlags(t *testing.T) {
+	tempDir := t.TempDir()
+	extName := "gh-json-ext"
+	extDir := filepath.Join(tempDir, "extensions", extName)
+	extPath := filepath.Join(extDir, extName)
+	assert.NoError(t, os.MkdirAll(extDir, 0755))
+	assert.NoError(t, os.WriteFile(filepath.Join(extDir, capabilitiesName), []byte("exporterFlags: true\n"), 0644))
+	e := &Extension{
+		kind: GitKind,
+		path: extPath,
+	}
+
+	assert.True(t, e.SupportsExporterFlags())
+}
+
+func TestSupportsExporterFlags_NoDeclaration(t *testing.T) {
+	tempDir := t.TempDir()
+	extName := "gh-plain-ext"
+	extDir := filepath.Join(tempDir, "extensions", extName)
+	extPath := filepath.Join(extDir, extName)
+	assert.NoError(t, os.MkdirAll(extDir, 0755))
+	e := &Extension{
+		kind: GitKind,
+		path: extPath,
+	}
+
+	assert.False(t, e.SupportsExporterFlags())
+}
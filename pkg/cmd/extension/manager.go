@@ -16,6 +16,7 @@ import (
 
 	"github.com/cli/cli/v2/api"
 	"github.com/cli/cli/v2/git"
+	"github.com/cli/cli/v2/internal/build"
 	"github.com/cli/cli/v2/internal/config"
 	"github.com/cli/cli/v2/internal/gh"
 	"github.com/cli/cli/v2/internal/ghrepo"
@@ -23,6 +24,7 @@ import (
 	"github.com/cli/cli/v2/pkg/findsh"
 	"github.com/cli/cli/v2/pkg/iostreams"
 	"github.com/cli/safeexec"
+	"github.com/hashicorp/go-version"
 	"gopkg.in/yaml.v3"
 )
 
@@ -96,6 +98,10 @@ func (m *Manager) Dispatch(args []string, stdin io.Reader, stdout, stderr io.Wri
 		return false, nil
 	}
 
+	if err := ghVersionSupported(build.Version, ext.MinGhVersion()); err != nil {
+		return true, err
+	}
+
 	var externalCmd *exec.Cmd
 
 	if ext.IsBinary() || runtime.GOOS != "windows" {
@@ -305,6 +311,23 @@ func (m *Manager) installBin(repo ghrepo.Interface, target string) error {
 		}
 	}
 
+	if !m.dryRunMode {
+		for _, a := range r.Assets {
+			if a.Name != capabilitiesName {
+				continue
+			}
+			capsPath := filepath.Join(targetDir, capabilitiesName)
+			if err := downloadAsset(m.client, a, capsPath); err != nil {
+				return fmt.Errorf("failed to download asset %s: %w", a.Name, err)
+			}
+			if err := checkMinGhVersion(targetDir); err != nil {
+				_ = os.RemoveAll(targetDir)
+				return err
+			}
+			break
+		}
+	}
+
 	binPath := filepath.Join(targetDir, name)
 	binPath += ext
 
@@ -370,6 +393,40 @@ func writeManifest(dir, name string, data []byte) (writeErr error) {
 	return
 }
 
+// checkMinGhVersion reads the capabilities file in dir, if any, and reports
+// whether the running gh satisfies the minimum version it declares.
+func checkMinGhVersion(dir string) error {
+	contents, err := os.ReadFile(filepath.Join(dir, capabilitiesName))
+	if err != nil {
+		return nil
+	}
+	var caps capabilities
+	if err := yaml.Unmarshal(contents, &caps); err != nil {
+		return nil
+	}
+	return ghVersionSupported(build.Version, caps.MinGhVersion)
+}
+
+func ghVersionSupported(currentVersion, minVersion string) error {
+	if minVersion == "" {
+		return nil
+	}
+	minV, err := version.NewVersion(strings.TrimPrefix(minVersion, "v"))
+	if err != nil {
+		// an unparseable declaration never blocks the extension
+		return nil
+	}
+	currentV, err := version.NewVersion(strings.TrimPrefix(currentVersion, "v"))
+	if err != nil {
+		// development builds of gh run everything
+		return nil
+	}
+	if currentV.LessThan(minV) {
+		return fmt.Errorf("this extension requires gh %s or newer, but gh %s is installed; upgrade gh to use this extension", minVersion, currentVersion)
+	}
+	return nil
+}
+
 func (m *Manager) installGit(repo ghrepo.Interface, target string) error {
 	protocol := m.config.GitProtocol(repo.RepoHost()).Value
 	cloneURL := ghrepo.FormatRemoteURL(repo, protocol)
@@ -390,6 +447,10 @@ func (m *Manager) installGit(repo ghrepo.Interface, target string) error {
 	if err != nil {
 		return err
 	}
+	if err := checkMinGhVersion(targetDir); err != nil {
+		_ = os.RemoveAll(targetDir)
+		return err
+	}
 	if commitSHA == "" {
 		return nil
 	}
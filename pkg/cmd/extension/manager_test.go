@@ -13,6 +13,7 @@ import (
 
 	"github.com/MakeNowJust/heredoc"
 	"github.com/cli/cli/v2/git"
+	"github.com/cli/cli/v2/internal/build"
 	"github.com/cli/cli/v2/internal/config"
 	"github.com/cli/cli/v2/internal/ghrepo"
 	"github.com/cli/cli/v2/internal/run"
@@ -186,6 +187,87 @@ func TestManager_Dispatch_binary(t *testing.T) {
 	assert.Equal(t, "", stderr.String())
 }
 
+func TestManager_Dispatch_minGhVersion(t *testing.T) {
+	oldVersion := build.Version
+	build.Version = "2.0.0"
+	t.Cleanup(func() { build.Version = oldVersion })
+
+	tempDir := t.TempDir()
+	extDir := filepath.Join(tempDir, "extensions", "gh-hello")
+	extPath := filepath.Join(extDir, "gh-hello")
+	assert.NoError(t, stubExtension(extPath))
+	assert.NoError(t, os.WriteFile(filepath.Join(extDir, capabilitiesName), []byte("minGhVersion: 99.0.0\n"), 0644))
+
+	gc, gcOne := &mockGitClient{}, &mockGitClient{}
+	gc.On("ForRepo", extDir).Return(gcOne).Once()
+
+	m := newTestManager(tempDir, nil, gc, nil)
+
+	stdout := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+	found, err := m.Dispatch([]string{"hello"}, nil, stdout, stderr)
+	assert.True(t, found)
+	assert.EqualError(t, err, "this extension requires gh 99.0.0 or newer, but gh 2.0.0 is installed; upgrade gh to use this extension")
+	assert.Equal(t, "", stdout.String())
+}
+
+func TestGhVersionSupported(t *testing.T) {
+	tests := []struct {
+		name           string
+		currentVersion string
+		minVersion     string
+		wantErr        string
+	}{
+		{
+			name:           "no minimum declared",
+			currentVersion: "2.40.0",
+			minVersion:     "",
+		},
+		{
+			name:           "current version newer",
+			currentVersion: "2.40.0",
+			minVersion:     "2.39.0",
+		},
+		{
+			name:           "current version equal",
+			currentVersion: "2.40.0",
+			minVersion:     "2.40.0",
+		},
+		{
+			name:           "current version older",
+			currentVersion: "2.40.0",
+			minVersion:     "2.41.0",
+			wantErr:        "this extension requires gh 2.41.0 or newer, but gh 2.40.0 is installed; upgrade gh to use this extension",
+		},
+		{
+			name:           "v prefixes",
+			currentVersion: "v2.40.0",
+			minVersion:     "v2.41.0",
+			wantErr:        "this extension requires gh v2.41.0 or newer, but gh v2.40.0 is installed; upgrade gh to use this extension",
+		},
+		{
+			name:           "development build",
+			currentVersion: "DEV",
+			minVersion:     "2.41.0",
+		},
+		{
+			name:           "unparseable minimum",
+			currentVersion: "2.40.0",
+			minVersion:     "latest",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ghVersionSupported(tt.currentVersion, tt.minVersion)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestManager_Remove(t *testing.T) {
 	tempDir := t.TempDir()
 	assert.NoError(t, stubExtension(filepath.Join(tempDir, "extensions", "gh-hello", "gh-hello")))
@@ -199,6 +199,8 @@ func ioStreams(f *cmdutil.Factory) *iostreams.IOStreams {
 		io.SetPager(pager.Value)
 	}
 
+	io.SetHyperlinksPolicy(cfg.Hyperlinks("").Value)
+
 	return io
 }
 
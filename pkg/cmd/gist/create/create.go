@@ -33,6 +33,7 @@ type CreateOptions struct {
 	Filenames        []string
 	FilenameOverride string
 	WebMode          bool
+	Force            bool
 
 	Config     func() (gh.Config, error)
 	HttpClient func() (*http.Client, error)
@@ -57,6 +58,10 @@ func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Co
 			file name to read from standard input.
 
 			By default, gists are secret; use %[1]s--public%[1]s to make publicly listed ones.
+
+			Before a public gist is created, its content is checked for common credential
+			formats and creation is aborted if any are found. Use %[1]s--force%[1]s to create the
+			gist anyway.
 		`, "`"),
 		Example: heredoc.Doc(`
 			# publish file 'hello.py' as a public gist
@@ -98,6 +103,7 @@ func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Co
 	cmd.Flags().BoolVarP(&opts.WebMode, "web", "w", false, "Open the web browser with created gist")
 	cmd.Flags().BoolVarP(&opts.Public, "public", "p", false, "List the gist publicly (default \"secret\")")
 	cmd.Flags().StringVarP(&opts.FilenameOverride, "filename", "f", "", "Provide a filename to be used when reading from standard input")
+	cmd.Flags().BoolVar(&opts.Force, "force", false, "Create a public gist even if the content appears to contain secrets")
 	return cmd
 }
 
@@ -114,6 +120,16 @@ func createRun(opts *CreateOptions) error {
 
 	errOut := opts.IO.ErrOut
 	cs := opts.IO.ColorScheme()
+
+	if opts.Public && !opts.Force {
+		if findings := scanForSecrets(files); len(findings) > 0 {
+			for _, finding := range findings {
+				fmt.Fprintf(errOut, "%s %s appears to contain a %s\n", cs.WarningIcon(), finding.filename, finding.kind)
+			}
+			return errors.New("refusing to create a public gist; use `--force` to create it anyway")
+		}
+	}
+
 	gistName := guessGistName(files)
 
 	processMessage := "Creating gist..."
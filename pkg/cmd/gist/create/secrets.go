@@ -0,0 +1,51 @@
+package create
+
+import (
+	"regexp"
+	"sort"
+
+	"github.com/cli/cli/v2/pkg/cmd/gist/shared"
+)
+
+// secretPattern matches one class of credential commonly leaked in pasted
+// content. The patterns favor precision over recall since every match blocks
+// gist creation.
+type secretPattern struct {
+	name string
+	re   *regexp.Regexp
+}
+
+var secretPatterns = []secretPattern{
+	{"GitHub token", regexp.MustCompile(`\b(?:ghp|gho|ghu|ghs|ghr)_[A-Za-z0-9]{36,}\b`)},
+	{"GitHub fine-grained token", regexp.MustCompile(`\bgithub_pat_[A-Za-z0-9_]{60,}\b`)},
+	{"AWS access key ID", regexp.MustCompile(`\b(?:AKIA|ASIA)[0-9A-Z]{16}\b`)},
+	{"Slack token", regexp.MustCompile(`\bxox[baprs]-[A-Za-z0-9-]{10,}\b`)},
+	{"Google API key", regexp.MustCompile(`\bAIza[0-9A-Za-z_-]{35}\b`)},
+	{"private key", regexp.MustCompile(`-----BEGIN (?:[A-Z]+ )?PRIVATE KEY-----`)},
+}
+
+// secretFinding is one suspected credential in a file to be posted.
+type secretFinding struct {
+	filename string
+	kind     string
+}
+
+// scanForSecrets checks the content of every file against secretPatterns and
+// reports the findings in filename order.
+func scanForSecrets(files map[string]*shared.GistFile) []secretFinding {
+	filenames := make([]string, 0, len(files))
+	for filename := range files {
+		filenames = append(filenames, filename)
+	}
+	sort.Strings(filenames)
+
+	var findings []secretFinding
+	for _, filename := range filenames {
+		for _, pattern := range secretPatterns {
+			if pattern.re.MatchString(files[filename].Content) {
+				findings = append(findings, secretFinding{filename: filename, kind: pattern.name})
+			}
+		}
+	}
+	return findings
+}
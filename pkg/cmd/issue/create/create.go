@@ -32,6 +32,7 @@ type CreateOptions struct {
 	EditorMode      bool
 	WebMode         bool
 	RecoverFile     string
+	NoDedupeCheck   bool
 
 	Title       string
 	Body        string
@@ -130,6 +131,7 @@ func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Co
 	cmd.Flags().StringVarP(&opts.Milestone, "milestone", "m", "", "Add the issue to a milestone by `name`")
 	cmd.Flags().StringVar(&opts.RecoverFile, "recover", "", "Recover input from a failed run of create")
 	cmd.Flags().StringVarP(&opts.Template, "template", "T", "", "Template `file` to use as starting body text")
+	cmd.Flags().BoolVar(&opts.NoDedupeCheck, "no-dedupe-check", false, "Skip searching for existing open issues with a similar title")
 
 	return cmd
 }
@@ -228,6 +230,14 @@ func createRun(opts *CreateOptions) (err error) {
 			}
 		}
 
+		if !opts.NoDedupeCheck {
+			var handled bool
+			handled, err = dedupeCheck(apiClient, baseRepo, opts, tb.Title)
+			if err != nil || handled {
+				return
+			}
+		}
+
 		if opts.Body == "" {
 			templateContent := ""
 
@@ -354,6 +364,108 @@ func createRun(opts *CreateOptions) (err error) {
 	return
 }
 
+type similarIssue struct {
+	ID     string
+	Number int
+	Title  string
+	URL    string
+}
+
+// dedupeCheck searches for open issues with a similar title and offers to
+// comment on one of them, or abort, instead of filing a duplicate. It reports
+// whether the submission was handled, i.e. the new issue should not be filed.
+func dedupeCheck(apiClient *api.Client, baseRepo ghrepo.Interface, opts *CreateOptions, title string) (bool, error) {
+	similar, err := searchSimilarIssues(apiClient, baseRepo, title)
+	if err != nil {
+		return false, err
+	}
+	if len(similar) == 0 {
+		return false, nil
+	}
+
+	cs := opts.IO.ColorScheme()
+	fmt.Fprintf(opts.IO.ErrOut, "%s Similar open issues were found in %s:\n\n", cs.WarningIcon(), ghrepo.FullName(baseRepo))
+	for _, issue := range similar {
+		fmt.Fprintf(opts.IO.ErrOut, "  #%d  %s\n", issue.Number, issue.Title)
+	}
+	fmt.Fprintln(opts.IO.ErrOut)
+
+	options := []string{"Submit a new issue"}
+	for _, issue := range similar {
+		options = append(options, fmt.Sprintf("Comment on #%d", issue.Number))
+	}
+	options = append(options, "Cancel")
+
+	selected, err := opts.Prompter.Select("What would you like to do?", options[0], options)
+	if err != nil {
+		return false, fmt.Errorf("unable to confirm: %w", err)
+	}
+
+	if selected == 0 {
+		return false, nil
+	}
+	if selected == len(options)-1 {
+		fmt.Fprintln(opts.IO.ErrOut, "Discarding.")
+		return true, cmdutil.CancelError
+	}
+
+	issue := similar[selected-1]
+	body, err := opts.Prompter.MarkdownEditor("Comment body", "", false)
+	if err != nil {
+		return false, err
+	}
+
+	commentURL, err := api.CommentCreate(apiClient, baseRepo.RepoHost(), api.CommentCreateInput{
+		Body:      body,
+		SubjectId: issue.ID,
+	})
+	if err != nil {
+		return false, err
+	}
+	fmt.Fprintln(opts.IO.Out, commentURL)
+	return true, nil
+}
+
+// searchSimilarIssues returns up to five open issues whose titles match the
+// given title.
+func searchSimilarIssues(apiClient *api.Client, baseRepo ghrepo.Interface, title string) ([]similarIssue, error) {
+	query := `query SimilarIssueSearch($query: String!, $limit: Int!) {
+		search(type: ISSUE, first: $limit, query: $query) {
+			nodes {
+				... on Issue {
+					id
+					number
+					title
+					url
+				}
+			}
+		}
+	}`
+
+	variables := map[string]interface{}{
+		"query": fmt.Sprintf("repo:%s is:issue is:open in:title %s", ghrepo.FullName(baseRepo), title),
+		"limit": 5,
+	}
+
+	var resp struct {
+		Search struct {
+			Nodes []similarIssue
+		}
+	}
+	if err := apiClient.GraphQL(baseRepo.RepoHost(), query, variables, &resp); err != nil {
+		return nil, err
+	}
+
+	// The inline fragment leaves empty nodes for non-issue results.
+	issues := resp.Search.Nodes[:0]
+	for _, node := range resp.Search.Nodes {
+		if node.ID != "" {
+			issues = append(issues, node)
+		}
+	}
+	return issues, nil
+}
+
 func generatePreviewURL(apiClient *api.Client, baseRepo ghrepo.Interface, tb prShared.IssueMetadataState) (string, error) {
 	openURL := ghrepo.GenerateRepoURL(baseRepo, "issues/new")
 	return prShared.WithPrAndIssueQueryParams(apiClient, baseRepo, openURL, tb)
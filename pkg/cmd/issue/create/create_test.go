@@ -609,6 +609,12 @@ func TestIssueCreate_recover(t *testing.T) {
 			assert.Equal(t, []interface{}{"BUGID", "TODOID"}, inputs["labelIds"])
 		}))
 
+	http.Register(
+		httpmock.GraphQL(`query SimilarIssueSearch\b`),
+		httpmock.StringResponse(`
+			{ "data": { "search": { "nodes": [] } } }`),
+	)
+
 	pm := &prompter.PrompterMock{}
 	pm.InputFunc = func(p, d string) (string, error) {
 		if p == "Title (required)" {
@@ -693,6 +699,12 @@ func TestIssueCreate_nonLegacyTemplate(t *testing.T) {
 			}),
 	)
 
+	http.Register(
+		httpmock.GraphQL(`query SimilarIssueSearch\b`),
+		httpmock.StringResponse(`
+			{ "data": { "search": { "nodes": [] } } }`),
+	)
+
 	pm := &prompter.PrompterMock{}
 	pm.MarkdownEditorFunc = func(p, d string, ba bool) (string, error) {
 		if p == "Body" {
@@ -734,6 +746,12 @@ func TestIssueCreate_continueInBrowser(t *testing.T) {
 			} } }`),
 	)
 
+	http.Register(
+		httpmock.GraphQL(`query SimilarIssueSearch\b`),
+		httpmock.StringResponse(`
+			{ "data": { "search": { "nodes": [] } } }`),
+	)
+
 	pm := &prompter.PrompterMock{}
 	pm.InputFunc = func(p, d string) (string, error) {
 		if p == "Title (required)" {
@@ -768,6 +786,68 @@ func TestIssueCreate_continueInBrowser(t *testing.T) {
 	assert.Equal(t, "https://github.com/OWNER/REPO/issues/new?body=body&title=hello", output.BrowsedURL)
 }
 
+func TestIssueCreate_dedupeComment(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	http.Register(
+		httpmock.GraphQL(`query RepositoryInfo\b`),
+		httpmock.StringResponse(`
+			{ "data": { "repository": {
+				"id": "REPOID",
+				"hasIssuesEnabled": true
+			} } }`),
+	)
+	http.Register(
+		httpmock.GraphQL(`query SimilarIssueSearch\b`),
+		httpmock.StringResponse(`
+			{ "data": { "search": { "nodes": [
+				{ "id": "ISSUEID", "number": 9, "title": "nothing works", "url": "https://github.com/OWNER/REPO/issues/9" }
+			] } } }`),
+	)
+	http.Register(
+		httpmock.GraphQL(`mutation CommentCreate\b`),
+		httpmock.GraphQLMutation(`
+			{ "data": { "addComment": { "commentEdge": { "node": {
+				"url": "https://github.com/OWNER/REPO/issues/9#issuecomment-1"
+			} } } } }`,
+			func(inputs map[string]interface{}) {
+				assert.Equal(t, "ISSUEID", inputs["subjectId"])
+				assert.Equal(t, "me too", inputs["body"])
+			}),
+	)
+
+	pm := &prompter.PrompterMock{}
+	pm.InputFunc = func(p, d string) (string, error) {
+		if p == "Title (required)" {
+			return "nothing works", nil
+		} else {
+			return "", prompter.NoSuchPromptErr(p)
+		}
+	}
+	pm.SelectFunc = func(p, _ string, opts []string) (int, error) {
+		if p == "What would you like to do?" {
+			return prompter.IndexFor(opts, "Comment on #9")
+		} else {
+			return -1, prompter.NoSuchPromptErr(p)
+		}
+	}
+	pm.MarkdownEditorFunc = func(p, d string, ba bool) (string, error) {
+		if p == "Comment body" {
+			return "me too", nil
+		} else {
+			return "", prompter.NoSuchPromptErr(p)
+		}
+	}
+
+	output, err := runCommand(http, true, ``, pm)
+	if err != nil {
+		t.Errorf("error running command `issue create`: %v", err)
+	}
+
+	assert.Equal(t, "https://github.com/OWNER/REPO/issues/9#issuecomment-1\n", output.String())
+}
+
 func TestIssueCreate_metadata(t *testing.T) {
 	http := &httpmock.Registry{}
 	defer http.Verify(t)
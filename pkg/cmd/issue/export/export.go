@@ -0,0 +1,152 @@
+package export
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/internal/text"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type ExportOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+
+	State  string
+	Output string
+}
+
+func NewCmdExport(f *cmdutil.Factory, runF func(*ExportOptions) error) *cobra.Command {
+	opts := &ExportOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export issues with comments and events for archival",
+		Long: heredoc.Docf(`
+			Export the issues of a repository as JSON Lines, one issue per line, including
+			bodies, comments, labels, timeline events, and reactions.
+
+			Issues are exported in ascending order of creation. When %[1]s--output%[1]s is used,
+			progress is recorded in a cursor file next to the output file so that an
+			interrupted export resumes where it left off instead of starting over.
+		`, "`"),
+		Example: heredoc.Doc(`
+			$ gh issue export --state all --output issues.jsonl
+			$ gh issue export --state closed | jq .number
+		`),
+		Args: cmdutil.NoArgsQuoteReminder,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// support `-R, --repo` override
+			opts.BaseRepo = f.BaseRepo
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return exportRun(opts)
+		},
+	}
+
+	cmdutil.StringEnumFlag(cmd, &opts.State, "state", "s", "open", []string{"open", "closed", "all"}, "Filter by state")
+	cmd.Flags().StringVarP(&opts.Output, "output", "o", "", "Write issues to a file instead of stdout")
+
+	return cmd
+}
+
+func exportRun(opts *ExportOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+	apiClient := api.NewClientFromHTTP(httpClient)
+
+	repo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	var states []string
+	switch opts.State {
+	case "open", "":
+		states = []string{"OPEN"}
+	case "closed":
+		states = []string{"CLOSED"}
+	case "all":
+		states = []string{"OPEN", "CLOSED"}
+	default:
+		return fmt.Errorf("invalid state: %s", opts.State)
+	}
+
+	var out io.Writer = opts.IO.Out
+	var cursor string
+	var cursorFile string
+
+	if opts.Output != "" {
+		cursorFile = opts.Output + ".cursor"
+		flags := os.O_CREATE | os.O_WRONLY
+		if data, err := os.ReadFile(cursorFile); err == nil {
+			cursor = strings.TrimSpace(string(data))
+			flags |= os.O_APPEND
+		} else {
+			flags |= os.O_TRUNC
+		}
+		f, err := os.OpenFile(opts.Output, flags, 0644)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		out = f
+	}
+
+	enc := json.NewEncoder(out)
+	exported := 0
+
+	for {
+		page, err := exportIssuePage(apiClient, repo, states, cursor)
+		if err != nil {
+			return err
+		}
+
+		for _, issue := range page.Issues {
+			if err := enc.Encode(issue); err != nil {
+				return err
+			}
+			exported++
+		}
+
+		if cursorFile != "" && page.EndCursor != "" {
+			if err := os.WriteFile(cursorFile, []byte(page.EndCursor), 0644); err != nil {
+				return err
+			}
+		}
+
+		if !page.HasNextPage {
+			break
+		}
+		cursor = page.EndCursor
+	}
+
+	if cursorFile != "" {
+		if err := os.Remove(cursorFile); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+
+	if opts.Output != "" || opts.IO.IsStdoutTTY() {
+		fmt.Fprintf(opts.IO.ErrOut, "Exported %s from %s\n", text.Pluralize(exported, "issue"), ghrepo.FullName(repo))
+	}
+
+	return nil
+}
@@ -0,0 +1,133 @@
+package export
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCmdExport(t *testing.T) {
+	tests := []struct {
+		name    string
+		cli     string
+		want    ExportOptions
+		wantErr string
+	}{
+		{
+			name: "defaults",
+			cli:  "",
+			want: ExportOptions{
+				State: "open",
+			},
+		},
+		{
+			name: "state and output",
+			cli:  "--state all --output issues.jsonl",
+			want: ExportOptions{
+				State:  "all",
+				Output: "issues.jsonl",
+			},
+		},
+		{
+			name:    "invalid state",
+			cli:     "--state merged",
+			wantErr: "invalid argument \"merged\" for \"-s, --state\" flag: valid values are {open|closed|all}",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ios, _, _, _ := iostreams.Test()
+			f := &cmdutil.Factory{
+				IOStreams: ios,
+			}
+
+			var opts *ExportOptions
+			cmd := NewCmdExport(f, func(o *ExportOptions) error {
+				opts = o
+				return nil
+			})
+
+			argv, err := shlex.Split(tt.cli)
+			require.NoError(t, err)
+			cmd.SetArgs(argv)
+
+			cmd.SetIn(&nopReader{})
+			cmd.SetOut(&nopWriter{})
+			cmd.SetErr(&nopWriter{})
+
+			_, err = cmd.ExecuteC()
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.want.State, opts.State)
+			assert.Equal(t, tt.want.Output, opts.Output)
+		})
+	}
+}
+
+func Test_exportRun(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+
+	reg.Register(
+		httpmock.GraphQL(`query IssueExport\b`),
+		httpmock.StringResponse(`
+			{ "data": { "repository": {
+				"hasIssuesEnabled": true,
+				"issues": {
+					"nodes": [{
+						"number": 1,
+						"title": "it broke",
+						"state": "OPEN",
+						"body": "the details",
+						"author": { "login": "monalisa" },
+						"labels": { "nodes": [{ "name": "bug" }] },
+						"comments": { "nodes": [{
+							"author": { "login": "hubot" },
+							"body": "confirmed"
+						}] }
+					}],
+					"pageInfo": { "hasNextPage": false, "endCursor": "" }
+				}
+			} } }
+		`),
+	)
+
+	ios, _, stdout, stderr := iostreams.Test()
+	opts := &ExportOptions{
+		IO: ios,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.New("OWNER", "REPO"), nil
+		},
+		State: "open",
+	}
+
+	err := exportRun(opts)
+	require.NoError(t, err)
+
+	assert.Contains(t, stdout.String(), `"number":1`)
+	assert.Contains(t, stdout.String(), `"labels":["bug"]`)
+	assert.Contains(t, stdout.String(), `"author":"hubot"`)
+	assert.Equal(t, "", stderr.String())
+}
+
+type nopReader struct{}
+
+func (nopReader) Read([]byte) (int, error) { return 0, nil }
+
+type nopWriter struct{}
+
+func (nopWriter) Write(p []byte) (int, error) { return len(p), nil }
@@ -0,0 +1,198 @@
+package export
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+)
+
+type exportedIssue struct {
+	Number         int                `json:"number"`
+	Title          string             `json:"title"`
+	State          string             `json:"state"`
+	Body           string             `json:"body"`
+	Author         string             `json:"author"`
+	CreatedAt      time.Time          `json:"createdAt"`
+	UpdatedAt      time.Time          `json:"updatedAt"`
+	ClosedAt       *time.Time         `json:"closedAt"`
+	Labels         []string           `json:"labels"`
+	ReactionGroups api.ReactionGroups `json:"reactionGroups"`
+	Comments       []exportedComment  `json:"comments"`
+	Events         []exportedEvent    `json:"events"`
+}
+
+type exportedComment struct {
+	Author         string             `json:"author"`
+	Body           string             `json:"body"`
+	CreatedAt      time.Time          `json:"createdAt"`
+	ReactionGroups api.ReactionGroups `json:"reactionGroups"`
+}
+
+type exportedEvent struct {
+	Type      string    `json:"type"`
+	Actor     string    `json:"actor"`
+	Label     string    `json:"label,omitempty"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+type issuePage struct {
+	Issues      []exportedIssue
+	EndCursor   string
+	HasNextPage bool
+}
+
+func exportIssuePage(client *api.Client, repo ghrepo.Interface, states []string, after string) (*issuePage, error) {
+	query := `
+	query IssueExport($owner: String!, $repo: String!, $states: [IssueState!], $endCursor: String) {
+		repository(owner: $owner, name: $repo) {
+			hasIssuesEnabled
+			issues(first: 50, after: $endCursor, orderBy: {field: CREATED_AT, direction: ASC}, states: $states) {
+				nodes {
+					number
+					title
+					state
+					body
+					author { login }
+					createdAt
+					updatedAt
+					closedAt
+					labels(first: 100) {
+						nodes { name }
+					}
+					reactionGroups {
+						content
+						users { totalCount }
+					}
+					comments(first: 100) {
+						nodes {
+							author { login }
+							body
+							createdAt
+							reactionGroups {
+								content
+								users { totalCount }
+							}
+						}
+					}
+					timelineItems(first: 100, itemTypes: [CLOSED_EVENT, REOPENED_EVENT, LABELED_EVENT, UNLABELED_EVENT]) {
+						nodes {
+							__typename
+							... on ClosedEvent { actor { login } createdAt }
+							... on ReopenedEvent { actor { login } createdAt }
+							... on LabeledEvent { actor { login } label { name } createdAt }
+							... on UnlabeledEvent { actor { login } label { name } createdAt }
+						}
+					}
+				}
+				pageInfo {
+					hasNextPage
+					endCursor
+				}
+			}
+		}
+	}`
+
+	variables := map[string]interface{}{
+		"owner":  repo.RepoOwner(),
+		"repo":   repo.RepoName(),
+		"states": states,
+	}
+	if after != "" {
+		variables["endCursor"] = after
+	}
+
+	type issueNode struct {
+		Number    int
+		Title     string
+		State     string
+		Body      string
+		Author    struct{ Login string }
+		CreatedAt time.Time
+		UpdatedAt time.Time
+		ClosedAt  *time.Time
+		Labels    struct {
+			Nodes []struct{ Name string }
+		}
+		ReactionGroups api.ReactionGroups
+		Comments       struct {
+			Nodes []struct {
+				Author         struct{ Login string }
+				Body           string
+				CreatedAt      time.Time
+				ReactionGroups api.ReactionGroups
+			}
+		}
+		TimelineItems struct {
+			Nodes []struct {
+				Typename  string `json:"__typename"`
+				Actor     struct{ Login string }
+				Label     struct{ Name string }
+				CreatedAt time.Time
+			}
+		}
+	}
+
+	type responseData struct {
+		Repository struct {
+			HasIssuesEnabled bool
+			Issues           struct {
+				Nodes    []issueNode
+				PageInfo struct {
+					HasNextPage bool
+					EndCursor   string
+				}
+			}
+		}
+	}
+
+	var response responseData
+	if err := client.GraphQL(repo.RepoHost(), query, variables, &response); err != nil {
+		return nil, err
+	}
+	if !response.Repository.HasIssuesEnabled {
+		return nil, fmt.Errorf("the '%s' repository has disabled issues", ghrepo.FullName(repo))
+	}
+
+	page := &issuePage{
+		EndCursor:   response.Repository.Issues.PageInfo.EndCursor,
+		HasNextPage: response.Repository.Issues.PageInfo.HasNextPage,
+	}
+
+	for _, node := range response.Repository.Issues.Nodes {
+		issue := exportedIssue{
+			Number:         node.Number,
+			Title:          node.Title,
+			State:          node.State,
+			Body:           node.Body,
+			Author:         node.Author.Login,
+			CreatedAt:      node.CreatedAt,
+			UpdatedAt:      node.UpdatedAt,
+			ClosedAt:       node.ClosedAt,
+			ReactionGroups: node.ReactionGroups,
+		}
+		for _, label := range node.Labels.Nodes {
+			issue.Labels = append(issue.Labels, label.Name)
+		}
+		for _, comment := range node.Comments.Nodes {
+			issue.Comments = append(issue.Comments, exportedComment{
+				Author:         comment.Author.Login,
+				Body:           comment.Body,
+				CreatedAt:      comment.CreatedAt,
+				ReactionGroups: comment.ReactionGroups,
+			})
+		}
+		for _, event := range node.TimelineItems.Nodes {
+			issue.Events = append(issue.Events, exportedEvent{
+				Type:      event.Typename,
+				Actor:     event.Actor.Login,
+				Label:     event.Label.Name,
+				CreatedAt: event.CreatedAt,
+			})
+		}
+		page.Issues = append(page.Issues, issue)
+	}
+
+	return page, nil
+}
@@ -35,10 +35,12 @@ func TestJSONFields(t *testing.T) {
 		"labels",
 		"milestone",
 		"number",
+		"participants",
 		"projectCards",
 		"projectItems",
 		"reactionGroups",
 		"state",
+		"subscribers",
 		"title",
 		"updatedAt",
 		"url",
@@ -0,0 +1,343 @@
+package auditrepos
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/gh"
+	"github.com/cli/cli/v2/internal/tableprinter"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+type AuditOptions struct {
+	IO         *iostreams.IOStreams
+	Config     func() (gh.Config, error)
+	HttpClient func() (*http.Client, error)
+
+	Organization string
+	PolicyPath   string
+	Format       string
+}
+
+// Policy holds the declarative expectations every repository is checked
+// against.
+type Policy struct {
+	Visibility        string   `yaml:"visibility"`
+	BranchProtection  *bool    `yaml:"branchProtection"`
+	RequireCodeowners bool     `yaml:"requireCodeowners"`
+	Topics            []string `yaml:"topics"`
+	Workflows         []string `yaml:"workflows"`
+}
+
+// Violation is a single policy rule a repository does not meet.
+type Violation struct {
+	Rule    string `json:"rule"`
+	Message string `json:"message"`
+}
+
+// RepoResult is the audit outcome for one repository.
+type RepoResult struct {
+	Name       string      `json:"name"`
+	Compliant  bool        `json:"compliant"`
+	Violations []Violation `json:"violations"`
+}
+
+func NewCmdAuditRepos(f *cmdutil.Factory, runF func(*AuditOptions) error) *cobra.Command {
+	opts := AuditOptions{
+		IO:         f.IOStreams,
+		Config:     f.Config,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "audit-repos <organization>",
+		Short: "Audit the repositories of an organization against a policy",
+		Long: heredoc.Docf(`
+			Check every repository of an organization against the declarative
+			expectations in a policy file and report the violations. Archived
+			repositories are skipped.
+
+			The policy file is YAML and may set any of the following keys:
+
+			- %[1]svisibility%[1]s: the visibility every repository must have (public, private, or internal)
+			- %[1]sbranchProtection%[1]s: whether the default branch must have a branch protection rule
+			- %[1]srequireCodeowners%[1]s: whether a CODEOWNERS file must be present
+			- %[1]stopics%[1]s: topics every repository must have
+			- %[1]sworkflows%[1]s: workflow files that must exist under %[1]s.github/workflows%[1]s
+
+			The command exits with a non-zero status when any repository is not
+			compliant.
+		`, "`"),
+		Example: heredoc.Doc(`
+			# audit the repositories of the github organization
+			$ gh org audit-repos github --policy policy.yml
+
+			# emit the report as SARIF for code scanning upload
+			$ gh org audit-repos github --policy policy.yml --format sarif
+		`),
+		Args: cmdutil.ExactArgs(1, "cannot audit: organization argument required"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Organization = args[0]
+
+			if runF != nil {
+				return runF(&opts)
+			}
+			return auditRun(&opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.PolicyPath, "policy", "", "Path to the policy `file`")
+	cmdutil.StringEnumFlag(cmd, &opts.Format, "format", "", "table", []string{"table", "json", "sarif"}, "Output format")
+
+	_ = cmd.MarkFlagRequired("policy")
+
+	return cmd
+}
+
+func auditRun(opts *AuditOptions) error {
+	policy, err := loadPolicy(opts.PolicyPath)
+	if err != nil {
+		return err
+	}
+
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+	host, _ := cfg.Authentication().DefaultHost()
+
+	opts.IO.StartProgressIndicator()
+	audits, err := listRepoAudits(httpClient, host, opts.Organization)
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		return fmt.Errorf("error fetching repositories: %w", err)
+	}
+
+	var results []RepoResult
+	compliant := true
+	for _, audit := range audits {
+		if audit.IsArchived {
+			continue
+		}
+		violations := policy.Check(audit)
+		if len(violations) > 0 {
+			compliant = false
+		}
+		results = append(results, RepoResult{
+			Name:       audit.Name,
+			Compliant:  len(violations) == 0,
+			Violations: violations,
+		})
+	}
+
+	switch opts.Format {
+	case "json":
+		err = printJSON(opts, results, compliant)
+	case "sarif":
+		err = printSARIF(opts, results)
+	default:
+		err = printTable(opts, results)
+	}
+	if err != nil {
+		return err
+	}
+
+	if !compliant {
+		return cmdutil.SilentError
+	}
+	return nil
+}
+
+func loadPolicy(path string) (*Policy, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var policy Policy
+	dec := yaml.NewDecoder(f)
+	dec.KnownFields(true)
+	if err := dec.Decode(&policy); err != nil {
+		return nil, fmt.Errorf("invalid policy file %q: %w", path, err)
+	}
+
+	switch strings.ToLower(policy.Visibility) {
+	case "", "public", "private", "internal":
+	default:
+		return nil, fmt.Errorf("invalid policy file %q: unknown visibility %q", path, policy.Visibility)
+	}
+
+	return &policy, nil
+}
+
+// Check returns the policy rules the repository does not meet.
+func (p *Policy) Check(audit RepoAudit) []Violation {
+	var violations []Violation
+
+	if p.Visibility != "" && !strings.EqualFold(audit.Visibility, p.Visibility) {
+		violations = append(violations, Violation{
+			Rule:    "visibility",
+			Message: fmt.Sprintf("visibility is %s, expected %s", strings.ToLower(audit.Visibility), strings.ToLower(p.Visibility)),
+		})
+	}
+
+	if p.BranchProtection != nil && *p.BranchProtection {
+		if audit.DefaultBranchRef == nil {
+			violations = append(violations, Violation{
+				Rule:    "branch-protection",
+				Message: "repository has no default branch",
+			})
+		} else if audit.DefaultBranchRef.BranchProtectionRule == nil {
+			violations = append(violations, Violation{
+				Rule:    "branch-protection",
+				Message: fmt.Sprintf("default branch %q is not protected", audit.DefaultBranchRef.Name),
+			})
+		}
+	}
+
+	if p.RequireCodeowners && !audit.HasCodeowners() {
+		violations = append(violations, Violation{
+			Rule:    "codeowners",
+			Message: "no CODEOWNERS file found",
+		})
+	}
+
+	topics := audit.TopicNames()
+	for _, want := range p.Topics {
+		if !containsFold(topics, want) {
+			violations = append(violations, Violation{
+				Rule:    "topics",
+				Message: fmt.Sprintf("missing topic %q", want),
+			})
+		}
+	}
+
+	workflows := audit.WorkflowNames()
+	for _, want := range p.Workflows {
+		if !containsFold(workflows, want) {
+			violations = append(violations, Violation{
+				Rule:    "workflows",
+				Message: fmt.Sprintf("missing workflow %q", want),
+			})
+		}
+	}
+
+	return violations
+}
+
+func containsFold(haystack []string, needle string) bool {
+	for _, s := range haystack {
+		if strings.EqualFold(s, needle) {
+			return true
+		}
+	}
+	return false
+}
+
+func printTable(opts *AuditOptions, results []RepoResult) error {
+	if len(results) == 0 {
+		return cmdutil.NewNoResultsError(fmt.Sprintf("no repositories found in organization %s", opts.Organization))
+	}
+
+	cs := opts.IO.ColorScheme()
+	tp := tableprinter.New(opts.IO, tableprinter.WithHeader("Repo", "Status", "Violations"))
+
+	for _, r := range results {
+		tp.AddField(r.Name)
+		if r.Compliant {
+			tp.AddField("compliant", tableprinter.WithColor(cs.Green))
+			tp.AddField("")
+		} else {
+			tp.AddField("non-compliant", tableprinter.WithColor(cs.Red))
+			messages := make([]string, len(r.Violations))
+			for i, v := range r.Violations {
+				messages[i] = v.Message
+			}
+			tp.AddField(strings.Join(messages, "; "), tableprinter.WithTruncate(nil))
+		}
+		tp.EndRow()
+	}
+
+	return tp.Render()
+}
+
+func printJSON(opts *AuditOptions, results []RepoResult, compliant bool) error {
+	report := map[string]interface{}{
+		"organization": opts.Organization,
+		"compliant":    compliant,
+		"repositories": results,
+	}
+	buf := bytes.Buffer{}
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(report); err != nil {
+		return err
+	}
+	_, err := opts.IO.Out.Write(buf.Bytes())
+	return err
+}
+
+func printSARIF(opts *AuditOptions, results []RepoResult) error {
+	sarifResults := []map[string]interface{}{}
+	for _, r := range results {
+		for _, v := range r.Violations {
+			sarifResults = append(sarifResults, map[string]interface{}{
+				"ruleId": v.Rule,
+				"level":  "error",
+				"message": map[string]interface{}{
+					"text": fmt.Sprintf("%s/%s: %s", opts.Organization, r.Name, v.Message),
+				},
+				"locations": []map[string]interface{}{
+					{
+						"physicalLocation": map[string]interface{}{
+							"artifactLocation": map[string]interface{}{
+								"uri": r.Name,
+							},
+						},
+					},
+				},
+			})
+		}
+	}
+
+	report := map[string]interface{}{
+		"$schema": "https://json.schemastore.org/sarif-2.1.0.json",
+		"version": "2.1.0",
+		"runs": []map[string]interface{}{
+			{
+				"tool": map[string]interface{}{
+					"driver": map[string]interface{}{
+						"name":           "gh-org-audit-repos",
+						"informationUri": "https://cli.github.com",
+					},
+				},
+				"results": sarifResults,
+			},
+		},
+	}
+
+	buf := bytes.Buffer{}
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(report); err != nil {
+		return err
+	}
+	_, err := opts.IO.Out.Write(buf.Bytes())
+	return err
+}
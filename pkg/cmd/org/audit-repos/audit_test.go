@@ -0,0 +1,261 @@
+package auditrepos
+
+import (
+	"bytes"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/internal/gh"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCmdAuditRepos(t *testing.T) {
+	tests := []struct {
+		name     string
+		cli      string
+		wants    AuditOptions
+		wantsErr string
+	}{
+		{
+			name: "organization and policy",
+			cli:  "github --policy policy.yml",
+			wants: AuditOptions{
+				Organization: "github",
+				PolicyPath:   "policy.yml",
+				Format:       "table",
+			},
+		},
+		{
+			name: "sarif format",
+			cli:  "github --policy policy.yml --format sarif",
+			wants: AuditOptions{
+				Organization: "github",
+				PolicyPath:   "policy.yml",
+				Format:       "sarif",
+			},
+		},
+		{
+			name:     "no organization",
+			cli:      "--policy policy.yml",
+			wantsErr: "cannot audit: organization argument required",
+		},
+		{
+			name:     "no policy",
+			cli:      "github",
+			wantsErr: `required flag(s) "policy" not set`,
+		},
+		{
+			name:     "invalid format",
+			cli:      "github --policy policy.yml --format xml",
+			wantsErr: "invalid argument \"xml\" for \"--format\" flag: valid values are {table|json|sarif}",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := &cmdutil.Factory{}
+
+			argv, err := shlex.Split(tt.cli)
+			assert.NoError(t, err)
+
+			var gotOpts *AuditOptions
+			cmd := NewCmdAuditRepos(f, func(opts *AuditOptions) error {
+				gotOpts = opts
+				return nil
+			})
+			cmd.SetArgs(argv)
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+
+			_, err = cmd.ExecuteC()
+			if tt.wantsErr != "" {
+				assert.EqualError(t, err, tt.wantsErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wants.Organization, gotOpts.Organization)
+			assert.Equal(t, tt.wants.PolicyPath, gotOpts.PolicyPath)
+			assert.Equal(t, tt.wants.Format, gotOpts.Format)
+		})
+	}
+}
+
+func TestLoadPolicy(t *testing.T) {
+	writePolicy := func(t *testing.T, contents string) string {
+		t.Helper()
+		path := filepath.Join(t.TempDir(), "policy.yml")
+		require.NoError(t, os.WriteFile(path, []byte(contents), 0600))
+		return path
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		path := writePolicy(t, heredoc.Doc(`
+			visibility: private
+			branchProtection: true
+			requireCodeowners: true
+			topics:
+			  - go
+			workflows:
+			  - ci.yml
+		`))
+		policy, err := loadPolicy(path)
+		require.NoError(t, err)
+		assert.Equal(t, "private", policy.Visibility)
+		assert.True(t, *policy.BranchProtection)
+		assert.True(t, policy.RequireCodeowners)
+		assert.Equal(t, []string{"go"}, policy.Topics)
+		assert.Equal(t, []string{"ci.yml"}, policy.Workflows)
+	})
+
+	t.Run("unknown key", func(t *testing.T) {
+		path := writePolicy(t, "visibilty: private\n")
+		_, err := loadPolicy(path)
+		assert.ErrorContains(t, err, "field visibilty not found")
+	})
+
+	t.Run("unknown visibility", func(t *testing.T) {
+		path := writePolicy(t, "visibility: secret\n")
+		_, err := loadPolicy(path)
+		assert.ErrorContains(t, err, `unknown visibility "secret"`)
+	})
+}
+
+func TestPolicyCheck(t *testing.T) {
+	protection := true
+	policy := &Policy{
+		Visibility:        "private",
+		BranchProtection:  &protection,
+		RequireCodeowners: true,
+		Topics:            []string{"go"},
+		Workflows:         []string{"ci.yml"},
+	}
+
+	t.Run("compliant", func(t *testing.T) {
+		var audit RepoAudit
+		audit.Name = "cli"
+		audit.Visibility = "PRIVATE"
+		audit.RepositoryTopics.Nodes = []struct {
+			Topic struct{ Name string }
+		}{{Topic: struct{ Name string }{Name: "go"}}}
+		audit.DefaultBranchRef = &struct {
+			Name                 string
+			BranchProtectionRule *struct{ ID string }
+		}{Name: "main", BranchProtectionRule: &struct{ ID string }{ID: "a rule"}}
+		audit.GithubCodeowners = &objectRef{Typename: "Blob"}
+		audit.Workflows = &struct {
+			Entries []struct{ Name string }
+		}{Entries: []struct{ Name string }{{Name: "ci.yml"}}}
+
+		assert.Empty(t, policy.Check(audit))
+	})
+
+	t.Run("non-compliant", func(t *testing.T) {
+		var audit RepoAudit
+		audit.Name = "cli"
+		audit.Visibility = "PUBLIC"
+		audit.DefaultBranchRef = &struct {
+			Name                 string
+			BranchProtectionRule *struct{ ID string }
+		}{Name: "main"}
+
+		violations := policy.Check(audit)
+		assert.Equal(t, []Violation{
+			{Rule: "visibility", Message: "visibility is public, expected private"},
+			{Rule: "branch-protection", Message: `default branch "main" is not protected`},
+			{Rule: "codeowners", Message: "no CODEOWNERS file found"},
+			{Rule: "topics", Message: `missing topic "go"`},
+			{Rule: "workflows", Message: `missing workflow "ci.yml"`},
+		}, violations)
+	})
+}
+
+func TestAuditRun(t *testing.T) {
+	policyPath := filepath.Join(t.TempDir(), "policy.yml")
+	require.NoError(t, os.WriteFile(policyPath, []byte("requireCodeowners: true\n"), 0600))
+
+	reposResponse := heredoc.Doc(`
+		{ "data": { "organization": { "repositories": {
+			"nodes": [
+				{
+					"name": "compliant-repo",
+					"visibility": "PRIVATE",
+					"githubCodeowners": {"__typename": "Blob"}
+				},
+				{
+					"name": "bad-repo",
+					"visibility": "PRIVATE"
+				},
+				{
+					"name": "old-repo",
+					"isArchived": true
+				}
+			],
+			"pageInfo": {"hasNextPage": false, "endCursor": ""}
+		} } } }
+	`)
+
+	t.Run("table", func(t *testing.T) {
+		reg := &httpmock.Registry{}
+		defer reg.Verify(t)
+		reg.Register(
+			httpmock.GraphQL(`query OrgRepoAudit\b`),
+			httpmock.StringResponse(reposResponse))
+
+		ios, _, stdout, _ := iostreams.Test()
+		opts := &AuditOptions{
+			IO: ios,
+			Config: func() (gh.Config, error) {
+				return config.NewBlankConfig(), nil
+			},
+			HttpClient: func() (*http.Client, error) {
+				return &http.Client{Transport: reg}, nil
+			},
+			Organization: "github",
+			PolicyPath:   policyPath,
+			Format:       "table",
+		}
+
+		err := auditRun(opts)
+		assert.ErrorIs(t, err, cmdutil.SilentError)
+		assert.Equal(t, "compliant-repo\tcompliant\t\nbad-repo\tnon-compliant\tno CODEOWNERS file found\n", stdout.String())
+	})
+
+	t.Run("sarif", func(t *testing.T) {
+		reg := &httpmock.Registry{}
+		defer reg.Verify(t)
+		reg.Register(
+			httpmock.GraphQL(`query OrgRepoAudit\b`),
+			httpmock.StringResponse(reposResponse))
+
+		ios, _, stdout, _ := iostreams.Test()
+		opts := &AuditOptions{
+			IO: ios,
+			Config: func() (gh.Config, error) {
+				return config.NewBlankConfig(), nil
+			},
+			HttpClient: func() (*http.Client, error) {
+				return &http.Client{Transport: reg}, nil
+			},
+			Organization: "github",
+			PolicyPath:   policyPath,
+			Format:       "sarif",
+		}
+
+		err := auditRun(opts)
+		assert.ErrorIs(t, err, cmdutil.SilentError)
+		assert.Contains(t, stdout.String(), `"version": "2.1.0"`)
+		assert.Contains(t, stdout.String(), `"ruleId": "codeowners"`)
+		assert.Contains(t, stdout.String(), `"text": "github/bad-repo: no CODEOWNERS file found"`)
+	})
+}
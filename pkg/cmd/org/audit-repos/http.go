@@ -0,0 +1,149 @@
+package auditrepos
+
+import (
+	"net/http"
+
+	"github.com/cli/cli/v2/api"
+)
+
+// RepoAudit is the subset of repository data a policy is checked against.
+type RepoAudit struct {
+	Name             string
+	Visibility       string
+	IsArchived       bool
+	RepositoryTopics struct {
+		Nodes []struct {
+			Topic struct {
+				Name string
+			}
+		}
+	}
+	DefaultBranchRef *struct {
+		Name                 string
+		BranchProtectionRule *struct {
+			ID string
+		}
+	}
+	RootCodeowners   *objectRef `json:"rootCodeowners"`
+	GithubCodeowners *objectRef `json:"githubCodeowners"`
+	DocsCodeowners   *objectRef `json:"docsCodeowners"`
+	Workflows        *struct {
+		Entries []struct {
+			Name string
+		}
+	} `json:"workflows"`
+}
+
+type objectRef struct {
+	Typename string `json:"__typename"`
+}
+
+// TopicNames returns the names of the topics of the repository.
+func (r RepoAudit) TopicNames() []string {
+	names := make([]string, len(r.RepositoryTopics.Nodes))
+	for i, n := range r.RepositoryTopics.Nodes {
+		names[i] = n.Topic.Name
+	}
+	return names
+}
+
+// HasCodeowners reports whether a CODEOWNERS file exists in any of the
+// locations GitHub recognizes.
+func (r RepoAudit) HasCodeowners() bool {
+	return r.RootCodeowners != nil || r.GithubCodeowners != nil || r.DocsCodeowners != nil
+}
+
+// WorkflowNames returns the file names under .github/workflows.
+func (r RepoAudit) WorkflowNames() []string {
+	if r.Workflows == nil {
+		return nil
+	}
+	names := make([]string, len(r.Workflows.Entries))
+	for i, e := range r.Workflows.Entries {
+		names[i] = e.Name
+	}
+	return names
+}
+
+func listRepoAudits(httpClient *http.Client, hostname string, org string) ([]RepoAudit, error) {
+	type response struct {
+		Organization struct {
+			Repositories struct {
+				Nodes    []RepoAudit
+				PageInfo struct {
+					HasNextPage bool
+					EndCursor   string
+				}
+			} `graphql:"repositories(first: $limit, after: $endCursor)"`
+		}
+	}
+
+	query := `query OrgRepoAudit($org: String!, $limit: Int!, $endCursor: String) {
+		organization(login: $org) {
+			repositories(first: $limit, after: $endCursor) {
+				nodes {
+					name
+					visibility
+					isArchived
+					repositoryTopics(first: 100) {
+						nodes {
+							topic {
+								name
+							}
+						}
+					}
+					defaultBranchRef {
+						name
+						branchProtectionRule {
+							id
+						}
+					}
+					rootCodeowners: object(expression: "HEAD:CODEOWNERS") {
+						__typename
+					}
+					githubCodeowners: object(expression: "HEAD:.github/CODEOWNERS") {
+						__typename
+					}
+					docsCodeowners: object(expression: "HEAD:docs/CODEOWNERS") {
+						__typename
+					}
+					workflows: object(expression: "HEAD:.github/workflows") {
+						... on Tree {
+							entries {
+								name
+							}
+						}
+					}
+				}
+				pageInfo {
+					hasNextPage
+					endCursor
+				}
+			}
+		}
+	}`
+
+	client := api.NewClientFromHTTP(httpClient)
+
+	var audits []RepoAudit
+	variables := map[string]interface{}{
+		"org":   org,
+		"limit": 100,
+	}
+
+	for {
+		var data response
+		if err := client.GraphQL(hostname, query, variables, &data); err != nil {
+			return nil, err
+		}
+
+		audits = append(audits, data.Organization.Repositories.Nodes...)
+
+		if !data.Organization.Repositories.PageInfo.HasNextPage {
+			break
+		}
+		variables["endCursor"] = data.Organization.Repositories.PageInfo.EndCursor
+	}
+
+	return audits, nil
+}
@@ -2,6 +2,7 @@ package org
 
 import (
 	"github.com/MakeNowJust/heredoc"
+	orgAuditCmd "github.com/cli/cli/v2/pkg/cmd/org/audit-repos"
 	orgListCmd "github.com/cli/cli/v2/pkg/cmd/org/list"
 	"github.com/cli/cli/v2/pkg/cmdutil"
 	"github.com/spf13/cobra"
@@ -18,7 +19,10 @@ func NewCmdOrg(f *cmdutil.Factory) *cobra.Command {
 		GroupID: "core",
 	}
 
-	cmdutil.AddGroup(cmd, "General commands", orgListCmd.NewCmdList(f, nil))
+	cmdutil.AddGroup(cmd, "General commands",
+		orgListCmd.NewCmdList(f, nil),
+		orgAuditCmd.NewCmdAuditRepos(f, nil),
+	)
 
 	return cmd
 }
@@ -0,0 +1,186 @@
+package artifacts
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/tableprinter"
+	"github.com/cli/cli/v2/pkg/cmd/pr/shared"
+	runShared "github.com/cli/cli/v2/pkg/cmd/run/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type ArtifactsOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+
+	Finder shared.PRFinder
+
+	SelectorArg string
+}
+
+// workflowRun is one GitHub Actions run behind the pull request's checks.
+type workflowRun struct {
+	id       int64
+	workflow string
+}
+
+func NewCmdArtifacts(f *cmdutil.Factory, runF func(*ArtifactsOptions) error) *cobra.Command {
+	opts := &ArtifactsOptions{
+		HttpClient: f.HttpClient,
+		IO:         f.IOStreams,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "artifacts [<number> | <url> | <branch>]",
+		Short: "List artifacts of a pull request's checks",
+		Long: heredoc.Docf(`
+			List the artifacts produced by the workflow runs behind a pull request's
+			checks.
+
+			The pull request's checks are mapped to their workflow runs, so artifacts
+			can be found without hunting for run IDs. Expired artifacts are omitted.
+			Download an artifact with %[1]sgh run download <run> --name <name>%[1]s.
+		`, "`"),
+		Example: heredoc.Doc(`
+			# List artifacts of the current branch's pull request
+			$ gh pr artifacts
+
+			# List artifacts of pull request 123
+			$ gh pr artifacts 123
+		`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Finder = shared.NewFinder(f)
+
+			if repoOverride, _ := cmd.Flags().GetString("repo"); repoOverride != "" && len(args) == 0 {
+				return cmdutil.FlagErrorf("argument required when using the `--repo` flag")
+			}
+
+			if len(args) > 0 {
+				opts.SelectorArg = args[0]
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return artifactsRun(opts)
+		},
+	}
+
+	return cmd
+}
+
+func artifactsRun(opts *ArtifactsOptions) error {
+	findOptions := shared.FindOptions{
+		Selector: opts.SelectorArg,
+		Fields:   []string{"number", "statusCheckRollup"},
+	}
+	pr, repo, err := opts.Finder.Find(findOptions)
+	if err != nil {
+		return err
+	}
+
+	client, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	runs := checkRuns(pr)
+	if len(runs) == 0 {
+		return fmt.Errorf("no workflow runs found on pull request #%d", pr.Number)
+	}
+
+	type artifactRow struct {
+		artifact runShared.Artifact
+		run      workflowRun
+	}
+	var rows []artifactRow
+	expired := 0
+	for _, run := range runs {
+		artifacts, err := runShared.ListArtifacts(client, repo, fmt.Sprintf("%d", run.id))
+		if err != nil {
+			return fmt.Errorf("failed to list artifacts of run %d: %w", run.id, err)
+		}
+		for _, artifact := range artifacts {
+			if artifact.Expired {
+				expired++
+				continue
+			}
+			rows = append(rows, artifactRow{artifact: artifact, run: run})
+		}
+	}
+
+	if len(rows) == 0 {
+		return cmdutil.NewNoResultsError(fmt.Sprintf("no artifacts found on pull request #%d", pr.Number))
+	}
+
+	cs := opts.IO.ColorScheme()
+	tp := tableprinter.New(opts.IO, tableprinter.WithHeader("NAME", "SIZE", "WORKFLOW", "RUN"))
+	for _, row := range rows {
+		tp.AddField(row.artifact.Name, tableprinter.WithColor(cs.Bold))
+		tp.AddField(humanFileSize(int64(row.artifact.Size)))
+		tp.AddField(row.run.workflow)
+		tp.AddField(fmt.Sprintf("%d", row.run.id), tableprinter.WithColor(cs.Cyan))
+		tp.EndRow()
+	}
+	if err := tp.Render(); err != nil {
+		return err
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		fmt.Fprintf(opts.IO.Out, "\nTo download an artifact, run: gh run download <run> --name <name>\n")
+		if expired > 0 {
+			fmt.Fprintf(opts.IO.Out, "Omitted %d expired artifacts\n", expired)
+		}
+	}
+	return nil
+}
+
+// checkRuns returns the distinct workflow runs behind the pull request's
+// checks, in the order the checks are reported. Checks that were not produced
+// by GitHub Actions carry no run and are skipped.
+func checkRuns(pr *api.PullRequest) []workflowRun {
+	if len(pr.StatusCheckRollup.Nodes) == 0 {
+		return nil
+	}
+
+	var runs []workflowRun
+	seen := make(map[int64]struct{})
+	for _, c := range pr.StatusCheckRollup.Nodes[0].Commit.StatusCheckRollup.Contexts.Nodes {
+		runID := c.CheckSuite.WorkflowRun.DatabaseID
+		if c.TypeName != "CheckRun" || runID == 0 {
+			continue
+		}
+		if _, exists := seen[runID]; exists {
+			continue
+		}
+		seen[runID] = struct{}{}
+		runs = append(runs, workflowRun{id: runID, workflow: c.CheckSuite.WorkflowRun.Workflow.Name})
+	}
+	return runs
+}
+
+func humanFileSize(s int64) string {
+	if s < 1024 {
+		return fmt.Sprintf("%d B", s)
+	}
+
+	kb := float64(s) / 1024
+	if kb < 1024 {
+		return fmt.Sprintf("%.2f KiB", kb)
+	}
+
+	mb := kb / 1024
+	if mb < 1024 {
+		return fmt.Sprintf("%.2f MiB", mb)
+	}
+
+	gb := mb / 1024
+	return fmt.Sprintf("%.2f GiB", gb)
+}
@@ -0,0 +1,223 @@
+package artifacts
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/pr/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCmdArtifacts(t *testing.T) {
+	tests := []struct {
+		name  string
+		cli   string
+		wants ArtifactsOptions
+	}{
+		{
+			name:  "no arguments",
+			cli:   "",
+			wants: ArtifactsOptions{},
+		},
+		{
+			name: "pr argument",
+			cli:  "1234",
+			wants: ArtifactsOptions{
+				SelectorArg: "1234",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ios, _, _, _ := iostreams.Test()
+			f := &cmdutil.Factory{
+				IOStreams: ios,
+			}
+
+			argv, err := shlex.Split(tt.cli)
+			assert.NoError(t, err)
+
+			var gotOpts *ArtifactsOptions
+			cmd := NewCmdArtifacts(f, func(opts *ArtifactsOptions) error {
+				gotOpts = opts
+				return nil
+			})
+			cmd.SetArgs(argv)
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+
+			_, err = cmd.ExecuteC()
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wants.SelectorArg, gotOpts.SelectorArg)
+		})
+	}
+}
+
+func TestArtifactsRun(t *testing.T) {
+	prWithRuns := func() *api.PullRequest {
+		pr := &api.PullRequest{Number: 123, HeadRefName: "trunk"}
+		pr.StatusCheckRollup.Nodes = []api.StatusCheckRollupNode{{
+			Commit: api.StatusCheckRollupCommit{
+				StatusCheckRollup: api.CommitStatusCheckRollup{
+					Contexts: api.CheckContexts{
+						Nodes: []api.CheckContext{
+							{
+								TypeName: "CheckRun",
+								Name:     "build",
+								CheckSuite: api.CheckSuite{
+									WorkflowRun: api.WorkflowRun{
+										DatabaseID: 101,
+										Workflow:   api.Workflow{Name: "CI"},
+									},
+								},
+							},
+							{
+								TypeName: "CheckRun",
+								Name:     "tests",
+								CheckSuite: api.CheckSuite{
+									WorkflowRun: api.WorkflowRun{
+										DatabaseID: 101,
+										Workflow:   api.Workflow{Name: "CI"},
+									},
+								},
+							},
+							{
+								TypeName: "CheckRun",
+								Name:     "lint",
+								CheckSuite: api.CheckSuite{
+									WorkflowRun: api.WorkflowRun{
+										DatabaseID: 202,
+										Workflow:   api.Workflow{Name: "Lint"},
+									},
+								},
+							},
+							{
+								TypeName: "StatusContext",
+								Context:  "external deploy",
+							},
+						},
+					},
+				},
+			},
+		}}
+		return pr
+	}
+
+	tests := []struct {
+		name      string
+		tty       bool
+		pr        *api.PullRequest
+		httpStubs func(*httpmock.Registry)
+		wantOut   string
+		wantErr   string
+	}{
+		{
+			name: "list artifacts tty",
+			tty:  true,
+			pr:   prWithRuns(),
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/runs/101/artifacts"),
+					httpmock.StringResponse(`{"artifacts": [
+						{"name": "dist", "size_in_bytes": 2048, "expired": false},
+						{"name": "old-logs", "size_in_bytes": 512, "expired": true}
+					]}`),
+				)
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/runs/202/artifacts"),
+					httpmock.StringResponse(`{"artifacts": [
+						{"name": "report", "size_in_bytes": 100, "expired": false}
+					]}`),
+				)
+			},
+			wantOut: heredoc.Doc(`
+				NAME    SIZE      WORKFLOW  RUN
+				dist    2.00 KiB  CI        101
+				report  100 B     Lint      202
+
+				To download an artifact, run: gh run download <run> --name <name>
+				Omitted 1 expired artifacts
+			`),
+		},
+		{
+			name: "list artifacts nontty",
+			pr:   prWithRuns(),
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/runs/101/artifacts"),
+					httpmock.StringResponse(`{"artifacts": [
+						{"name": "dist", "size_in_bytes": 2048, "expired": false}
+					]}`),
+				)
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/runs/202/artifacts"),
+					httpmock.StringResponse(`{"artifacts": []}`),
+				)
+			},
+			wantOut: "dist\t2.00 KiB\tCI\t101\n",
+		},
+		{
+			name:    "no workflow runs",
+			tty:     true,
+			pr:      &api.PullRequest{Number: 123, HeadRefName: "trunk"},
+			wantErr: "no workflow runs found on pull request #123",
+		},
+		{
+			name: "no artifacts",
+			tty:  true,
+			pr:   prWithRuns(),
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/runs/101/artifacts"),
+					httpmock.StringResponse(`{"artifacts": []}`),
+				)
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/runs/202/artifacts"),
+					httpmock.StringResponse(`{"artifacts": []}`),
+				)
+			},
+			wantErr: "no artifacts found on pull request #123",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ios, _, stdout, _ := iostreams.Test()
+			ios.SetStdoutTTY(tt.tty)
+
+			reg := &httpmock.Registry{}
+			defer reg.Verify(t)
+			if tt.httpStubs != nil {
+				tt.httpStubs(reg)
+			}
+
+			opts := &ArtifactsOptions{
+				HttpClient: func() (*http.Client, error) {
+					return &http.Client{Transport: reg}, nil
+				},
+				IO:          ios,
+				SelectorArg: "123",
+				Finder:      shared.NewMockFinder("123", tt.pr, ghrepo.New("OWNER", "REPO")),
+			}
+
+			err := artifactsRun(opts)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+
+			assert.Equal(t, tt.wantOut, stdout.String())
+		})
+	}
+}
@@ -118,6 +118,8 @@ func NewCmdChecks(f *cmdutil.Factory, runF func(*ChecksOptions) error) *cobra.Co
 
 	cmdutil.AddJSONFlags(cmd, &opts.Exporter, prCheckFields)
 
+	cmd.AddCommand(NewCmdChecksRerun(f, nil))
+
 	return cmd
 }
 
@@ -251,6 +253,19 @@ func checksRun(opts *ChecksOptions) error {
 }
 
 func populateStatusChecks(client *http.Client, repo ghrepo.Interface, pr *api.PullRequest, requiredChecks bool, includeEvent bool) ([]check, checkCounts, error) {
+	contexts, err := fetchStatusCheckContexts(client, repo, pr, includeEvent)
+	if err != nil {
+		return nil, checkCounts{}, err
+	}
+
+	checks, counts := aggregateChecks(contexts, requiredChecks)
+	if len(checks) == 0 && requiredChecks {
+		return checks, counts, fmt.Errorf("no required checks reported on the '%s' branch", pr.HeadRefName)
+	}
+	return checks, counts, nil
+}
+
+func fetchStatusCheckContexts(client *http.Client, repo ghrepo.Interface, pr *api.PullRequest, includeEvent bool) ([]api.CheckContext, error) {
 	apiClient := api.NewClientFromHTTP(client)
 
 	type response struct {
@@ -276,11 +291,11 @@ func populateStatusChecks(client *http.Client, repo ghrepo.Interface, pr *api.Pu
 		var resp response
 		err := apiClient.GraphQL(repo.RepoHost(), query, variables, &resp)
 		if err != nil {
-			return nil, checkCounts{}, err
+			return nil, err
 		}
 
 		if len(resp.Node.StatusCheckRollup.Nodes) == 0 {
-			return nil, checkCounts{}, errors.New("no commit found on the pull request")
+			return nil, errors.New("no commit found on the pull request")
 		}
 
 		result := resp.Node.StatusCheckRollup.Nodes[0].Commit.StatusCheckRollup.Contexts
@@ -296,12 +311,8 @@ func populateStatusChecks(client *http.Client, repo ghrepo.Interface, pr *api.Pu
 	}
 
 	if len(statusCheckRollup.Nodes) == 0 {
-		return nil, checkCounts{}, fmt.Errorf("no checks reported on the '%s' branch", pr.HeadRefName)
+		return nil, fmt.Errorf("no checks reported on the '%s' branch", pr.HeadRefName)
 	}
 
-	checks, counts := aggregateChecks(statusCheckRollup.Nodes, requiredChecks)
-	if len(checks) == 0 && requiredChecks {
-		return checks, counts, fmt.Errorf("no required checks reported on the '%s' branch", pr.HeadRefName)
-	}
-	return checks, counts, nil
+	return statusCheckRollup.Nodes, nil
 }
@@ -0,0 +1,213 @@
+package checks
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/api"
+	fd "github.com/cli/cli/v2/internal/featuredetection"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/pr/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type ChecksRerunOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+
+	Finder   shared.PRFinder
+	Detector fd.Detector
+
+	SelectorArg string
+	Failed      bool
+	CheckName   string
+}
+
+func NewCmdChecksRerun(f *cmdutil.Factory, runF func(*ChecksRerunOptions) error) *cobra.Command {
+	opts := &ChecksRerunOptions{
+		HttpClient: f.HttpClient,
+		IO:         f.IOStreams,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "rerun [<number> | <url> | <branch>]",
+		Short: "Rerun checks for a single pull request",
+		Long: heredoc.Docf(`
+			Rerun the workflow runs behind a pull request's checks.
+
+			Without flags, every workflow run that reported a check on the pull request
+			is rerun in full. With %[1]s--failed%[1]s, only the failed jobs of runs with failing
+			checks are rerun. With %[1]s--check%[1]s, only the run that produced the named check
+			is rerun.
+
+			Checks that were not produced by GitHub Actions cannot be rerun and are
+			skipped.
+		`, "`"),
+		Example: heredoc.Doc(`
+			# Rerun the failed jobs of the current branch's pull request
+			$ gh pr checks rerun --failed
+
+			# Rerun the workflow run behind the "build" check of pull request 123
+			$ gh pr checks rerun 123 --check build
+		`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Finder = shared.NewFinder(f)
+
+			if repoOverride, _ := cmd.Flags().GetString("repo"); repoOverride != "" && len(args) == 0 {
+				return cmdutil.FlagErrorf("argument required when using the `--repo` flag")
+			}
+
+			if opts.Failed && opts.CheckName != "" {
+				return cmdutil.FlagErrorf("cannot use `--failed` flag with `--check` flag")
+			}
+
+			if len(args) > 0 {
+				opts.SelectorArg = args[0]
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return checksRerunRun(opts)
+		},
+	}
+
+	cmd.Flags().BoolVar(&opts.Failed, "failed", false, "Rerun only the failed jobs of runs with failing checks")
+	cmd.Flags().StringVar(&opts.CheckName, "check", "", "Rerun only the run that produced the check with `name`")
+
+	return cmd
+}
+
+func checksRerunRun(opts *ChecksRerunOptions) error {
+	findOptions := shared.FindOptions{
+		Selector: opts.SelectorArg,
+		Fields:   []string{"number", "headRefName"},
+	}
+	pr, repo, err := opts.Finder.Find(findOptions)
+	if err != nil {
+		return err
+	}
+
+	client, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	if opts.Detector == nil {
+		cachedClient := api.NewCachedHTTPClient(client, time.Hour*24)
+		opts.Detector = fd.NewDetector(cachedClient, repo.RepoHost())
+	}
+	var includeEvent bool
+	if features, featuresErr := opts.Detector.PullRequestFeatures(); featuresErr != nil {
+		return featuresErr
+	} else {
+		includeEvent = features.CheckRunEvent
+	}
+
+	contexts, err := fetchStatusCheckContexts(client, repo, pr, includeEvent)
+	if err != nil {
+		return err
+	}
+
+	cs := opts.IO.ColorScheme()
+
+	var runIDs []int64
+	seen := make(map[int64]struct{})
+	matched := false
+	for _, c := range eliminateDuplicates(contexts) {
+		name := c.Name
+		if name == "" {
+			name = c.Context
+		}
+
+		if opts.CheckName != "" && !strings.EqualFold(name, opts.CheckName) {
+			continue
+		}
+		matched = true
+
+		if opts.Failed && !checkIsFailed(c) {
+			continue
+		}
+
+		runID := c.CheckSuite.WorkflowRun.DatabaseID
+		if c.TypeName != "CheckRun" || runID == 0 {
+			if opts.CheckName != "" {
+				return fmt.Errorf("check %q was not produced by a GitHub Actions workflow and cannot be rerun", name)
+			}
+			fmt.Fprintf(opts.IO.ErrOut, "%s Skipping %q; it was not produced by a GitHub Actions workflow\n", cs.WarningIcon(), name)
+			continue
+		}
+
+		if _, exists := seen[runID]; exists {
+			continue
+		}
+		seen[runID] = struct{}{}
+		runIDs = append(runIDs, runID)
+	}
+
+	if opts.CheckName != "" && !matched {
+		return fmt.Errorf("no check named %q found on pull request #%d", opts.CheckName, pr.Number)
+	}
+	if len(runIDs) == 0 {
+		if opts.Failed {
+			return fmt.Errorf("no failed workflow runs found on pull request #%d", pr.Number)
+		}
+		return fmt.Errorf("no workflow runs found on pull request #%d", pr.Number)
+	}
+
+	runVerb := "rerun"
+	if opts.Failed {
+		runVerb = "rerun-failed-jobs"
+	}
+
+	apiClient := api.NewClientFromHTTP(client)
+	for _, runID := range runIDs {
+		path := fmt.Sprintf("repos/%s/actions/runs/%d/%s", ghrepo.FullName(repo), runID, runVerb)
+		if err := apiClient.REST(repo.RepoHost(), "POST", path, nil, nil); err != nil {
+			var httpError api.HTTPError
+			if errors.As(err, &httpError) && httpError.StatusCode == 403 {
+				return fmt.Errorf("run %d cannot be rerun; its workflow file may be broken", runID)
+			}
+			return fmt.Errorf("failed to rerun: %w", err)
+		}
+
+		if opts.IO.IsStdoutTTY() {
+			onlyFailedMsg := ""
+			if opts.Failed {
+				onlyFailedMsg = "(failed jobs) "
+			}
+			fmt.Fprintf(opts.IO.Out, "%s Requested rerun %sof run %s\n",
+				cs.SuccessIcon(),
+				onlyFailedMsg,
+				cs.Cyanf("%d", runID))
+		}
+	}
+
+	return nil
+}
+
+// checkIsFailed reports whether a check context falls into the "fail" bucket
+// of aggregateChecks.
+func checkIsFailed(c api.CheckContext) bool {
+	state := string(c.State)
+	if state == "" {
+		if c.Status == "COMPLETED" {
+			state = string(c.Conclusion)
+		} else {
+			state = c.Status
+		}
+	}
+	switch state {
+	case "ERROR", "FAILURE", "TIMED_OUT", "ACTION_REQUIRED":
+		return true
+	}
+	return false
+}
@@ -0,0 +1,249 @@
+package checks
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/api"
+	fd "github.com/cli/cli/v2/internal/featuredetection"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/pr/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCmdChecksRerun(t *testing.T) {
+	tests := []struct {
+		name       string
+		cli        string
+		wants      ChecksRerunOptions
+		wantsError string
+	}{
+		{
+			name:  "no arguments",
+			cli:   "",
+			wants: ChecksRerunOptions{},
+		},
+		{
+			name: "pr argument",
+			cli:  "1234",
+			wants: ChecksRerunOptions{
+				SelectorArg: "1234",
+			},
+		},
+		{
+			name: "failed flag",
+			cli:  "--failed",
+			wants: ChecksRerunOptions{
+				Failed: true,
+			},
+		},
+		{
+			name: "check flag",
+			cli:  "--check build",
+			wants: ChecksRerunOptions{
+				CheckName: "build",
+			},
+		},
+		{
+			name:       "failed flag with check flag",
+			cli:        "--failed --check build",
+			wantsError: "cannot use `--failed` flag with `--check` flag",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ios, _, _, _ := iostreams.Test()
+			f := &cmdutil.Factory{
+				IOStreams: ios,
+			}
+
+			argv, err := shlex.Split(tt.cli)
+			assert.NoError(t, err)
+
+			var gotOpts *ChecksRerunOptions
+			cmd := NewCmdChecksRerun(f, func(opts *ChecksRerunOptions) error {
+				gotOpts = opts
+				return nil
+			})
+			cmd.SetArgs(argv)
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+
+			_, err = cmd.ExecuteC()
+			if tt.wantsError != "" {
+				assert.EqualError(t, err, tt.wantsError)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wants.SelectorArg, gotOpts.SelectorArg)
+			assert.Equal(t, tt.wants.Failed, gotOpts.Failed)
+			assert.Equal(t, tt.wants.CheckName, gotOpts.CheckName)
+		})
+	}
+}
+
+func TestChecksRerunRun(t *testing.T) {
+	tests := []struct {
+		name       string
+		tty        bool
+		failed     bool
+		checkName  string
+		httpStubs  func(*httpmock.Registry)
+		wantOut    string
+		wantErrOut string
+		wantErr    string
+	}{
+		{
+			name: "rerun all tty",
+			tty:  true,
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.GraphQL(`query PullRequestStatusChecks\b`),
+					httpmock.FileResponse("./fixtures/rerunChecks.json"),
+				)
+				reg.Register(
+					httpmock.REST("POST", "repos/OWNER/REPO/actions/runs/101/rerun"),
+					httpmock.StringResponse("{}"),
+				)
+				reg.Register(
+					httpmock.REST("POST", "repos/OWNER/REPO/actions/runs/202/rerun"),
+					httpmock.StringResponse("{}"),
+				)
+			},
+			wantOut: heredoc.Doc(`
+				✓ Requested rerun of run 101
+				✓ Requested rerun of run 202
+			`),
+			wantErrOut: "! Skipping \"external deploy\"; it was not produced by a GitHub Actions workflow\n",
+		},
+		{
+			name:   "rerun failed tty",
+			tty:    true,
+			failed: true,
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.GraphQL(`query PullRequestStatusChecks\b`),
+					httpmock.FileResponse("./fixtures/rerunChecks.json"),
+				)
+				reg.Register(
+					httpmock.REST("POST", "repos/OWNER/REPO/actions/runs/202/rerun-failed-jobs"),
+					httpmock.StringResponse("{}"),
+				)
+			},
+			wantOut: "✓ Requested rerun (failed jobs) of run 202\n",
+		},
+		{
+			name:      "rerun named check",
+			tty:       true,
+			checkName: "cool tests",
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.GraphQL(`query PullRequestStatusChecks\b`),
+					httpmock.FileResponse("./fixtures/rerunChecks.json"),
+				)
+				reg.Register(
+					httpmock.REST("POST", "repos/OWNER/REPO/actions/runs/101/rerun"),
+					httpmock.StringResponse("{}"),
+				)
+			},
+			wantOut: "✓ Requested rerun of run 101\n",
+		},
+		{
+			name:      "named check not found",
+			tty:       true,
+			checkName: "nope",
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.GraphQL(`query PullRequestStatusChecks\b`),
+					httpmock.FileResponse("./fixtures/rerunChecks.json"),
+				)
+			},
+			wantErr: `no check named "nope" found on pull request #123`,
+		},
+		{
+			name:      "named check is not a workflow run",
+			tty:       true,
+			checkName: "external deploy",
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.GraphQL(`query PullRequestStatusChecks\b`),
+					httpmock.FileResponse("./fixtures/rerunChecks.json"),
+				)
+			},
+			wantErr: `check "external deploy" was not produced by a GitHub Actions workflow and cannot be rerun`,
+		},
+		{
+			name:   "no failed checks",
+			tty:    true,
+			failed: true,
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.GraphQL(`query PullRequestStatusChecks\b`),
+					httpmock.FileResponse("./fixtures/allPassing.json"),
+				)
+			},
+			wantErr: "no failed workflow runs found on pull request #123",
+		},
+		{
+			name: "broken workflow file",
+			tty:  true,
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.GraphQL(`query PullRequestStatusChecks\b`),
+					httpmock.FileResponse("./fixtures/rerunChecks.json"),
+				)
+				reg.Register(
+					httpmock.REST("POST", "repos/OWNER/REPO/actions/runs/101/rerun"),
+					httpmock.StatusStringResponse(403, "Unable to retry this workflow run"),
+				)
+			},
+			wantErrOut: "! Skipping \"external deploy\"; it was not produced by a GitHub Actions workflow\n",
+			wantErr:    "run 101 cannot be rerun; its workflow file may be broken",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ios, _, stdout, stderr := iostreams.Test()
+			ios.SetStdoutTTY(tt.tty)
+
+			reg := &httpmock.Registry{}
+			defer reg.Verify(t)
+			if tt.httpStubs != nil {
+				tt.httpStubs(reg)
+			}
+
+			response := &api.PullRequest{Number: 123, HeadRefName: "trunk"}
+
+			opts := &ChecksRerunOptions{
+				HttpClient: func() (*http.Client, error) {
+					return &http.Client{Transport: reg}, nil
+				},
+				IO:          ios,
+				SelectorArg: "123",
+				Finder:      shared.NewMockFinder("123", response, ghrepo.New("OWNER", "REPO")),
+				Detector:    &fd.EnabledDetectorMock{},
+				Failed:      tt.failed,
+				CheckName:   tt.checkName,
+			}
+
+			err := checksRerunRun(opts)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, tt.wantOut, stdout.String())
+			assert.Equal(t, tt.wantErrOut, stderr.String())
+		})
+	}
+}
@@ -0,0 +1,191 @@
+package conflicts
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/api"
+	cliContext "github.com/cli/cli/v2/context"
+	"github.com/cli/cli/v2/git"
+	"github.com/cli/cli/v2/internal/text"
+	"github.com/cli/cli/v2/pkg/cmd/pr/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type ConflictsOptions struct {
+	IO        *iostreams.IOStreams
+	GitClient *git.Client
+	Remotes   func() (cliContext.Remotes, error)
+	Branch    func() (string, error)
+
+	Finder shared.PRFinder
+
+	SelectorArg string
+	Checkout    bool
+}
+
+func NewCmdConflicts(f *cmdutil.Factory, runF func(*ConflictsOptions) error) *cobra.Command {
+	opts := &ConflictsOptions{
+		IO:        f.IOStreams,
+		GitClient: f.GitClient,
+		Remotes:   f.Remotes,
+		Branch:    f.Branch,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "conflicts [<number> | <url> | <branch>]",
+		Short: "Show merge conflicts for a pull request",
+		Long: heredoc.Docf(`
+			Show the files of a pull request that conflict with its base branch.
+
+			The conflicting file list is computed locally with %[1]sgit merge-tree%[1]s, so this
+			command must be run from inside a clone of the repository.
+
+			With %[1]s--checkout%[1]s, the base branch is merged into the checked out pull
+			request branch without committing, leaving conflict markers in the working
+			tree ready for resolution.
+		`, "`"),
+		Example: heredoc.Doc(`
+			$ gh pr conflicts 123
+
+			# start resolving conflicts locally
+			$ gh pr checkout 123
+			$ gh pr conflicts 123 --checkout
+		`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Finder = shared.NewFinder(f)
+
+			if len(args) > 0 {
+				opts.SelectorArg = args[0]
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return conflictsRun(opts)
+		},
+	}
+
+	cmd.Flags().BoolVar(&opts.Checkout, "checkout", false, "Merge the base branch into the checked out pull request branch for local resolution")
+
+	return cmd
+}
+
+func conflictsRun(opts *ConflictsOptions) error {
+	findOptions := shared.FindOptions{
+		Selector: opts.SelectorArg,
+		Fields:   []string{"number", "mergeable", "baseRefName", "headRefName"},
+	}
+	pr, baseRepo, err := opts.Finder.Find(findOptions)
+	if err != nil {
+		return err
+	}
+
+	cs := opts.IO.ColorScheme()
+
+	switch pr.Mergeable {
+	case api.PullRequestMergeableMergeable:
+		fmt.Fprintf(opts.IO.Out, "%s #%d has no conflicts with %s\n", cs.SuccessIcon(), pr.Number, pr.BaseRefName)
+		return nil
+	case api.PullRequestMergeableUnknown:
+		return fmt.Errorf("GitHub is still determining the merge state of #%d; try again in a few seconds", pr.Number)
+	}
+
+	ctx := context.Background()
+
+	remotes, err := opts.Remotes()
+	if err != nil {
+		return err
+	}
+	baseRemote, err := remotes.FindByRepo(baseRepo.RepoOwner(), baseRepo.RepoName())
+	if err != nil {
+		return fmt.Errorf("no git remote found for %s/%s: %w", baseRepo.RepoOwner(), baseRepo.RepoName(), err)
+	}
+
+	baseOID, err := fetchRef(ctx, opts.GitClient, baseRemote.Name, "refs/heads/"+pr.BaseRefName)
+	if err != nil {
+		return fmt.Errorf("error fetching base branch %q: %w", pr.BaseRefName, err)
+	}
+	headOID, err := fetchRef(ctx, opts.GitClient, baseRemote.Name, fmt.Sprintf("refs/pull/%d/head", pr.Number))
+	if err != nil {
+		return fmt.Errorf("error fetching pull request head: %w", err)
+	}
+
+	conflictingFiles, err := mergeTreeConflicts(ctx, opts.GitClient, baseOID, headOID)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(opts.IO.ErrOut, "%s #%d has conflicts with %s in %s\n",
+		cs.FailureIcon(), pr.Number, pr.BaseRefName, text.Pluralize(len(conflictingFiles), "file"))
+	for _, file := range conflictingFiles {
+		fmt.Fprintf(opts.IO.Out, "%s\n", file)
+	}
+
+	if !opts.Checkout {
+		return cmdutil.SilentError
+	}
+
+	currentBranch, err := opts.Branch()
+	if err != nil {
+		return err
+	}
+	if currentBranch != pr.HeadRefName {
+		return fmt.Errorf("the pull request branch must be checked out first: gh pr checkout %d", pr.Number)
+	}
+
+	// The merge is expected to stop with conflicts; that is the state we are
+	// bootstrapping, so only report errors unrelated to conflict markers.
+	mergeCmd, err := opts.GitClient.Command(ctx, "merge", "--no-commit", "--no-ff", baseOID)
+	if err != nil {
+		return err
+	}
+	_ = mergeCmd.Run()
+
+	fmt.Fprintf(opts.IO.ErrOut, "%s Merged %s into %s; resolve the conflicts and commit the result\n",
+		cs.WarningIcon(), pr.BaseRefName, currentBranch)
+
+	return nil
+}
+
+// fetchRef fetches a single ref from the remote and returns the commit it resolved to.
+func fetchRef(ctx context.Context, gitClient *git.Client, remote, refspec string) (string, error) {
+	if err := gitClient.Fetch(ctx, remote, refspec); err != nil {
+		return "", err
+	}
+
+	revCmd, err := gitClient.Command(ctx, "rev-parse", "FETCH_HEAD")
+	if err != nil {
+		return "", err
+	}
+	out, err := revCmd.Output()
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
+// mergeTreeConflicts returns the files that conflict when merging head into base,
+// computed without touching the working tree.
+func mergeTreeConflicts(ctx context.Context, gitClient *git.Client, baseOID, headOID string) ([]string, error) {
+	cmd, err := gitClient.Command(ctx, "merge-tree", "--write-tree", "--name-only", "--no-messages", baseOID, headOID)
+	if err != nil {
+		return nil, err
+	}
+
+	// merge-tree exits with status 1 when the merge has conflicts, which is the
+	// case we are interested in, so the exit status is ignored here.
+	out, _ := cmd.Output()
+
+	lines := strings.Split(strings.TrimSpace(string(out)), "\n")
+	if len(lines) < 2 {
+		return nil, nil
+	}
+	// The first line is the merged tree OID; the rest are conflicted paths.
+	return lines[1:], nil
+}
@@ -0,0 +1,139 @@
+package conflicts
+
+import (
+	"testing"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/pr/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_NewCmdConflicts(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    string
+		want    ConflictsOptions
+		wantErr string
+	}{
+		{
+			name: "number argument",
+			args: "123",
+			want: ConflictsOptions{
+				SelectorArg: "123",
+			},
+		},
+		{
+			name: "no argument",
+			args: "",
+			want: ConflictsOptions{
+				SelectorArg: "",
+			},
+		},
+		{
+			name: "checkout flag",
+			args: "123 --checkout",
+			want: ConflictsOptions{
+				SelectorArg: "123",
+				Checkout:    true,
+			},
+		},
+		{
+			name:    "too many arguments",
+			args:    "123 456",
+			wantErr: "accepts at most 1 arg(s), received 2",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ios, _, _, _ := iostreams.Test()
+
+			f := &cmdutil.Factory{
+				IOStreams: ios,
+			}
+
+			var opts *ConflictsOptions
+			cmd := NewCmdConflicts(f, func(o *ConflictsOptions) error {
+				opts = o
+				return nil
+			})
+
+			argv, err := shlex.Split(tt.args)
+			require.NoError(t, err)
+			cmd.SetArgs(argv)
+
+			cmd.SetIn(&nopReader{})
+			cmd.SetOut(&nopWriter{})
+			cmd.SetErr(&nopWriter{})
+
+			_, err = cmd.ExecuteC()
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.want.SelectorArg, opts.SelectorArg)
+			assert.Equal(t, tt.want.Checkout, opts.Checkout)
+		})
+	}
+}
+
+func Test_conflictsRun(t *testing.T) {
+	tests := []struct {
+		name       string
+		pr         *api.PullRequest
+		wantStdout string
+		wantErr    string
+	}{
+		{
+			name: "no conflicts",
+			pr: &api.PullRequest{
+				Number:      123,
+				Mergeable:   api.PullRequestMergeableMergeable,
+				BaseRefName: "main",
+			},
+			wantStdout: "✓ #123 has no conflicts with main\n",
+		},
+		{
+			name: "merge state unknown",
+			pr: &api.PullRequest{
+				Number:    123,
+				Mergeable: api.PullRequestMergeableUnknown,
+			},
+			wantErr: "GitHub is still determining the merge state of #123; try again in a few seconds",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ios, _, stdout, _ := iostreams.Test()
+
+			opts := &ConflictsOptions{
+				IO:          ios,
+				Finder:      shared.NewMockFinder("123", tt.pr, ghrepo.New("OWNER", "REPO")),
+				SelectorArg: "123",
+			}
+
+			err := conflictsRun(opts)
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.wantStdout, stdout.String())
+		})
+	}
+}
+
+type nopReader struct{}
+
+func (nopReader) Read([]byte) (int, error) { return 0, nil }
+
+type nopWriter struct{}
+
+func (nopWriter) Write(p []byte) (int, error) { return len(p), nil }
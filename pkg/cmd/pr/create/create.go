@@ -68,6 +68,7 @@ type CreateOptions struct {
 
 	MaintainerCanModify bool
 	Template            string
+	FromPatch           string
 
 	DryRun bool
 }
@@ -216,6 +217,15 @@ func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Co
 				return cmdutil.FlagErrorf("`--dry-run` is not supported when using `--web`")
 			}
 
+			if opts.FromPatch != "" {
+				if opts.HeadBranch == "" {
+					return cmdutil.FlagErrorf("`--head` is required to name the new branch when using `--from-patch`")
+				}
+				if opts.WebMode {
+					return cmdutil.FlagErrorf("the `--from-patch` flag is not supported with `--web`")
+				}
+			}
+
 			if runF != nil {
 				return runF(opts)
 			}
@@ -242,6 +252,7 @@ func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Co
 	fl.StringVarP(&opts.Milestone, "milestone", "m", "", "Add the pull request to a milestone by `name`")
 	fl.Bool("no-maintainer-edit", false, "Disable maintainer's ability to modify pull request")
 	fl.StringVar(&opts.RecoverFile, "recover", "", "Recover input from a failed run of create")
+	fl.StringVar(&opts.FromPatch, "from-patch", "", "Apply an mbox-formatted patch series from `file` onto a new branch named by `--head` before creating the pull request")
 	fl.StringVarP(&opts.Template, "template", "T", "", "Template `file` to use as starting body text")
 	fl.BoolVar(&opts.DryRun, "dry-run", false, "Print details instead of creating the PR. May still push git changes.")
 
@@ -259,6 +270,12 @@ func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Co
 }
 
 func createRun(opts *CreateOptions) (err error) {
+	if opts.FromPatch != "" {
+		if err := applyPatchSeries(opts); err != nil {
+			return err
+		}
+	}
+
 	ctx, err := NewCreateContext(opts)
 	if err != nil {
 		return
@@ -880,6 +897,43 @@ func previewPR(opts CreateOptions, openURL string) error {
 	return opts.Browser.Browse(openURL)
 }
 
+// applyPatchSeries creates the head branch from the current HEAD and applies an
+// mbox-formatted patch series onto it with `git am`. The branch is left checked
+// out so that the regular create flow pushes it.
+func applyPatchSeries(opts *CreateOptions) error {
+	ctx := context.Background()
+	gitClient := opts.GitClient
+
+	if _, err := os.Stat(opts.FromPatch); err != nil {
+		return fmt.Errorf("could not read patch file: %w", err)
+	}
+
+	if gitClient.HasLocalBranch(ctx, opts.HeadBranch) {
+		return fmt.Errorf("branch %q already exists", opts.HeadBranch)
+	}
+
+	checkoutCmd, err := gitClient.Command(ctx, "checkout", "-b", opts.HeadBranch)
+	if err != nil {
+		return err
+	}
+	if _, err := checkoutCmd.Output(); err != nil {
+		return fmt.Errorf("could not create branch %q: %w", opts.HeadBranch, err)
+	}
+
+	amCmd, err := gitClient.Command(ctx, "am", opts.FromPatch)
+	if err != nil {
+		return err
+	}
+	if _, err := amCmd.Output(); err != nil {
+		if abortCmd, abortErr := gitClient.Command(ctx, "am", "--abort"); abortErr == nil {
+			_, _ = abortCmd.Output()
+		}
+		return fmt.Errorf("could not apply patch series %q: %w", opts.FromPatch, err)
+	}
+
+	return nil
+}
+
 func handlePush(opts CreateOptions, ctx CreateContext) error {
 	didForkRepo := false
 	headRepo := ctx.HeadRepo
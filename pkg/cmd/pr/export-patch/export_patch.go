@@ -0,0 +1,129 @@
+package exportpatch
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghinstance"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/pr/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type ExportPatchOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+
+	Finder shared.PRFinder
+
+	SelectorArg string
+	Output      string
+}
+
+func NewCmdExportPatch(f *cmdutil.Factory, runF func(*ExportPatchOptions) error) *cobra.Command {
+	opts := &ExportPatchOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "export-patch [<number> | <url> | <branch>]",
+		Short: "Export the commits of a pull request as an mbox patch series",
+		Long: heredoc.Docf(`
+			Export the commits of a pull request in %[1]sgit format-patch%[1]s style, as an
+			mbox file suitable for %[1]sgit am%[1]s or an email-based review workflow.
+
+			Without an argument, the pull request that belongs to the current branch
+			is selected.
+		`, "`"),
+		Example: heredoc.Doc(`
+			$ gh pr export-patch 123 --output series.mbox
+			$ gh pr export-patch 123 | git am
+		`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Finder = shared.NewFinder(f)
+
+			if len(args) > 0 {
+				opts.SelectorArg = args[0]
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return exportPatchRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Output, "output", "o", "", "Write the patch series to a file instead of stdout")
+
+	return cmd
+}
+
+func exportPatchRun(opts *ExportPatchOptions) error {
+	findOptions := shared.FindOptions{
+		Selector: opts.SelectorArg,
+		Fields:   []string{"number"},
+	}
+	pr, baseRepo, err := opts.Finder.Find(findOptions)
+	if err != nil {
+		return err
+	}
+
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	patch, err := fetchPatch(httpClient, baseRepo, pr.Number)
+	if err != nil {
+		return fmt.Errorf("could not find pull request patch: %w", err)
+	}
+	defer patch.Close()
+
+	var out io.Writer = opts.IO.Out
+	if opts.Output != "" {
+		f, err := os.Create(opts.Output)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		out = f
+	}
+
+	_, err = io.Copy(out, patch)
+	return err
+}
+
+func fetchPatch(httpClient *http.Client, baseRepo ghrepo.Interface, prNumber int) (io.ReadCloser, error) {
+	url := fmt.Sprintf(
+		"%srepos/%s/pulls/%d",
+		ghinstance.RESTPrefix(baseRepo.RepoHost()),
+		ghrepo.FullName(baseRepo),
+		prNumber,
+	)
+
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Accept", "application/vnd.github.v3.patch")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != 200 {
+		return nil, api.HandleHTTPError(resp)
+	}
+
+	return resp.Body, nil
+}
@@ -0,0 +1,111 @@
+package exportpatch
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/pr/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_NewCmdExportPatch(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    string
+		want    ExportPatchOptions
+		wantErr string
+	}{
+		{
+			name: "number argument",
+			args: "123",
+			want: ExportPatchOptions{
+				SelectorArg: "123",
+			},
+		},
+		{
+			name: "output file",
+			args: "123 --output series.mbox",
+			want: ExportPatchOptions{
+				SelectorArg: "123",
+				Output:      "series.mbox",
+			},
+		},
+		{
+			name:    "too many arguments",
+			args:    "123 456",
+			wantErr: "accepts at most 1 arg(s), received 2",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ios, _, _, _ := iostreams.Test()
+			f := &cmdutil.Factory{
+				IOStreams: ios,
+			}
+
+			var opts *ExportPatchOptions
+			cmd := NewCmdExportPatch(f, func(o *ExportPatchOptions) error {
+				opts = o
+				return nil
+			})
+
+			argv, err := shlex.Split(tt.args)
+			require.NoError(t, err)
+			cmd.SetArgs(argv)
+
+			cmd.SetIn(&nopReader{})
+			cmd.SetOut(&nopWriter{})
+			cmd.SetErr(&nopWriter{})
+
+			_, err = cmd.ExecuteC()
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.want.SelectorArg, opts.SelectorArg)
+			assert.Equal(t, tt.want.Output, opts.Output)
+		})
+	}
+}
+
+func Test_exportPatchRun(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+
+	patchBody := "From abc123 Mon Sep 17 00:00:00 2001\nSubject: [PATCH] fix the thing\n"
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/pulls/123"),
+		httpmock.StringResponse(patchBody),
+	)
+
+	ios, _, stdout, _ := iostreams.Test()
+	opts := &ExportPatchOptions{
+		IO: ios,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		Finder:      shared.NewMockFinder("123", &api.PullRequest{Number: 123}, ghrepo.New("OWNER", "REPO")),
+		SelectorArg: "123",
+	}
+
+	err := exportPatchRun(opts)
+	require.NoError(t, err)
+	assert.Equal(t, patchBody, stdout.String())
+}
+
+type nopReader struct{}
+
+func (nopReader) Read([]byte) (int, error) { return 0, nil }
+
+type nopWriter struct{}
+
+func (nopWriter) Write(p []byte) (int, error) { return len(p), nil }
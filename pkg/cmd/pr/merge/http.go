@@ -118,6 +118,28 @@ func disableAutoMerge(client *http.Client, repo ghrepo.Interface, prID string) e
 	return gql.Mutate(repo.RepoHost(), "PullRequestAutoMergeDisable", &mutation, variables)
 }
 
+func pullRequestMergeability(client *http.Client, repo ghrepo.Interface, prID string) (state, mergeStateStatus string, err error) {
+	var query struct {
+		Node struct {
+			PullRequest struct {
+				State            string
+				MergeStateStatus string
+			} `graphql:"...on PullRequest"`
+		} `graphql:"node(id: $prID)"`
+	}
+
+	variables := map[string]interface{}{
+		"prID": githubv4.ID(prID),
+	}
+
+	gql := api.NewClientFromHTTP(client)
+	if err := gql.Query(repo.RepoHost(), "PullRequestMergeability", &query, variables); err != nil {
+		return "", "", err
+	}
+
+	return query.Node.PullRequest.State, query.Node.PullRequest.MergeStateStatus, nil
+}
+
 func getMergeText(client *http.Client, repo ghrepo.Interface, prID string, mergeMethod PullRequestMergeMethod) (string, string, error) {
 	var method githubv4.PullRequestMergeMethod
 	switch mergeMethod {
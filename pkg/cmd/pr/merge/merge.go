@@ -5,6 +5,9 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"os"
+	"os/exec"
+	"time"
 
 	"github.com/MakeNowJust/heredoc"
 	"github.com/cli/cli/v2/api"
@@ -12,13 +15,18 @@ import (
 	"github.com/cli/cli/v2/git"
 	"github.com/cli/cli/v2/internal/gh"
 	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/internal/run"
 	"github.com/cli/cli/v2/pkg/cmd/pr/shared"
 	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/findsh"
 	"github.com/cli/cli/v2/pkg/iostreams"
 	"github.com/cli/cli/v2/pkg/surveyext"
 	"github.com/spf13/cobra"
 )
 
+// defaultWatchInterval is how often the pull request is polled in `--when-checks-pass` mode.
+const defaultWatchInterval time.Duration = 30 * time.Second
+
 type editor interface {
 	Edit(string, string) (string, error)
 }
@@ -40,6 +48,11 @@ type MergeOptions struct {
 	AutoMergeEnable  bool
 	AutoMergeDisable bool
 
+	WhenChecksPass bool
+	WatchInterval  time.Duration
+	WatchTimeout   time.Duration
+	NotifyHook     string
+
 	AuthorEmail string
 
 	Body    string
@@ -74,6 +87,7 @@ func NewCmdMerge(f *cmdutil.Factory, runF func(*MergeOptions) error) *cobra.Comm
 	)
 
 	var bodyFile string
+	var watchInterval int
 
 	cmd := &cobra.Command{
 		Use:   "merge [<number> | <url> | <branch>]",
@@ -88,6 +102,13 @@ func NewCmdMerge(f *cmdutil.Factory, runF func(*MergeOptions) error) *cobra.Comm
 			If required checks have not yet passed, auto-merge will be enabled.
 			If required checks have passed, the pull request will be added to the merge queue.
 			To bypass a merge queue and merge directly, pass the %[1]s--admin%[1]s flag.
+
+			With %[1]s--when-checks-pass%[1]s, the pull request is polled in the foreground until
+			its checks and review requirements are met, and is then merged. Unlike %[1]s--auto%[1]s,
+			nothing is enabled on the server, so this also works in repositories where
+			auto-merge is disabled. Use %[1]s--timeout%[1]s to give up after a duration, and
+			%[1]s--notify-hook%[1]s to run a shell command once waiting finishes; the hook runs
+			with %[1]sGH_REPO%[1]s, %[1]sGH_PR_NUMBER%[1]s, and %[1]sGH_MERGE_STATUS%[1]s set in its environment.
 		`, "`"),
 		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -127,14 +148,36 @@ func NewCmdMerge(f *cmdutil.Factory, runF func(*MergeOptions) error) *cobra.Comm
 			bodyFileProvided := bodyFile != ""
 
 			if err := cmdutil.MutuallyExclusive(
-				"specify only one of `--auto`, `--disable-auto`, or `--admin`",
+				"specify only one of `--auto`, `--disable-auto`, `--admin`, or `--when-checks-pass`",
 				opts.AutoMergeEnable,
 				opts.AutoMergeDisable,
 				opts.UseAdmin,
+				opts.WhenChecksPass,
 			); err != nil {
 				return err
 			}
 
+			intervalChanged := cmd.Flags().Changed("interval")
+			if !opts.WhenChecksPass {
+				if intervalChanged {
+					return cmdutil.FlagErrorf("cannot use `--interval` flag without `--when-checks-pass` flag")
+				}
+				if cmd.Flags().Changed("timeout") {
+					return cmdutil.FlagErrorf("cannot use `--timeout` flag without `--when-checks-pass` flag")
+				}
+				if opts.NotifyHook != "" {
+					return cmdutil.FlagErrorf("cannot use `--notify-hook` flag without `--when-checks-pass` flag")
+				}
+			}
+
+			if intervalChanged {
+				var err error
+				opts.WatchInterval, err = time.ParseDuration(fmt.Sprintf("%ds", watchInterval))
+				if err != nil {
+					return cmdutil.FlagErrorf("could not parse `--interval` flag: %w", err)
+				}
+			}
+
 			if err := cmdutil.MutuallyExclusive(
 				"specify only one of `--body` or `--body-file`",
 				bodyProvided,
@@ -181,6 +224,10 @@ func NewCmdMerge(f *cmdutil.Factory, runF func(*MergeOptions) error) *cobra.Comm
 	cmd.Flags().BoolVarP(&flagSquash, "squash", "s", false, "Squash the commits into one commit and merge it into the base branch")
 	cmd.Flags().BoolVar(&opts.AutoMergeEnable, "auto", false, "Automatically merge only after necessary requirements are met")
 	cmd.Flags().BoolVar(&opts.AutoMergeDisable, "disable-auto", false, "Disable auto-merge for this pull request")
+	cmd.Flags().BoolVar(&opts.WhenChecksPass, "when-checks-pass", false, "Wait in the foreground until the pull request can be merged, then merge it")
+	cmd.Flags().IntVarP(&watchInterval, "interval", "i", 30, "Refresh interval in seconds when using `--when-checks-pass` flag")
+	cmd.Flags().DurationVar(&opts.WatchTimeout, "timeout", 0, "Give up after the specified `duration` when using `--when-checks-pass` flag, e.g. \"30m\"")
+	cmd.Flags().StringVar(&opts.NotifyHook, "notify-hook", "", "Shell `command` to run when waiting finishes when using `--when-checks-pass` flag")
 	cmd.Flags().StringVar(&opts.MatchHeadCommit, "match-head-commit", "", "Commit `SHA` that the pull request head must match to allow merge")
 	cmd.Flags().StringVarP(&opts.AuthorEmail, "author-email", "A", "", "Email `text` for merge commit author")
 	return cmd
@@ -270,6 +317,83 @@ func (m *mergeContext) canMerge() error {
 	return cmdutil.SilentError
 }
 
+// Poll the pull request in the foreground until its merge requirements are met.
+// Unlike auto-merge, nothing is enabled on the server side.
+func (m *mergeContext) waitForMergeability() error {
+	if !m.opts.WhenChecksPass {
+		return nil
+	}
+
+	interval := m.opts.WatchInterval
+	if interval <= 0 {
+		interval = defaultWatchInterval
+	}
+
+	var deadline time.Time
+	if m.opts.WatchTimeout > 0 {
+		deadline = time.Now().Add(m.opts.WatchTimeout)
+	}
+
+	waiting := false
+	for {
+		if m.merged || m.pr.State == MergeStateStatusMerged {
+			m.merged = true
+			return m.runNotifyHook("merged")
+		}
+		if m.pr.State == "CLOSED" {
+			_ = m.runNotifyHook("closed")
+			return fmt.Errorf("pull request %s#%d was closed", ghrepo.FullName(m.baseRepo), m.pr.Number)
+		}
+		if isImmediatelyMergeable(m.pr.MergeStateStatus) {
+			return m.runNotifyHook("mergeable")
+		}
+		if !deadline.IsZero() && !time.Now().Before(deadline) {
+			_ = m.runNotifyHook("timeout")
+			return fmt.Errorf("timed out waiting for pull request %s#%d to become mergeable", ghrepo.FullName(m.baseRepo), m.pr.Number)
+		}
+
+		if !waiting {
+			_ = m.warnf("Waiting for pull request %s#%d to become mergeable; checking every %s. Press Ctrl+C to quit.\n", ghrepo.FullName(m.baseRepo), m.pr.Number, interval)
+			waiting = true
+		}
+
+		time.Sleep(interval)
+
+		state, mergeStateStatus, err := pullRequestMergeability(m.httpClient, m.baseRepo, m.pr.ID)
+		if err != nil {
+			return err
+		}
+		m.pr.State = state
+		m.pr.MergeStateStatus = mergeStateStatus
+	}
+}
+
+// Run the notification hook, if any, with the outcome of the wait in its environment.
+// A failing hook does not abort the merge.
+func (m *mergeContext) runNotifyHook(status string) error {
+	if m.opts.NotifyHook == "" {
+		return nil
+	}
+
+	shPath, err := findsh.Find()
+	if err != nil {
+		return err
+	}
+
+	cmd := exec.Command(shPath, "-c", m.opts.NotifyHook)
+	cmd.Env = append(os.Environ(),
+		fmt.Sprintf("GH_REPO=%s", ghrepo.FullName(m.baseRepo)),
+		fmt.Sprintf("GH_PR_NUMBER=%d", m.pr.Number),
+		fmt.Sprintf("GH_MERGE_STATUS=%s", status),
+	)
+	cmd.Stdout = m.opts.IO.Out
+	cmd.Stderr = m.opts.IO.ErrOut
+	if err := run.PrepareCmd(cmd).Run(); err != nil {
+		_ = m.warnf("%s notify hook failed: %v\n", m.cs.WarningIcon(), err)
+	}
+	return nil
+}
+
 // Merge the pull request. May prompt the user for input parameters for the merge.
 func (m *mergeContext) merge() error {
 	if m.merged {
@@ -531,6 +655,10 @@ func mergeRun(opts *MergeOptions) error {
 
 	ctx.warnIfDiverged()
 
+	if err := ctx.waitForMergeability(); err != nil {
+		return err
+	}
+
 	if err := ctx.canMerge(); err != nil {
 		return err
 	}
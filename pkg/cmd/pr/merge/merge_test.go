@@ -11,6 +11,7 @@ import (
 	"regexp"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/MakeNowJust/heredoc"
 	"github.com/cli/cli/v2/api"
@@ -156,6 +157,55 @@ func Test_NewCmdMerge(t *testing.T) {
 				AuthorEmail:             "octocat@github.com",
 			},
 		},
+		{
+			name:  "when-checks-pass specified",
+			args:  "123 --when-checks-pass --timeout 30m --notify-hook 'notify-send done'",
+			isTTY: true,
+			want: MergeOptions{
+				SelectorArg:             "123",
+				DeleteBranch:            false,
+				IsDeleteBranchIndicated: false,
+				CanDeleteLocalBranch:    true,
+				MergeMethod:             PullRequestMergeMethodMerge,
+				MergeStrategyEmpty:      true,
+				Body:                    "",
+				BodySet:                 false,
+				AuthorEmail:             "",
+				WhenChecksPass:          true,
+				WatchTimeout:            30 * time.Minute,
+				NotifyHook:              "notify-send done",
+			},
+		},
+		{
+			name:  "when-checks-pass with interval",
+			args:  "123 --when-checks-pass --interval 5",
+			isTTY: true,
+			want: MergeOptions{
+				SelectorArg:             "123",
+				DeleteBranch:            false,
+				IsDeleteBranchIndicated: false,
+				CanDeleteLocalBranch:    true,
+				MergeMethod:             PullRequestMergeMethodMerge,
+				MergeStrategyEmpty:      true,
+				Body:                    "",
+				BodySet:                 false,
+				AuthorEmail:             "",
+				WhenChecksPass:          true,
+				WatchInterval:           5 * time.Second,
+			},
+		},
+		{
+			name:    "interval without when-checks-pass",
+			args:    "123 --interval 5",
+			isTTY:   true,
+			wantErr: "cannot use `--interval` flag without `--when-checks-pass` flag",
+		},
+		{
+			name:    "when-checks-pass with auto",
+			args:    "123 --when-checks-pass --auto",
+			isTTY:   true,
+			wantErr: "specify only one of `--auto`, `--disable-auto`, `--admin`, or `--when-checks-pass`",
+		},
 		{
 			name:    "body and body-file flags",
 			args:    "123 --body 'test' --body-file 'test-file.txt'",
@@ -228,6 +278,10 @@ func Test_NewCmdMerge(t *testing.T) {
 			assert.Equal(t, tt.want.BodySet, opts.BodySet)
 			assert.Equal(t, tt.want.MatchHeadCommit, opts.MatchHeadCommit)
 			assert.Equal(t, tt.want.AuthorEmail, opts.AuthorEmail)
+			assert.Equal(t, tt.want.WhenChecksPass, opts.WhenChecksPass)
+			assert.Equal(t, tt.want.WatchInterval, opts.WatchInterval)
+			assert.Equal(t, tt.want.WatchTimeout, opts.WatchTimeout)
+			assert.Equal(t, tt.want.NotifyHook, opts.NotifyHook)
 		})
 	}
 }
@@ -1617,6 +1671,88 @@ func TestMergeRun_autoMerge_directMerge(t *testing.T) {
 	assert.Equal(t, "✓ Merged pull request OWNER/REPO#123 ()\n", stderr.String())
 }
 
+func TestMergeRun_whenChecksPass(t *testing.T) {
+	ios, _, stdout, stderr := iostreams.Test()
+	ios.SetStdoutTTY(true)
+	ios.SetStderrTTY(true)
+
+	tr := initFakeHTTP()
+	defer tr.Verify(t)
+	tr.Register(
+		httpmock.GraphQL(`query PullRequestMergeability\b`),
+		httpmock.StringResponse(`{"data":{"node":{"state":"OPEN","mergeStateStatus":"BLOCKED"}}}`))
+	tr.Register(
+		httpmock.GraphQL(`query PullRequestMergeability\b`),
+		httpmock.StringResponse(`{"data":{"node":{"state":"OPEN","mergeStateStatus":"CLEAN"}}}`))
+	tr.Register(
+		httpmock.GraphQL(`mutation PullRequestMerge\b`),
+		httpmock.GraphQLMutation(`{}`, func(input map[string]interface{}) {
+			assert.Equal(t, "THE-ID", input["pullRequestId"].(string))
+			assert.Equal(t, "MERGE", input["mergeMethod"].(string))
+		}))
+
+	cs, cmdTeardown := run.Stub()
+	defer cmdTeardown(t)
+	cs.Register(`sh -c notify`, 0, "")
+
+	err := mergeRun(&MergeOptions{
+		IO: ios,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: tr}, nil
+		},
+		SelectorArg:    "https://github.com/OWNER/REPO/pull/123",
+		WhenChecksPass: true,
+		WatchInterval:  time.Millisecond,
+		NotifyHook:     "notify",
+		MergeMethod:    PullRequestMergeMethodMerge,
+		Finder: shared.NewMockFinder(
+			"https://github.com/OWNER/REPO/pull/123",
+			&api.PullRequest{ID: "THE-ID", Number: 123, State: "OPEN", MergeStateStatus: "BLOCKED"},
+			ghrepo.New("OWNER", "REPO"),
+		),
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, "", stdout.String())
+	assert.Equal(t, heredoc.Doc(`
+		Waiting for pull request OWNER/REPO#123 to become mergeable; checking every 1ms. Press Ctrl+C to quit.
+		✓ Merged pull request OWNER/REPO#123 ()
+	`), stderr.String())
+}
+
+func TestMergeRun_whenChecksPass_timeout(t *testing.T) {
+	ios, _, stdout, stderr := iostreams.Test()
+	ios.SetStdoutTTY(true)
+	ios.SetStderrTTY(true)
+
+	tr := initFakeHTTP()
+	defer tr.Verify(t)
+
+	_, cmdTeardown := run.Stub()
+	defer cmdTeardown(t)
+
+	err := mergeRun(&MergeOptions{
+		IO: ios,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: tr}, nil
+		},
+		SelectorArg:    "https://github.com/OWNER/REPO/pull/123",
+		WhenChecksPass: true,
+		WatchInterval:  time.Millisecond,
+		WatchTimeout:   time.Nanosecond,
+		MergeMethod:    PullRequestMergeMethodMerge,
+		Finder: shared.NewMockFinder(
+			"https://github.com/OWNER/REPO/pull/123",
+			&api.PullRequest{ID: "THE-ID", Number: 123, State: "OPEN", MergeStateStatus: "BLOCKED"},
+			ghrepo.New("OWNER", "REPO"),
+		),
+	})
+	assert.EqualError(t, err, "timed out waiting for pull request OWNER/REPO#123 to become mergeable")
+
+	assert.Equal(t, "", stdout.String())
+	assert.Equal(t, "", stderr.String())
+}
+
 func TestMergeRun_disableAutoMerge(t *testing.T) {
 	ios, _, stdout, stderr := iostreams.Test()
 	ios.SetStdoutTTY(true)
@@ -3,17 +3,21 @@ package pr
 import (
 	"github.com/MakeNowJust/heredoc"
 	cmdLock "github.com/cli/cli/v2/pkg/cmd/issue/lock"
+	cmdArtifacts "github.com/cli/cli/v2/pkg/cmd/pr/artifacts"
 	cmdCheckout "github.com/cli/cli/v2/pkg/cmd/pr/checkout"
 	cmdChecks "github.com/cli/cli/v2/pkg/cmd/pr/checks"
 	cmdClose "github.com/cli/cli/v2/pkg/cmd/pr/close"
 	cmdComment "github.com/cli/cli/v2/pkg/cmd/pr/comment"
+	cmdConflicts "github.com/cli/cli/v2/pkg/cmd/pr/conflicts"
 	cmdCreate "github.com/cli/cli/v2/pkg/cmd/pr/create"
 	cmdDiff "github.com/cli/cli/v2/pkg/cmd/pr/diff"
 	cmdEdit "github.com/cli/cli/v2/pkg/cmd/pr/edit"
+	cmdExportPatch "github.com/cli/cli/v2/pkg/cmd/pr/export-patch"
 	cmdList "github.com/cli/cli/v2/pkg/cmd/pr/list"
 	cmdMerge "github.com/cli/cli/v2/pkg/cmd/pr/merge"
 	cmdReady "github.com/cli/cli/v2/pkg/cmd/pr/ready"
 	cmdReopen "github.com/cli/cli/v2/pkg/cmd/pr/reopen"
+	cmdRequestReview "github.com/cli/cli/v2/pkg/cmd/pr/request-review"
 	cmdReview "github.com/cli/cli/v2/pkg/cmd/pr/review"
 	cmdStatus "github.com/cli/cli/v2/pkg/cmd/pr/status"
 	cmdUpdateBranch "github.com/cli/cli/v2/pkg/cmd/pr/update-branch"
@@ -54,9 +58,13 @@ func NewCmdPR(f *cmdutil.Factory) *cobra.Command {
 	cmdutil.AddGroup(cmd, "Targeted commands",
 		cmdView.NewCmdView(f, nil),
 		cmdDiff.NewCmdDiff(f, nil),
+		cmdExportPatch.NewCmdExportPatch(f, nil),
 		cmdCheckout.NewCmdCheckout(f, nil),
 		cmdChecks.NewCmdChecks(f, nil),
+		cmdArtifacts.NewCmdArtifacts(f, nil),
+		cmdConflicts.NewCmdConflicts(f, nil),
 		cmdReview.NewCmdReview(f, nil),
+		cmdRequestReview.NewCmdRequestReview(f, nil),
 		cmdMerge.NewCmdMerge(f, nil),
 		cmdUpdateBranch.NewCmdUpdateBranch(f, nil),
 		cmdReady.NewCmdReady(f, nil),
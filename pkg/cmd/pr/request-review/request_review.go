@@ -0,0 +1,218 @@
+package requestreview
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/pr/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+const (
+	strategyRoundRobin  = "round-robin"
+	strategyLoadBalance = "load-balance"
+)
+
+type RequestReviewOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+
+	Finder shared.PRFinder
+
+	SelectorArg string
+	FromTeam    string
+	Strategy    string
+	Count       int
+}
+
+func NewCmdRequestReview(f *cmdutil.Factory, runF func(*RequestReviewOptions) error) *cobra.Command {
+	opts := &RequestReviewOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "request-review [<number> | <url> | <branch>]",
+		Short: "Request reviews from members of a team",
+		Long: heredoc.Docf(`
+			Request pull request reviews from members of a team, picking reviewers
+			according to a strategy instead of requesting a review from the whole team.
+
+			The %[1]sround-robin%[1]s strategy deterministically rotates through team members
+			based on the pull request number. The %[1]sload-balance%[1]s strategy picks the
+			members with the fewest open review requests.
+
+			The pull request author is never selected.
+		`, "`"),
+		Example: heredoc.Doc(`
+			$ gh pr request-review --from-team octo-org/frontend
+			$ gh pr request-review 123 --from-team octo-org/frontend --strategy load-balance --count 2
+		`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Finder = shared.NewFinder(f)
+
+			if repoOverride, _ := cmd.Flags().GetString("repo"); repoOverride != "" && len(args) == 0 {
+				return cmdutil.FlagErrorf("argument required when using the --repo flag")
+			}
+
+			if len(args) > 0 {
+				opts.SelectorArg = args[0]
+			}
+
+			if !strings.Contains(opts.FromTeam, "/") {
+				return cmdutil.FlagErrorf("invalid team %q: expected the ORG/TEAM format", opts.FromTeam)
+			}
+
+			if opts.Count < 1 {
+				return cmdutil.FlagErrorf("invalid reviewer count: %v", opts.Count)
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return requestReviewRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.FromTeam, "from-team", "", "Select reviewers from this team, in the `ORG/TEAM` format (required)")
+	cmdutil.StringEnumFlag(cmd, &opts.Strategy, "strategy", "", strategyRoundRobin, []string{strategyRoundRobin, strategyLoadBalance}, "Strategy for picking reviewers")
+	cmd.Flags().IntVar(&opts.Count, "count", 1, "Number of reviewers to request")
+	_ = cmd.MarkFlagRequired("from-team")
+
+	return cmd
+}
+
+func requestReviewRun(opts *RequestReviewOptions) error {
+	findOptions := shared.FindOptions{
+		Selector: opts.SelectorArg,
+		Fields:   []string{"number", "author"},
+	}
+	pr, baseRepo, err := opts.Finder.Find(findOptions)
+	if err != nil {
+		return err
+	}
+
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+	apiClient := api.NewClientFromHTTP(httpClient)
+
+	teamParts := strings.SplitN(opts.FromTeam, "/", 2)
+	org, teamSlug := teamParts[0], teamParts[1]
+
+	members, err := teamMembers(apiClient, baseRepo.RepoHost(), org, teamSlug)
+	if err != nil {
+		return fmt.Errorf("could not list members of %s: %w", opts.FromTeam, err)
+	}
+
+	candidates := make([]string, 0, len(members))
+	for _, login := range members {
+		if strings.EqualFold(login, pr.Author.Login) {
+			continue
+		}
+		candidates = append(candidates, login)
+	}
+	if len(candidates) == 0 {
+		return fmt.Errorf("no eligible reviewers in %s", opts.FromTeam)
+	}
+
+	count := opts.Count
+	if count > len(candidates) {
+		count = len(candidates)
+	}
+
+	var reviewers []string
+	switch opts.Strategy {
+	case strategyLoadBalance:
+		reviewers, err = pickByLoad(apiClient, baseRepo.RepoHost(), candidates, count)
+		if err != nil {
+			return err
+		}
+	default:
+		start := pr.Number % len(candidates)
+		for i := 0; i < count; i++ {
+			reviewers = append(reviewers, candidates[(start+i)%len(candidates)])
+		}
+	}
+
+	if err := requestReviewers(apiClient, baseRepo, pr.Number, reviewers); err != nil {
+		return fmt.Errorf("could not request reviews: %w", err)
+	}
+
+	cs := opts.IO.ColorScheme()
+	fmt.Fprintf(opts.IO.ErrOut, "%s Requested reviews from %s on pull request %s#%d\n",
+		cs.SuccessIcon(), strings.Join(reviewers, ", "), ghrepo.FullName(baseRepo), pr.Number)
+
+	return nil
+}
+
+func teamMembers(client *api.Client, host, org, teamSlug string) ([]string, error) {
+	var response []struct {
+		Login string `json:"login"`
+	}
+	path := fmt.Sprintf("orgs/%s/teams/%s/members?per_page=100", org, teamSlug)
+	if err := client.REST(host, "GET", path, nil, &response); err != nil {
+		return nil, err
+	}
+
+	members := make([]string, 0, len(response))
+	for _, member := range response {
+		members = append(members, member.Login)
+	}
+	sort.Strings(members)
+	return members, nil
+}
+
+// pickByLoad returns the count candidates with the fewest open review
+// requests, determined with one search query per candidate.
+func pickByLoad(client *api.Client, host string, candidates []string, count int) ([]string, error) {
+	type reviewerLoad struct {
+		login string
+		load  int
+	}
+
+	loads := make([]reviewerLoad, 0, len(candidates))
+	for _, login := range candidates {
+		var response struct {
+			TotalCount int `json:"total_count"`
+		}
+		query := url.Values{}
+		query.Set("q", fmt.Sprintf("is:pr is:open review-requested:%s", login))
+		query.Set("per_page", "1")
+		if err := client.REST(host, "GET", "search/issues?"+query.Encode(), nil, &response); err != nil {
+			return nil, fmt.Errorf("could not determine review load for %s: %w", login, err)
+		}
+		loads = append(loads, reviewerLoad{login: login, load: response.TotalCount})
+	}
+
+	sort.SliceStable(loads, func(i, j int) bool {
+		return loads[i].load < loads[j].load
+	})
+
+	reviewers := make([]string, 0, count)
+	for _, l := range loads[:count] {
+		reviewers = append(reviewers, l.login)
+	}
+	return reviewers, nil
+}
+
+func requestReviewers(client *api.Client, repo ghrepo.Interface, prNumber int, reviewers []string) error {
+	body := &bytes.Buffer{}
+	if err := json.NewEncoder(body).Encode(map[string]interface{}{"reviewers": reviewers}); err != nil {
+		return err
+	}
+	path := fmt.Sprintf("repos/%s/%s/pulls/%d/requested_reviewers", repo.RepoOwner(), repo.RepoName(), prNumber)
+	return client.REST(repo.RepoHost(), "POST", path, body, nil)
+}
@@ -0,0 +1,117 @@
+package requestreview
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/pr/shared"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequestReviewRun(t *testing.T) {
+	membersResponse := `[
+		{"login": "hubot"},
+		{"login": "monalisa"},
+		{"login": "octocat"}
+	]`
+
+	tests := []struct {
+		name          string
+		opts          RequestReviewOptions
+		prNumber      int
+		httpStubs     func(*httpmock.Registry)
+		wantReviewers string
+		wantErr       string
+	}{
+		{
+			name:     "round robin rotates by pull request number",
+			opts:     RequestReviewOptions{FromTeam: "octo-org/frontend", Strategy: "round-robin", Count: 2},
+			prNumber: 4,
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "orgs/octo-org/teams/frontend/members"),
+					httpmock.StringResponse(membersResponse),
+				)
+				reg.Register(
+					httpmock.REST("POST", "repos/OWNER/REPO/pulls/4/requested_reviewers"),
+					httpmock.RESTPayload(201, `{}`, func(payload map[string]interface{}) {
+						assert.Equal(t, []interface{}{"hubot", "monalisa"}, payload["reviewers"])
+					}),
+				)
+			},
+			wantReviewers: "hubot, monalisa",
+		},
+		{
+			name:     "load balance picks least loaded reviewers",
+			opts:     RequestReviewOptions{FromTeam: "octo-org/frontend", Strategy: "load-balance", Count: 1},
+			prNumber: 4,
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "orgs/octo-org/teams/frontend/members"),
+					httpmock.StringResponse(membersResponse),
+				)
+				reg.Register(
+					httpmock.QueryMatcher("GET", "search/issues", map[string][]string{"q": {"is:pr is:open review-requested:hubot"}}),
+					httpmock.StringResponse(`{"total_count": 5}`),
+				)
+				reg.Register(
+					httpmock.QueryMatcher("GET", "search/issues", map[string][]string{"q": {"is:pr is:open review-requested:monalisa"}}),
+					httpmock.StringResponse(`{"total_count": 1}`),
+				)
+				reg.Register(
+					httpmock.REST("POST", "repos/OWNER/REPO/pulls/4/requested_reviewers"),
+					httpmock.RESTPayload(201, `{}`, func(payload map[string]interface{}) {
+						assert.Equal(t, []interface{}{"monalisa"}, payload["reviewers"])
+					}),
+				)
+			},
+			wantReviewers: "monalisa",
+		},
+		{
+			name:     "author is excluded",
+			opts:     RequestReviewOptions{FromTeam: "octo-org/frontend", Strategy: "round-robin", Count: 3},
+			prNumber: 3,
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "orgs/octo-org/teams/frontend/members"),
+					httpmock.StringResponse(`[{"login": "octocat"}]`),
+				)
+			},
+			wantErr: "no eligible reviewers in octo-org/frontend",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reg := &httpmock.Registry{}
+			defer reg.Verify(t)
+			if tt.httpStubs != nil {
+				tt.httpStubs(reg)
+			}
+
+			ios, _, _, stderr := iostreams.Test()
+
+			opts := tt.opts
+			opts.IO = ios
+			opts.HttpClient = func() (*http.Client, error) {
+				return &http.Client{Transport: reg}, nil
+			}
+			opts.Finder = shared.NewMockFinder("", &api.PullRequest{
+				Number: tt.prNumber,
+				Author: api.Author{Login: "octocat"},
+			}, ghrepo.New("OWNER", "REPO"))
+
+			err := requestReviewRun(&opts)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Contains(t, stderr.String(), tt.wantReviewers)
+		})
+	}
+}
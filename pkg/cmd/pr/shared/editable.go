@@ -199,6 +199,55 @@ func (e Editable) MilestoneId() (*string, error) {
 	return &m, err
 }
 
+// UnmarkUnchangedFields clears the Edited flag of any field whose new value
+// matches the current value, so answering a survey prompt by keeping a field
+// as-is does not submit that field.
+func (e *Editable) UnmarkUnchangedFields() {
+	if e.Title.Edited && e.Title.Value == e.Title.Default {
+		e.Title.Edited = false
+	}
+	if e.Body.Edited && e.Body.Value == e.Body.Default {
+		e.Body.Edited = false
+	}
+	if e.Base.Edited && e.Base.Value == e.Base.Default {
+		e.Base.Edited = false
+	}
+	if e.Reviewers.Edited && sameStringSet(e.Reviewers.Value, e.Reviewers.Default) {
+		e.Reviewers.Edited = false
+	}
+	if e.Assignees.Edited && sameStringSet(e.Assignees.Value, e.Assignees.Default) {
+		e.Assignees.Edited = false
+	}
+	// interactive label selection records the full selection in Add and the
+	// deselected labels in Remove
+	if e.Labels.Edited && len(e.Labels.Remove) == 0 && sameStringSet(e.Labels.Add, e.Labels.Default) {
+		e.Labels.Edited = false
+	}
+	if e.Projects.Edited && sameStringSet(e.Projects.Value, e.Projects.Default) {
+		e.Projects.Edited = false
+	}
+	if e.Milestone.Edited {
+		if e.Milestone.Value == e.Milestone.Default ||
+			(e.Milestone.Value == noMilestone && e.Milestone.Default == "") {
+			e.Milestone.Edited = false
+		}
+	}
+}
+
+func sameStringSet(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	s := set.NewStringSet()
+	s.AddValues(a)
+	for _, v := range b {
+		if !s.Contains(v) {
+			return false
+		}
+	}
+	return true
+}
+
 // Clone creates a mostly-shallow copy of Editable suitable for use in parallel
 // go routines. Fields that would be mutated will be copied.
 func (e *Editable) Clone() Editable {
@@ -328,6 +377,9 @@ func EditFieldsSurvey(p EditPrompter, editable *Editable, editorCommand string)
 		return fmt.Errorf("Discarding...")
 	}
 
+	// only submit the fields whose values actually changed
+	editable.UnmarkUnchangedFields()
+
 	return nil
 }
 
@@ -0,0 +1,102 @@
+package shared
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnmarkUnchangedFields(t *testing.T) {
+	tests := []struct {
+		name     string
+		editable Editable
+		assert   func(t *testing.T, e Editable)
+	}{
+		{
+			name: "unchanged fields are unmarked",
+			editable: Editable{
+				Title: EditableString{
+					Value:   "a title",
+					Default: "a title",
+					Edited:  true,
+				},
+				Body: EditableString{
+					Value:   "a body",
+					Default: "a body",
+					Edited:  true,
+				},
+				Assignees: EditableSlice{
+					Value:   []string{"monalisa", "hubot"},
+					Default: []string{"hubot", "monalisa"},
+					Edited:  true,
+				},
+				Labels: EditableSlice{
+					Add:     []string{"bug"},
+					Default: []string{"bug"},
+					Edited:  true,
+				},
+				Projects: EditableProjects{
+					EditableSlice: EditableSlice{
+						Value:   []string{"Roadmap"},
+						Default: []string{"Roadmap"},
+						Edited:  true,
+					},
+				},
+				Milestone: EditableString{
+					Value:   "v1.0",
+					Default: "v1.0",
+					Edited:  true,
+				},
+			},
+			assert: func(t *testing.T, e Editable) {
+				assert.False(t, e.Dirty())
+			},
+		},
+		{
+			name: "changed fields stay marked",
+			editable: Editable{
+				Title: EditableString{
+					Value:   "a new title",
+					Default: "a title",
+					Edited:  true,
+				},
+				Assignees: EditableSlice{
+					Value:   []string{"monalisa"},
+					Default: []string{"monalisa", "hubot"},
+					Edited:  true,
+				},
+				Labels: EditableSlice{
+					Add:     []string{"bug"},
+					Remove:  []string{"needs-triage"},
+					Default: []string{"bug", "needs-triage"},
+					Edited:  true,
+				},
+			},
+			assert: func(t *testing.T, e Editable) {
+				assert.True(t, e.Title.Edited)
+				assert.True(t, e.Assignees.Edited)
+				assert.True(t, e.Labels.Edited)
+			},
+		},
+		{
+			name: "keeping no milestone is unchanged",
+			editable: Editable{
+				Milestone: EditableString{
+					Value:   noMilestone,
+					Default: "",
+					Edited:  true,
+				},
+			},
+			assert: func(t *testing.T, e Editable) {
+				assert.False(t, e.Milestone.Edited)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.editable.UnmarkUnchangedFields()
+			tt.assert(t, tt.editable)
+		})
+	}
+}
@@ -60,6 +60,7 @@ func TestJSONFields(t *testing.T) {
 		"mergedBy",
 		"milestone",
 		"number",
+		"participants",
 		"potentialMergeCommit",
 		"projectCards",
 		"projectItems",
@@ -69,6 +70,7 @@ func TestJSONFields(t *testing.T) {
 		"reviews",
 		"state",
 		"statusCheckRollup",
+		"subscribers",
 		"title",
 		"updatedAt",
 		"url",
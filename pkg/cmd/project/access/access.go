@@ -0,0 +1,22 @@
+package access
+
+import (
+	cmdGrant "github.com/cli/cli/v2/pkg/cmd/project/access/grant"
+	cmdList "github.com/cli/cli/v2/pkg/cmd/project/access/list"
+	cmdRevoke "github.com/cli/cli/v2/pkg/cmd/project/access/revoke"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+func NewCmdAccess(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "access <command>",
+		Short: "Manage access to a project",
+	}
+
+	cmd.AddCommand(cmdList.NewCmdList(f, nil))
+	cmd.AddCommand(cmdGrant.NewCmdGrant(f, nil))
+	cmd.AddCommand(cmdRevoke.NewCmdRevoke(f, nil))
+
+	return cmd
+}
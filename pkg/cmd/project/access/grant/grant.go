@@ -0,0 +1,177 @@
+package grant
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/gh"
+	"github.com/cli/cli/v2/pkg/cmd/project/shared/client"
+	"github.com/cli/cli/v2/pkg/cmd/project/shared/queries"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/shurcooL/githubv4"
+	"github.com/spf13/cobra"
+)
+
+type grantOpts struct {
+	number int32
+	owner  string
+	user   string
+	team   string
+	role   string
+}
+
+type grantConfig struct {
+	httpClient func() (*http.Client, error)
+	config     func() (gh.Config, error)
+	client     *queries.Client
+	opts       grantOpts
+	io         *iostreams.IOStreams
+}
+
+func NewCmdGrant(f *cmdutil.Factory, runF func(config grantConfig) error) *cobra.Command {
+	opts := grantOpts{}
+	grantCmd := &cobra.Command{
+		Short: "Grant a user or team access to a project",
+		Use:   "grant [<number>]",
+		Example: heredoc.Doc(`
+			# grant monalisa read access to the organization's project "1"
+			gh project access grant 1 --owner my-organization --user monalisa --role read
+
+			# grant the team "my-team" admin access to the organization's project "1"
+			gh project access grant 1 --owner my-organization --team my-team --role admin
+		`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := client.New(f)
+			if err != nil {
+				return err
+			}
+
+			if len(args) == 1 {
+				num, err := strconv.ParseInt(args[0], 10, 32)
+				if err != nil {
+					return cmdutil.FlagErrorf("invalid number: %v", args[0])
+				}
+				opts.number = int32(num)
+			}
+
+			if err := cmdutil.MutuallyExclusive("specify only one of `--user` or `--team`", opts.user != "", opts.team != ""); err != nil {
+				return err
+			}
+			if opts.user == "" && opts.team == "" {
+				return cmdutil.FlagErrorf("one of `--user` or `--team` is required")
+			}
+
+			config := grantConfig{
+				httpClient: f.HttpClient,
+				config:     f.Config,
+				client:     client,
+				opts:       opts,
+				io:         f.IOStreams,
+			}
+
+			// allow testing of the command without actually running it
+			if runF != nil {
+				return runF(config)
+			}
+			return runGrant(config)
+		},
+	}
+
+	grantCmd.Flags().StringVar(&opts.owner, "owner", "", "Login of the owner. Use \"@me\" for the current user. Defaults to the owner of the current directory's repository.")
+	grantCmd.Flags().StringVar(&opts.user, "user", "", "Login of the user to grant access to")
+	grantCmd.Flags().StringVar(&opts.team, "team", "", "Slug of the team to grant access to")
+	cmdutil.StringEnumFlag(grantCmd, &opts.role, "role", "", "write", []string{"read", "write", "admin"}, "Role to grant")
+
+	return grantCmd
+}
+
+// ProjectRole maps a role flag value to its GraphQL enum value.
+func ProjectRole(role string) githubv4.ProjectV2Roles {
+	switch role {
+	case "read":
+		return githubv4.ProjectV2RolesReader
+	case "admin":
+		return githubv4.ProjectV2RolesAdmin
+	default:
+		return githubv4.ProjectV2RolesWriter
+	}
+}
+
+// Collaborator resolves the user login or team slug to a collaborator input
+// with the given role. Teams belong to the owner organization.
+func Collaborator(config *queries.Client, httpClient func() (*http.Client, error), cfgFunc func() (gh.Config, error), owner *queries.Owner, user, team string, role githubv4.ProjectV2Roles) (githubv4.ProjectV2Collaborator, error) {
+	collaborator := githubv4.ProjectV2Collaborator{Role: role}
+
+	if user != "" {
+		id, _, err := config.OwnerIDAndType(user)
+		if err != nil {
+			return collaborator, err
+		}
+		collaborator.UserID = githubv4.NewID(githubv4.ID(id))
+		return collaborator, nil
+	}
+
+	c, err := httpClient()
+	if err != nil {
+		return collaborator, err
+	}
+	cfg, err := cfgFunc()
+	if err != nil {
+		return collaborator, err
+	}
+	host, _ := cfg.Authentication().DefaultHost()
+
+	t, err := api.OrganizationTeam(api.NewClientFromHTTP(c), host, owner.Login, team)
+	if err != nil {
+		return collaborator, err
+	}
+	collaborator.TeamID = githubv4.NewID(githubv4.ID(t.ID))
+	return collaborator, nil
+}
+
+func runGrant(config grantConfig) error {
+	canPrompt := config.io.CanPrompt()
+	owner, err := config.client.NewOwner(canPrompt, config.opts.owner)
+	if err != nil {
+		return err
+	}
+
+	project, err := config.client.NewProject(canPrompt, owner, config.opts.number, false)
+	if err != nil {
+		return err
+	}
+	if config.opts.number == 0 {
+		config.opts.number = project.Number
+	}
+
+	collaborator, err := Collaborator(config.client, config.httpClient, config.config, owner, config.opts.user, config.opts.team, ProjectRole(config.opts.role))
+	if err != nil {
+		return err
+	}
+
+	err = config.client.UpdateProjectCollaborators(project.ID, []githubv4.ProjectV2Collaborator{collaborator})
+	if err != nil {
+		return err
+	}
+
+	return printResults(config, project.Title)
+}
+
+func printResults(config grantConfig, title string) error {
+	if !config.io.IsStdoutTTY() {
+		return nil
+	}
+
+	target := config.opts.user
+	if target == "" {
+		target = config.opts.team
+	}
+
+	_, err := fmt.Fprintf(config.io.Out, "Granted %s access to '%s' on project #%d '%s'\n", config.opts.role, target, config.opts.number, title)
+	return err
+}
@@ -0,0 +1,213 @@
+package grant
+
+import (
+	"testing"
+
+	"github.com/cli/cli/v2/pkg/cmd/project/shared/queries"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestNewCmdGrant(t *testing.T) {
+	tests := []struct {
+		name        string
+		cli         string
+		wants       grantOpts
+		wantsErr    bool
+		wantsErrMsg string
+	}{
+		{
+			name:        "not-a-number",
+			cli:         "x --user monalisa",
+			wantsErr:    true,
+			wantsErrMsg: "invalid number: x",
+		},
+		{
+			name:        "no-user-or-team",
+			cli:         "1",
+			wantsErr:    true,
+			wantsErrMsg: "one of `--user` or `--team` is required",
+		},
+		{
+			name:        "user-and-team",
+			cli:         "1 --user monalisa --team my-team",
+			wantsErr:    true,
+			wantsErrMsg: "specify only one of `--user` or `--team`",
+		},
+		{
+			name: "user",
+			cli:  "1 --user monalisa",
+			wants: grantOpts{
+				number: 1,
+				user:   "monalisa",
+				role:   "write",
+			},
+		},
+		{
+			name: "team-with-role",
+			cli:  "1 --owner my-org --team my-team --role admin",
+			wants: grantOpts{
+				number: 1,
+				owner:  "my-org",
+				team:   "my-team",
+				role:   "admin",
+			},
+		},
+		{
+			name:        "invalid-role",
+			cli:         "1 --user monalisa --role owner",
+			wantsErr:    true,
+			wantsErrMsg: "invalid argument \"owner\" for \"--role\" flag: valid values are {read|write|admin}",
+		},
+	}
+
+	t.Setenv("GH_TOKEN", "auth-token")
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ios, _, _, _ := iostreams.Test()
+			f := &cmdutil.Factory{
+				IOStreams: ios,
+			}
+
+			argv, err := shlex.Split(tt.cli)
+			assert.NoError(t, err)
+
+			var gotOpts grantOpts
+			cmd := NewCmdGrant(f, func(config grantConfig) error {
+				gotOpts = config.opts
+				return nil
+			})
+
+			cmd.SetArgs(argv)
+			_, err = cmd.ExecuteC()
+			if tt.wantsErr {
+				assert.Error(t, err)
+				assert.Equal(t, tt.wantsErrMsg, err.Error())
+				return
+			}
+			assert.NoError(t, err)
+
+			assert.Equal(t, tt.wants.number, gotOpts.number)
+			assert.Equal(t, tt.wants.owner, gotOpts.owner)
+			assert.Equal(t, tt.wants.user, gotOpts.user)
+			assert.Equal(t, tt.wants.team, gotOpts.team)
+			assert.Equal(t, tt.wants.role, gotOpts.role)
+		})
+	}
+}
+
+func TestRunGrant_User(t *testing.T) {
+	defer gock.Off()
+	// gock.Observe(gock.DumpRequest)
+
+	// get owner ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query UserOrgOwner.*",
+			"variables": map[string]interface{}{
+				"login": "monalisa",
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"id": "an ID",
+				},
+			},
+			"errors": []interface{}{
+				map[string]interface{}{
+					"type": "NOT_FOUND",
+					"path": []string{"organization"},
+				},
+			},
+		})
+
+	// get user project ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query UserProject.*",
+			"variables": map[string]interface{}{
+				"login":       "monalisa",
+				"number":      1,
+				"firstItems":  0,
+				"afterItems":  nil,
+				"firstFields": 0,
+				"afterFields": nil,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"projectV2": map[string]string{
+						"id":    "project-ID",
+						"title": "first-project",
+					},
+				},
+			},
+		})
+
+	// get collaborator user ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query UserOrgOwner.*",
+			"variables": map[string]interface{}{
+				"login": "hubot",
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"id": "hubot-ID",
+				},
+			},
+			"errors": []interface{}{
+				map[string]interface{}{
+					"type": "NOT_FOUND",
+					"path": []string{"organization"},
+				},
+			},
+		})
+
+	// update collaborators
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`{"query":"mutation UpdateProjectV2Collaborators.*","variables":{"input":{"projectId":"project-ID","collaborators":\[{"role":"READER","userId":"hubot-ID"}\]}}}`).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"updateProjectV2Collaborators": map[string]interface{}{},
+			},
+		})
+
+	client := queries.NewTestClient()
+
+	ios, _, stdout, _ := iostreams.Test()
+	ios.SetStdoutTTY(true)
+	config := grantConfig{
+		opts: grantOpts{
+			number: 1,
+			owner:  "monalisa",
+			user:   "hubot",
+			role:   "read",
+		},
+		client: client,
+		io:     ios,
+	}
+
+	err := runGrant(config)
+	assert.NoError(t, err)
+	assert.Equal(t, "Granted read access to 'hubot' on project #1 'first-project'\n", stdout.String())
+}
@@ -0,0 +1,132 @@
+package revoke
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/gh"
+	"github.com/cli/cli/v2/pkg/cmd/project/access/grant"
+	"github.com/cli/cli/v2/pkg/cmd/project/shared/client"
+	"github.com/cli/cli/v2/pkg/cmd/project/shared/queries"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/shurcooL/githubv4"
+	"github.com/spf13/cobra"
+)
+
+type revokeOpts struct {
+	number int32
+	owner  string
+	user   string
+	team   string
+}
+
+type revokeConfig struct {
+	httpClient func() (*http.Client, error)
+	config     func() (gh.Config, error)
+	client     *queries.Client
+	opts       revokeOpts
+	io         *iostreams.IOStreams
+}
+
+func NewCmdRevoke(f *cmdutil.Factory, runF func(config revokeConfig) error) *cobra.Command {
+	opts := revokeOpts{}
+	revokeCmd := &cobra.Command{
+		Short: "Revoke a user's or team's access to a project",
+		Use:   "revoke [<number>]",
+		Example: heredoc.Doc(`
+			# revoke monalisa's access to the organization's project "1"
+			gh project access revoke 1 --owner my-organization --user monalisa
+
+			# revoke the team "my-team"'s access to the organization's project "1"
+			gh project access revoke 1 --owner my-organization --team my-team
+		`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := client.New(f)
+			if err != nil {
+				return err
+			}
+
+			if len(args) == 1 {
+				num, err := strconv.ParseInt(args[0], 10, 32)
+				if err != nil {
+					return cmdutil.FlagErrorf("invalid number: %v", args[0])
+				}
+				opts.number = int32(num)
+			}
+
+			if err := cmdutil.MutuallyExclusive("specify only one of `--user` or `--team`", opts.user != "", opts.team != ""); err != nil {
+				return err
+			}
+			if opts.user == "" && opts.team == "" {
+				return cmdutil.FlagErrorf("one of `--user` or `--team` is required")
+			}
+
+			config := revokeConfig{
+				httpClient: f.HttpClient,
+				config:     f.Config,
+				client:     client,
+				opts:       opts,
+				io:         f.IOStreams,
+			}
+
+			// allow testing of the command without actually running it
+			if runF != nil {
+				return runF(config)
+			}
+			return runRevoke(config)
+		},
+	}
+
+	revokeCmd.Flags().StringVar(&opts.owner, "owner", "", "Login of the owner. Use \"@me\" for the current user. Defaults to the owner of the current directory's repository.")
+	revokeCmd.Flags().StringVar(&opts.user, "user", "", "Login of the user to revoke access from")
+	revokeCmd.Flags().StringVar(&opts.team, "team", "", "Slug of the team to revoke access from")
+
+	return revokeCmd
+}
+
+func runRevoke(config revokeConfig) error {
+	canPrompt := config.io.CanPrompt()
+	owner, err := config.client.NewOwner(canPrompt, config.opts.owner)
+	if err != nil {
+		return err
+	}
+
+	project, err := config.client.NewProject(canPrompt, owner, config.opts.number, false)
+	if err != nil {
+		return err
+	}
+	if config.opts.number == 0 {
+		config.opts.number = project.Number
+	}
+
+	// revoking access is granting the NONE role
+	collaborator, err := grant.Collaborator(config.client, config.httpClient, config.config, owner, config.opts.user, config.opts.team, githubv4.ProjectV2RolesNone)
+	if err != nil {
+		return err
+	}
+
+	err = config.client.UpdateProjectCollaborators(project.ID, []githubv4.ProjectV2Collaborator{collaborator})
+	if err != nil {
+		return err
+	}
+
+	return printResults(config, project.Title)
+}
+
+func printResults(config revokeConfig, title string) error {
+	if !config.io.IsStdoutTTY() {
+		return nil
+	}
+
+	target := config.opts.user
+	if target == "" {
+		target = config.opts.team
+	}
+
+	_, err := fmt.Fprintf(config.io.Out, "Revoked access for '%s' from project #%d '%s'\n", target, config.opts.number, title)
+	return err
+}
@@ -0,0 +1,415 @@
+package board
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/pkg/cmd/project/shared/client"
+	"github.com/cli/cli/v2/pkg/cmd/project/shared/queries"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/shurcooL/githubv4"
+	"github.com/spf13/cobra"
+)
+
+type boardOpts struct {
+	owner  string
+	number int32
+}
+
+type boardConfig struct {
+	io       *iostreams.IOStreams
+	client   *queries.Client
+	opts     boardOpts
+	prompter iprompter
+}
+
+type iprompter interface {
+	Select(string, string, []string) (int, error)
+	Input(string, string) (string, error)
+}
+
+type updateProjectV2FieldValueMutation struct {
+	Update struct {
+		Item queries.ProjectItem `graphql:"projectV2Item"`
+	} `graphql:"updateProjectV2ItemFieldValue(input:$input)"`
+}
+
+type clearProjectV2FieldValueMutation struct {
+	Clear struct {
+		Item queries.ProjectItem `graphql:"projectV2Item"`
+	} `graphql:"clearProjectV2ItemFieldValue(input:$input)"`
+}
+
+// A column groups the project items that share a value of the board's
+// single-select field. The "No value" column has an empty optionID.
+type column struct {
+	name     string
+	optionID string
+	items    []queries.ProjectItem
+}
+
+func NewCmdBoard(f *cmdutil.Factory, runF func(config boardConfig) error) *cobra.Command {
+	opts := boardOpts{}
+	boardCmd := &cobra.Command{
+		Short: "View a project as an interactive board",
+		Use:   "board [<number>]",
+		Long: heredoc.Docf(`
+			View the items of a project as a board, grouped into columns by the
+			project's %[1]sStatus%[1]s field (or its first single-select field).
+
+			The board is interactive: items can be moved between columns, opened to
+			show their details, and their field values edited, all from the terminal.
+		`, "`"),
+		Example: heredoc.Doc(`
+			# view monalisa's project "1" as a board
+			gh project board 1 --owner monalisa
+		`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := client.New(f)
+			if err != nil {
+				return err
+			}
+
+			if len(args) == 1 {
+				num, err := strconv.ParseInt(args[0], 10, 32)
+				if err != nil {
+					return cmdutil.FlagErrorf("invalid number: %v", args[0])
+				}
+				opts.number = int32(num)
+			}
+
+			config := boardConfig{
+				io:       f.IOStreams,
+				client:   client,
+				opts:     opts,
+				prompter: f.Prompter,
+			}
+
+			// allow testing of the command without actually running it
+			if runF != nil {
+				return runF(config)
+			}
+			return runBoard(config)
+		},
+	}
+
+	boardCmd.Flags().StringVar(&opts.owner, "owner", "", "Login of the owner. Use \"@me\" for the current user. Defaults to the owner of the current directory's repository.")
+
+	return boardCmd
+}
+
+func runBoard(config boardConfig) error {
+	if !config.io.CanPrompt() {
+		return errors.New("project board requires an interactive terminal")
+	}
+
+	owner, err := config.client.NewOwner(true, config.opts.owner)
+	if err != nil {
+		return err
+	}
+
+	project, err := config.client.NewProject(true, owner, config.opts.number, false)
+	if err != nil {
+		return err
+	}
+	config.opts.number = project.Number
+
+	for {
+		config.io.StartProgressIndicator()
+		items, err := config.client.ProjectItems(owner, config.opts.number, queries.LimitMax)
+		if err != nil {
+			config.io.StopProgressIndicator()
+			return err
+		}
+		fields, err := config.client.ProjectFields(owner, config.opts.number, queries.LimitMax)
+		config.io.StopProgressIndicator()
+		if err != nil {
+			return err
+		}
+
+		groupField, ok := groupingField(fields.Fields.Nodes)
+		if !ok {
+			return errors.New("project has no single-select field to group items by")
+		}
+
+		columns := buildColumns(groupField, items.Items.Nodes)
+		printBoard(config, items.Title, columns)
+
+		options := []string{"Move an item", "View an item", "Edit an item field", "Refresh", "Quit"}
+		selected, err := config.prompter.Select("What would you like to do?", "", options)
+		if err != nil {
+			return err
+		}
+
+		switch options[selected] {
+		case "Move an item":
+			err = moveItem(config, items.ID, groupField, columns)
+		case "View an item":
+			err = viewItem(config, columns)
+		case "Edit an item field":
+			err = editItemField(config, items.ID, fields.Fields.Nodes, columns)
+		case "Refresh":
+			continue
+		case "Quit":
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// groupingField returns the single-select field the board's columns are based
+// on, preferring a field named "Status".
+func groupingField(fields []queries.ProjectField) (queries.ProjectField, bool) {
+	var firstSingleSelect *queries.ProjectField
+	for i, field := range fields {
+		if field.TypeName != "ProjectV2SingleSelectField" {
+			continue
+		}
+		if strings.EqualFold(field.Name(), "Status") {
+			return field, true
+		}
+		if firstSingleSelect == nil {
+			firstSingleSelect = &fields[i]
+		}
+	}
+	if firstSingleSelect != nil {
+		return *firstSingleSelect, true
+	}
+	return queries.ProjectField{}, false
+}
+
+func buildColumns(groupField queries.ProjectField, items []queries.ProjectItem) []column {
+	columns := make([]column, 0, len(groupField.SingleSelectField.Options)+1)
+	for _, option := range groupField.SingleSelectField.Options {
+		columns = append(columns, column{name: option.Name, optionID: option.ID})
+	}
+	columns = append(columns, column{name: fmt.Sprintf("No %s", strings.ToLower(groupField.Name()))})
+
+	for _, item := range items {
+		name := itemColumn(item, groupField.Name())
+		placed := false
+		for i := range columns {
+			if columns[i].name == name {
+				columns[i].items = append(columns[i].items, item)
+				placed = true
+				break
+			}
+		}
+		if !placed {
+			columns[len(columns)-1].items = append(columns[len(columns)-1].items, item)
+		}
+	}
+	return columns
+}
+
+// itemColumn returns the item's value of the board's single-select field, or
+// the empty string when the field is not set.
+func itemColumn(item queries.ProjectItem, fieldName string) string {
+	for _, v := range item.FieldValues.Nodes {
+		if v.Type != "ProjectV2ItemFieldSingleSelectValue" {
+			continue
+		}
+		if v.ProjectV2ItemFieldSingleSelectValue.Field.Name() == fieldName {
+			return v.ProjectV2ItemFieldSingleSelectValue.Name
+		}
+	}
+	return ""
+}
+
+func printBoard(config boardConfig, title string, columns []column) {
+	cs := config.io.ColorScheme()
+	out := config.io.Out
+
+	fmt.Fprintf(out, "\n%s\n", cs.Bold(title))
+	for _, col := range columns {
+		fmt.Fprintf(out, "\n%s (%d)\n", cs.Bold(col.name), len(col.items))
+		for _, item := range col.items {
+			fmt.Fprintf(out, "  %s\n", itemLabel(item))
+		}
+	}
+	fmt.Fprintln(out)
+}
+
+func itemLabel(item queries.ProjectItem) string {
+	if item.Number() == 0 {
+		return item.Title()
+	}
+	return fmt.Sprintf("%s#%d %s", item.Repo(), item.Number(), item.Title())
+}
+
+// selectItem prompts for one of the items on the board and returns it.
+func selectItem(config boardConfig, columns []column) (queries.ProjectItem, error) {
+	var items []queries.ProjectItem
+	var labels []string
+	for _, col := range columns {
+		for _, item := range col.items {
+			items = append(items, item)
+			labels = append(labels, fmt.Sprintf("%s [%s]", itemLabel(item), col.name))
+		}
+	}
+	if len(items) == 0 {
+		return queries.ProjectItem{}, errors.New("the project has no items")
+	}
+
+	selected, err := config.prompter.Select("Which item?", "", labels)
+	if err != nil {
+		return queries.ProjectItem{}, err
+	}
+	return items[selected], nil
+}
+
+func moveItem(config boardConfig, projectID string, groupField queries.ProjectField, columns []column) error {
+	item, err := selectItem(config, columns)
+	if err != nil {
+		return err
+	}
+
+	names := make([]string, len(columns))
+	for i, col := range columns {
+		names[i] = col.name
+	}
+	selected, err := config.prompter.Select("Move to which column?", "", names)
+	if err != nil {
+		return err
+	}
+
+	// moving to the "No value" column clears the field
+	if columns[selected].optionID == "" {
+		query := &clearProjectV2FieldValueMutation{}
+		variables := map[string]interface{}{
+			"input": githubv4.ClearProjectV2ItemFieldValueInput{
+				ProjectID: githubv4.ID(projectID),
+				ItemID:    githubv4.ID(item.ID()),
+				FieldID:   githubv4.ID(groupField.ID()),
+			},
+		}
+		return config.client.Mutate("ClearItemValue", query, variables)
+	}
+
+	query := &updateProjectV2FieldValueMutation{}
+	variables := map[string]interface{}{
+		"input": githubv4.UpdateProjectV2ItemFieldValueInput{
+			ProjectID: githubv4.ID(projectID),
+			ItemID:    githubv4.ID(item.ID()),
+			FieldID:   githubv4.ID(groupField.ID()),
+			Value: githubv4.ProjectV2FieldValue{
+				SingleSelectOptionID: githubv4.NewString(githubv4.String(columns[selected].optionID)),
+			},
+		},
+	}
+	return config.client.Mutate("UpdateItemValues", query, variables)
+}
+
+func viewItem(config boardConfig, columns []column) error {
+	item, err := selectItem(config, columns)
+	if err != nil {
+		return err
+	}
+
+	cs := config.io.ColorScheme()
+	out := config.io.Out
+
+	fmt.Fprintf(out, "\n%s\n", cs.Bold(item.Title()))
+	fmt.Fprintf(out, "%s\n", cs.Gray(item.Type()))
+	if item.Repo() != "" {
+		fmt.Fprintf(out, "%s#%d\n", item.Repo(), item.Number())
+	}
+	if item.URL() != "" {
+		fmt.Fprintf(out, "%s\n", item.URL())
+	}
+	if item.Body() != "" {
+		fmt.Fprintf(out, "\n%s\n", item.Body())
+	}
+	fmt.Fprintln(out)
+	return nil
+}
+
+func editItemField(config boardConfig, projectID string, fields []queries.ProjectField, columns []column) error {
+	item, err := selectItem(config, columns)
+	if err != nil {
+		return err
+	}
+
+	// only field types with a prompt-friendly representation are offered
+	var editable []queries.ProjectField
+	var labels []string
+	for _, field := range fields {
+		switch field.DataType() {
+		case "TEXT", "NUMBER", "DATE", "SINGLE_SELECT":
+			if strings.EqualFold(field.Name(), "Title") {
+				continue
+			}
+			editable = append(editable, field)
+			labels = append(labels, field.Name())
+		}
+	}
+	if len(editable) == 0 {
+		return errors.New("the project has no editable fields")
+	}
+
+	selected, err := config.prompter.Select("Which field?", "", labels)
+	if err != nil {
+		return err
+	}
+	field := editable[selected]
+
+	var value githubv4.ProjectV2FieldValue
+	switch field.DataType() {
+	case "TEXT":
+		text, err := config.prompter.Input(fmt.Sprintf("New value for %s", field.Name()), "")
+		if err != nil {
+			return err
+		}
+		value.Text = githubv4.NewString(githubv4.String(text))
+	case "NUMBER":
+		raw, err := config.prompter.Input(fmt.Sprintf("New value for %s", field.Name()), "")
+		if err != nil {
+			return err
+		}
+		number, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return fmt.Errorf("invalid number %q", raw)
+		}
+		value.Number = githubv4.NewFloat(githubv4.Float(number))
+	case "DATE":
+		raw, err := config.prompter.Input(fmt.Sprintf("New value for %s (YYYY-MM-DD)", field.Name()), "")
+		if err != nil {
+			return err
+		}
+		date, err := time.Parse("2006-01-02", raw)
+		if err != nil {
+			return fmt.Errorf("invalid date %q: date must be in YYYY-MM-DD format", raw)
+		}
+		value.Date = githubv4.NewDate(githubv4.Date{Time: date})
+	case "SINGLE_SELECT":
+		options := field.SingleSelectField.Options
+		names := make([]string, len(options))
+		for i, option := range options {
+			names[i] = option.Name
+		}
+		selected, err := config.prompter.Select(fmt.Sprintf("New value for %s", field.Name()), "", names)
+		if err != nil {
+			return err
+		}
+		value.SingleSelectOptionID = githubv4.NewString(githubv4.String(options[selected].ID))
+	}
+
+	query := &updateProjectV2FieldValueMutation{}
+	variables := map[string]interface{}{
+		"input": githubv4.UpdateProjectV2ItemFieldValueInput{
+			ProjectID: githubv4.ID(projectID),
+			ItemID:    githubv4.ID(item.ID()),
+			FieldID:   githubv4.ID(field.ID()),
+			Value:     value,
+		},
+	}
+	return config.client.Mutate("UpdateItemValues", query, variables)
+}
@@ -0,0 +1,304 @@
+package board
+
+import (
+	"testing"
+
+	"github.com/cli/cli/v2/internal/prompter"
+	"github.com/cli/cli/v2/pkg/cmd/project/shared/queries"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestNewCmdBoard(t *testing.T) {
+	tests := []struct {
+		name        string
+		cli         string
+		wants       boardOpts
+		wantsErr    bool
+		wantsErrMsg string
+	}{
+		{
+			name:        "not-a-number",
+			cli:         "x",
+			wantsErr:    true,
+			wantsErrMsg: "invalid number: x",
+		},
+		{
+			name: "number",
+			cli:  "123",
+			wants: boardOpts{
+				number: 123,
+			},
+		},
+		{
+			name: "owner",
+			cli:  "--owner monalisa",
+			wants: boardOpts{
+				owner: "monalisa",
+			},
+		},
+	}
+
+	t.Setenv("GH_TOKEN", "auth-token")
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ios, _, _, _ := iostreams.Test()
+			f := &cmdutil.Factory{
+				IOStreams: ios,
+			}
+
+			argv, err := shlex.Split(tt.cli)
+			assert.NoError(t, err)
+
+			var gotOpts boardOpts
+			cmd := NewCmdBoard(f, func(config boardConfig) error {
+				gotOpts = config.opts
+				return nil
+			})
+
+			cmd.SetArgs(argv)
+			_, err = cmd.ExecuteC()
+			if tt.wantsErr {
+				assert.Error(t, err)
+				assert.Equal(t, tt.wantsErrMsg, err.Error())
+				return
+			}
+			assert.NoError(t, err)
+
+			assert.Equal(t, tt.wants.number, gotOpts.number)
+			assert.Equal(t, tt.wants.owner, gotOpts.owner)
+		})
+	}
+}
+
+func TestRunBoard_NonInteractive(t *testing.T) {
+	ios, _, _, _ := iostreams.Test()
+	config := boardConfig{
+		opts: boardOpts{
+			owner:  "monalisa",
+			number: 1,
+		},
+		client: queries.NewTestClient(),
+		io:     ios,
+	}
+
+	err := runBoard(config)
+	assert.EqualError(t, err, "project board requires an interactive terminal")
+}
+
+func registerBoardQueries(t *testing.T) {
+	t.Helper()
+
+	// get project items
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		JSON(map[string]interface{}{
+			"query": "query UserProjectWithItems.*",
+			"variables": map[string]interface{}{
+				"firstItems":  queries.LimitMax,
+				"afterItems":  nil,
+				"firstFields": queries.LimitMax,
+				"afterFields": nil,
+				"login":       "monalisa",
+				"number":      1,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"id":    "project ID",
+						"title": "a project",
+						"items": map[string]interface{}{
+							"nodes": []map[string]interface{}{
+								{
+									"id": "issue item ID",
+									"content": map[string]interface{}{
+										"__typename": "Issue",
+										"title":      "an issue",
+										"number":     1,
+										"repository": map[string]string{
+											"nameWithOwner": "cli/go-gh",
+										},
+									},
+									"fieldValues": map[string]interface{}{
+										"nodes": []map[string]interface{}{
+											{
+												"__typename": "ProjectV2ItemFieldSingleSelectValue",
+												"name":       "To Do",
+												"field": map[string]interface{}{
+													"__typename": "ProjectV2SingleSelectField",
+													"name":       "Status",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		})
+
+	// get project fields
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		JSON(map[string]interface{}{
+			"query": "query UserProjectWithFields.*",
+			"variables": map[string]interface{}{
+				"firstItems":  queries.LimitMax,
+				"afterItems":  nil,
+				"firstFields": queries.LimitMax,
+				"afterFields": nil,
+				"login":       "monalisa",
+				"number":      1,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"fields": map[string]interface{}{
+							"nodes": []map[string]interface{}{
+								{
+									"__typename": "ProjectV2SingleSelectField",
+									"id":         "status ID",
+									"name":       "Status",
+									"options": []map[string]string{
+										{"id": "to do ID", "name": "To Do"},
+										{"id": "done ID", "name": "Done"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		})
+}
+
+func TestRunBoard_Move(t *testing.T) {
+	defer gock.Off()
+	// gock.Observe(gock.DumpRequest)
+
+	// get user ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query UserOrgOwner.*",
+			"variables": map[string]interface{}{
+				"login": "monalisa",
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"id": "an ID",
+				},
+			},
+			"errors": []interface{}{
+				map[string]interface{}{
+					"type": "NOT_FOUND",
+					"path": []string{"organization"},
+				},
+			},
+		})
+
+	// get project
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query UserProject.*",
+			"variables": map[string]interface{}{
+				"login":       "monalisa",
+				"number":      1,
+				"firstItems":  0,
+				"afterItems":  nil,
+				"firstFields": 0,
+				"afterFields": nil,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"id":     "project ID",
+						"number": 1,
+					},
+				},
+			},
+		})
+
+	registerBoardQueries(t)
+
+	// move the item to the Done column
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`.*"input":{"projectId":"project ID","itemId":"issue item ID","fieldId":"status ID","value":{"singleSelectOptionId":"done ID"}}.*`).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"updateProjectV2ItemFieldValue": map[string]interface{}{
+					"projectV2Item": map[string]interface{}{
+						"id": "issue item ID",
+					},
+				},
+			},
+		})
+
+	// the board is redrawn after the move
+	registerBoardQueries(t)
+
+	client := queries.NewTestClient()
+
+	actionPrompts := 0
+	pm := &prompter.PrompterMock{}
+	pm.SelectFunc = func(prompt, _ string, opts []string) (int, error) {
+		switch prompt {
+		case "What would you like to do?":
+			actionPrompts++
+			if actionPrompts == 1 {
+				return prompter.IndexFor(opts, "Move an item")
+			}
+			return prompter.IndexFor(opts, "Quit")
+		case "Which item?":
+			return prompter.IndexFor(opts, "cli/go-gh#1 an issue [To Do]")
+		case "Move to which column?":
+			return prompter.IndexFor(opts, "Done")
+		default:
+			return -1, prompter.NoSuchPromptErr(prompt)
+		}
+	}
+
+	ios, _, stdout, _ := iostreams.Test()
+	ios.SetStdinTTY(true)
+	ios.SetStdoutTTY(true)
+	config := boardConfig{
+		opts: boardOpts{
+			owner:  "monalisa",
+			number: 1,
+		},
+		client:   client,
+		io:       ios,
+		prompter: pm,
+	}
+
+	err := runBoard(config)
+	assert.NoError(t, err)
+	assert.Contains(t, stdout.String(), "a project")
+	assert.Contains(t, stdout.String(), "To Do (1)")
+	assert.Contains(t, stdout.String(), "Done (0)")
+	assert.Contains(t, stdout.String(), "No status (0)")
+	assert.Contains(t, stdout.String(), "cli/go-gh#1 an issue")
+}
@@ -75,9 +75,9 @@ func NewCmdClose(f *cmdutil.Factory, runF func(config closeConfig) error) *cobra
 		},
 	}
 
-	closeCmd.Flags().StringVar(&opts.owner, "owner", "", "Login of the owner. Use \"@me\" for the current user.")
+	closeCmd.Flags().StringVar(&opts.owner, "owner", "", "Login of the owner. Use \"@me\" for the current user. Defaults to the owner of the current directory's repository.")
 	closeCmd.Flags().BoolVar(&opts.reopen, "undo", false, "Reopen a closed project")
-	cmdutil.AddFormatFlags(closeCmd, &opts.exporter)
+	cmdutil.AddFormatAndJSONFlags(closeCmd, &opts.exporter, queries.ProjectJSONFields)
 
 	return closeCmd
 }
@@ -3,6 +3,8 @@ package copy
 import (
 	"fmt"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/MakeNowJust/heredoc"
 	"github.com/cli/cli/v2/pkg/cmd/project/shared/client"
@@ -15,6 +17,8 @@ import (
 
 type copyOpts struct {
 	includeDraftIssues bool
+	includeItems       bool
+	includeViews       bool
 	number             int32
 	ownerID            string
 	projectID          string
@@ -36,17 +40,65 @@ type copyProjectMutation struct {
 	} `graphql:"copyProjectV2(input:$input)"`
 }
 
+type addProjectItemMutation struct {
+	CreateProjectItem struct {
+		ProjectV2Item queries.ProjectItem `graphql:"item"`
+	} `graphql:"addProjectV2ItemById(input:$input)"`
+}
+
+type createProjectDraftItemMutation struct {
+	CreateProjectDraftItem struct {
+		ProjectV2Item queries.ProjectItem `graphql:"projectItem"`
+	} `graphql:"addProjectV2DraftIssue(input:$input)"`
+}
+
+type updateProjectV2FieldValueMutation struct {
+	UpdateProjectV2ItemFieldValue struct {
+		Item queries.ProjectItem `graphql:"projectV2Item"`
+	} `graphql:"updateProjectV2ItemFieldValue(input:$input)"`
+}
+
+// CreateProjectV2ViewInput mirrors the GraphQL input of the same name, which
+// is not available in the githubv4 bindings.
+type CreateProjectV2ViewInput struct {
+	ProjectID githubv4.ID                  `json:"projectId"`
+	Name      githubv4.String              `json:"name"`
+	Layout    githubv4.ProjectV2ViewLayout `json:"layout"`
+}
+
+type createProjectV2ViewMutation struct {
+	CreateProjectV2View struct {
+		View queries.ProjectV2View `graphql:"projectV2View"`
+	} `graphql:"createProjectV2View(input:$input)"`
+}
+
 func NewCmdCopy(f *cmdutil.Factory, runF func(config copyConfig) error) *cobra.Command {
 	opts := copyOpts{}
 	copyCmd := &cobra.Command{
 		Short: "Copy a project",
 		Use:   "copy [<number>]",
+		Long: heredoc.Docf(`
+			Copy a project.
+
+			By default only the project fields are copied. With %[1]s--include-items%[1]s, the
+			draft issues and linked items of the source project are copied along with
+			their text, number, date, and single-select field values; iteration values
+			are not copied. With %[1]s--include-views%[1]s, the views of the source project
+			are recreated on the copy.
+		`, "`"),
 		Example: heredoc.Doc(`
 			# copy project "1" owned by monalisa to github
 			gh project copy 1 --source-owner monalisa --target-owner github --title "a new project"
+
+			# copy the project with its items, field values, and views
+			gh project copy 1 --source-owner monalisa --target-owner github --title "a new project" --include-items --include-views
 		`),
 		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.includeItems && opts.includeDraftIssues {
+				return cmdutil.FlagErrorf("cannot use `--drafts` with `--include-items`")
+			}
+
 			client, err := client.New(f)
 			if err != nil {
 				return err
@@ -78,7 +130,9 @@ func NewCmdCopy(f *cmdutil.Factory, runF func(config copyConfig) error) *cobra.C
 	copyCmd.Flags().StringVar(&opts.targetOwner, "target-owner", "", "Login of the target owner. Use \"@me\" for the current user.")
 	copyCmd.Flags().StringVar(&opts.title, "title", "", "Title for the new project")
 	copyCmd.Flags().BoolVar(&opts.includeDraftIssues, "drafts", false, "Include draft issues when copying")
-	cmdutil.AddFormatFlags(copyCmd, &opts.exporter)
+	copyCmd.Flags().BoolVar(&opts.includeItems, "include-items", false, "Copy items and their field values to the new project")
+	copyCmd.Flags().BoolVar(&opts.includeViews, "include-views", false, "Copy views to the new project")
+	cmdutil.AddFormatAndJSONFlags(copyCmd, &opts.exporter, queries.ProjectJSONFields)
 
 	_ = copyCmd.MarkFlagRequired("title")
 
@@ -104,6 +158,7 @@ func runCopy(config copyConfig) error {
 
 	config.opts.projectID = project.ID
 	config.opts.ownerID = targetOwner.ID
+	config.opts.number = project.Number
 
 	query, variables := copyArgs(config)
 
@@ -112,11 +167,212 @@ func runCopy(config copyConfig) error {
 		return err
 	}
 
+	copied := query.CopyProjectV2.ProjectV2
+
+	if config.opts.includeItems {
+		if err := copyItems(config, sourceOwner, targetOwner, copied); err != nil {
+			return err
+		}
+	}
+
+	if config.opts.includeViews {
+		if err := copyViews(config, sourceOwner, copied); err != nil {
+			return err
+		}
+	}
+
 	if config.opts.exporter != nil {
-		return config.opts.exporter.Write(config.io, query.CopyProjectV2.ProjectV2)
+		return config.opts.exporter.Write(config.io, copied)
+	}
+
+	return printResults(config, copied)
+}
+
+// copyItems copies the items of the source project, along with their field
+// values, to the copied project. Field values are matched to the copied fields
+// by name, and single-select values are remapped to the option IDs of the
+// copied field.
+func copyItems(config copyConfig, sourceOwner *queries.Owner, targetOwner *queries.Owner, copied queries.Project) error {
+	source, err := config.client.ProjectItems(sourceOwner, config.opts.number, queries.LimitMax)
+	if err != nil {
+		return err
+	}
+
+	target, err := config.client.ProjectFields(targetOwner, copied.Number, queries.LimitMax)
+	if err != nil {
+		return err
 	}
+	targetFields := make(map[string]queries.ProjectField, len(target.Fields.Nodes))
+	for _, f := range target.Fields.Nodes {
+		targetFields[strings.ToLower(f.Name())] = f
+	}
+
+	config.io.StartProgressIndicator()
+	var failed int
+	for _, item := range source.Items.Nodes {
+		if err := copySingleItem(config, targetFields, copied.ID, item); err != nil {
+			config.io.StopProgressIndicator()
+			fmt.Fprintf(config.io.ErrOut, "%q: %v\n", item.Title(), err)
+			failed++
+			config.io.StartProgressIndicator()
+		}
+	}
+	config.io.StopProgressIndicator()
+
+	if failed > 0 {
+		return fmt.Errorf("failed to copy %d of %d items", failed, len(source.Items.Nodes))
+	}
+
+	if config.io.IsStdoutTTY() {
+		if _, err := fmt.Fprintf(config.io.Out, "Copied %d items\n", len(source.Items.Nodes)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func copySingleItem(config copyConfig, targetFields map[string]queries.ProjectField, projectID string, item queries.ProjectItem) error {
+	var itemID string
+	if item.Type() == "DraftIssue" {
+		draftQuery := &createProjectDraftItemMutation{}
+		variables := map[string]interface{}{
+			"input": githubv4.AddProjectV2DraftIssueInput{
+				Body:      githubv4.NewString(githubv4.String(item.Body())),
+				ProjectID: githubv4.ID(projectID),
+				Title:     githubv4.String(item.Title()),
+			},
+		}
+		if err := config.client.Mutate("CreateDraftItem", draftQuery, variables); err != nil {
+			return err
+		}
+		itemID = draftQuery.CreateProjectDraftItem.ProjectV2Item.Id
+	} else {
+		contentID, err := config.client.IssueOrPullRequestID(item.URL())
+		if err != nil {
+			return err
+		}
+		addQuery := &addProjectItemMutation{}
+		variables := map[string]interface{}{
+			"input": githubv4.AddProjectV2ItemByIdInput{
+				ProjectID: githubv4.ID(projectID),
+				ContentID: githubv4.ID(contentID),
+			},
+		}
+		if err := config.client.Mutate("AddItem", addQuery, variables); err != nil {
+			return err
+		}
+		itemID = addQuery.CreateProjectItem.ProjectV2Item.Id
+	}
+
+	for _, v := range item.FieldValues.Nodes {
+		field, value, ok, err := copiedFieldValue(targetFields, v)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			continue
+		}
+		updateQuery := &updateProjectV2FieldValueMutation{}
+		variables := map[string]interface{}{
+			"input": githubv4.UpdateProjectV2ItemFieldValueInput{
+				ProjectID: githubv4.ID(projectID),
+				ItemID:    githubv4.ID(itemID),
+				FieldID:   githubv4.ID(field.ID()),
+				Value:     value,
+			},
+		}
+		if err := config.client.Mutate("UpdateItemValues", updateQuery, variables); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// copiedFieldValue translates a field value of a source item into a value for
+// the matching field of the copied project. The built-in Title field and value
+// types that cannot be set through the API, such as iterations and labels, are
+// skipped.
+func copiedFieldValue(targetFields map[string]queries.ProjectField, v queries.FieldValueNodes) (queries.ProjectField, githubv4.ProjectV2FieldValue, bool, error) {
+	var name string
+	var value githubv4.ProjectV2FieldValue
 
-	return printResults(config, query.CopyProjectV2.ProjectV2)
+	switch v.Type {
+	case "ProjectV2ItemFieldTextValue":
+		name = v.ProjectV2ItemFieldTextValue.Field.Name()
+		value = githubv4.ProjectV2FieldValue{
+			Text: githubv4.NewString(githubv4.String(v.ProjectV2ItemFieldTextValue.Text)),
+		}
+	case "ProjectV2ItemFieldNumberValue":
+		name = v.ProjectV2ItemFieldNumberValue.Field.Name()
+		value = githubv4.ProjectV2FieldValue{
+			Number: githubv4.NewFloat(githubv4.Float(v.ProjectV2ItemFieldNumberValue.Number)),
+		}
+	case "ProjectV2ItemFieldDateValue":
+		name = v.ProjectV2ItemFieldDateValue.Field.Name()
+		date, err := time.Parse("2006-01-02", v.ProjectV2ItemFieldDateValue.Date)
+		if err != nil {
+			return queries.ProjectField{}, value, false, fmt.Errorf("invalid date %q for field %q", v.ProjectV2ItemFieldDateValue.Date, name)
+		}
+		value = githubv4.ProjectV2FieldValue{
+			Date: githubv4.NewDate(githubv4.Date{Time: date}),
+		}
+	case "ProjectV2ItemFieldSingleSelectValue":
+		name = v.ProjectV2ItemFieldSingleSelectValue.Field.Name()
+		field, ok := targetFields[strings.ToLower(name)]
+		if !ok {
+			return queries.ProjectField{}, value, false, nil
+		}
+		optionName := v.ProjectV2ItemFieldSingleSelectValue.Name
+		for _, opt := range field.SingleSelectField.Options {
+			if strings.EqualFold(opt.Name, optionName) {
+				return field, githubv4.ProjectV2FieldValue{
+					SingleSelectOptionID: githubv4.NewString(githubv4.String(opt.ID)),
+				}, true, nil
+			}
+		}
+		return queries.ProjectField{}, value, false, fmt.Errorf("%q is not an option of field %q", optionName, field.Name())
+	default:
+		return queries.ProjectField{}, value, false, nil
+	}
+
+	if name == "Title" {
+		return queries.ProjectField{}, value, false, nil
+	}
+	field, ok := targetFields[strings.ToLower(name)]
+	if !ok {
+		return queries.ProjectField{}, value, false, nil
+	}
+	return field, value, true, nil
+}
+
+// copyViews recreates the views of the source project on the copied project.
+func copyViews(config copyConfig, sourceOwner *queries.Owner, copied queries.Project) error {
+	views, err := config.client.ProjectViews(sourceOwner, config.opts.number, queries.LimitMax)
+	if err != nil {
+		return err
+	}
+
+	for _, view := range views.Nodes {
+		query := &createProjectV2ViewMutation{}
+		variables := map[string]interface{}{
+			"input": CreateProjectV2ViewInput{
+				ProjectID: githubv4.ID(copied.ID),
+				Name:      githubv4.String(view.Name),
+				Layout:    githubv4.ProjectV2ViewLayout(view.Layout),
+			},
+		}
+		if err := config.client.Mutate("CreateView", query, variables); err != nil {
+			return err
+		}
+	}
+
+	if config.io.IsStdoutTTY() {
+		if _, err := fmt.Fprintf(config.io.Out, "Copied %d views\n", len(views.Nodes)); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func copyArgs(config copyConfig) (*copyProjectMutation, map[string]interface{}) {
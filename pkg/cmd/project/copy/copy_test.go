@@ -65,6 +65,28 @@ func TestNewCmdCopy(t *testing.T) {
 				title:              "t",
 			},
 		},
+		{
+			name: "include-items",
+			cli:  "--include-items --title t",
+			wants: copyOpts{
+				includeItems: true,
+				title:        "t",
+			},
+		},
+		{
+			name: "include-views",
+			cli:  "--include-views --title t",
+			wants: copyOpts{
+				includeViews: true,
+				title:        "t",
+			},
+		},
+		{
+			name:        "drafts and include-items",
+			cli:         "--drafts --include-items --title t",
+			wantsErr:    true,
+			wantsErrMsg: "cannot use `--drafts` with `--include-items`",
+		},
 		{
 			name: "json",
 			cli:  "--format json --title t",
@@ -107,6 +129,8 @@ func TestNewCmdCopy(t *testing.T) {
 			assert.Equal(t, tt.wants.targetOwner, gotOpts.targetOwner)
 			assert.Equal(t, tt.wants.title, gotOpts.title)
 			assert.Equal(t, tt.wants.includeDraftIssues, gotOpts.includeDraftIssues)
+			assert.Equal(t, tt.wants.includeItems, gotOpts.includeItems)
+			assert.Equal(t, tt.wants.includeViews, gotOpts.includeViews)
 			assert.Equal(t, tt.wantsExporter, gotOpts.exporter != nil)
 		})
 	}
@@ -584,3 +608,447 @@ func TestRunCopy_JSON(t *testing.T) {
 		`{"number":1,"url":"http://a-url.com","shortDescription":"","public":false,"closed":false,"title":"a title","id":"","readme":"","items":{"totalCount":0},"fields":{"totalCount":0},"owner":{"type":"","login":"monalisa"}}`,
 		stdout.String())
 }
+
+func TestRunCopy_IncludeItems(t *testing.T) {
+	defer gock.Off()
+	// gock.Observe(gock.DumpRequest)
+
+	// get source project ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query UserProject.*",
+			"variables": map[string]interface{}{
+				"login":       "monalisa",
+				"number":      1,
+				"firstItems":  0,
+				"afterItems":  nil,
+				"firstFields": 0,
+				"afterFields": nil,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"id":     "source ID",
+						"number": 1,
+					},
+				},
+			},
+		})
+
+	// get source user ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query UserOrgOwner.*",
+			"variables": map[string]string{
+				"login": "monalisa",
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"id":    "an ID",
+					"login": "monalisa",
+				},
+			},
+			"errors": []interface{}{
+				map[string]interface{}{
+					"type": "NOT_FOUND",
+					"path": []string{"organization"},
+				},
+			},
+		})
+
+	// get target user ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query UserOrgOwner.*",
+			"variables": map[string]string{
+				"login": "monalisa",
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"id":    "an ID",
+					"login": "monalisa",
+				},
+			},
+			"errors": []interface{}{
+				map[string]interface{}{
+					"type": "NOT_FOUND",
+					"path": []string{"organization"},
+				},
+			},
+		})
+
+	// copy project
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`{"query":"mutation CopyProjectV2.*","variables":{"afterFields":null,"afterItems":null,"firstFields":0,"firstItems":0,"input":{"projectId":"source ID","ownerId":"an ID","title":"a title","includeDraftIssues":false}}}`).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"copyProjectV2": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"id":     "copied ID",
+						"number": 2,
+						"title":  "a title",
+						"url":    "http://a-url.com",
+						"owner": map[string]string{
+							"login": "monalisa",
+						},
+					},
+				},
+			},
+		})
+
+	// get source project items
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		JSON(map[string]interface{}{
+			"query": "query UserProjectWithItems.*",
+			"variables": map[string]interface{}{
+				"firstItems":  queries.LimitMax,
+				"afterItems":  nil,
+				"firstFields": queries.LimitMax,
+				"afterFields": nil,
+				"login":       "monalisa",
+				"number":      1,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"items": map[string]interface{}{
+							"nodes": []map[string]interface{}{
+								{
+									"id": "draft item ID",
+									"content": map[string]interface{}{
+										"id":         "draft content ID",
+										"__typename": "DraftIssue",
+										"title":      "a draft",
+										"body":       "draft body",
+									},
+									"fieldValues": map[string]interface{}{
+										"nodes": []map[string]interface{}{
+											{
+												"__typename": "ProjectV2ItemFieldSingleSelectValue",
+												"name":       "In Progress",
+												"field": map[string]interface{}{
+													"__typename": "ProjectV2SingleSelectField",
+													"name":       "Status",
+												},
+											},
+											{
+												"__typename": "ProjectV2ItemFieldTextValue",
+												"text":       "some notes",
+												"field": map[string]interface{}{
+													"__typename": "ProjectV2Field",
+													"name":       "Notes",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		})
+
+	// get target project fields
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		JSON(map[string]interface{}{
+			"query": "query UserProjectWithFields.*",
+			"variables": map[string]interface{}{
+				"firstItems":  queries.LimitMax,
+				"afterItems":  nil,
+				"firstFields": queries.LimitMax,
+				"afterFields": nil,
+				"login":       "monalisa",
+				"number":      2,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"fields": map[string]interface{}{
+							"nodes": []map[string]interface{}{
+								{
+									"__typename": "ProjectV2SingleSelectField",
+									"id":         "target status ID",
+									"name":       "Status",
+									"options": []map[string]string{
+										{"id": "done option ID", "name": "Done"},
+										{"id": "in progress option ID", "name": "In Progress"},
+									},
+								},
+								{
+									"__typename": "ProjectV2Field",
+									"id":         "target notes ID",
+									"name":       "Notes",
+									"dataType":   "TEXT",
+								},
+							},
+						},
+					},
+				},
+			},
+		})
+
+	// create draft item
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`{"query":"mutation CreateDraftItem.*","variables":{"input":{"projectId":"copied ID","title":"a draft","body":"draft body"}}}`).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"addProjectV2DraftIssue": map[string]interface{}{
+					"projectItem": map[string]interface{}{
+						"id": "new item ID",
+					},
+				},
+			},
+		})
+
+	// set the remapped single-select value
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`{"query":"mutation UpdateItemValues.*","variables":{"input":{"projectId":"copied ID","itemId":"new item ID","fieldId":"target status ID","value":{"singleSelectOptionId":"in progress option ID"}}}}`).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"updateProjectV2ItemFieldValue": map[string]interface{}{
+					"projectV2Item": map[string]interface{}{
+						"id": "new item ID",
+					},
+				},
+			},
+		})
+
+	// set the text value
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`{"query":"mutation UpdateItemValues.*","variables":{"input":{"projectId":"copied ID","itemId":"new item ID","fieldId":"target notes ID","value":{"text":"some notes"}}}}`).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"updateProjectV2ItemFieldValue": map[string]interface{}{
+					"projectV2Item": map[string]interface{}{
+						"id": "new item ID",
+					},
+				},
+			},
+		})
+
+	client := queries.NewTestClient()
+
+	ios, _, stdout, _ := iostreams.Test()
+	ios.SetStdoutTTY(true)
+	config := copyConfig{
+		io: ios,
+		opts: copyOpts{
+			title:        "a title",
+			sourceOwner:  "monalisa",
+			targetOwner:  "monalisa",
+			number:       1,
+			includeItems: true,
+		},
+		client: client,
+	}
+
+	err := runCopy(config)
+	assert.NoError(t, err)
+	assert.Equal(t, "Copied 1 items\nhttp://a-url.com\n", stdout.String())
+}
+
+func TestRunCopy_IncludeViews(t *testing.T) {
+	defer gock.Off()
+	// gock.Observe(gock.DumpRequest)
+
+	// get source project ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query UserProject.*",
+			"variables": map[string]interface{}{
+				"login":       "monalisa",
+				"number":      1,
+				"firstItems":  0,
+				"afterItems":  nil,
+				"firstFields": 0,
+				"afterFields": nil,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"id":     "source ID",
+						"number": 1,
+					},
+				},
+			},
+		})
+
+	// get source user ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query UserOrgOwner.*",
+			"variables": map[string]string{
+				"login": "monalisa",
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"id":    "an ID",
+					"login": "monalisa",
+				},
+			},
+			"errors": []interface{}{
+				map[string]interface{}{
+					"type": "NOT_FOUND",
+					"path": []string{"organization"},
+				},
+			},
+		})
+
+	// get target user ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query UserOrgOwner.*",
+			"variables": map[string]string{
+				"login": "monalisa",
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"id":    "an ID",
+					"login": "monalisa",
+				},
+			},
+			"errors": []interface{}{
+				map[string]interface{}{
+					"type": "NOT_FOUND",
+					"path": []string{"organization"},
+				},
+			},
+		})
+
+	// copy project
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`{"query":"mutation CopyProjectV2.*","variables":{"afterFields":null,"afterItems":null,"firstFields":0,"firstItems":0,"input":{"projectId":"source ID","ownerId":"an ID","title":"a title","includeDraftIssues":false}}}`).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"copyProjectV2": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"id":     "copied ID",
+						"number": 2,
+						"title":  "a title",
+						"url":    "http://a-url.com",
+						"owner": map[string]string{
+							"login": "monalisa",
+						},
+					},
+				},
+			},
+		})
+
+	// get source project views
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		JSON(map[string]interface{}{
+			"query": "query UserProjectWithViews.*",
+			"variables": map[string]interface{}{
+				"firstViews": queries.LimitMax,
+				"afterViews": nil,
+				"login":      "monalisa",
+				"number":     1,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"views": map[string]interface{}{
+							"totalCount": 1,
+							"nodes": []map[string]interface{}{
+								{
+									"id":     "view ID",
+									"number": 1,
+									"name":   "Sprint board",
+									"layout": "BOARD_LAYOUT",
+								},
+							},
+						},
+					},
+				},
+			},
+		})
+
+	// recreate the view on the copy
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`{"query":"mutation CreateView.*","variables":{"input":{"projectId":"copied ID","name":"Sprint board","layout":"BOARD_LAYOUT"}}}`).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"createProjectV2View": map[string]interface{}{
+					"projectV2View": map[string]interface{}{
+						"id":   "new view ID",
+						"name": "Sprint board",
+					},
+				},
+			},
+		})
+
+	client := queries.NewTestClient()
+
+	ios, _, stdout, _ := iostreams.Test()
+	ios.SetStdoutTTY(true)
+	config := copyConfig{
+		io: ios,
+		opts: copyOpts{
+			title:        "a title",
+			sourceOwner:  "monalisa",
+			targetOwner:  "monalisa",
+			number:       1,
+			includeViews: true,
+		},
+		client: client,
+	}
+
+	err := runCopy(config)
+	assert.NoError(t, err)
+	assert.Equal(t, "Copied 1 views\nhttp://a-url.com\n", stdout.String())
+}
@@ -13,10 +13,13 @@ import (
 )
 
 type createOpts struct {
-	title    string
-	owner    string
-	ownerID  string
-	exporter cmdutil.Exporter
+	title         string
+	owner         string
+	ownerID       string
+	fromTemplate  int32
+	templateOwner string
+	templateID    string
+	exporter      cmdutil.Exporter
 }
 
 type createConfig struct {
@@ -31,6 +34,12 @@ type createProjectMutation struct {
 	} `graphql:"createProjectV2(input:$input)"`
 }
 
+type copyProjectTemplateMutation struct {
+	CopyProjectV2 struct {
+		ProjectV2 queries.Project `graphql:"projectV2"`
+	} `graphql:"copyProjectV2(input:$input)"`
+}
+
 func NewCmdCreate(f *cmdutil.Factory, runF func(config createConfig) error) *cobra.Command {
 	opts := createOpts{}
 	createCmd := &cobra.Command{
@@ -39,8 +48,15 @@ func NewCmdCreate(f *cmdutil.Factory, runF func(config createConfig) error) *cob
 		Example: heredoc.Doc(`
 			# create a new project owned by login monalisa
 			gh project create --owner monalisa --title "a new project"
+
+			# create a new project from the github org's template project "1"
+			gh project create --owner monalisa --title "a new project" --from-template 1 --template-owner github
 		`),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.templateOwner != "" && opts.fromTemplate == 0 {
+				return cmdutil.FlagErrorf("`--template-owner` requires `--from-template`")
+			}
+
 			client, err := client.New(f)
 			if err != nil {
 				return err
@@ -61,8 +77,10 @@ func NewCmdCreate(f *cmdutil.Factory, runF func(config createConfig) error) *cob
 	}
 
 	createCmd.Flags().StringVar(&opts.title, "title", "", "Title for the project")
-	createCmd.Flags().StringVar(&opts.owner, "owner", "", "Login of the owner. Use \"@me\" for the current user.")
-	cmdutil.AddFormatFlags(createCmd, &opts.exporter)
+	createCmd.Flags().StringVar(&opts.owner, "owner", "", "Login of the owner. Use \"@me\" for the current user. Defaults to the owner of the current directory's repository.")
+	createCmd.Flags().Int32Var(&opts.fromTemplate, "from-template", 0, "Number of a template project to copy fields, views, and workflows from")
+	createCmd.Flags().StringVar(&opts.templateOwner, "template-owner", "", "Login of the template project's owner, defaults to the new project's owner")
+	cmdutil.AddFormatAndJSONFlags(createCmd, &opts.exporter, queries.ProjectJSONFields)
 
 	_ = createCmd.MarkFlagRequired("title")
 
@@ -77,6 +95,11 @@ func runCreate(config createConfig) error {
 	}
 
 	config.opts.ownerID = owner.ID
+
+	if config.opts.fromTemplate != 0 {
+		return runCreateFromTemplate(config, owner)
+	}
+
 	query, variables := createArgs(config)
 
 	err = config.client.Mutate("CreateProjectV2", query, variables)
@@ -91,6 +114,39 @@ func runCreate(config createConfig) error {
 	return printResults(config, query.CreateProjectV2.ProjectV2)
 }
 
+// runCreateFromTemplate instantiates the new project as a copy of a template
+// project, carrying over its fields, views, and workflows.
+func runCreateFromTemplate(config createConfig, owner *queries.Owner) error {
+	canPrompt := config.io.CanPrompt()
+	templateOwner := owner
+	if config.opts.templateOwner != "" {
+		var err error
+		templateOwner, err = config.client.NewOwner(canPrompt, config.opts.templateOwner)
+		if err != nil {
+			return err
+		}
+	}
+
+	template, err := config.client.NewProject(canPrompt, templateOwner, config.opts.fromTemplate, false)
+	if err != nil {
+		return err
+	}
+	config.opts.templateID = template.ID
+
+	query, variables := createFromTemplateArgs(config)
+
+	err = config.client.Mutate("CopyProjectV2", query, variables)
+	if err != nil {
+		return err
+	}
+
+	if config.opts.exporter != nil {
+		return config.opts.exporter.Write(config.io, query.CopyProjectV2.ProjectV2)
+	}
+
+	return printResults(config, query.CopyProjectV2.ProjectV2)
+}
+
 func createArgs(config createConfig) (*createProjectMutation, map[string]interface{}) {
 	return &createProjectMutation{}, map[string]interface{}{
 		"input": githubv4.CreateProjectV2Input{
@@ -104,6 +160,20 @@ func createArgs(config createConfig) (*createProjectMutation, map[string]interfa
 	}
 }
 
+func createFromTemplateArgs(config createConfig) (*copyProjectTemplateMutation, map[string]interface{}) {
+	return &copyProjectTemplateMutation{}, map[string]interface{}{
+		"input": githubv4.CopyProjectV2Input{
+			ProjectID: githubv4.ID(config.opts.templateID),
+			OwnerID:   githubv4.ID(config.opts.ownerID),
+			Title:     githubv4.String(config.opts.title),
+		},
+		"firstItems":  githubv4.Int(0),
+		"afterItems":  (*githubv4.String)(nil),
+		"firstFields": githubv4.Int(0),
+		"afterFields": (*githubv4.String)(nil),
+	}
+}
+
 func printResults(config createConfig, project queries.Project) error {
 	if !config.io.IsStdoutTTY() {
 		return nil
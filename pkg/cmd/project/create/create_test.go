@@ -43,6 +43,21 @@ func TestNewCmdCreate(t *testing.T) {
 			},
 			wantsExporter: true,
 		},
+		{
+			name: "from-template",
+			cli:  "--title t --from-template 1 --template-owner github",
+			wants: createOpts{
+				title:         "t",
+				fromTemplate:  1,
+				templateOwner: "github",
+			},
+		},
+		{
+			name:        "template-owner-without-from-template",
+			cli:         "--title t --template-owner github",
+			wantsErr:    true,
+			wantsErrMsg: "`--template-owner` requires `--from-template`",
+		},
 	}
 
 	t.Setenv("GH_TOKEN", "auth-token")
@@ -74,6 +89,8 @@ func TestNewCmdCreate(t *testing.T) {
 
 			assert.Equal(t, tt.wants.title, gotOpts.title)
 			assert.Equal(t, tt.wants.owner, gotOpts.owner)
+			assert.Equal(t, tt.wants.fromTemplate, gotOpts.fromTemplate)
+			assert.Equal(t, tt.wants.templateOwner, gotOpts.templateOwner)
 			assert.Equal(t, tt.wantsExporter, gotOpts.exporter != nil)
 		})
 	}
@@ -149,6 +166,103 @@ func TestRunCreate_User(t *testing.T) {
 		stdout.String())
 }
 
+func TestRunCreate_FromTemplate(t *testing.T) {
+	defer gock.Off()
+	gock.Observe(gock.DumpRequest)
+
+	// get user ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query UserOrgOwner.*",
+			"variables": map[string]string{
+				"login": "monalisa",
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"id":    "an ID",
+					"login": "monalisa",
+				},
+			},
+			"errors": []interface{}{
+				map[string]interface{}{
+					"type": "NOT_FOUND",
+					"path": []string{"organization"},
+				},
+			},
+		})
+
+	// get template project ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query UserProject.*",
+			"variables": map[string]interface{}{
+				"login":       "monalisa",
+				"number":      1,
+				"firstItems":  0,
+				"afterItems":  nil,
+				"firstFields": 0,
+				"afterFields": nil,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"projectV2": map[string]string{
+						"id": "template ID",
+					},
+				},
+			},
+		})
+
+	// copy template project
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`{"query":"mutation CopyProjectV2.*","variables":{"afterFields":null,"afterItems":null,"firstFields":0,"firstItems":0,"input":{"projectId":"template ID","ownerId":"an ID","title":"a title"}}}`).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"copyProjectV2": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"title": "a title",
+						"url":   "http://a-url.com",
+						"owner": map[string]string{
+							"login": "monalisa",
+						},
+					},
+				},
+			},
+		})
+
+	client := queries.NewTestClient()
+
+	ios, _, stdout, _ := iostreams.Test()
+	ios.SetStdoutTTY(true)
+	config := createConfig{
+		opts: createOpts{
+			title:        "a title",
+			owner:        "monalisa",
+			fromTemplate: 1,
+		},
+		client: client,
+		io:     ios,
+	}
+
+	err := runCreate(config)
+	assert.NoError(t, err)
+	assert.Equal(
+		t,
+		"http://a-url.com\n",
+		stdout.String())
+}
+
 func TestRunCreate_Org(t *testing.T) {
 	defer gock.Off()
 	gock.Observe(gock.DumpRequest)
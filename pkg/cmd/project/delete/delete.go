@@ -70,8 +70,8 @@ func NewCmdDelete(f *cmdutil.Factory, runF func(config deleteConfig) error) *cob
 		},
 	}
 
-	deleteCmd.Flags().StringVar(&opts.owner, "owner", "", "Login of the owner. Use \"@me\" for the current user.")
-	cmdutil.AddFormatFlags(deleteCmd, &opts.exporter)
+	deleteCmd.Flags().StringVar(&opts.owner, "owner", "", "Login of the owner. Use \"@me\" for the current user. Defaults to the owner of the current directory's repository.")
+	cmdutil.AddFormatAndJSONFlags(deleteCmd, &opts.exporter, queries.ProjectJSONFields)
 
 	return deleteCmd
 }
@@ -19,15 +19,21 @@ type editOpts struct {
 	title            string
 	readme           string
 	visibility       string
+	template         string
 	shortDescription string
 	projectID        string
 	exporter         cmdutil.Exporter
 }
 
+type iprompter interface {
+	Confirm(string, bool) (bool, error)
+}
+
 type editConfig struct {
-	client *queries.Client
-	opts   editOpts
-	io     *iostreams.IOStreams
+	client   *queries.Client
+	opts     editOpts
+	io       *iostreams.IOStreams
+	prompter iprompter
 }
 
 type updateProjectMutation struct {
@@ -36,6 +42,18 @@ type updateProjectMutation struct {
 	} `graphql:"updateProjectV2(input:$input)"`
 }
 
+type markProjectTemplateMutation struct {
+	TemplateProject struct {
+		Project queries.Project `graphql:"projectV2"`
+	} `graphql:"markProjectV2AsTemplate(input:$input)"`
+}
+
+type unmarkProjectTemplateMutation struct {
+	TemplateProject struct {
+		Project queries.Project `graphql:"projectV2"`
+	} `graphql:"unmarkProjectV2AsTemplate(input:$input)"`
+}
+
 const projectVisibilityPublic = "PUBLIC"
 const projectVisibilityPrivate = "PRIVATE"
 
@@ -64,12 +82,13 @@ func NewCmdEdit(f *cmdutil.Factory, runF func(config editConfig) error) *cobra.C
 			}
 
 			config := editConfig{
-				client: client,
-				opts:   opts,
-				io:     f.IOStreams,
+				client:   client,
+				opts:     opts,
+				io:       f.IOStreams,
+				prompter: f.Prompter,
 			}
 
-			if config.opts.title == "" && config.opts.shortDescription == "" && config.opts.readme == "" && config.opts.visibility == "" {
+			if config.opts.title == "" && config.opts.shortDescription == "" && config.opts.readme == "" && config.opts.visibility == "" && config.opts.template == "" {
 				return fmt.Errorf("no fields to edit")
 			}
 			// allow testing of the command without actually running it
@@ -80,18 +99,30 @@ func NewCmdEdit(f *cmdutil.Factory, runF func(config editConfig) error) *cobra.C
 		},
 	}
 
-	editCmd.Flags().StringVar(&opts.owner, "owner", "", "Login of the owner. Use \"@me\" for the current user.")
+	editCmd.Flags().StringVar(&opts.owner, "owner", "", "Login of the owner. Use \"@me\" for the current user. Defaults to the owner of the current directory's repository.")
 	cmdutil.StringEnumFlag(editCmd, &opts.visibility, "visibility", "", "", []string{projectVisibilityPublic, projectVisibilityPrivate}, "Change project visibility")
+	cmdutil.StringEnumFlag(editCmd, &opts.template, "is-template", "", "", []string{"true", "false"}, "Mark or unmark the project as a template (org projects only)")
 	editCmd.Flags().StringVar(&opts.title, "title", "", "New title for the project")
 	editCmd.Flags().StringVar(&opts.readme, "readme", "", "New readme for the project")
 	editCmd.Flags().StringVarP(&opts.shortDescription, "description", "d", "", "New description of the project")
-	cmdutil.AddFormatFlags(editCmd, &opts.exporter)
+	cmdutil.AddFormatAndJSONFlags(editCmd, &opts.exporter, queries.ProjectJSONFields)
 
 	return editCmd
 }
 
 func runEdit(config editConfig) error {
 	canPrompt := config.io.CanPrompt()
+
+	if config.opts.visibility == projectVisibilityPublic && canPrompt {
+		confirmed, err := config.prompter.Confirm("Public projects are visible to everyone. Make this project public?", false)
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			return cmdutil.CancelError
+		}
+	}
+
 	owner, err := config.client.NewOwner(canPrompt, config.opts.owner)
 	if err != nil {
 		return err
@@ -103,17 +134,38 @@ func runEdit(config editConfig) error {
 	}
 	config.opts.projectID = project.ID
 
-	query, variables := editArgs(config)
-	err = config.client.Mutate("UpdateProjectV2", query, variables)
-	if err != nil {
-		return err
+	edited := *project
+	if config.opts.title != "" || config.opts.shortDescription != "" || config.opts.readme != "" || config.opts.visibility != "" {
+		query, variables := editArgs(config)
+		err = config.client.Mutate("UpdateProjectV2", query, variables)
+		if err != nil {
+			return err
+		}
+		edited = query.UpdateProjectV2.ProjectV2
+	}
+
+	switch config.opts.template {
+	case "true":
+		query, variables := markTemplateArgs(config)
+		err = config.client.Mutate("MarkProjectTemplate", query, variables)
+		if err != nil {
+			return err
+		}
+		edited = query.TemplateProject.Project
+	case "false":
+		query, variables := unmarkTemplateArgs(config)
+		err = config.client.Mutate("UnmarkProjectTemplate", query, variables)
+		if err != nil {
+			return err
+		}
+		edited = query.TemplateProject.Project
 	}
 
 	if config.opts.exporter != nil {
-		return config.opts.exporter.Write(config.io, query.UpdateProjectV2.ProjectV2)
+		return config.opts.exporter.Write(config.io, edited)
 	}
 
-	return printResults(config, query.UpdateProjectV2.ProjectV2)
+	return printResults(config, edited)
 }
 
 func editArgs(config editConfig) (*updateProjectMutation, map[string]interface{}) {
@@ -144,6 +196,30 @@ func editArgs(config editConfig) (*updateProjectMutation, map[string]interface{}
 	}
 }
 
+func markTemplateArgs(config editConfig) (*markProjectTemplateMutation, map[string]interface{}) {
+	return &markProjectTemplateMutation{}, map[string]interface{}{
+		"input": githubv4.MarkProjectV2AsTemplateInput{
+			ProjectID: githubv4.ID(config.opts.projectID),
+		},
+		"firstItems":  githubv4.Int(0),
+		"afterItems":  (*githubv4.String)(nil),
+		"firstFields": githubv4.Int(0),
+		"afterFields": (*githubv4.String)(nil),
+	}
+}
+
+func unmarkTemplateArgs(config editConfig) (*unmarkProjectTemplateMutation, map[string]interface{}) {
+	return &unmarkProjectTemplateMutation{}, map[string]interface{}{
+		"input": githubv4.UnmarkProjectV2AsTemplateInput{
+			ProjectID: githubv4.ID(config.opts.projectID),
+		},
+		"firstItems":  githubv4.Int(0),
+		"afterItems":  (*githubv4.String)(nil),
+		"firstFields": githubv4.Int(0),
+		"afterFields": (*githubv4.String)(nil),
+	}
+}
+
 func printResults(config editConfig, project queries.Project) error {
 	if !config.io.IsStdoutTTY() {
 		return nil
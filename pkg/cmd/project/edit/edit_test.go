@@ -82,6 +82,19 @@ func TestNewCmdEdit(t *testing.T) {
 				visibility: "PUBLIC",
 			},
 		},
+		{
+			name: "is-template",
+			cli:  "--is-template true",
+			wants: editOpts{
+				template: "true",
+			},
+		},
+		{
+			name:        "is-template-error",
+			cli:         "--is-template maybe",
+			wantsErr:    true,
+			wantsErrMsg: "invalid argument \"maybe\" for \"--is-template\" flag: valid values are {true|false}",
+		},
 		{
 			name: "json",
 			cli:  "--format json --title t",
@@ -125,6 +138,7 @@ func TestNewCmdEdit(t *testing.T) {
 			assert.Equal(t, tt.wants.title, gotOpts.title)
 			assert.Equal(t, tt.wants.readme, gotOpts.readme)
 			assert.Equal(t, tt.wants.shortDescription, gotOpts.shortDescription)
+			assert.Equal(t, tt.wants.template, gotOpts.template)
 			assert.Equal(t, tt.wantsExporter, gotOpts.exporter != nil)
 		})
 	}
@@ -609,3 +623,95 @@ func TestRunUpdate_JSON(t *testing.T) {
 		`{"number":1,"url":"http://a-url.com","shortDescription":"","public":false,"closed":false,"title":"a title","id":"","readme":"","items":{"totalCount":0},"fields":{"totalCount":0},"owner":{"type":"","login":"monalisa"}}`,
 		stdout.String())
 }
+
+func TestRunUpdate_MarkTemplate(t *testing.T) {
+	defer gock.Off()
+	gock.Observe(gock.DumpRequest)
+	// get org ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query UserOrgOwner.*",
+			"variables": map[string]interface{}{
+				"login": "github",
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"organization": map[string]interface{}{
+					"id": "an ID",
+				},
+			},
+			"errors": []interface{}{
+				map[string]interface{}{
+					"type": "NOT_FOUND",
+					"path": []string{"user"},
+				},
+			},
+		})
+
+	// get project ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query OrgProject.*",
+			"variables": map[string]interface{}{
+				"login":       "github",
+				"number":      1,
+				"firstItems":  0,
+				"afterItems":  nil,
+				"firstFields": 0,
+				"afterFields": nil,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"organization": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"id": "an ID",
+					},
+				},
+			},
+		})
+
+	// template project
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`{"query":"mutation MarkProjectTemplate.*","variables":{"afterFields":null,"afterItems":null,"firstFields":0,"firstItems":0,"input":{"projectId":"an ID"}}}`).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"markProjectV2AsTemplate": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"number": 1,
+						"url":    "http://a-url.com",
+					},
+				},
+			},
+		})
+
+	client := queries.NewTestClient()
+
+	ios, _, stdout, _ := iostreams.Test()
+	ios.SetStdoutTTY(true)
+	config := editConfig{
+		opts: editOpts{
+			number:   1,
+			owner:    "github",
+			template: "true",
+		},
+		client: client,
+		io:     ios,
+	}
+
+	err := runEdit(config)
+	assert.NoError(t, err)
+	assert.Equal(
+		t,
+		"http://a-url.com\n",
+		stdout.String())
+}
@@ -3,6 +3,8 @@ package fieldcreate
 import (
 	"fmt"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/MakeNowJust/heredoc"
 	"github.com/cli/cli/v2/pkg/cmd/project/shared/client"
@@ -14,13 +16,19 @@ import (
 )
 
 type createFieldOpts struct {
-	name                string
-	dataType            string
-	owner               string
-	singleSelectOptions []string
-	number              int32
-	projectID           string
-	exporter            cmdutil.Exporter
+	name                    string
+	dataType                string
+	description             string
+	defaultValue            string
+	owner                   string
+	singleSelectOptions     []string
+	singleSelectOptionSpecs []string
+	iterationStartDate      string
+	iterationDuration       int32
+	iterations              int32
+	number                  int32
+	projectID               string
+	exporter                cmdutil.Exporter
 }
 
 type createFieldConfig struct {
@@ -35,6 +43,26 @@ type createProjectV2FieldMutation struct {
 	} `graphql:"createProjectV2Field(input:$input)"`
 }
 
+// CreateProjectV2FieldInput mirrors githubv4.CreateProjectV2FieldInput and adds
+// the iteration configuration, which the library does not define. The type name
+// determines the name of the GraphQL input type.
+type CreateProjectV2FieldInput struct {
+	ProjectID              githubv4.ID                                       `json:"projectId"`
+	DataType               githubv4.ProjectV2CustomFieldType                 `json:"dataType"`
+	Name                   githubv4.String                                   `json:"name"`
+	Description            *githubv4.String                                  `json:"description,omitempty"`
+	DefaultValue           *githubv4.String                                  `json:"defaultValue,omitempty"`
+	SingleSelectOptions    *[]githubv4.ProjectV2SingleSelectFieldOptionInput `json:"singleSelectOptions,omitempty"`
+	IterationConfiguration *ProjectV2IterationFieldConfigurationInput        `json:"iterationConfiguration,omitempty"`
+}
+
+// ProjectV2IterationFieldConfigurationInput is the schedule for an ITERATION field.
+type ProjectV2IterationFieldConfigurationInput struct {
+	StartDate  githubv4.Date `json:"startDate"`
+	Duration   githubv4.Int  `json:"duration"`
+	Iterations githubv4.Int  `json:"iterations"`
+}
+
 func NewCmdCreateField(f *cmdutil.Factory, runF func(config createFieldConfig) error) *cobra.Command {
 	opts := createFieldOpts{}
 	createFieldCmd := &cobra.Command{
@@ -46,6 +74,9 @@ func NewCmdCreateField(f *cmdutil.Factory, runF func(config createFieldConfig) e
 
 			# create a field with three options to select from for owner monalisa
 			gh project field-create 1 --owner monalisa --name "new field" --data-type "SINGLE_SELECT" --single-select-options "one,two,three"
+
+			# create a field whose options carry a color and a description
+			gh project field-create 1 --owner monalisa --name "new field" --data-type "SINGLE_SELECT" --single-select-option "Blocked:RED:waiting on dependency" --single-select-option "Done:GREEN"
 		`),
 		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -68,8 +99,23 @@ func NewCmdCreateField(f *cmdutil.Factory, runF func(config createFieldConfig) e
 				io:     f.IOStreams,
 			}
 
-			if config.opts.dataType == "SINGLE_SELECT" && len(config.opts.singleSelectOptions) == 0 {
-				return fmt.Errorf("passing `--single-select-options` is required for SINGLE_SELECT data type")
+			if config.opts.dataType == "SINGLE_SELECT" && len(config.opts.singleSelectOptions) == 0 && len(config.opts.singleSelectOptionSpecs) == 0 {
+				return fmt.Errorf("passing `--single-select-options` or `--single-select-option` is required for SINGLE_SELECT data type")
+			}
+
+			if config.opts.dataType == "ITERATION" && config.opts.iterationStartDate == "" {
+				return fmt.Errorf("passing `--iteration-start-date` is required for ITERATION data type")
+			}
+
+			if config.opts.defaultValue != "" {
+				if config.opts.dataType != "TEXT" && config.opts.dataType != "NUMBER" {
+					return cmdutil.FlagErrorf("`--default-value` is only supported for TEXT and NUMBER data types")
+				}
+				if config.opts.dataType == "NUMBER" {
+					if _, err := strconv.ParseFloat(config.opts.defaultValue, 64); err != nil {
+						return cmdutil.FlagErrorf("invalid `--default-value` for NUMBER data type: %q", config.opts.defaultValue)
+					}
+				}
 			}
 
 			// allow testing of the command without actually running it
@@ -80,11 +126,17 @@ func NewCmdCreateField(f *cmdutil.Factory, runF func(config createFieldConfig) e
 		},
 	}
 
-	createFieldCmd.Flags().StringVar(&opts.owner, "owner", "", "Login of the owner. Use \"@me\" for the current user.")
+	createFieldCmd.Flags().StringVar(&opts.owner, "owner", "", "Login of the owner. Use \"@me\" for the current user. Defaults to the owner of the current directory's repository.")
 	createFieldCmd.Flags().StringVar(&opts.name, "name", "", "Name of the new field")
-	cmdutil.StringEnumFlag(createFieldCmd, &opts.dataType, "data-type", "", "", []string{"TEXT", "SINGLE_SELECT", "DATE", "NUMBER"}, "DataType of the new field.")
+	createFieldCmd.Flags().StringVar(&opts.description, "description", "", "Description of the new field")
+	createFieldCmd.Flags().StringVar(&opts.defaultValue, "default-value", "", "Default value for new items, for TEXT and NUMBER data types")
+	cmdutil.StringEnumFlag(createFieldCmd, &opts.dataType, "data-type", "", "", []string{"TEXT", "SINGLE_SELECT", "DATE", "NUMBER", "ITERATION"}, "DataType of the new field.")
 	createFieldCmd.Flags().StringSliceVar(&opts.singleSelectOptions, "single-select-options", []string{}, "Options for SINGLE_SELECT data type")
-	cmdutil.AddFormatFlags(createFieldCmd, &opts.exporter)
+	createFieldCmd.Flags().StringArrayVar(&opts.singleSelectOptionSpecs, "single-select-option", nil, "Option for SINGLE_SELECT data type in `name[:color[:description]]` format (may be repeated)")
+	createFieldCmd.Flags().StringVar(&opts.iterationStartDate, "iteration-start-date", "", "Start date of the first iteration for ITERATION data type in YYYY-MM-DD format")
+	createFieldCmd.Flags().Int32Var(&opts.iterationDuration, "iteration-duration", 14, "Duration of each iteration for ITERATION data type in days")
+	createFieldCmd.Flags().Int32Var(&opts.iterations, "iterations", 3, "Number of iterations to schedule for ITERATION data type")
+	cmdutil.AddFormatAndJSONFlags(createFieldCmd, &opts.exporter, queries.FieldJSONFields)
 
 	_ = createFieldCmd.MarkFlagRequired("name")
 	_ = createFieldCmd.MarkFlagRequired("data-type")
@@ -105,12 +157,16 @@ func runCreateField(config createFieldConfig) error {
 	}
 	config.opts.projectID = project.ID
 
-	query, variables := createFieldArgs(config)
+	query, variables, err := createFieldArgs(config)
+	if err != nil {
+		return err
+	}
 
 	err = config.client.Mutate("CreateField", query, variables)
 	if err != nil {
 		return err
 	}
+	queries.InvalidateFieldCache()
 
 	if config.opts.exporter != nil {
 		return config.opts.exporter.Write(config.io, query.CreateProjectV2Field.Field)
@@ -119,14 +175,22 @@ func runCreateField(config createFieldConfig) error {
 	return printResults(config, query.CreateProjectV2Field.Field)
 }
 
-func createFieldArgs(config createFieldConfig) (*createProjectV2FieldMutation, map[string]interface{}) {
-	input := githubv4.CreateProjectV2FieldInput{
+func createFieldArgs(config createFieldConfig) (*createProjectV2FieldMutation, map[string]interface{}, error) {
+	input := CreateProjectV2FieldInput{
 		ProjectID: githubv4.ID(config.opts.projectID),
 		DataType:  githubv4.ProjectV2CustomFieldType(config.opts.dataType),
 		Name:      githubv4.String(config.opts.name),
 	}
 
-	if len(config.opts.singleSelectOptions) != 0 {
+	if config.opts.description != "" {
+		input.Description = githubv4.NewString(githubv4.String(config.opts.description))
+	}
+
+	if config.opts.defaultValue != "" {
+		input.DefaultValue = githubv4.NewString(githubv4.String(config.opts.defaultValue))
+	}
+
+	if len(config.opts.singleSelectOptions) != 0 || len(config.opts.singleSelectOptionSpecs) != 0 {
 		opts := make([]githubv4.ProjectV2SingleSelectFieldOptionInput, 0)
 		for _, opt := range config.opts.singleSelectOptions {
 			opts = append(opts, githubv4.ProjectV2SingleSelectFieldOptionInput{
@@ -134,12 +198,65 @@ func createFieldArgs(config createFieldConfig) (*createProjectV2FieldMutation, m
 				Color: githubv4.ProjectV2SingleSelectFieldOptionColor("GRAY"),
 			})
 		}
+		for _, spec := range config.opts.singleSelectOptionSpecs {
+			opt, err := parseSingleSelectOption(spec)
+			if err != nil {
+				return nil, nil, err
+			}
+			opts = append(opts, opt)
+		}
 		input.SingleSelectOptions = &opts
 	}
 
+	if config.opts.iterationStartDate != "" {
+		startDate, err := time.Parse("2006-01-02", config.opts.iterationStartDate)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid iteration start date: %w", err)
+		}
+		input.IterationConfiguration = &ProjectV2IterationFieldConfigurationInput{
+			StartDate:  githubv4.Date{Time: startDate},
+			Duration:   githubv4.Int(config.opts.iterationDuration),
+			Iterations: githubv4.Int(config.opts.iterations),
+		}
+	}
+
 	return &createProjectV2FieldMutation{}, map[string]interface{}{
 		"input": input,
+	}, nil
+}
+
+// singleSelectOptionColors are the display colors a single select option can have.
+var singleSelectOptionColors = []string{"GRAY", "BLUE", "GREEN", "YELLOW", "ORANGE", "RED", "PINK", "PURPLE"}
+
+// parseSingleSelectOption parses one --single-select-option definition in
+// name[:color[:description]] format. The color defaults to GRAY.
+func parseSingleSelectOption(spec string) (githubv4.ProjectV2SingleSelectFieldOptionInput, error) {
+	parts := strings.SplitN(spec, ":", 3)
+	option := githubv4.ProjectV2SingleSelectFieldOptionInput{
+		Name:  githubv4.String(parts[0]),
+		Color: githubv4.ProjectV2SingleSelectFieldOptionColor("GRAY"),
+	}
+	if parts[0] == "" {
+		return option, cmdutil.FlagErrorf("invalid `--single-select-option` value %q: expected name[:color[:description]]", spec)
+	}
+	if len(parts) > 1 && parts[1] != "" {
+		color := strings.ToUpper(parts[1])
+		valid := false
+		for _, c := range singleSelectOptionColors {
+			if color == c {
+				valid = true
+				break
+			}
+		}
+		if !valid {
+			return option, cmdutil.FlagErrorf("invalid color %q for option %q: valid colors are {%s}", parts[1], parts[0], strings.Join(singleSelectOptionColors, "|"))
+		}
+		option.Color = githubv4.ProjectV2SingleSelectFieldOptionColor(color)
+	}
+	if len(parts) > 2 {
+		option.Description = githubv4.String(parts[2])
 	}
+	return option, nil
 }
 
 func printResults(config createFieldConfig, field queries.ProjectField) error {
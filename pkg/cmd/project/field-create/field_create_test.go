@@ -36,7 +36,26 @@ func TestNewCmdCreateField(t *testing.T) {
 			name:        "single-select-no-options",
 			cli:         "123 --name n --data-type SINGLE_SELECT",
 			wantsErr:    true,
-			wantsErrMsg: "passing `--single-select-options` is required for SINGLE_SELECT data type",
+			wantsErrMsg: "passing `--single-select-options` or `--single-select-option` is required for SINGLE_SELECT data type",
+		},
+		{
+			name:        "iteration-no-start-date",
+			cli:         "123 --name n --data-type ITERATION",
+			wantsErr:    true,
+			wantsErrMsg: "passing `--iteration-start-date` is required for ITERATION data type",
+		},
+		{
+			name: "iteration",
+			cli:  "123 --name n --data-type ITERATION --iteration-start-date 2025-03-03 --iteration-duration 7 --iterations 5",
+			wants: createFieldOpts{
+				number:              123,
+				name:                "n",
+				dataType:            "ITERATION",
+				iterationStartDate:  "2025-03-03",
+				iterationDuration:   7,
+				iterations:          5,
+				singleSelectOptions: []string{},
+			},
 		},
 		{
 			name: "number",
@@ -46,6 +65,8 @@ func TestNewCmdCreateField(t *testing.T) {
 				name:                "n",
 				dataType:            "TEXT",
 				singleSelectOptions: []string{},
+				iterationDuration:   14,
+				iterations:          3,
 			},
 		},
 		{
@@ -56,6 +77,8 @@ func TestNewCmdCreateField(t *testing.T) {
 				name:                "n",
 				dataType:            "TEXT",
 				singleSelectOptions: []string{},
+				iterationDuration:   14,
+				iterations:          3,
 			},
 		},
 		{
@@ -65,8 +88,48 @@ func TestNewCmdCreateField(t *testing.T) {
 				singleSelectOptions: []string{"a", "b"},
 				name:                "n",
 				dataType:            "TEXT",
+				iterationDuration:   14,
+				iterations:          3,
 			},
 		},
+		{
+			name: "single-select-option-specs",
+			cli:  "--name n --data-type SINGLE_SELECT --single-select-option Blocked:RED:waiting --single-select-option Done:GREEN",
+			wants: createFieldOpts{
+				singleSelectOptions:     []string{},
+				singleSelectOptionSpecs: []string{"Blocked:RED:waiting", "Done:GREEN"},
+				name:                    "n",
+				dataType:                "SINGLE_SELECT",
+				iterationDuration:       14,
+				iterations:              3,
+			},
+		},
+		{
+			name: "description-and-default-value",
+			cli:  "123 --name n --data-type TEXT --description d --default-value hello",
+			wants: createFieldOpts{
+				number:              123,
+				name:                "n",
+				dataType:            "TEXT",
+				description:         "d",
+				defaultValue:        "hello",
+				singleSelectOptions: []string{},
+				iterationDuration:   14,
+				iterations:          3,
+			},
+		},
+		{
+			name:        "default-value-wrong-data-type",
+			cli:         "123 --name n --data-type DATE --default-value hello",
+			wantsErr:    true,
+			wantsErrMsg: "`--default-value` is only supported for TEXT and NUMBER data types",
+		},
+		{
+			name:        "default-value-not-a-number",
+			cli:         "123 --name n --data-type NUMBER --default-value abc",
+			wantsErr:    true,
+			wantsErrMsg: "invalid `--default-value` for NUMBER data type: \"abc\"",
+		},
 		{
 			name: "json",
 			cli:  "--format json --name n --data-type TEXT ",
@@ -74,6 +137,8 @@ func TestNewCmdCreateField(t *testing.T) {
 				name:                "n",
 				dataType:            "TEXT",
 				singleSelectOptions: []string{},
+				iterationDuration:   14,
+				iterations:          3,
 			},
 			wantsExporter: true,
 		},
@@ -110,7 +175,13 @@ func TestNewCmdCreateField(t *testing.T) {
 			assert.Equal(t, tt.wants.owner, gotOpts.owner)
 			assert.Equal(t, tt.wants.name, gotOpts.name)
 			assert.Equal(t, tt.wants.dataType, gotOpts.dataType)
+			assert.Equal(t, tt.wants.description, gotOpts.description)
+			assert.Equal(t, tt.wants.defaultValue, gotOpts.defaultValue)
 			assert.Equal(t, tt.wants.singleSelectOptions, gotOpts.singleSelectOptions)
+			assert.Equal(t, tt.wants.singleSelectOptionSpecs, gotOpts.singleSelectOptionSpecs)
+			assert.Equal(t, tt.wants.iterationStartDate, gotOpts.iterationStartDate)
+			assert.Equal(t, tt.wants.iterationDuration, gotOpts.iterationDuration)
+			assert.Equal(t, tt.wants.iterations, gotOpts.iterations)
 			assert.Equal(t, tt.wantsExporter, gotOpts.exporter != nil)
 		})
 	}
@@ -630,6 +701,151 @@ func TestRunCreateField_NUMBER(t *testing.T) {
 		stdout.String())
 }
 
+func TestRunCreateField_ITERATION(t *testing.T) {
+	defer gock.Off()
+	gock.Observe(gock.DumpRequest)
+	// get viewer ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query ViewerOwner.*",
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"viewer": map[string]interface{}{
+					"id": "an ID",
+				},
+			},
+		})
+
+	// get project ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query ViewerProject.*",
+			"variables": map[string]interface{}{
+				"number":      1,
+				"firstItems":  0,
+				"afterItems":  nil,
+				"firstFields": 0,
+				"afterFields": nil,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"viewer": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"id": "an ID",
+					},
+				},
+			},
+		})
+
+	// create Field
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`{"query":"mutation CreateField.*","variables":{"input":{"projectId":"an ID","dataType":"ITERATION","name":"a name","iterationConfiguration":{"startDate":"2025-03-03T00:00:00Z","duration":7,"iterations":5}}}}`).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"createProjectV2Field": map[string]interface{}{
+					"projectV2Field": map[string]interface{}{
+						"id": "Field ID",
+					},
+				},
+			},
+		})
+
+	client := queries.NewTestClient()
+
+	ios, _, stdout, _ := iostreams.Test()
+	ios.SetStdoutTTY(true)
+	config := createFieldConfig{
+		opts: createFieldOpts{
+			owner:              "@me",
+			number:             1,
+			name:               "a name",
+			dataType:           "ITERATION",
+			iterationStartDate: "2025-03-03",
+			iterationDuration:  7,
+			iterations:         5,
+		},
+		client: client,
+		io:     ios,
+	}
+
+	err := runCreateField(config)
+	assert.NoError(t, err)
+	assert.Equal(
+		t,
+		"Created field\n",
+		stdout.String())
+}
+
+func TestRunCreateField_InvalidStartDate(t *testing.T) {
+	ios, _, _, _ := iostreams.Test()
+	config := createFieldConfig{
+		opts: createFieldOpts{
+			owner:              "@me",
+			number:             1,
+			name:               "a name",
+			dataType:           "ITERATION",
+			iterationStartDate: "March 3",
+		},
+		client: queries.NewTestClient(),
+		io:     ios,
+	}
+
+	// get viewer ID
+	defer gock.Off()
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query ViewerOwner.*",
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"viewer": map[string]interface{}{
+					"id": "an ID",
+				},
+			},
+		})
+
+	// get project ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query ViewerProject.*",
+			"variables": map[string]interface{}{
+				"number":      1,
+				"firstItems":  0,
+				"afterItems":  nil,
+				"firstFields": 0,
+				"afterFields": nil,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"viewer": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"id": "an ID",
+					},
+				},
+			},
+		})
+
+	err := runCreateField(config)
+	assert.ErrorContains(t, err, "invalid iteration start date")
+}
+
 func TestRunCreateField_JSON(t *testing.T) {
 	defer gock.Off()
 	gock.Observe(gock.DumpRequest)
@@ -724,3 +940,260 @@ func TestRunCreateField_JSON(t *testing.T) {
 		`{"id":"Field ID","name":"a name","type":"ProjectV2Field"}`,
 		stdout.String())
 }
+
+func TestRunCreateField_DescriptionAndDefaultValue(t *testing.T) {
+	defer gock.Off()
+	gock.Observe(gock.DumpRequest)
+
+	// get user ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query UserOrgOwner.*",
+			"variables": map[string]interface{}{
+				"login": "monalisa",
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"id": "an ID",
+				},
+			},
+			"errors": []interface{}{
+				map[string]interface{}{
+					"type": "NOT_FOUND",
+					"path": []string{"organization"},
+				},
+			},
+		})
+
+	// get project ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query UserProject.*",
+			"variables": map[string]interface{}{
+				"login":       "monalisa",
+				"number":      1,
+				"firstItems":  0,
+				"afterItems":  nil,
+				"firstFields": 0,
+				"afterFields": nil,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"id": "an ID",
+					},
+				},
+			},
+		})
+
+	// create Field
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`{"query":"mutation CreateField.*","variables":{"input":{"projectId":"an ID","dataType":"TEXT","name":"a name","description":"a description","defaultValue":"hello"}}}`).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"createProjectV2Field": map[string]interface{}{
+					"projectV2Field": map[string]interface{}{
+						"id": "Field ID",
+					},
+				},
+			},
+		})
+
+	client := queries.NewTestClient()
+
+	ios, _, stdout, _ := iostreams.Test()
+	ios.SetStdoutTTY(true)
+	config := createFieldConfig{
+		opts: createFieldOpts{
+			name:         "a name",
+			owner:        "monalisa",
+			number:       1,
+			dataType:     "TEXT",
+			description:  "a description",
+			defaultValue: "hello",
+		},
+		client: client,
+		io:     ios,
+	}
+
+	err := runCreateField(config)
+	assert.NoError(t, err)
+	assert.Equal(
+		t,
+		"Created field\n",
+		stdout.String())
+}
+
+func TestRunCreateField_SingleSelectOptionSpecs(t *testing.T) {
+	defer gock.Off()
+	gock.Observe(gock.DumpRequest)
+
+	// get user ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query UserOrgOwner.*",
+			"variables": map[string]interface{}{
+				"login": "monalisa",
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"id": "an ID",
+				},
+			},
+			"errors": []interface{}{
+				map[string]interface{}{
+					"type": "NOT_FOUND",
+					"path": []string{"organization"},
+				},
+			},
+		})
+
+	// get project ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query UserProject.*",
+			"variables": map[string]interface{}{
+				"login":       "monalisa",
+				"number":      1,
+				"firstItems":  0,
+				"afterItems":  nil,
+				"firstFields": 0,
+				"afterFields": nil,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"id": "an ID",
+					},
+				},
+			},
+		})
+
+	// create Field
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`{"query":"mutation CreateField.*","variables":{"input":{"projectId":"an ID","dataType":"SINGLE_SELECT","name":"a name","singleSelectOptions":\[{"name":"Blocked","color":"RED","description":"waiting on dependency"},{"name":"Done","color":"GREEN","description":""}\]}}}`).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"createProjectV2Field": map[string]interface{}{
+					"projectV2Field": map[string]interface{}{
+						"id": "Field ID",
+					},
+				},
+			},
+		})
+
+	client := queries.NewTestClient()
+
+	ios, _, stdout, _ := iostreams.Test()
+	ios.SetStdoutTTY(true)
+	config := createFieldConfig{
+		opts: createFieldOpts{
+			name:                    "a name",
+			owner:                   "monalisa",
+			number:                  1,
+			dataType:                "SINGLE_SELECT",
+			singleSelectOptionSpecs: []string{"Blocked:RED:waiting on dependency", "Done:green"},
+		},
+		client: client,
+		io:     ios,
+	}
+
+	err := runCreateField(config)
+	assert.NoError(t, err)
+	assert.Equal(
+		t,
+		"Created field\n",
+		stdout.String())
+}
+
+func TestParseSingleSelectOption(t *testing.T) {
+	tests := []struct {
+		name        string
+		spec        string
+		wantName    string
+		wantColor   string
+		wantDesc    string
+		wantsErrMsg string
+	}{
+		{
+			name:      "name only",
+			spec:      "Blocked",
+			wantName:  "Blocked",
+			wantColor: "GRAY",
+		},
+		{
+			name:      "name and color",
+			spec:      "Blocked:RED",
+			wantName:  "Blocked",
+			wantColor: "RED",
+		},
+		{
+			name:      "lowercase color",
+			spec:      "Blocked:red",
+			wantName:  "Blocked",
+			wantColor: "RED",
+		},
+		{
+			name:      "name color and description",
+			spec:      "Blocked:RED:waiting on dependency",
+			wantName:  "Blocked",
+			wantColor: "RED",
+			wantDesc:  "waiting on dependency",
+		},
+		{
+			name:      "empty color keeps the default",
+			spec:      "Blocked::waiting on dependency",
+			wantName:  "Blocked",
+			wantColor: "GRAY",
+			wantDesc:  "waiting on dependency",
+		},
+		{
+			name:        "missing name",
+			spec:        ":RED",
+			wantsErrMsg: "invalid `--single-select-option` value \":RED\": expected name[:color[:description]]",
+		},
+		{
+			name:        "invalid color",
+			spec:        "Blocked:MAUVE",
+			wantsErrMsg: "invalid color \"MAUVE\" for option \"Blocked\": valid colors are {GRAY|BLUE|GREEN|YELLOW|ORANGE|RED|PINK|PURPLE}",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			option, err := parseSingleSelectOption(tt.spec)
+			if tt.wantsErrMsg != "" {
+				assert.EqualError(t, err, tt.wantsErrMsg)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantName, string(option.Name))
+			assert.Equal(t, tt.wantColor, string(option.Color))
+			assert.Equal(t, tt.wantDesc, string(option.Description))
+		})
+	}
+}
@@ -54,7 +54,7 @@ func NewCmdDeleteField(f *cmdutil.Factory, runF func(config deleteFieldConfig) e
 	}
 
 	deleteFieldCmd.Flags().StringVar(&opts.fieldID, "id", "", "ID of the field to delete")
-	cmdutil.AddFormatFlags(deleteFieldCmd, &opts.exporter)
+	cmdutil.AddFormatAndJSONFlags(deleteFieldCmd, &opts.exporter, queries.FieldJSONFields)
 
 	_ = deleteFieldCmd.MarkFlagRequired("id")
 
@@ -68,6 +68,7 @@ func runDeleteField(config deleteFieldConfig) error {
 	if err != nil {
 		return err
 	}
+	queries.InvalidateFieldCache()
 
 	if config.opts.exporter != nil {
 		return config.opts.exporter.Write(config.io, query.DeleteProjectV2Field.Field)
@@ -0,0 +1,132 @@
+package fieldedit
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/pkg/cmd/project/shared/client"
+	"github.com/cli/cli/v2/pkg/cmd/project/shared/queries"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/shurcooL/githubv4"
+	"github.com/spf13/cobra"
+)
+
+type editFieldOpts struct {
+	fieldID  string
+	position int
+	hidden   string
+	exporter cmdutil.Exporter
+}
+
+type editFieldConfig struct {
+	client *queries.Client
+	opts   editFieldOpts
+	io     *iostreams.IOStreams
+}
+
+type updateProjectV2FieldMutation struct {
+	UpdateProjectV2Field struct {
+		Field queries.ProjectField `graphql:"projectV2Field"`
+	} `graphql:"updateProjectV2Field(input:$input)"`
+}
+
+// UpdateProjectV2FieldInput is the input to the updateProjectV2Field
+// mutation. It is not yet defined in githubv4.
+type UpdateProjectV2FieldInput struct {
+	FieldID  githubv4.ID       `json:"fieldId"`
+	Position *githubv4.Int     `json:"position,omitempty"`
+	Hidden   *githubv4.Boolean `json:"hidden,omitempty"`
+}
+
+func NewCmdEditField(f *cmdutil.Factory, runF func(config editFieldConfig) error) *cobra.Command {
+	opts := editFieldOpts{}
+	editFieldCmd := &cobra.Command{
+		Short: "Edit a field in a project",
+		Use:   "field-edit",
+		Example: heredoc.Doc(`
+			# move a field to the second position
+			gh project field-edit --id <field-ID> --position 2
+
+			# hide a field
+			gh project field-edit --id <field-ID> --hidden true
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !cmd.Flags().Changed("position") && opts.hidden == "" {
+				return cmdutil.FlagErrorf("specify at least one of `--position` or `--hidden`")
+			}
+			if cmd.Flags().Changed("position") && opts.position < 1 {
+				return cmdutil.FlagErrorf("invalid value for --position: must be at least 1")
+			}
+
+			client, err := client.New(f)
+			if err != nil {
+				return err
+			}
+
+			config := editFieldConfig{
+				client: client,
+				opts:   opts,
+				io:     f.IOStreams,
+			}
+
+			// allow testing of the command without actually running it
+			if runF != nil {
+				return runF(config)
+			}
+			return runEditField(config)
+		},
+	}
+
+	editFieldCmd.Flags().StringVar(&opts.fieldID, "id", "", "ID of the field to edit")
+	editFieldCmd.Flags().IntVar(&opts.position, "position", 0, "New position of the field, starting at 1")
+	cmdutil.StringEnumFlag(editFieldCmd, &opts.hidden, "hidden", "", "", []string{"true", "false"}, "Hide or show the field")
+	cmdutil.AddFormatAndJSONFlags(editFieldCmd, &opts.exporter, queries.FieldJSONFields)
+
+	_ = editFieldCmd.MarkFlagRequired("id")
+
+	return editFieldCmd
+}
+
+func runEditField(config editFieldConfig) error {
+	query, variables := editFieldArgs(config)
+
+	err := config.client.Mutate("UpdateField", query, variables)
+	if err != nil {
+		return err
+	}
+	queries.InvalidateFieldCache()
+
+	if config.opts.exporter != nil {
+		return config.opts.exporter.Write(config.io, query.UpdateProjectV2Field.Field)
+	}
+
+	return printResults(config, query.UpdateProjectV2Field.Field)
+}
+
+func editFieldArgs(config editFieldConfig) (*updateProjectV2FieldMutation, map[string]interface{}) {
+	input := UpdateProjectV2FieldInput{
+		FieldID: githubv4.ID(config.opts.fieldID),
+	}
+	if config.opts.position > 0 {
+		input.Position = githubv4.NewInt(githubv4.Int(config.opts.position))
+	}
+	if config.opts.hidden == "true" {
+		input.Hidden = githubv4.NewBoolean(githubv4.Boolean(true))
+	} else if config.opts.hidden == "false" {
+		input.Hidden = githubv4.NewBoolean(githubv4.Boolean(false))
+	}
+
+	return &updateProjectV2FieldMutation{}, map[string]interface{}{
+		"input": input,
+	}
+}
+
+func printResults(config editFieldConfig, field queries.ProjectField) error {
+	if !config.io.IsStdoutTTY() {
+		return nil
+	}
+
+	_, err := fmt.Fprintf(config.io.Out, "Edited field %q\n", field.Name())
+	return err
+}
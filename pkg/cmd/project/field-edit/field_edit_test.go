@@ -0,0 +1,187 @@
+package fieldedit
+
+import (
+	"testing"
+
+	"github.com/cli/cli/v2/pkg/cmd/project/shared/queries"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestNewCmdEditField(t *testing.T) {
+	tests := []struct {
+		name          string
+		cli           string
+		wants         editFieldOpts
+		wantsErr      bool
+		wantsErrMsg   string
+		wantsExporter bool
+	}{
+		{
+			name:        "no id",
+			cli:         "--position 1",
+			wantsErr:    true,
+			wantsErrMsg: "required flag(s) \"id\" not set",
+		},
+		{
+			name:        "no changes",
+			cli:         "--id 123",
+			wantsErr:    true,
+			wantsErrMsg: "specify at least one of `--position` or `--hidden`",
+		},
+		{
+			name:        "invalid position",
+			cli:         "--id 123 --position 0",
+			wantsErr:    true,
+			wantsErrMsg: "invalid value for --position: must be at least 1",
+		},
+		{
+			name:        "invalid hidden",
+			cli:         "--id 123 --hidden yes",
+			wantsErr:    true,
+			wantsErrMsg: "invalid argument \"yes\" for \"--hidden\" flag: valid values are {true|false}",
+		},
+		{
+			name: "position",
+			cli:  "--id 123 --position 2",
+			wants: editFieldOpts{
+				fieldID:  "123",
+				position: 2,
+			},
+		},
+		{
+			name: "hidden",
+			cli:  "--id 123 --hidden true",
+			wants: editFieldOpts{
+				fieldID: "123",
+				hidden:  "true",
+			},
+		},
+		{
+			name: "json",
+			cli:  "--id 123 --position 1 --format json",
+			wants: editFieldOpts{
+				fieldID:  "123",
+				position: 1,
+			},
+			wantsExporter: true,
+		},
+	}
+
+	t.Setenv("GH_TOKEN", "auth-token")
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ios, _, _, _ := iostreams.Test()
+			f := &cmdutil.Factory{
+				IOStreams: ios,
+			}
+
+			argv, err := shlex.Split(tt.cli)
+			assert.NoError(t, err)
+
+			var gotOpts editFieldOpts
+			cmd := NewCmdEditField(f, func(config editFieldConfig) error {
+				gotOpts = config.opts
+				return nil
+			})
+
+			cmd.SetArgs(argv)
+			_, err = cmd.ExecuteC()
+			if tt.wantsErr {
+				assert.Error(t, err)
+				assert.Equal(t, tt.wantsErrMsg, err.Error())
+				return
+			}
+			assert.NoError(t, err)
+
+			assert.Equal(t, tt.wants.fieldID, gotOpts.fieldID)
+			assert.Equal(t, tt.wants.position, gotOpts.position)
+			assert.Equal(t, tt.wants.hidden, gotOpts.hidden)
+			assert.Equal(t, tt.wantsExporter, gotOpts.exporter != nil)
+		})
+	}
+}
+
+func TestRunEditField_Position(t *testing.T) {
+	defer gock.Off()
+	// gock.Observe(gock.DumpRequest)
+
+	// update field
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`{"query":"mutation UpdateField.*","variables":{"input":{"fieldId":"an ID","position":2}}}`).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"updateProjectV2Field": map[string]interface{}{
+					"projectV2Field": map[string]interface{}{
+						"__typename": "ProjectV2Field",
+						"id":         "Field ID",
+						"name":       "Status",
+					},
+				},
+			},
+		})
+
+	client := queries.NewTestClient()
+
+	ios, _, stdout, _ := iostreams.Test()
+	ios.SetStdoutTTY(true)
+	config := editFieldConfig{
+		opts: editFieldOpts{
+			fieldID:  "an ID",
+			position: 2,
+		},
+		client: client,
+		io:     ios,
+	}
+
+	err := runEditField(config)
+	assert.NoError(t, err)
+	assert.Equal(
+		t,
+		"Edited field \"Status\"\n",
+		stdout.String())
+}
+
+func TestRunEditField_Hide(t *testing.T) {
+	defer gock.Off()
+	// gock.Observe(gock.DumpRequest)
+
+	// update field
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`{"query":"mutation UpdateField.*","variables":{"input":{"fieldId":"an ID","hidden":true}}}`).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"updateProjectV2Field": map[string]interface{}{
+					"projectV2Field": map[string]interface{}{
+						"__typename": "ProjectV2Field",
+						"id":         "Field ID",
+						"name":       "Status",
+					},
+				},
+			},
+		})
+
+	client := queries.NewTestClient()
+
+	ios, _, stdout, _ := iostreams.Test()
+	config := editFieldConfig{
+		opts: editFieldOpts{
+			fieldID: "an ID",
+			hidden:  "true",
+		},
+		client: client,
+		io:     ios,
+	}
+
+	err := runEditField(config)
+	assert.NoError(t, err)
+	assert.Equal(t, "", stdout.String())
+}
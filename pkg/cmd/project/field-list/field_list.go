@@ -64,8 +64,8 @@ func NewCmdList(f *cmdutil.Factory, runF func(config listConfig) error) *cobra.C
 		},
 	}
 
-	listCmd.Flags().StringVar(&opts.owner, "owner", "", "Login of the owner. Use \"@me\" for the current user.")
-	cmdutil.AddFormatFlags(listCmd, &opts.exporter)
+	listCmd.Flags().StringVar(&opts.owner, "owner", "", "Login of the owner. Use \"@me\" for the current user. Defaults to the owner of the current directory's repository.")
+	cmdutil.AddFormatAndJSONFlags(listCmd, &opts.exporter, queries.FieldsJSONFields)
 	listCmd.Flags().IntVarP(&opts.limit, "limit", "L", queries.LimitDefault, "Maximum number of fields to fetch")
 
 	return listCmd
@@ -88,7 +88,7 @@ func runList(config listConfig) error {
 		config.opts.number = project.Number
 	}
 
-	project, err := config.client.ProjectFields(owner, config.opts.number, config.opts.limit)
+	project, err := config.client.ProjectFieldsCached(owner, config.opts.number, config.opts.limit)
 	if err != nil {
 		return err
 	}
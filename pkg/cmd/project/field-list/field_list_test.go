@@ -160,6 +160,8 @@ func TestRunList_User_tty(t *testing.T) {
 			},
 		})
 
+	t.Setenv("XDG_STATE_HOME", t.TempDir())
+
 	client := queries.NewTestClient()
 
 	ios, _, stdout, _ := iostreams.Test()
@@ -255,6 +257,8 @@ func TestRunList_User(t *testing.T) {
 			},
 		})
 
+	t.Setenv("XDG_STATE_HOME", t.TempDir())
+
 	client := queries.NewTestClient()
 
 	ios, _, stdout, _ := iostreams.Test()
@@ -347,6 +351,8 @@ func TestRunList_Org(t *testing.T) {
 			},
 		})
 
+	t.Setenv("XDG_STATE_HOME", t.TempDir())
+
 	client := queries.NewTestClient()
 
 	ios, _, stdout, _ := iostreams.Test()
@@ -429,6 +435,8 @@ func TestRunList_Me(t *testing.T) {
 			},
 		})
 
+	t.Setenv("XDG_STATE_HOME", t.TempDir())
+
 	client := queries.NewTestClient()
 
 	ios, _, stdout, _ := iostreams.Test()
@@ -495,6 +503,8 @@ func TestRunList_Empty(t *testing.T) {
 			},
 		})
 
+	t.Setenv("XDG_STATE_HOME", t.TempDir())
+
 	client := queries.NewTestClient()
 
 	ios, _, _, _ := iostreams.Test()
@@ -587,6 +597,8 @@ func TestRunList_JSON(t *testing.T) {
 			},
 		})
 
+	t.Setenv("XDG_STATE_HOME", t.TempDir())
+
 	client := queries.NewTestClient()
 
 	ios, _, stdout, _ := iostreams.Test()
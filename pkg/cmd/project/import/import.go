@@ -0,0 +1,395 @@
+package importcmd
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/pkg/cmd/project/shared/client"
+	"github.com/cli/cli/v2/pkg/cmd/project/shared/queries"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/shurcooL/githubv4"
+	"github.com/spf13/cobra"
+)
+
+type importOpts struct {
+	owner     string
+	number    int32
+	file      string
+	dryRun    bool
+	projectID string
+}
+
+type importConfig struct {
+	io     *iostreams.IOStreams
+	client *queries.Client
+	opts   importOpts
+}
+
+// importRow is a single parsed CSV row, ready to be imported.
+type importRow struct {
+	line   int
+	title  string
+	body   string
+	url    string
+	values []importFieldValue
+}
+
+type importFieldValue struct {
+	field queries.ProjectField
+	value githubv4.ProjectV2FieldValue
+}
+
+type addProjectItemMutation struct {
+	CreateProjectItem struct {
+		ProjectV2Item queries.ProjectItem `graphql:"item"`
+	} `graphql:"addProjectV2ItemById(input:$input)"`
+}
+
+type createProjectDraftItemMutation struct {
+	CreateProjectDraftItem struct {
+		ProjectV2Item queries.ProjectItem `graphql:"projectItem"`
+	} `graphql:"addProjectV2DraftIssue(input:$input)"`
+}
+
+type updateProjectV2FieldValueMutation struct {
+	UpdateProjectV2ItemFieldValue struct {
+		Item queries.ProjectItem `graphql:"projectV2Item"`
+	} `graphql:"updateProjectV2ItemFieldValue(input:$input)"`
+}
+
+func NewCmdImport(f *cmdutil.Factory, runF func(config importConfig) error) *cobra.Command {
+	opts := importOpts{}
+	importCmd := &cobra.Command{
+		Short: "Import items into a project from a CSV file",
+		Use:   "import [<number>]",
+		Long: heredoc.Doc(`
+			Import items into a project from a CSV file.
+
+			The first row of the file names the columns. A "title" column creates a
+			draft issue per row, with an optional "body" column; a "url" column adds
+			the existing issue or pull request at that URL instead. Every other
+			column must match a project field by name and sets that field on the
+			imported item. Rows that fail to import are reported with their line
+			number and do not stop the rest of the import.
+		`),
+		Example: heredoc.Doc(`
+			# import items into the current user's project "1"
+			gh project import 1 --owner "@me" --file backlog.csv
+
+			# validate a file without changing the project
+			gh project import 1 --owner "@me" --file backlog.csv --dry-run
+		`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := client.New(f)
+			if err != nil {
+				return err
+			}
+
+			if len(args) == 1 {
+				num, err := strconv.ParseInt(args[0], 10, 32)
+				if err != nil {
+					return cmdutil.FlagErrorf("invalid number: %v", args[0])
+				}
+				opts.number = int32(num)
+			}
+
+			config := importConfig{
+				io:     f.IOStreams,
+				client: client,
+				opts:   opts,
+			}
+
+			// allow testing of the command without actually running it
+			if runF != nil {
+				return runF(config)
+			}
+			return runImport(config)
+		},
+	}
+
+	importCmd.Flags().StringVar(&opts.owner, "owner", "", "Login of the owner. Use \"@me\" for the current user. Defaults to the owner of the current directory's repository.")
+	importCmd.Flags().StringVar(&opts.file, "file", "", "Path to the CSV `file` to import, use \"-\" to read from standard input")
+	importCmd.Flags().BoolVar(&opts.dryRun, "dry-run", false, "Validate the file without changing the project")
+
+	_ = importCmd.MarkFlagRequired("file")
+
+	return importCmd
+}
+
+func runImport(config importConfig) error {
+	canPrompt := config.io.CanPrompt()
+	owner, err := config.client.NewOwner(canPrompt, config.opts.owner)
+	if err != nil {
+		return err
+	}
+
+	project, err := config.client.NewProject(canPrompt, owner, config.opts.number, false)
+	if err != nil {
+		return err
+	}
+	config.opts.projectID = project.ID
+	config.opts.number = project.Number
+
+	fieldsProject, err := config.client.ProjectFields(owner, config.opts.number, queries.LimitMax)
+	if err != nil {
+		return err
+	}
+
+	reader := config.io.In
+	if config.opts.file != "-" {
+		f, err := os.Open(config.opts.file)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		reader = f
+	}
+
+	rows, err := parseImportFile(config, reader, fieldsProject.Fields.Nodes)
+	if err != nil {
+		return err
+	}
+
+	if config.opts.dryRun {
+		if config.io.IsStdoutTTY() {
+			_, err = fmt.Fprintf(config.io.Out, "Would import %d items into project %d\n", len(rows), config.opts.number)
+			return err
+		}
+		return nil
+	}
+
+	return importRows(config, rows)
+}
+
+// parseImportFile reads and validates every row, reporting all invalid rows at
+// once so a file can be fixed in a single pass.
+func parseImportFile(config importConfig, reader io.Reader, fields []queries.ProjectField) ([]importRow, error) {
+	csvReader := csv.NewReader(reader)
+	header, err := csvReader.Read()
+	if err == io.EOF {
+		return nil, fmt.Errorf("no header row in %s", config.opts.file)
+	} else if err != nil {
+		return nil, err
+	}
+
+	// map columns to project fields; title, body, and url are handled directly
+	titleCol, bodyCol, urlCol := -1, -1, -1
+	fieldCols := make(map[int]queries.ProjectField)
+	for i, col := range header {
+		switch strings.ToLower(col) {
+		case "title":
+			titleCol = i
+		case "body":
+			bodyCol = i
+		case "url":
+			urlCol = i
+		default:
+			var matched bool
+			for _, f := range fields {
+				if strings.EqualFold(f.Name(), col) {
+					fieldCols[i] = f
+					matched = true
+					break
+				}
+			}
+			if !matched {
+				return nil, fmt.Errorf("column %q does not match any project field", col)
+			}
+		}
+	}
+	if titleCol == -1 && urlCol == -1 {
+		return nil, fmt.Errorf("a %q or %q column is required", "title", "url")
+	}
+
+	var rows []importRow
+	var invalid int
+	for line := 2; ; line++ {
+		record, err := csvReader.Read()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+
+		row := importRow{line: line}
+		if titleCol != -1 {
+			row.title = record[titleCol]
+		}
+		if bodyCol != -1 {
+			row.body = record[bodyCol]
+		}
+		if urlCol != -1 {
+			row.url = record[urlCol]
+		}
+
+		rowErr := func(format string, args ...interface{}) {
+			fmt.Fprintf(config.io.ErrOut, "row %d: %s\n", line, fmt.Sprintf(format, args...))
+			invalid++
+		}
+
+		if row.title == "" && row.url == "" {
+			rowErr("a value for %q or %q is required", "title", "url")
+			continue
+		}
+		if row.title != "" && row.url != "" {
+			rowErr("cannot set both %q and %q", "title", "url")
+			continue
+		}
+
+		valid := true
+		for i := range record {
+			f, ok := fieldCols[i]
+			if !ok {
+				continue
+			}
+			raw := record[i]
+			if raw == "" {
+				continue
+			}
+			value, err := fieldValue(f, raw)
+			if err != nil {
+				rowErr("%v", err)
+				valid = false
+				break
+			}
+			row.values = append(row.values, importFieldValue{field: f, value: value})
+		}
+		if valid {
+			rows = append(rows, row)
+		}
+	}
+
+	if invalid > 0 {
+		return nil, fmt.Errorf("%d rows in %s are invalid", invalid, config.opts.file)
+	}
+	return rows, nil
+}
+
+// fieldValue converts a raw CSV value according to the data type of the
+// project field its column maps to.
+func fieldValue(field queries.ProjectField, raw string) (githubv4.ProjectV2FieldValue, error) {
+	switch field.Type() {
+	case "ProjectV2SingleSelectField":
+		for _, opt := range field.SingleSelectField.Options {
+			if strings.EqualFold(opt.Name, raw) {
+				return githubv4.ProjectV2FieldValue{
+					SingleSelectOptionID: githubv4.NewString(githubv4.String(opt.ID)),
+				}, nil
+			}
+		}
+		return githubv4.ProjectV2FieldValue{}, fmt.Errorf("%q is not an option of field %q", raw, field.Name())
+	case "ProjectV2IterationField":
+		return githubv4.ProjectV2FieldValue{}, fmt.Errorf("field %q is an iteration field; set it with `gh project item-edit`", field.Name())
+	}
+
+	switch field.Field.DataType {
+	case "NUMBER":
+		number, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return githubv4.ProjectV2FieldValue{}, fmt.Errorf("invalid number %q for field %q", raw, field.Name())
+		}
+		return githubv4.ProjectV2FieldValue{
+			Number: githubv4.NewFloat(githubv4.Float(number)),
+		}, nil
+	case "DATE":
+		date, err := time.Parse("2006-01-02", raw)
+		if err != nil {
+			return githubv4.ProjectV2FieldValue{}, fmt.Errorf("invalid date %q for field %q: expected the YYYY-MM-DD format", raw, field.Name())
+		}
+		return githubv4.ProjectV2FieldValue{
+			Date: githubv4.NewDate(githubv4.Date{Time: date}),
+		}, nil
+	default:
+		return githubv4.ProjectV2FieldValue{
+			Text: githubv4.NewString(githubv4.String(raw)),
+		}, nil
+	}
+}
+
+func importRows(config importConfig, rows []importRow) error {
+	config.io.StartProgressIndicator()
+	var failed int
+	for _, row := range rows {
+		if err := importSingleRow(config, row); err != nil {
+			config.io.StopProgressIndicator()
+			fmt.Fprintf(config.io.ErrOut, "row %d: %v\n", row.line, err)
+			failed++
+			config.io.StartProgressIndicator()
+		}
+	}
+	config.io.StopProgressIndicator()
+
+	if failed > 0 {
+		return fmt.Errorf("failed to import %d of %d items", failed, len(rows))
+	}
+
+	return printResults(config, len(rows))
+}
+
+func importSingleRow(config importConfig, row importRow) error {
+	var itemID string
+	if row.url != "" {
+		contentID, err := config.client.IssueOrPullRequestID(row.url)
+		if err != nil {
+			return err
+		}
+		addQuery := &addProjectItemMutation{}
+		variables := map[string]interface{}{
+			"input": githubv4.AddProjectV2ItemByIdInput{
+				ProjectID: githubv4.ID(config.opts.projectID),
+				ContentID: githubv4.ID(contentID),
+			},
+		}
+		if err := config.client.Mutate("AddItem", addQuery, variables); err != nil {
+			return err
+		}
+		itemID = addQuery.CreateProjectItem.ProjectV2Item.Id
+	} else {
+		draftQuery := &createProjectDraftItemMutation{}
+		variables := map[string]interface{}{
+			"input": githubv4.AddProjectV2DraftIssueInput{
+				Body:      githubv4.NewString(githubv4.String(row.body)),
+				ProjectID: githubv4.ID(config.opts.projectID),
+				Title:     githubv4.String(row.title),
+			},
+		}
+		if err := config.client.Mutate("CreateDraftItem", draftQuery, variables); err != nil {
+			return err
+		}
+		itemID = draftQuery.CreateProjectDraftItem.ProjectV2Item.Id
+	}
+
+	for _, v := range row.values {
+		updateQuery := &updateProjectV2FieldValueMutation{}
+		variables := map[string]interface{}{
+			"input": githubv4.UpdateProjectV2ItemFieldValueInput{
+				ProjectID: githubv4.ID(config.opts.projectID),
+				ItemID:    githubv4.ID(itemID),
+				FieldID:   githubv4.ID(v.field.ID()),
+				Value:     v.value,
+			},
+		}
+		if err := config.client.Mutate("UpdateItemValues", updateQuery, variables); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func printResults(config importConfig, count int) error {
+	if !config.io.IsStdoutTTY() {
+		return nil
+	}
+
+	_, err := fmt.Fprintf(config.io.Out, "Imported %d items into project %d\n", count, config.opts.number)
+	return err
+}
@@ -0,0 +1,414 @@
+package importcmd
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/cli/cli/v2/pkg/cmd/project/shared/queries"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestNewCmdImport(t *testing.T) {
+	tests := []struct {
+		name        string
+		cli         string
+		wants       importOpts
+		wantsErr    bool
+		wantsErrMsg string
+	}{
+		{
+			name:        "missing-file",
+			cli:         "",
+			wantsErr:    true,
+			wantsErrMsg: "required flag(s) \"file\" not set",
+		},
+		{
+			name:        "not-a-number",
+			cli:         "x --file data.csv",
+			wantsErr:    true,
+			wantsErrMsg: "invalid number: x",
+		},
+		{
+			name: "file",
+			cli:  "123 --file data.csv",
+			wants: importOpts{
+				number: 123,
+				file:   "data.csv",
+			},
+		},
+		{
+			name: "dry-run",
+			cli:  "--owner monalisa --file - --dry-run",
+			wants: importOpts{
+				owner:  "monalisa",
+				file:   "-",
+				dryRun: true,
+			},
+		},
+	}
+
+	t.Setenv("GH_TOKEN", "auth-token")
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ios, _, _, _ := iostreams.Test()
+			f := &cmdutil.Factory{
+				IOStreams: ios,
+			}
+
+			argv, err := shlex.Split(tt.cli)
+			assert.NoError(t, err)
+
+			var gotOpts importOpts
+			cmd := NewCmdImport(f, func(config importConfig) error {
+				gotOpts = config.opts
+				return nil
+			})
+
+			cmd.SetArgs(argv)
+			_, err = cmd.ExecuteC()
+			if tt.wantsErr {
+				assert.Equal(t, tt.wantsErrMsg, err.Error())
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+
+			assert.Equal(t, tt.wants.number, gotOpts.number)
+			assert.Equal(t, tt.wants.owner, gotOpts.owner)
+			assert.Equal(t, tt.wants.file, gotOpts.file)
+			assert.Equal(t, tt.wants.dryRun, gotOpts.dryRun)
+		})
+	}
+}
+
+func importTestFields() []queries.ProjectField {
+	var status queries.ProjectField
+	status.TypeName = "ProjectV2SingleSelectField"
+	status.SingleSelectField.ID = "status ID"
+	status.SingleSelectField.Name = "Status"
+	status.SingleSelectField.Options = []queries.SingleSelectFieldOptions{
+		{ID: "todo ID", Name: "Todo"},
+		{ID: "done ID", Name: "Done"},
+	}
+
+	var points queries.ProjectField
+	points.TypeName = "ProjectV2Field"
+	points.Field.ID = "points ID"
+	points.Field.Name = "Points"
+	points.Field.DataType = "NUMBER"
+
+	var due queries.ProjectField
+	due.TypeName = "ProjectV2Field"
+	due.Field.ID = "due ID"
+	due.Field.Name = "Due"
+	due.Field.DataType = "DATE"
+
+	return []queries.ProjectField{status, points, due}
+}
+
+func TestParseImportFile(t *testing.T) {
+	ios, _, _, _ := iostreams.Test()
+	config := importConfig{
+		io:   ios,
+		opts: importOpts{file: "data.csv"},
+	}
+
+	input := strings.NewReader(strings.Join([]string{
+		`title,body,url,Status,Points,Due`,
+		`a draft,draft body,,Todo,3,2025-06-01`,
+		`,,https://github.com/cli/cli/issues/1,done,,`,
+	}, "\n"))
+
+	rows, err := parseImportFile(config, input, importTestFields())
+	assert.NoError(t, err)
+	assert.Len(t, rows, 2)
+
+	assert.Equal(t, 2, rows[0].line)
+	assert.Equal(t, "a draft", rows[0].title)
+	assert.Equal(t, "draft body", rows[0].body)
+	assert.Len(t, rows[0].values, 3)
+	assert.Equal(t, "todo ID", string(*rows[0].values[0].value.SingleSelectOptionID))
+	assert.Equal(t, float64(3), float64(*rows[0].values[1].value.Number))
+	assert.Equal(t, "2025-06-01", rows[0].values[2].value.Date.Format("2006-01-02"))
+
+	assert.Equal(t, "https://github.com/cli/cli/issues/1", rows[1].url)
+	assert.Equal(t, "done ID", string(*rows[1].values[0].value.SingleSelectOptionID))
+}
+
+func TestParseImportFile_UnknownColumn(t *testing.T) {
+	ios, _, _, _ := iostreams.Test()
+	config := importConfig{
+		io:   ios,
+		opts: importOpts{file: "data.csv"},
+	}
+
+	input := strings.NewReader("title,Severity\nbug,high\n")
+
+	_, err := parseImportFile(config, input, importTestFields())
+	assert.EqualError(t, err, `column "Severity" does not match any project field`)
+}
+
+func TestParseImportFile_InvalidRows(t *testing.T) {
+	ios, _, _, stderr := iostreams.Test()
+	config := importConfig{
+		io:   ios,
+		opts: importOpts{file: "data.csv"},
+	}
+
+	input := strings.NewReader(strings.Join([]string{
+		`title,url,Status,Points`,
+		`,,Todo,1`,
+		`both,https://github.com/cli/cli/issues/1,,`,
+		`bad option,,Unknown,`,
+		`bad number,,,x`,
+		`fine,,Done,2`,
+	}, "\n"))
+
+	_, err := parseImportFile(config, input, importTestFields())
+	assert.EqualError(t, err, "4 rows in data.csv are invalid")
+	assert.Equal(t, strings.Join([]string{
+		`row 2: a value for "title" or "url" is required`,
+		`row 3: cannot set both "title" and "url"`,
+		`row 4: "Unknown" is not an option of field "Status"`,
+		`row 5: invalid number "x" for field "Points"`,
+	}, "\n")+"\n", stderr.String())
+}
+
+func TestRunImport_DryRun(t *testing.T) {
+	defer gock.Off()
+	// gock.Observe(gock.DumpRequest)
+
+	// get viewer ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query ViewerOwner.*",
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"viewer": map[string]interface{}{
+					"id": "an ID",
+				},
+			},
+		})
+
+	// get project ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query ViewerProject.*",
+			"variables": map[string]interface{}{
+				"number":      1,
+				"firstItems":  0,
+				"afterItems":  nil,
+				"firstFields": 0,
+				"afterFields": nil,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"viewer": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"id":     "project ID",
+						"number": 1,
+					},
+				},
+			},
+		})
+
+	// get project fields
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		JSON(map[string]interface{}{
+			"query": "query ViewerProject.*",
+			"variables": map[string]interface{}{
+				"number":      1,
+				"firstItems":  queries.LimitMax,
+				"afterItems":  nil,
+				"firstFields": queries.LimitMax,
+				"afterFields": nil,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"viewer": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"fields": map[string]interface{}{
+							"nodes": []map[string]interface{}{
+								{
+									"__typename": "ProjectV2Field",
+									"name":       "Points",
+									"id":         "points ID",
+								},
+							},
+						},
+					},
+				},
+			},
+		})
+
+	file := filepath.Join(t.TempDir(), "data.csv")
+	assert.NoError(t, os.WriteFile(file, []byte("title,Points\none,1\ntwo,2\n"), 0600))
+
+	client := queries.NewTestClient()
+
+	ios, _, stdout, _ := iostreams.Test()
+	ios.SetStdoutTTY(true)
+	config := importConfig{
+		opts: importOpts{
+			number: 1,
+			owner:  "@me",
+			file:   file,
+			dryRun: true,
+		},
+		client: client,
+		io:     ios,
+	}
+
+	err := runImport(config)
+	assert.NoError(t, err)
+	assert.Equal(t, "Would import 2 items into project 1\n", stdout.String())
+}
+
+func TestRunImport_Draft(t *testing.T) {
+	defer gock.Off()
+	// gock.Observe(gock.DumpRequest)
+
+	// get viewer ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query ViewerOwner.*",
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"viewer": map[string]interface{}{
+					"id": "an ID",
+				},
+			},
+		})
+
+	// get project ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query ViewerProject.*",
+			"variables": map[string]interface{}{
+				"number":      1,
+				"firstItems":  0,
+				"afterItems":  nil,
+				"firstFields": 0,
+				"afterFields": nil,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"viewer": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"id":     "project ID",
+						"number": 1,
+					},
+				},
+			},
+		})
+
+	// get project fields
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		JSON(map[string]interface{}{
+			"query": "query ViewerProject.*",
+			"variables": map[string]interface{}{
+				"number":      1,
+				"firstItems":  queries.LimitMax,
+				"afterItems":  nil,
+				"firstFields": queries.LimitMax,
+				"afterFields": nil,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"viewer": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"fields": map[string]interface{}{
+							"nodes": []map[string]interface{}{
+								{
+									"__typename": "ProjectV2Field",
+									"name":       "Points",
+									"id":         "points ID",
+									"dataType":   "NUMBER",
+								},
+							},
+						},
+					},
+				},
+			},
+		})
+
+	// create draft issue
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`{"query":"mutation CreateDraftItem.*","variables":{"input":{"projectId":"project ID","title":"a draft","body":"draft body"}}}`).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"addProjectV2DraftIssue": map[string]interface{}{
+					"projectItem": map[string]interface{}{
+						"id": "item ID",
+					},
+				},
+			},
+		})
+
+	// set field value
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`{"query":"mutation UpdateItemValues.*","variables":{"input":{"projectId":"project ID","itemId":"item ID","fieldId":"points ID","value":{"number":3}}}}`).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"updateProjectV2ItemFieldValue": map[string]interface{}{
+					"projectV2Item": map[string]interface{}{
+						"id": "item ID",
+					},
+				},
+			},
+		})
+
+	file := filepath.Join(t.TempDir(), "data.csv")
+	assert.NoError(t, os.WriteFile(file, []byte("title,body,Points\na draft,draft body,3\n"), 0600))
+
+	client := queries.NewTestClient()
+
+	ios, _, stdout, _ := iostreams.Test()
+	ios.SetStdoutTTY(true)
+	config := importConfig{
+		opts: importOpts{
+			number: 1,
+			owner:  "@me",
+			file:   file,
+		},
+		client: client,
+		io:     ios,
+	}
+
+	err := runImport(config)
+	assert.NoError(t, err)
+	assert.Equal(t, "Imported 1 items into project 1\n", stdout.String())
+}
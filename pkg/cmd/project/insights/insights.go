@@ -0,0 +1,314 @@
+package insights
+
+import (
+	"encoding/csv"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/tableprinter"
+	"github.com/cli/cli/v2/pkg/cmd/project/shared/client"
+	"github.com/cli/cli/v2/pkg/cmd/project/shared/queries"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type insightsOpts struct {
+	owner    string
+	number   int32
+	chart    string
+	since    string
+	groupBy  string
+	limit    int
+	format   string
+	exporter cmdutil.Exporter
+}
+
+type insightsConfig struct {
+	io     *iostreams.IOStreams
+	client *queries.Client
+	opts   insightsOpts
+	now    func() time.Time
+}
+
+// insightsJSONFields are the JSON field names available to the --json flag,
+// matching the keys of insightSeries.ExportData.
+var insightsJSONFields = []string{"chart", "points", "since"}
+
+// insightSeries is the time-series data behind a project insights chart.
+type insightSeries struct {
+	Chart  string
+	Since  string
+	Points []insightPoint
+}
+
+// insightPoint is the item counts of the project at the end of one day.
+type insightPoint struct {
+	Date      string
+	Open      int
+	Completed int
+	Total     int
+	Groups    map[string]int
+}
+
+func (s insightSeries) ExportData(_ []string) map[string]interface{} {
+	points := make([]map[string]interface{}, len(s.Points))
+	for i, p := range s.Points {
+		point := map[string]interface{}{
+			"date":      p.Date,
+			"open":      p.Open,
+			"completed": p.Completed,
+			"total":     p.Total,
+		}
+		if p.Groups != nil {
+			point["groups"] = p.Groups
+		}
+		points[i] = point
+	}
+	return map[string]interface{}{
+		"chart":  s.Chart,
+		"since":  s.Since,
+		"points": points,
+	}
+}
+
+func NewCmdInsights(f *cmdutil.Factory, runF func(config insightsConfig) error) *cobra.Command {
+	opts := insightsOpts{}
+	insightsCmd := &cobra.Command{
+		Short: "Export the time-series data behind a project's insights",
+		Use:   "insights [<number>]",
+		Long: heredoc.Docf(`
+			Export the time-series data behind a project's insights charts.
+
+			For every day of the requested period, the number of open, completed, and
+			total items in the project is reported, computed from the creation and close
+			times of the project's items. With %[1]s--group-by%[1]s, the open items are
+			additionally counted per value of a single-select field.
+		`, "`"),
+		Example: heredoc.Doc(`
+			# export the burn up data of the last 30 days as JSON
+			gh project insights 1 --owner "@me" --chart burnup --since 30d --format json
+
+			# export the burn down data per status as CSV
+			gh project insights 1 --owner "@me" --chart burndown --group-by Status --format csv
+		`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := client.New(f)
+			if err != nil {
+				return err
+			}
+
+			if len(args) == 1 {
+				num, err := strconv.ParseInt(args[0], 10, 32)
+				if err != nil {
+					return cmdutil.FlagErrorf("invalid number: %v", args[0])
+				}
+				opts.number = int32(num)
+			}
+
+			if _, err := parseSince(opts.since); err != nil {
+				return err
+			}
+
+			config := insightsConfig{
+				io:     f.IOStreams,
+				client: client,
+				opts:   opts,
+				now:    time.Now,
+			}
+
+			// allow testing of the command without actually running it
+			if runF != nil {
+				return runF(config)
+			}
+			return runInsights(config)
+		},
+	}
+
+	insightsCmd.Flags().StringVar(&opts.owner, "owner", "", "Login of the owner. Use \"@me\" for the current user. Defaults to the owner of the current directory's repository.")
+	cmdutil.StringEnumFlag(insightsCmd, &opts.chart, "chart", "", "burnup", []string{"burnup", "burndown"}, "Chart to export data for")
+	insightsCmd.Flags().StringVar(&opts.since, "since", "30d", "Length of the reported period, e.g. 30d, 4w, or 12h")
+	insightsCmd.Flags().StringVar(&opts.groupBy, "group-by", "", "Name of a single-select field to group open items by")
+	cmdutil.AddFormatAndJSONFlagsWithFormats(insightsCmd, &opts.exporter, &opts.format, []string{"json", "csv"}, insightsJSONFields)
+	insightsCmd.Flags().IntVarP(&opts.limit, "limit", "L", queries.LimitMax, "Maximum number of items to fetch")
+
+	return insightsCmd
+}
+
+// parseSince parses a duration that, unlike time.ParseDuration, also accepts
+// days ("30d") and weeks ("4w").
+func parseSince(since string) (time.Duration, error) {
+	if n, err := strconv.Atoi(strings.TrimSuffix(since, "d")); err == nil && strings.HasSuffix(since, "d") {
+		return time.Duration(n) * 24 * time.Hour, nil
+	}
+	if n, err := strconv.Atoi(strings.TrimSuffix(since, "w")); err == nil && strings.HasSuffix(since, "w") {
+		return time.Duration(n) * 7 * 24 * time.Hour, nil
+	}
+	d, err := time.ParseDuration(since)
+	if err != nil {
+		return 0, cmdutil.FlagErrorf("invalid duration %q: use formats like 30d, 4w, or 12h", since)
+	}
+	return d, nil
+}
+
+func runInsights(config insightsConfig) error {
+	canPrompt := config.io.CanPrompt()
+	owner, err := config.client.NewOwner(canPrompt, config.opts.owner)
+	if err != nil {
+		return err
+	}
+
+	// no need to fetch the project if we already have the number
+	if config.opts.number == 0 {
+		project, err := config.client.NewProject(canPrompt, owner, config.opts.number, false)
+		if err != nil {
+			return err
+		}
+		config.opts.number = project.Number
+	}
+
+	items, err := config.client.ProjectInsightItems(owner, config.opts.number, config.opts.limit)
+	if err != nil {
+		return err
+	}
+
+	since, err := parseSince(config.opts.since)
+	if err != nil {
+		return err
+	}
+
+	series := buildSeries(config.opts, items.Nodes, config.now().UTC(), since)
+
+	if config.opts.exporter != nil {
+		return config.opts.exporter.Write(config.io, series)
+	}
+
+	if config.opts.format == "csv" {
+		return printCSV(config, series)
+	}
+
+	return printResults(config, series)
+}
+
+// buildSeries computes one point per day of the reported period. A point
+// counts the items created on or before that day; items whose content was
+// closed by then count as completed.
+func buildSeries(opts insightsOpts, items []queries.InsightItem, now time.Time, since time.Duration) insightSeries {
+	series := insightSeries{
+		Chart: opts.chart,
+		Since: opts.since,
+	}
+
+	today := now.Truncate(24 * time.Hour)
+	days := int(since.Hours() / 24)
+
+	for d := days; d >= 0; d-- {
+		endOfDay := today.AddDate(0, 0, -d).Add(24*time.Hour - time.Second)
+		point := insightPoint{
+			Date: endOfDay.Format("2006-01-02"),
+		}
+		if opts.groupBy != "" {
+			point.Groups = map[string]int{}
+		}
+
+		for _, item := range items {
+			created, err := time.Parse(time.RFC3339, item.CreatedAt)
+			if err != nil || created.After(endOfDay) {
+				continue
+			}
+			point.Total++
+
+			if closedAt := item.ClosedAt(); closedAt != "" {
+				if closed, err := time.Parse(time.RFC3339, closedAt); err == nil && !closed.After(endOfDay) {
+					point.Completed++
+					continue
+				}
+			}
+
+			point.Open++
+			if point.Groups != nil {
+				group := item.FieldValueByName(opts.groupBy)
+				if group == "" {
+					group = "(none)"
+				}
+				point.Groups[group]++
+			}
+		}
+
+		series.Points = append(series.Points, point)
+	}
+
+	return series
+}
+
+// groupColumns returns the group names seen across the series, sorted.
+func groupColumns(series insightSeries) []string {
+	seen := map[string]struct{}{}
+	for _, p := range series.Points {
+		for group := range p.Groups {
+			seen[group] = struct{}{}
+		}
+	}
+	groups := make([]string, 0, len(seen))
+	for group := range seen {
+		groups = append(groups, group)
+	}
+	sort.Strings(groups)
+	return groups
+}
+
+func printCSV(config insightsConfig, series insightSeries) error {
+	groups := groupColumns(series)
+
+	header := []string{"Date", "Open", "Completed", "Total"}
+	header = append(header, groups...)
+
+	cw := csv.NewWriter(config.io.Out)
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+
+	for _, p := range series.Points {
+		row := []string{p.Date, strconv.Itoa(p.Open), strconv.Itoa(p.Completed), strconv.Itoa(p.Total)}
+		for _, group := range groups {
+			row = append(row, strconv.Itoa(p.Groups[group]))
+		}
+		if err := cw.Write(row); err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+func printResults(config insightsConfig, series insightSeries) error {
+	if len(series.Points) == 0 {
+		return cmdutil.NewNoResultsError(fmt.Sprintf("Project %d has no insights data for the requested period", config.opts.number))
+	}
+
+	groups := groupColumns(series)
+
+	header := []string{"Date", "Open", "Completed", "Total"}
+	header = append(header, groups...)
+
+	tp := tableprinter.New(config.io, tableprinter.WithHeader(header...))
+
+	for _, p := range series.Points {
+		tp.AddField(p.Date)
+		tp.AddField(strconv.Itoa(p.Open))
+		tp.AddField(strconv.Itoa(p.Completed))
+		tp.AddField(strconv.Itoa(p.Total))
+		for _, group := range groups {
+			tp.AddField(strconv.Itoa(p.Groups[group]))
+		}
+		tp.EndRow()
+	}
+
+	return tp.Render()
+}
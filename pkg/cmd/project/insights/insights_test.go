@@ -0,0 +1,237 @@
+package insights
+
+import (
+	"testing"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/pkg/cmd/project/shared/queries"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestNewCmdInsights(t *testing.T) {
+	tests := []struct {
+		name          string
+		cli           string
+		wants         insightsOpts
+		wantsErr      bool
+		wantsErrMsg   string
+		wantsExporter bool
+	}{
+		{
+			name:        "not-a-number",
+			cli:         "x",
+			wantsErr:    true,
+			wantsErrMsg: "invalid number: x",
+		},
+		{
+			name:        "invalid-chart",
+			cli:         "--chart pie",
+			wantsErr:    true,
+			wantsErrMsg: "invalid argument \"pie\" for \"--chart\" flag: valid values are {burnup|burndown}",
+		},
+		{
+			name:        "invalid-since",
+			cli:         "--since x",
+			wantsErr:    true,
+			wantsErrMsg: "invalid duration \"x\": use formats like 30d, 4w, or 12h",
+		},
+		{
+			name: "defaults",
+			cli:  "123",
+			wants: insightsOpts{
+				number: 123,
+				chart:  "burnup",
+				since:  "30d",
+				limit:  100,
+			},
+		},
+		{
+			name: "all-flags",
+			cli:  "123 --owner monalisa --chart burndown --since 4w --group-by Status",
+			wants: insightsOpts{
+				number:  123,
+				owner:   "monalisa",
+				chart:   "burndown",
+				since:   "4w",
+				groupBy: "Status",
+				limit:   100,
+			},
+		},
+		{
+			name: "json",
+			cli:  "123 --format json",
+			wants: insightsOpts{
+				number: 123,
+				chart:  "burnup",
+				since:  "30d",
+				limit:  100,
+			},
+			wantsExporter: true,
+		},
+	}
+
+	t.Setenv("GH_TOKEN", "auth-token")
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ios, _, _, _ := iostreams.Test()
+			f := &cmdutil.Factory{
+				IOStreams: ios,
+			}
+
+			argv, err := shlex.Split(tt.cli)
+			assert.NoError(t, err)
+
+			var gotOpts insightsOpts
+			cmd := NewCmdInsights(f, func(config insightsConfig) error {
+				gotOpts = config.opts
+				return nil
+			})
+
+			cmd.SetArgs(argv)
+			_, err = cmd.ExecuteC()
+			if tt.wantsErr {
+				assert.Equal(t, tt.wantsErrMsg, err.Error())
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+
+			assert.Equal(t, tt.wants.number, gotOpts.number)
+			assert.Equal(t, tt.wants.owner, gotOpts.owner)
+			assert.Equal(t, tt.wants.chart, gotOpts.chart)
+			assert.Equal(t, tt.wants.since, gotOpts.since)
+			assert.Equal(t, tt.wants.groupBy, gotOpts.groupBy)
+			assert.Equal(t, tt.wants.limit, gotOpts.limit)
+			assert.Equal(t, tt.wantsExporter, gotOpts.exporter != nil)
+		})
+	}
+}
+
+func TestBuildSeries(t *testing.T) {
+	now := time.Date(2025, 1, 10, 12, 0, 0, 0, time.UTC)
+
+	openItem := queries.InsightItem{CreatedAt: "2025-01-08T09:00:00Z"}
+	openItem.Content.TypeName = "Issue"
+
+	closedItem := queries.InsightItem{CreatedAt: "2025-01-07T09:00:00Z"}
+	closedItem.Content.TypeName = "Issue"
+	closedItem.Content.Issue.ClosedAt = "2025-01-09T09:00:00Z"
+
+	series := buildSeries(insightsOpts{chart: "burnup", since: "2d"}, []queries.InsightItem{openItem, closedItem}, now, 48*time.Hour)
+
+	assert.Equal(t, "burnup", series.Chart)
+	assert.Equal(t, []insightPoint{
+		{Date: "2025-01-08", Open: 2, Completed: 0, Total: 2},
+		{Date: "2025-01-09", Open: 1, Completed: 1, Total: 2},
+		{Date: "2025-01-10", Open: 1, Completed: 1, Total: 2},
+	}, series.Points)
+}
+
+func TestRunInsights_User_CSV(t *testing.T) {
+	defer gock.Off()
+	// gock.Observe(gock.DumpRequest)
+
+	// get user ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query UserOrgOwner.*",
+			"variables": map[string]interface{}{
+				"login": "monalisa",
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"id": "an ID",
+				},
+			},
+			"errors": []interface{}{
+				map[string]interface{}{
+					"type": "NOT_FOUND",
+					"path": []string{"organization"},
+				},
+			},
+		})
+
+	// list insight items
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		JSON(map[string]interface{}{
+			"query": "query UserProjectWithInsightItems.*",
+			"variables": map[string]interface{}{
+				"login":      "monalisa",
+				"number":     1,
+				"firstItems": queries.LimitMax,
+				"afterItems": nil,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"items": map[string]interface{}{
+							"totalCount": 1,
+							"nodes": []map[string]interface{}{
+								{
+									"createdAt": time.Now().UTC().AddDate(0, 0, -3).Format(time.RFC3339),
+									"content": map[string]interface{}{
+										"__typename": "Issue",
+									},
+									"fieldValues": map[string]interface{}{
+										"nodes": []map[string]interface{}{
+											{
+												"__typename": "ProjectV2ItemFieldSingleSelectValue",
+												"name":       "Todo",
+												"field": map[string]interface{}{
+													"__typename": "ProjectV2SingleSelectField",
+													"name":       "Status",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		})
+
+	client := queries.NewTestClient()
+
+	ios, _, stdout, _ := iostreams.Test()
+	config := insightsConfig{
+		opts: insightsOpts{
+			number:  1,
+			owner:   "monalisa",
+			chart:   "burnup",
+			since:   "1d",
+			groupBy: "Status",
+			format:  "csv",
+			limit:   queries.LimitMax,
+		},
+		client: client,
+		io:     ios,
+		now:    time.Now,
+	}
+
+	err := runInsights(config)
+	assert.NoError(t, err)
+
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	assert.Equal(t, heredoc.Docf(`
+		Date,Open,Completed,Total,Todo
+		%s,1,0,1,1
+		%s,1,0,1,1
+	`, today.AddDate(0, 0, -1).Format("2006-01-02"), today.Format("2006-01-02")), stdout.String())
+}
@@ -14,26 +14,42 @@ import (
 )
 
 type addItemOpts struct {
-	owner     string
-	number    int32
-	itemURL   string
-	projectID string
-	itemID    string
-	exporter  cmdutil.Exporter
+	owner       string
+	number      int32
+	itemURL     string
+	searchQuery string
+	limit       int
+	projectID   string
+	itemID      string
+	exporter    cmdutil.Exporter
 }
 
 type addItemConfig struct {
-	client *queries.Client
-	opts   addItemOpts
-	io     *iostreams.IOStreams
+	client   *queries.Client
+	opts     addItemOpts
+	io       *iostreams.IOStreams
+	prompter iprompter
 }
 
+type iprompter interface {
+	Confirm(string, bool) (bool, error)
+}
+
+// confirmThreshold is the number of search results above which the user is
+// asked to confirm before the items are added.
+const confirmThreshold = 25
+
 type addProjectItemMutation struct {
 	CreateProjectItem struct {
 		ProjectV2Item queries.ProjectItem `graphql:"item"`
 	} `graphql:"addProjectV2ItemById(input:$input)"`
 }
 
+// addItemResult receives the aliased response for one add mutation in a batch.
+type addItemResult struct {
+	Item queries.ProjectItem `graphql:"item"`
+}
+
 func NewCmdAddItem(f *cmdutil.Factory, runF func(config addItemConfig) error) *cobra.Command {
 	opts := addItemOpts{}
 	addItemCmd := &cobra.Command{
@@ -42,6 +58,9 @@ func NewCmdAddItem(f *cmdutil.Factory, runF func(config addItemConfig) error) *c
 		Example: heredoc.Doc(`
 			# add an item to monalisa's project "1"
 			gh project item-add 1 --owner monalisa --url https://github.com/monalisa/myproject/issues/23
+
+			# add every open bug of a repository to monalisa's project "1"
+			gh project item-add 1 --owner monalisa --search "repo:monalisa/myproject is:issue label:bug is:open"
 		`),
 		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -59,9 +78,10 @@ func NewCmdAddItem(f *cmdutil.Factory, runF func(config addItemConfig) error) *c
 			}
 
 			config := addItemConfig{
-				client: client,
-				opts:   opts,
-				io:     f.IOStreams,
+				client:   client,
+				opts:     opts,
+				io:       f.IOStreams,
+				prompter: f.Prompter,
 			}
 
 			// allow testing of the command without actually running it
@@ -72,11 +92,14 @@ func NewCmdAddItem(f *cmdutil.Factory, runF func(config addItemConfig) error) *c
 		},
 	}
 
-	addItemCmd.Flags().StringVar(&opts.owner, "owner", "", "Login of the owner. Use \"@me\" for the current user.")
+	addItemCmd.Flags().StringVar(&opts.owner, "owner", "", "Login of the owner. Use \"@me\" for the current user. Defaults to the owner of the current directory's repository.")
 	addItemCmd.Flags().StringVar(&opts.itemURL, "url", "", "URL of the issue or pull request to add to the project")
-	cmdutil.AddFormatFlags(addItemCmd, &opts.exporter)
+	addItemCmd.Flags().StringVar(&opts.searchQuery, "search", "", "Add every issue and pull request matching a search `query`")
+	addItemCmd.Flags().IntVarP(&opts.limit, "limit", "L", queries.LimitMax, "Maximum number of search results to add")
+	cmdutil.AddFormatAndJSONFlags(addItemCmd, &opts.exporter, queries.ProjectItemJSONFields)
 
-	_ = addItemCmd.MarkFlagRequired("url")
+	addItemCmd.MarkFlagsOneRequired("url", "search")
+	addItemCmd.MarkFlagsMutuallyExclusive("url", "search")
 
 	return addItemCmd
 }
@@ -93,6 +116,11 @@ func runAddItem(config addItemConfig) error {
 		return err
 	}
 	config.opts.projectID = project.ID
+	config.opts.number = project.Number
+
+	if config.opts.searchQuery != "" {
+		return addItemsFromSearch(config)
+	}
 
 	itemID, err := config.client.IssueOrPullRequestID(config.opts.itemURL)
 	if err != nil {
@@ -114,6 +142,65 @@ func runAddItem(config addItemConfig) error {
 
 }
 
+// addItemsFromSearch adds every issue and pull request matching the search
+// query to the project, asking for confirmation first on large result sets.
+func addItemsFromSearch(config addItemConfig) error {
+	config.io.StartProgressIndicator()
+	ids, total, err := config.client.SearchIssueOrPullRequestIDs(config.opts.searchQuery, config.opts.limit)
+	config.io.StopProgressIndicator()
+	if err != nil {
+		return err
+	}
+
+	if len(ids) == 0 {
+		return cmdutil.NewNoResultsError(fmt.Sprintf("no issues or pull requests matched %q", config.opts.searchQuery))
+	}
+
+	if total > len(ids) {
+		fmt.Fprintf(config.io.ErrOut, "Adding %d of %d results; use `--limit` to raise the cap\n", len(ids), total)
+	}
+
+	if len(ids) > confirmThreshold && config.io.CanPrompt() {
+		confirmed, err := config.prompter.Confirm(fmt.Sprintf("Add %d items to project %d?", len(ids), config.opts.number), false)
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			return cmdutil.CancelError
+		}
+	}
+
+	batch := queries.NewBatchMutation()
+	for _, id := range ids {
+		batch.Add("addProjectV2ItemById", githubv4.AddProjectV2ItemByIdInput{
+			ProjectID: githubv4.ID(config.opts.projectID),
+			ContentID: githubv4.ID(id),
+		}, addItemResult{})
+	}
+
+	config.io.StartProgressIndicatorWithLabel(fmt.Sprintf("Adding %d items", len(ids)))
+	results, err := config.client.MutateBatch("AddItems", batch)
+	config.io.StopProgressIndicator()
+	if err != nil {
+		return err
+	}
+
+	items := make([]queries.ProjectItem, 0, len(results))
+	for _, result := range results {
+		items = append(items, result.(addItemResult).Item)
+	}
+
+	if config.opts.exporter != nil {
+		return config.opts.exporter.Write(config.io, items)
+	}
+
+	if !config.io.IsStdoutTTY() {
+		return nil
+	}
+	_, err = fmt.Fprintf(config.io.Out, "Added %d items\n", len(items))
+	return err
+}
+
 func addItemArgs(config addItemConfig) (*addProjectItemMutation, map[string]interface{}) {
 	return &addProjectItemMutation{}, map[string]interface{}{
 		"input": githubv4.AddProjectV2ItemByIdInput{
@@ -21,10 +21,24 @@ func TestNewCmdaddItem(t *testing.T) {
 		wantsExporter bool
 	}{
 		{
-			name:        "missing-url",
+			name:        "missing-url-and-search",
 			cli:         "",
 			wantsErr:    true,
-			wantsErrMsg: "required flag(s) \"url\" not set",
+			wantsErrMsg: "at least one of the flags in the group [url search] is required",
+		},
+		{
+			name:        "url-and-search",
+			cli:         "--url github.com/cli/cli --search \"is:issue\"",
+			wantsErr:    true,
+			wantsErrMsg: "if any flags in the group [url search] are set none of the others can be; [search url] were all set",
+		},
+		{
+			name: "search",
+			cli:  "--search \"repo:cli/cli is:issue label:bug is:open\"",
+			wants: addItemOpts{
+				searchQuery: "repo:cli/cli is:issue label:bug is:open",
+				limit:       queries.LimitMax,
+			},
 		},
 		{
 			name:        "not-a-number",
@@ -95,6 +109,7 @@ func TestNewCmdaddItem(t *testing.T) {
 			assert.Equal(t, tt.wants.number, gotOpts.number)
 			assert.Equal(t, tt.wants.owner, gotOpts.owner)
 			assert.Equal(t, tt.wants.itemURL, gotOpts.itemURL)
+			assert.Equal(t, tt.wants.searchQuery, gotOpts.searchQuery)
 			assert.Equal(t, tt.wantsExporter, gotOpts.exporter != nil)
 		})
 	}
@@ -539,3 +554,133 @@ func TestRunAddItem_JSON(t *testing.T) {
 		`{"id":"item ID","title":"a title","body":"","type":"Issue"}`,
 		stdout.String())
 }
+
+func TestRunAddItem_Search(t *testing.T) {
+	defer gock.Off()
+	// gock.Observe(gock.DumpRequest)
+
+	// get user ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query UserOrgOwner.*",
+			"variables": map[string]interface{}{
+				"login": "monalisa",
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"id": "an ID",
+				},
+			},
+			"errors": []interface{}{
+				map[string]interface{}{
+					"type": "NOT_FOUND",
+					"path": []string{"organization"},
+				},
+			},
+		})
+
+	// get project ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query UserProject.*",
+			"variables": map[string]interface{}{
+				"login":       "monalisa",
+				"number":      1,
+				"firstItems":  0,
+				"afterItems":  nil,
+				"firstFields": 0,
+				"afterFields": nil,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"id":     "an ID",
+						"number": 1,
+					},
+				},
+			},
+		})
+
+	// search for matching issues
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query SearchIssueOrPullRequestIDs.*",
+			"variables": map[string]interface{}{
+				"query": "repo:monalisa/myproject is:issue label:bug is:open",
+				"first": 100,
+				"after": nil,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"search": map[string]interface{}{
+					"issueCount": 2,
+					"pageInfo": map[string]interface{}{
+						"hasNextPage": false,
+					},
+					"nodes": []map[string]interface{}{
+						{
+							"__typename": "Issue",
+							"id":         "issue ID",
+						},
+						{
+							"__typename": "PullRequest",
+							"id":         "pull request ID",
+						},
+					},
+				},
+			},
+		})
+
+	// add the issue and the pull request in one batch
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`{"query":"mutation AddItems.*","variables":{"input0":{"projectId":"an ID","contentId":"issue ID"},"input1":{"projectId":"an ID","contentId":"pull request ID"}}}`).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"alias0": map[string]interface{}{
+					"item": map[string]interface{}{
+						"id": "item ID",
+					},
+				},
+				"alias1": map[string]interface{}{
+					"item": map[string]interface{}{
+						"id": "another item ID",
+					},
+				},
+			},
+		})
+
+	client := queries.NewTestClient()
+
+	ios, _, stdout, _ := iostreams.Test()
+	ios.SetStdoutTTY(true)
+	config := addItemConfig{
+		opts: addItemOpts{
+			owner:       "monalisa",
+			number:      1,
+			searchQuery: "repo:monalisa/myproject is:issue label:bug is:open",
+			limit:       queries.LimitMax,
+		},
+		client: client,
+		io:     ios,
+	}
+
+	err := runAddItem(config)
+	assert.NoError(t, err)
+	assert.Equal(t, "Added 2 items\n", stdout.String())
+}
@@ -3,8 +3,10 @@ package itemarchive
 import (
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/text"
 	"github.com/cli/cli/v2/pkg/cmd/project/shared/client"
 	"github.com/cli/cli/v2/pkg/cmd/project/shared/queries"
 	"github.com/cli/cli/v2/pkg/cmdutil"
@@ -18,6 +20,9 @@ type archiveItemOpts struct {
 	number    int32
 	undo      bool
 	itemID    string
+	query     string
+	allDone   bool
+	limit     int
 	projectID string
 	exporter  cmdutil.Exporter
 }
@@ -48,6 +53,9 @@ func NewCmdArchiveItem(f *cmdutil.Factory, runF func(config archiveItemConfig) e
 		Example: heredoc.Doc(`
 			# archive an item in the current user's project "1"
 			gh project item-archive 1 --owner "@me" --id <item-ID>
+
+			# archive all items in the Done status of the current user's project "1"
+			gh project item-archive 1 --owner "@me" --all-done
 		`),
 		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -64,6 +72,16 @@ func NewCmdArchiveItem(f *cmdutil.Factory, runF func(config archiveItemConfig) e
 				opts.number = int32(num)
 			}
 
+			if err := cmdutil.MutuallyExclusive(
+				"specify only one of `--id`, `--query`, or `--all-done`",
+				opts.itemID != "", opts.query != "", opts.allDone,
+			); err != nil {
+				return err
+			}
+			if opts.itemID == "" && opts.query == "" && !opts.allDone {
+				return cmdutil.FlagErrorf("specify one of `--id`, `--query`, or `--all-done`")
+			}
+
 			config := archiveItemConfig{
 				client: client,
 				opts:   opts,
@@ -78,12 +96,13 @@ func NewCmdArchiveItem(f *cmdutil.Factory, runF func(config archiveItemConfig) e
 		},
 	}
 
-	archiveItemCmd.Flags().StringVar(&opts.owner, "owner", "", "Login of the owner. Use \"@me\" for the current user.")
+	archiveItemCmd.Flags().StringVar(&opts.owner, "owner", "", "Login of the owner. Use \"@me\" for the current user. Defaults to the owner of the current directory's repository.")
 	archiveItemCmd.Flags().StringVar(&opts.itemID, "id", "", "ID of the item to archive")
+	archiveItemCmd.Flags().StringVar(&opts.query, "query", "", "Archive all items whose title contains the query")
+	archiveItemCmd.Flags().BoolVar(&opts.allDone, "all-done", false, "Archive all items in the Done status")
 	archiveItemCmd.Flags().BoolVar(&opts.undo, "undo", false, "Unarchive an item")
-	cmdutil.AddFormatFlags(archiveItemCmd, &opts.exporter)
-
-	_ = archiveItemCmd.MarkFlagRequired("id")
+	archiveItemCmd.Flags().IntVarP(&opts.limit, "limit", "L", queries.LimitDefault, "Maximum number of items to fetch when using `--query` or `--all-done`")
+	cmdutil.AddFormatAndJSONFlags(archiveItemCmd, &opts.exporter, queries.ProjectItemJSONFields)
 
 	return archiveItemCmd
 }
@@ -95,6 +114,10 @@ func runArchiveItem(config archiveItemConfig) error {
 		return err
 	}
 
+	if config.opts.itemID == "" {
+		return runArchiveItems(config, owner)
+	}
+
 	project, err := config.client.NewProject(canPrompt, owner, config.opts.number, false)
 	if err != nil {
 		return err
@@ -127,6 +150,68 @@ func runArchiveItem(config archiveItemConfig) error {
 	return printResults(config, query.ArchiveProjectItem.ProjectV2Item)
 }
 
+// runArchiveItems archives or unarchives every item matched by the --query or
+// --all-done selectors.
+func runArchiveItems(config archiveItemConfig, owner *queries.Owner) error {
+	project, err := config.client.ProjectItems(owner, config.opts.number, config.opts.limit)
+	if err != nil {
+		return err
+	}
+
+	items := selectItems(config.opts, project.Items.Nodes)
+	if len(items) == 0 {
+		return cmdutil.NewNoResultsError(fmt.Sprintf("Project %d for owner %s has no matching items", project.Number, owner.Login))
+	}
+
+	ids := make([]string, len(items))
+	for i, item := range items {
+		ids[i] = item.ID()
+	}
+
+	if err := config.client.ArchiveProjectItems(project.ID, ids, config.opts.undo); err != nil {
+		return err
+	}
+
+	if config.opts.exporter != nil {
+		v := make([]map[string]interface{}, len(items))
+		for i, item := range items {
+			v[i] = item.ExportData(nil)
+		}
+		return config.opts.exporter.Write(config.io, map[string]interface{}{
+			"items":      v,
+			"totalCount": len(items),
+		})
+	}
+
+	if !config.io.IsStdoutTTY() {
+		return nil
+	}
+
+	if config.opts.undo {
+		_, err = fmt.Fprintf(config.io.Out, "Unarchived %s\n", text.Pluralize(len(items), "item"))
+		return err
+	}
+
+	_, err = fmt.Fprintf(config.io.Out, "Archived %s\n", text.Pluralize(len(items), "item"))
+	return err
+}
+
+// selectItems filters the project's items down to those matched by the
+// --query or --all-done selectors.
+func selectItems(opts archiveItemOpts, items []queries.ProjectItem) []queries.ProjectItem {
+	var selected []queries.ProjectItem
+	for _, item := range items {
+		if opts.allDone && !strings.EqualFold(item.SingleSelectValueByName("Status"), "Done") {
+			continue
+		}
+		if opts.query != "" && !strings.Contains(strings.ToLower(item.Title()), strings.ToLower(opts.query)) {
+			continue
+		}
+		selected = append(selected, item)
+	}
+	return selected
+}
+
 func archiveItemArgs(config archiveItemConfig) (*archiveProjectItemMutation, map[string]interface{}) {
 	return &archiveProjectItemMutation{}, map[string]interface{}{
 		"input": githubv4.ArchiveProjectV2ItemInput{
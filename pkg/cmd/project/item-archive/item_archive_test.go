@@ -21,10 +21,16 @@ func TestNewCmdarchiveItem(t *testing.T) {
 		wantsExporter bool
 	}{
 		{
-			name:        "missing-id",
+			name:        "missing-selector",
 			cli:         "",
 			wantsErr:    true,
-			wantsErrMsg: "required flag(s) \"id\" not set",
+			wantsErrMsg: "specify one of `--id`, `--query`, or `--all-done`",
+		},
+		{
+			name:        "id-and-query",
+			cli:         "--id 123 --query bug",
+			wantsErr:    true,
+			wantsErrMsg: "specify only one of `--id`, `--query`, or `--all-done`",
 		},
 		{
 			name:        "not-a-number",
@@ -63,6 +69,20 @@ func TestNewCmdarchiveItem(t *testing.T) {
 				itemID: "123",
 			},
 		},
+		{
+			name: "query",
+			cli:  "--query bug",
+			wants: archiveItemOpts{
+				query: "bug",
+			},
+		},
+		{
+			name: "all-done",
+			cli:  "--all-done",
+			wants: archiveItemOpts{
+				allDone: true,
+			},
+		},
 		{
 			name: "json",
 			cli:  "--format json --id 123",
@@ -103,6 +123,8 @@ func TestNewCmdarchiveItem(t *testing.T) {
 			assert.Equal(t, tt.wants.number, gotOpts.number)
 			assert.Equal(t, tt.wants.owner, gotOpts.owner)
 			assert.Equal(t, tt.wants.itemID, gotOpts.itemID)
+			assert.Equal(t, tt.wants.query, gotOpts.query)
+			assert.Equal(t, tt.wants.allDone, gotOpts.allDone)
 			assert.Equal(t, tt.wants.undo, gotOpts.undo)
 			assert.Equal(t, tt.wantsExporter, gotOpts.exporter != nil)
 		})
@@ -732,3 +754,138 @@ func TestRunArchive_JSON(t *testing.T) {
 		`{"id":"item ID","title":"a title","body":"","type":"Issue"}`,
 		stdout.String())
 }
+
+func TestRunArchive_User_AllDone(t *testing.T) {
+	defer gock.Off()
+	gock.Observe(gock.DumpRequest)
+
+	// get user ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query UserOrgOwner.*",
+			"variables": map[string]interface{}{
+				"login": "monalisa",
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"id": "an ID",
+				},
+			},
+			"errors": []interface{}{
+				map[string]interface{}{
+					"type": "NOT_FOUND",
+					"path": []string{"organization"},
+				},
+			},
+		})
+
+	// list project items
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query UserProjectWithItems.*",
+			"variables": map[string]interface{}{
+				"login":       "monalisa",
+				"number":      1,
+				"firstItems":  queries.LimitDefault,
+				"afterItems":  nil,
+				"firstFields": queries.LimitMax,
+				"afterFields": nil,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"number": 1,
+						"id":     "project ID",
+						"items": map[string]interface{}{
+							"totalCount": 2,
+							"nodes": []map[string]interface{}{
+								{
+									"id": "item 1",
+									"content": map[string]interface{}{
+										"__typename": "Issue",
+										"title":      "a bug",
+									},
+									"fieldValues": map[string]interface{}{
+										"nodes": []map[string]interface{}{
+											{
+												"__typename": "ProjectV2ItemFieldSingleSelectValue",
+												"name":       "Done",
+												"field": map[string]interface{}{
+													"__typename": "ProjectV2SingleSelectField",
+													"name":       "Status",
+												},
+											},
+										},
+									},
+								},
+								{
+									"id": "item 2",
+									"content": map[string]interface{}{
+										"__typename": "Issue",
+										"title":      "a feature",
+									},
+									"fieldValues": map[string]interface{}{
+										"nodes": []map[string]interface{}{
+											{
+												"__typename": "ProjectV2ItemFieldSingleSelectValue",
+												"name":       "Todo",
+												"field": map[string]interface{}{
+													"__typename": "ProjectV2SingleSelectField",
+													"name":       "Status",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		})
+
+	// archive the matched items in one batch
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`{"query":"mutation ArchiveProjectItems.*","variables":{"input0":{"projectId":"project ID","itemId":"item 1"}}}`).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"alias0": map[string]interface{}{
+					"clientMutationId": nil,
+				},
+			},
+		})
+
+	client := queries.NewTestClient()
+
+	ios, _, stdout, _ := iostreams.Test()
+	ios.SetStdoutTTY(true)
+	config := archiveItemConfig{
+		opts: archiveItemOpts{
+			owner:   "monalisa",
+			number:  1,
+			allDone: true,
+			limit:   queries.LimitDefault,
+		},
+		client: client,
+		io:     ios,
+	}
+
+	err := runArchiveItem(config)
+	assert.NoError(t, err)
+	assert.Equal(
+		t,
+		"Archived 1 item\n",
+		stdout.String())
+}
@@ -0,0 +1,139 @@
+package itemconvert
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/project/shared/client"
+	"github.com/cli/cli/v2/pkg/cmd/project/shared/queries"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/shurcooL/githubv4"
+	"github.com/spf13/cobra"
+)
+
+type convertItemOpts struct {
+	itemID   string
+	repo     string
+	repoID   string
+	exporter cmdutil.Exporter
+}
+
+type convertItemConfig struct {
+	httpClient func() (*http.Client, error)
+	client     *queries.Client
+	opts       convertItemOpts
+	io         *iostreams.IOStreams
+}
+
+type convertProjectDraftIssueItemMutation struct {
+	ConvertProjectV2DraftIssueItemToIssue struct {
+		Item queries.ProjectItem `graphql:"item"`
+	} `graphql:"convertProjectV2DraftIssueItemToIssue(input:$input)"`
+}
+
+// ConvertProjectV2DraftIssueItemToIssueInput is the input to the
+// convertProjectV2DraftIssueItemToIssue mutation. It is not yet defined in
+// githubv4.
+type ConvertProjectV2DraftIssueItemToIssueInput struct {
+	ItemID       githubv4.ID `json:"itemId"`
+	RepositoryID githubv4.ID `json:"repositoryId"`
+}
+
+func NewCmdConvertItem(f *cmdutil.Factory, runF func(config convertItemConfig) error) *cobra.Command {
+	opts := convertItemOpts{}
+	convertItemCmd := &cobra.Command{
+		Short: "Convert a draft issue item into an issue",
+		Use:   "item-convert <item-ID>",
+		Long: heredoc.Docf(`
+			Convert a draft issue item into an issue in the given repository.
+
+			The draft issue's title, body, and field values are preserved on the new
+			issue. The repository may be specified in the %[1]s[HOST/]OWNER/REPO%[1]s format.
+		`, "`"),
+		Example: heredoc.Doc(`
+			# convert a draft issue into an issue in cli/cli
+			gh project item-convert <item-ID> --repo cli/cli
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := client.New(f)
+			if err != nil {
+				return err
+			}
+
+			opts.itemID = args[0]
+
+			config := convertItemConfig{
+				httpClient: f.HttpClient,
+				client:     client,
+				opts:       opts,
+				io:         f.IOStreams,
+			}
+
+			// allow testing of the command without actually running it
+			if runF != nil {
+				return runF(config)
+			}
+			return runConvertItem(config)
+		},
+	}
+
+	convertItemCmd.Flags().StringVarP(&opts.repo, "repo", "R", "", "The repository to create the issue in")
+	cmdutil.AddFormatAndJSONFlags(convertItemCmd, &opts.exporter, queries.ProjectItemJSONFields)
+
+	_ = convertItemCmd.MarkFlagRequired("repo")
+
+	return convertItemCmd
+}
+
+func runConvertItem(config convertItemConfig) error {
+	repo, err := ghrepo.FromFullName(config.opts.repo)
+	if err != nil {
+		return err
+	}
+
+	httpClient, err := config.httpClient()
+	if err != nil {
+		return err
+	}
+
+	ghRepo, err := api.GitHubRepo(api.NewClientFromHTTP(httpClient), repo)
+	if err != nil {
+		return err
+	}
+	config.opts.repoID = ghRepo.ID
+
+	query, variables := convertItemArgs(config)
+	err = config.client.Mutate("ConvertDraftIssueItem", query, variables)
+	if err != nil {
+		return err
+	}
+
+	if config.opts.exporter != nil {
+		return config.opts.exporter.Write(config.io, query.ConvertProjectV2DraftIssueItemToIssue.Item)
+	}
+
+	return printResults(config, query.ConvertProjectV2DraftIssueItemToIssue.Item)
+}
+
+func convertItemArgs(config convertItemConfig) (*convertProjectDraftIssueItemMutation, map[string]interface{}) {
+	return &convertProjectDraftIssueItemMutation{}, map[string]interface{}{
+		"input": ConvertProjectV2DraftIssueItemToIssueInput{
+			ItemID:       githubv4.ID(config.opts.itemID),
+			RepositoryID: githubv4.ID(config.opts.repoID),
+		},
+	}
+}
+
+func printResults(config convertItemConfig, item queries.ProjectItem) error {
+	if !config.io.IsStdoutTTY() {
+		return nil
+	}
+
+	_, err := fmt.Fprintf(config.io.Out, "Converted item to issue %s\n", item.URL())
+	return err
+}
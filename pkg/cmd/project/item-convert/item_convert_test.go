@@ -0,0 +1,143 @@
+package itemconvert
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/pkg/cmd/project/shared/queries"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestNewCmdConvertItem(t *testing.T) {
+	tests := []struct {
+		name          string
+		cli           string
+		wants         convertItemOpts
+		wantsErr      bool
+		wantsErrMsg   string
+		wantsExporter bool
+	}{
+		{
+			name:        "missing-item-id",
+			cli:         "--repo cli/cli",
+			wantsErr:    true,
+			wantsErrMsg: "accepts 1 arg(s), received 0",
+		},
+		{
+			name:        "missing-repo",
+			cli:         "item-ID",
+			wantsErr:    true,
+			wantsErrMsg: "required flag(s) \"repo\" not set",
+		},
+		{
+			name: "item-id-and-repo",
+			cli:  "item-ID --repo cli/cli",
+			wants: convertItemOpts{
+				itemID: "item-ID",
+				repo:   "cli/cli",
+			},
+		},
+		{
+			name: "json",
+			cli:  "item-ID --repo cli/cli --format json",
+			wants: convertItemOpts{
+				itemID: "item-ID",
+				repo:   "cli/cli",
+			},
+			wantsExporter: true,
+		},
+	}
+
+	t.Setenv("GH_TOKEN", "auth-token")
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ios, _, _, _ := iostreams.Test()
+			f := &cmdutil.Factory{
+				IOStreams: ios,
+			}
+
+			argv, err := shlex.Split(tt.cli)
+			assert.NoError(t, err)
+
+			var gotOpts convertItemOpts
+			cmd := NewCmdConvertItem(f, func(config convertItemConfig) error {
+				gotOpts = config.opts
+				return nil
+			})
+
+			cmd.SetArgs(argv)
+			_, err = cmd.ExecuteC()
+			if tt.wantsErr {
+				assert.Error(t, err)
+				assert.Equal(t, tt.wantsErrMsg, err.Error())
+				return
+			}
+			assert.NoError(t, err)
+
+			assert.Equal(t, tt.wants.itemID, gotOpts.itemID)
+			assert.Equal(t, tt.wants.repo, gotOpts.repo)
+			assert.Equal(t, tt.wantsExporter, gotOpts.exporter != nil)
+		})
+	}
+}
+
+func TestRunConvertItem(t *testing.T) {
+	defer gock.Off()
+	gock.Observe(gock.DumpRequest)
+
+	// get repo ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`.*query RepositoryInfo.*`).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"repository": map[string]interface{}{
+					"id": "repo-ID",
+				},
+			},
+		})
+
+	// convert draft issue item to issue
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`{"query":"mutation ConvertDraftIssueItem.*","variables":{"input":{"itemId":"item-ID","repositoryId":"repo-ID"}}}`).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"convertProjectV2DraftIssueItemToIssue": map[string]interface{}{
+					"item": map[string]interface{}{
+						"id": "item-ID",
+						"content": map[string]interface{}{
+							"__typename": "Issue",
+							"title":      "a title",
+							"url":        "https://github.com/cli/cli/issues/1",
+						},
+					},
+				},
+			},
+		})
+
+	ios, _, stdout, _ := iostreams.Test()
+	ios.SetStdoutTTY(true)
+	cfg := convertItemConfig{
+		opts: convertItemOpts{
+			itemID: "item-ID",
+			repo:   "cli/cli",
+		},
+		client: queries.NewTestClient(),
+		httpClient: func() (*http.Client, error) {
+			return http.DefaultClient, nil
+		},
+		io: ios,
+	}
+
+	err := runConvertItem(cfg)
+	assert.NoError(t, err)
+	assert.Equal(t, "Converted item to issue https://github.com/cli/cli/issues/1\n", stdout.String())
+}
@@ -3,6 +3,8 @@ package itemcreate
 import (
 	"fmt"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/MakeNowJust/heredoc"
 	"github.com/cli/cli/v2/pkg/cmd/project/shared/client"
@@ -18,10 +20,25 @@ type createItemOpts struct {
 	body      string
 	owner     string
 	number    int32
+	fields    []string
 	projectID string
 	exporter  cmdutil.Exporter
 }
 
+// fieldUpdate is one resolved --field flag: the field to set on the created
+// item and its typed value.
+type fieldUpdate struct {
+	fieldID string
+	value   githubv4.ProjectV2FieldValue
+}
+
+// updateItemResult receives the aliased response for one field update.
+type updateItemResult struct {
+	Item struct {
+		ID string `graphql:"id"`
+	} `graphql:"projectV2Item"`
+}
+
 type createItemConfig struct {
 	client *queries.Client
 	opts   createItemOpts
@@ -42,9 +59,18 @@ func NewCmdCreateItem(f *cmdutil.Factory, runF func(config createItemConfig) err
 		Example: heredoc.Doc(`
 			# create a draft issue in the current user's project "1"
 			gh project item-create 1 --owner "@me" --title "new item" --body "new item body"
+
+			# create a draft issue with its Status and Sprint fields already set
+			gh project item-create 1 --owner monalisa --title "new item" --field Status=Done --field "Sprint=Iteration 3"
 		`),
 		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			for _, spec := range opts.fields {
+				if name, _, ok := strings.Cut(spec, "="); !ok || name == "" {
+					return cmdutil.FlagErrorf("invalid `--field` value %q: expected name=value", spec)
+				}
+			}
+
 			client, err := client.New(f)
 			if err != nil {
 				return err
@@ -72,10 +98,11 @@ func NewCmdCreateItem(f *cmdutil.Factory, runF func(config createItemConfig) err
 		},
 	}
 
-	createItemCmd.Flags().StringVar(&opts.owner, "owner", "", "Login of the owner. Use \"@me\" for the current user.")
+	createItemCmd.Flags().StringVar(&opts.owner, "owner", "", "Login of the owner. Use \"@me\" for the current user. Defaults to the owner of the current directory's repository.")
 	createItemCmd.Flags().StringVar(&opts.title, "title", "", "Title for the draft issue")
 	createItemCmd.Flags().StringVar(&opts.body, "body", "", "Body for the draft issue")
-	cmdutil.AddFormatFlags(createItemCmd, &opts.exporter)
+	createItemCmd.Flags().StringArrayVar(&opts.fields, "field", nil, "Set a field value on the new item in `name=value` format (may be repeated)")
+	cmdutil.AddFormatAndJSONFlags(createItemCmd, &opts.exporter, queries.ProjectItemJSONFields)
 
 	_ = createItemCmd.MarkFlagRequired("title")
 
@@ -95,6 +122,13 @@ func runCreateItem(config createItemConfig) error {
 	}
 	config.opts.projectID = project.ID
 
+	// resolve field names and values before creating anything so that bad
+	// input does not leave a half-configured item behind
+	updates, err := resolveFieldUpdates(config, owner)
+	if err != nil {
+		return err
+	}
+
 	query, variables := createDraftIssueArgs(config)
 
 	err = config.client.Mutate("CreateDraftItem", query, variables)
@@ -102,11 +136,131 @@ func runCreateItem(config createItemConfig) error {
 		return err
 	}
 
+	item := query.CreateProjectDraftItem.ProjectV2Item
+	if len(updates) > 0 {
+		if err := applyFieldUpdates(config, item.Id, updates); err != nil {
+			return err
+		}
+	}
+
 	if config.opts.exporter != nil {
-		return config.opts.exporter.Write(config.io, query.CreateProjectDraftItem.ProjectV2Item)
+		return config.opts.exporter.Write(config.io, item)
+	}
+
+	return printResults(config, item)
+}
+
+// resolveFieldUpdates matches each --field flag against the project's field
+// schema and parses its value according to the field's data type.
+func resolveFieldUpdates(config createItemConfig, owner *queries.Owner) ([]fieldUpdate, error) {
+	if len(config.opts.fields) == 0 {
+		return nil, nil
 	}
 
-	return printResults(config, query.CreateProjectDraftItem.ProjectV2Item)
+	project, err := config.client.ProjectFieldsCached(owner, config.opts.number, queries.LimitMax)
+	if err != nil {
+		return nil, err
+	}
+
+	updates := make([]fieldUpdate, 0, len(config.opts.fields))
+	for _, spec := range config.opts.fields {
+		name, rawValue, _ := strings.Cut(spec, "=")
+		var field *queries.ProjectField
+		for i := range project.Fields.Nodes {
+			if strings.EqualFold(project.Fields.Nodes[i].Name(), name) {
+				field = &project.Fields.Nodes[i]
+				break
+			}
+		}
+		if field == nil {
+			names := make([]string, len(project.Fields.Nodes))
+			for i, f := range project.Fields.Nodes {
+				names[i] = fmt.Sprintf("%q", f.Name())
+			}
+			return nil, fmt.Errorf("project has no field named %q; available fields: %s", name, strings.Join(names, ", "))
+		}
+		value, err := parseFieldValue(config, *field, rawValue)
+		if err != nil {
+			return nil, err
+		}
+		updates = append(updates, fieldUpdate{fieldID: field.ID(), value: value})
+	}
+	return updates, nil
+}
+
+// parseFieldValue converts the raw flag value into a typed field value.
+// Single select options and iterations are matched by name.
+func parseFieldValue(config createItemConfig, field queries.ProjectField, raw string) (githubv4.ProjectV2FieldValue, error) {
+	switch field.DataType() {
+	case "TEXT":
+		return githubv4.ProjectV2FieldValue{
+			Text: githubv4.NewString(githubv4.String(raw)),
+		}, nil
+	case "NUMBER":
+		number, err := strconv.ParseFloat(raw, 32)
+		if err != nil {
+			return githubv4.ProjectV2FieldValue{}, fmt.Errorf("invalid value %q for number field %q", raw, field.Name())
+		}
+		return githubv4.ProjectV2FieldValue{
+			Number: githubv4.NewFloat(githubv4.Float(number)),
+		}, nil
+	case "DATE":
+		date, err := time.Parse("2006-01-02", raw)
+		if err != nil {
+			return githubv4.ProjectV2FieldValue{}, fmt.Errorf("invalid value %q for date field %q: expected the YYYY-MM-DD format", raw, field.Name())
+		}
+		return githubv4.ProjectV2FieldValue{
+			Date: githubv4.NewDate(githubv4.Date{Time: date}),
+		}, nil
+	case "SINGLE_SELECT":
+		options := field.Options()
+		for _, o := range options {
+			if strings.EqualFold(o.Name, raw) {
+				return githubv4.ProjectV2FieldValue{
+					SingleSelectOptionID: githubv4.NewString(githubv4.String(o.ID)),
+				}, nil
+			}
+		}
+		names := make([]string, len(options))
+		for i, o := range options {
+			names[i] = fmt.Sprintf("%q", o.Name)
+		}
+		return githubv4.ProjectV2FieldValue{}, fmt.Errorf("field %q has no option named %q; available options: %s", field.Name(), raw, strings.Join(names, ", "))
+	case "ITERATION":
+		_, iterations, err := config.client.ProjectFieldByIDCached(field.ID())
+		if err != nil {
+			return githubv4.ProjectV2FieldValue{}, err
+		}
+		for _, it := range iterations {
+			if strings.EqualFold(it.Title, raw) {
+				return githubv4.ProjectV2FieldValue{
+					IterationID: githubv4.NewString(githubv4.String(it.ID)),
+				}, nil
+			}
+		}
+		titles := make([]string, len(iterations))
+		for i, it := range iterations {
+			titles[i] = fmt.Sprintf("%q", it.Title)
+		}
+		return githubv4.ProjectV2FieldValue{}, fmt.Errorf("field %q has no iteration named %q; available iterations: %s", field.Name(), raw, strings.Join(titles, ", "))
+	}
+	return githubv4.ProjectV2FieldValue{}, fmt.Errorf("field %q has type %s, which cannot be set with `--field`", field.Name(), field.DataType())
+}
+
+// applyFieldUpdates sets the resolved field values on the created item in
+// batched mutations.
+func applyFieldUpdates(config createItemConfig, itemID string, updates []fieldUpdate) error {
+	batch := queries.NewBatchMutation()
+	for _, update := range updates {
+		batch.Add("updateProjectV2ItemFieldValue", githubv4.UpdateProjectV2ItemFieldValueInput{
+			ProjectID: githubv4.ID(config.opts.projectID),
+			ItemID:    githubv4.ID(itemID),
+			FieldID:   githubv4.ID(update.fieldID),
+			Value:     update.value,
+		}, updateItemResult{})
+	}
+	_, err := config.client.MutateBatch("CreateItemFields", batch)
+	return err
 }
 
 func createDraftIssueArgs(config createItemConfig) (*createProjectDraftItemMutation, map[string]interface{}) {
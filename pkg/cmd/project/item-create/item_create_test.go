@@ -63,6 +63,20 @@ func TestNewCmdCreateItem(t *testing.T) {
 				title: "t",
 			},
 		},
+		{
+			name: "field",
+			cli:  "--title t --field Status=Done --field 'Sprint=Iteration 3'",
+			wants: createItemOpts{
+				title:  "t",
+				fields: []string{"Status=Done", "Sprint=Iteration 3"},
+			},
+		},
+		{
+			name:        "invalid-field",
+			cli:         "--title t --field Status",
+			wantsErr:    true,
+			wantsErrMsg: "invalid `--field` value \"Status\": expected name=value",
+		},
 		{
 			name: "json",
 			cli:  "--format json --title t",
@@ -103,6 +117,7 @@ func TestNewCmdCreateItem(t *testing.T) {
 			assert.Equal(t, tt.wants.number, gotOpts.number)
 			assert.Equal(t, tt.wants.owner, gotOpts.owner)
 			assert.Equal(t, tt.wants.title, gotOpts.title)
+			assert.Equal(t, tt.wants.fields, gotOpts.fields)
 			assert.Equal(t, tt.wantsExporter, gotOpts.exporter != nil)
 		})
 	}
@@ -466,3 +481,260 @@ func TestRunCreateItem_JSON(t *testing.T) {
 		`{"id":"item ID","title":"","body":"","type":"Draft"}`,
 		stdout.String())
 }
+
+func TestRunCreateItem_Fields(t *testing.T) {
+	t.Setenv("GH_PROJECT_FIELD_CACHE_TTL", "0")
+	defer gock.Off()
+	gock.Observe(gock.DumpRequest)
+	// get user ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query UserOrgOwner.*",
+			"variables": map[string]interface{}{
+				"login": "monalisa",
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"id": "an ID",
+				},
+			},
+			"errors": []interface{}{
+				map[string]interface{}{
+					"type": "NOT_FOUND",
+					"path": []string{"organization"},
+				},
+			},
+		})
+
+	// get project ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query UserProject.*",
+			"variables": map[string]interface{}{
+				"login":       "monalisa",
+				"number":      1,
+				"firstItems":  0,
+				"afterItems":  nil,
+				"firstFields": 0,
+				"afterFields": nil,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"id": "project ID",
+					},
+				},
+			},
+		})
+
+	// get project fields
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query UserProjectWithFields.*",
+			"variables": map[string]interface{}{
+				"login":       "monalisa",
+				"number":      1,
+				"firstItems":  queries.LimitMax,
+				"afterItems":  nil,
+				"firstFields": queries.LimitMax,
+				"afterFields": nil,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"fields": map[string]interface{}{
+							"nodes": []map[string]interface{}{
+								{
+									"__typename": "ProjectV2SingleSelectField",
+									"id":         "status ID",
+									"name":       "Status",
+									"dataType":   "SINGLE_SELECT",
+									"options": []map[string]interface{}{
+										{
+											"id":   "done ID",
+											"name": "Done",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		})
+
+	// create item
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`{"query":"mutation CreateDraftItem.*","variables":{"input":{"projectId":"project ID","title":"a title","body":""}}}`).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"addProjectV2DraftIssue": map[string]interface{}{
+					"projectItem": map[string]interface{}{
+						"id": "item ID",
+					},
+				},
+			},
+		})
+
+	// set the Status field
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`{"query":"mutation CreateItemFields.*alias0: updateProjectV2ItemFieldValue\(input: \$input0\).*","variables":{"input0":{"projectId":"project ID","itemId":"item ID","fieldId":"status ID","value":{"singleSelectOptionId":"done ID"}}}}`).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"alias0": map[string]interface{}{
+					"projectV2Item": map[string]interface{}{
+						"id": "item ID",
+					},
+				},
+			},
+		})
+
+	client := queries.NewTestClient()
+
+	ios, _, stdout, _ := iostreams.Test()
+	ios.SetStdoutTTY(true)
+	config := createItemConfig{
+		opts: createItemOpts{
+			title:  "a title",
+			owner:  "monalisa",
+			number: 1,
+			fields: []string{"Status=Done"},
+		},
+		client: client,
+		io:     ios,
+	}
+
+	err := runCreateItem(config)
+	assert.NoError(t, err)
+	assert.Equal(
+		t,
+		"Created item\n",
+		stdout.String())
+}
+
+func TestRunCreateItem_UnknownField(t *testing.T) {
+	t.Setenv("GH_PROJECT_FIELD_CACHE_TTL", "0")
+	defer gock.Off()
+	gock.Observe(gock.DumpRequest)
+	// get user ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query UserOrgOwner.*",
+			"variables": map[string]interface{}{
+				"login": "monalisa",
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"id": "an ID",
+				},
+			},
+			"errors": []interface{}{
+				map[string]interface{}{
+					"type": "NOT_FOUND",
+					"path": []string{"organization"},
+				},
+			},
+		})
+
+	// get project ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query UserProject.*",
+			"variables": map[string]interface{}{
+				"login":       "monalisa",
+				"number":      1,
+				"firstItems":  0,
+				"afterItems":  nil,
+				"firstFields": 0,
+				"afterFields": nil,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"id": "project ID",
+					},
+				},
+			},
+		})
+
+	// get project fields
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query UserProjectWithFields.*",
+			"variables": map[string]interface{}{
+				"login":       "monalisa",
+				"number":      1,
+				"firstItems":  queries.LimitMax,
+				"afterItems":  nil,
+				"firstFields": queries.LimitMax,
+				"afterFields": nil,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"fields": map[string]interface{}{
+							"nodes": []map[string]interface{}{
+								{
+									"__typename": "ProjectV2Field",
+									"id":         "status ID",
+									"name":       "Status",
+									"dataType":   "TEXT",
+								},
+							},
+						},
+					},
+				},
+			},
+		})
+
+	client := queries.NewTestClient()
+
+	ios, _, _, _ := iostreams.Test()
+	config := createItemConfig{
+		opts: createItemOpts{
+			title:  "a title",
+			owner:  "monalisa",
+			number: 1,
+			fields: []string{"Priority=High"},
+		},
+		client: client,
+		io:     ios,
+	}
+
+	err := runCreateItem(config)
+	assert.EqualError(t, err, `project has no field named "Priority"; available fields: "Status"`)
+}
@@ -16,6 +16,7 @@ import (
 type deleteItemOpts struct {
 	owner     string
 	number    int32
+	itemIDs   []string
 	itemID    string
 	projectID string
 	exporter  cmdutil.Exporter
@@ -33,6 +34,12 @@ type deleteProjectItemMutation struct {
 	} `graphql:"deleteProjectV2Item(input:$input)"`
 }
 
+// deleteItemResult receives the aliased response for one delete mutation in a
+// batch.
+type deleteItemResult struct {
+	DeletedItemId githubv4.ID `graphql:"deletedItemId"`
+}
+
 func NewCmdDeleteItem(f *cmdutil.Factory, runF func(config deleteItemConfig) error) *cobra.Command {
 	opts := deleteItemOpts{}
 	deleteItemCmd := &cobra.Command{
@@ -41,6 +48,9 @@ func NewCmdDeleteItem(f *cmdutil.Factory, runF func(config deleteItemConfig) err
 		Example: heredoc.Doc(`
 			# delete an item in the current user's project "1"
 			gh project item-delete 1 --owner "@me" --id <item-ID>
+
+			# delete multiple items in one batched request
+			gh project item-delete 1 --owner "@me" --id <item-ID> --id <item-ID>
 		`),
 		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -71,9 +81,9 @@ func NewCmdDeleteItem(f *cmdutil.Factory, runF func(config deleteItemConfig) err
 		},
 	}
 
-	deleteItemCmd.Flags().StringVar(&opts.owner, "owner", "", "Login of the owner. Use \"@me\" for the current user.")
-	deleteItemCmd.Flags().StringVar(&opts.itemID, "id", "", "ID of the item to delete")
-	cmdutil.AddFormatFlags(deleteItemCmd, &opts.exporter)
+	deleteItemCmd.Flags().StringVar(&opts.owner, "owner", "", "Login of the owner. Use \"@me\" for the current user. Defaults to the owner of the current directory's repository.")
+	deleteItemCmd.Flags().StringSliceVar(&opts.itemIDs, "id", nil, "IDs of the items to delete")
+	cmdutil.AddFormatAndJSONFlags(deleteItemCmd, &opts.exporter, []string{"id"})
 
 	_ = deleteItemCmd.MarkFlagRequired("id")
 
@@ -93,6 +103,11 @@ func runDeleteItem(config deleteItemConfig) error {
 	}
 	config.opts.projectID = project.ID
 
+	if len(config.opts.itemIDs) > 1 {
+		return deleteItems(config)
+	}
+
+	config.opts.itemID = config.opts.itemIDs[0]
 	query, variables := deleteItemArgs(config)
 	err = config.client.Mutate("DeleteProjectItem", query, variables)
 	if err != nil {
@@ -107,6 +122,40 @@ func runDeleteItem(config deleteItemConfig) error {
 
 }
 
+// deleteItems deletes several items in batched aliased mutations instead of
+// one request per item.
+func deleteItems(config deleteItemConfig) error {
+	batch := queries.NewBatchMutation()
+	for _, itemID := range config.opts.itemIDs {
+		batch.Add("deleteProjectV2Item", githubv4.DeleteProjectV2ItemInput{
+			ProjectID: githubv4.ID(config.opts.projectID),
+			ItemID:    githubv4.ID(itemID),
+		}, deleteItemResult{})
+	}
+
+	results, err := config.client.MutateBatch("DeleteProjectItems", batch)
+	if err != nil {
+		return err
+	}
+
+	if config.opts.exporter != nil {
+		deleted := make([]map[string]interface{}, 0, len(results))
+		for _, result := range results {
+			deleted = append(deleted, map[string]interface{}{
+				"id": result.(deleteItemResult).DeletedItemId,
+			})
+		}
+		return config.opts.exporter.Write(config.io, deleted)
+	}
+
+	if !config.io.IsStdoutTTY() {
+		return nil
+	}
+
+	_, err = fmt.Fprintf(config.io.Out, "Deleted %d items\n", len(results))
+	return err
+}
+
 func deleteItemArgs(config deleteItemConfig) (*deleteProjectItemMutation, map[string]interface{}) {
 	return &deleteProjectItemMutation{}, map[string]interface{}{
 		"input": githubv4.DeleteProjectV2ItemInput{
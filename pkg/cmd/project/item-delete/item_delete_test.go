@@ -36,30 +36,37 @@ func TestNewCmdDeleteItem(t *testing.T) {
 			name: "item-id",
 			cli:  "--id 123",
 			wants: deleteItemOpts{
-				itemID: "123",
+				itemIDs: []string{"123"},
+			},
+		},
+		{
+			name: "multiple-item-ids",
+			cli:  "--id 123 --id 456",
+			wants: deleteItemOpts{
+				itemIDs: []string{"123", "456"},
 			},
 		},
 		{
 			name: "number",
 			cli:  "456 --id 123",
 			wants: deleteItemOpts{
-				number: 456,
-				itemID: "123",
+				number:  456,
+				itemIDs: []string{"123"},
 			},
 		},
 		{
 			name: "owner",
 			cli:  "--owner monalisa --id 123",
 			wants: deleteItemOpts{
-				owner:  "monalisa",
-				itemID: "123",
+				owner:   "monalisa",
+				itemIDs: []string{"123"},
 			},
 		},
 		{
 			name: "json",
 			cli:  "--format json --id 123",
 			wants: deleteItemOpts{
-				itemID: "123",
+				itemIDs: []string{"123"},
 			},
 			wantsExporter: true,
 		},
@@ -94,7 +101,7 @@ func TestNewCmdDeleteItem(t *testing.T) {
 
 			assert.Equal(t, tt.wants.number, gotOpts.number)
 			assert.Equal(t, tt.wants.owner, gotOpts.owner)
-			assert.Equal(t, tt.wants.itemID, gotOpts.itemID)
+			assert.Equal(t, tt.wants.itemIDs, gotOpts.itemIDs)
 			assert.Equal(t, tt.wantsExporter, gotOpts.exporter != nil)
 		})
 	}
@@ -173,9 +180,9 @@ func TestRunDelete_User(t *testing.T) {
 	ios.SetStdoutTTY(true)
 	config := deleteItemConfig{
 		opts: deleteItemOpts{
-			owner:  "monalisa",
-			number: 1,
-			itemID: "item ID",
+			owner:   "monalisa",
+			number:  1,
+			itemIDs: []string{"item ID"},
 		},
 		client: client,
 		io:     ios,
@@ -262,9 +269,9 @@ func TestRunDelete_Org(t *testing.T) {
 	ios.SetStdoutTTY(true)
 	config := deleteItemConfig{
 		opts: deleteItemOpts{
-			owner:  "github",
-			number: 1,
-			itemID: "item ID",
+			owner:   "github",
+			number:  1,
+			itemIDs: []string{"item ID"},
 		},
 		client: client,
 		io:     ios,
@@ -341,9 +348,9 @@ func TestRunDelete_Me(t *testing.T) {
 	ios.SetStdoutTTY(true)
 	config := deleteItemConfig{
 		opts: deleteItemOpts{
-			owner:  "@me",
-			number: 1,
-			itemID: "item ID",
+			owner:   "@me",
+			number:  1,
+			itemIDs: []string{"item ID"},
 		},
 		client: client,
 		io:     ios,
@@ -431,7 +438,7 @@ func TestRunDelete_JSON(t *testing.T) {
 		opts: deleteItemOpts{
 			owner:    "monalisa",
 			number:   1,
-			itemID:   "item ID",
+			itemIDs:  []string{"item ID"},
 			exporter: cmdutil.NewJSONExporter(),
 		},
 		client: client,
@@ -445,3 +452,95 @@ func TestRunDelete_JSON(t *testing.T) {
 		`{"id":"item ID"}`,
 		stdout.String())
 }
+
+func TestRunDelete_User_Multiple(t *testing.T) {
+	defer gock.Off()
+	gock.Observe(gock.DumpRequest)
+	// get user ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query UserOrgOwner.*",
+			"variables": map[string]interface{}{
+				"login": "monalisa",
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"id": "an ID",
+				},
+			},
+			"errors": []interface{}{
+				map[string]interface{}{
+					"type": "NOT_FOUND",
+					"path": []string{"organization"},
+				},
+			},
+		})
+
+	// get project ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query UserProject.*",
+			"variables": map[string]interface{}{
+				"login":       "monalisa",
+				"number":      1,
+				"firstItems":  0,
+				"afterItems":  nil,
+				"firstFields": 0,
+				"afterFields": nil,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"id": "an ID",
+					},
+				},
+			},
+		})
+
+	// delete both items in one batch
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`{"query":"mutation DeleteProjectItems.*","variables":{"input0":{"projectId":"an ID","itemId":"item 1"},"input1":{"projectId":"an ID","itemId":"item 2"}}}`).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"alias0": map[string]interface{}{
+					"deletedItemId": "item 1",
+				},
+				"alias1": map[string]interface{}{
+					"deletedItemId": "item 2",
+				},
+			},
+		})
+
+	client := queries.NewTestClient()
+
+	ios, _, stdout, _ := iostreams.Test()
+	ios.SetStdoutTTY(true)
+	config := deleteItemConfig{
+		opts: deleteItemOpts{
+			owner:   "monalisa",
+			number:  1,
+			itemIDs: []string{"item 1", "item 2"},
+		},
+		client: client,
+		io:     ios,
+	}
+
+	err := runDeleteItem(config)
+	assert.NoError(t, err)
+	assert.Equal(
+		t,
+		"Deleted 2 items\n",
+		stdout.String())
+}
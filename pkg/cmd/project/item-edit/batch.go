@@ -0,0 +1,181 @@
+package itemedit
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/cli/cli/v2/pkg/cmd/project/shared/queries"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/shurcooL/githubv4"
+)
+
+// batchEdit describes one field update read from the --from-file input. Each
+// entry updates (or clears) a single field value on a single item.
+type batchEdit struct {
+	ID                   string   `json:"id"`
+	ProjectID            string   `json:"projectId,omitempty"`
+	FieldID              string   `json:"fieldId"`
+	Text                 *string  `json:"text,omitempty"`
+	Number               *float32 `json:"number,omitempty"`
+	Date                 *string  `json:"date,omitempty"`
+	SingleSelectOptionID *string  `json:"singleSelectOptionId,omitempty"`
+	IterationID          *string  `json:"iterationId,omitempty"`
+	Clear                bool     `json:"clear,omitempty"`
+}
+
+// batchUpdateResult receives the aliased response for one mutation in a batch.
+type batchUpdateResult struct {
+	Item struct {
+		ID string `graphql:"id"`
+	} `graphql:"projectV2Item"`
+}
+
+func runBatchEditItems(config editItemConfig) error {
+	edits, err := readBatchEdits(config)
+	if err != nil {
+		return err
+	}
+
+	batch, err := buildBatchMutation(edits)
+	if err != nil {
+		return err
+	}
+
+	if _, err := config.client.MutateBatch("BatchEditItems", batch); err != nil {
+		return fmt.Errorf("failed to edit items: %w", err)
+	}
+
+	if config.io.IsStdoutTTY() {
+		_, err := fmt.Fprintf(config.io.Out, "Edited %d items\n", len(edits))
+		return err
+	}
+	return nil
+}
+
+func readBatchEdits(config editItemConfig) ([]batchEdit, error) {
+	var reader *os.File
+	if config.opts.fromFile == "-" {
+		reader = os.Stdin
+	} else {
+		f, err := os.Open(config.opts.fromFile)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		reader = f
+	}
+
+	var edits []batchEdit
+	if err := json.NewDecoder(reader).Decode(&edits); err != nil {
+		return nil, fmt.Errorf("could not parse %q: %w", config.opts.fromFile, err)
+	}
+	if len(edits) == 0 {
+		return nil, fmt.Errorf("no edits found in %q", config.opts.fromFile)
+	}
+
+	for i := range edits {
+		if edits[i].ProjectID == "" {
+			edits[i].ProjectID = config.opts.projectID
+		}
+		if err := validateBatchEdit(edits[i]); err != nil {
+			return nil, fmt.Errorf("invalid entry %d: %w", i+1, err)
+		}
+	}
+	return edits, nil
+}
+
+func validateBatchEdit(edit batchEdit) error {
+	if edit.ID == "" {
+		return fmt.Errorf("missing item ID")
+	}
+	if edit.FieldID == "" {
+		return fmt.Errorf("missing field ID")
+	}
+	if edit.ProjectID == "" {
+		return fmt.Errorf("missing project ID; set it per entry or with `--project-id`")
+	}
+
+	values := 0
+	for _, set := range []bool{
+		edit.Text != nil,
+		edit.Number != nil,
+		edit.Date != nil,
+		edit.SingleSelectOptionID != nil,
+		edit.IterationID != nil,
+	} {
+		if set {
+			values++
+		}
+	}
+	if edit.Clear && values > 0 {
+		return fmt.Errorf("cannot combine a value with clear")
+	}
+	if !edit.Clear && values != 1 {
+		return fmt.Errorf("exactly one of text, number, date, singleSelectOptionId or iterationId is required")
+	}
+	if edit.Date != nil {
+		if _, err := time.Parse("2006-01-02", *edit.Date); err != nil {
+			return fmt.Errorf("invalid date %q: expected the YYYY-MM-DD format", *edit.Date)
+		}
+	}
+	return nil
+}
+
+// buildBatchMutation assembles a batched mutation that applies every edit as
+// an aliased mutation.
+func buildBatchMutation(edits []batchEdit) (*queries.BatchMutation, error) {
+	batch := queries.NewBatchMutation()
+	for _, edit := range edits {
+		if edit.Clear {
+			batch.Add("clearProjectV2ItemFieldValue", githubv4.ClearProjectV2ItemFieldValueInput{
+				ProjectID: githubv4.ID(edit.ProjectID),
+				ItemID:    githubv4.ID(edit.ID),
+				FieldID:   githubv4.ID(edit.FieldID),
+			}, batchUpdateResult{})
+		} else {
+			value, err := batchFieldValue(edit)
+			if err != nil {
+				return nil, err
+			}
+			batch.Add("updateProjectV2ItemFieldValue", githubv4.UpdateProjectV2ItemFieldValueInput{
+				ProjectID: githubv4.ID(edit.ProjectID),
+				ItemID:    githubv4.ID(edit.ID),
+				FieldID:   githubv4.ID(edit.FieldID),
+				Value:     value,
+			}, batchUpdateResult{})
+		}
+	}
+	return batch, nil
+}
+
+func batchFieldValue(edit batchEdit) (githubv4.ProjectV2FieldValue, error) {
+	switch {
+	case edit.Text != nil:
+		return githubv4.ProjectV2FieldValue{
+			Text: githubv4.NewString(githubv4.String(*edit.Text)),
+		}, nil
+	case edit.Number != nil:
+		return githubv4.ProjectV2FieldValue{
+			Number: githubv4.NewFloat(githubv4.Float(*edit.Number)),
+		}, nil
+	case edit.Date != nil:
+		date, err := time.Parse("2006-01-02", *edit.Date)
+		if err != nil {
+			return githubv4.ProjectV2FieldValue{}, err
+		}
+		return githubv4.ProjectV2FieldValue{
+			Date: githubv4.NewDate(githubv4.Date{Time: date}),
+		}, nil
+	case edit.SingleSelectOptionID != nil:
+		return githubv4.ProjectV2FieldValue{
+			SingleSelectOptionID: githubv4.NewString(githubv4.String(*edit.SingleSelectOptionID)),
+		}, nil
+	case edit.IterationID != nil:
+		return githubv4.ProjectV2FieldValue{
+			IterationID: githubv4.NewString(githubv4.String(*edit.IterationID)),
+		}, nil
+	}
+	return githubv4.ProjectV2FieldValue{}, cmdutil.FlagErrorf("no value to set")
+}
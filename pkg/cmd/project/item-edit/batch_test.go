@@ -0,0 +1,94 @@
+package itemedit
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/cli/cli/v2/pkg/cmd/project/shared/queries"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestRunItemEdit_FromFile(t *testing.T) {
+	defer gock.Off()
+	// gock.Observe(gock.DumpRequest)
+
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`{"query":"mutation BatchEditItems.*alias0: updateProjectV2ItemFieldValue\(input: \$input0\).*alias1: clearProjectV2ItemFieldValue\(input: \$input1\).*"variables":{"input0":{"projectId":"project_id","itemId":"item_id_1","fieldId":"field_id","value":{"text":"new text"}},"input1":{"projectId":"project_id","itemId":"item_id_2","fieldId":"field_id"}}}`).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"alias0": map[string]interface{}{
+					"projectV2Item": map[string]interface{}{"id": "item_id_1"},
+				},
+				"alias1": map[string]interface{}{
+					"projectV2Item": map[string]interface{}{"id": "item_id_2"},
+				},
+			},
+		})
+
+	file := filepath.Join(t.TempDir(), "items.json")
+	require.NoError(t, os.WriteFile(file, []byte(`[
+		{"id": "item_id_1", "fieldId": "field_id", "text": "new text"},
+		{"id": "item_id_2", "fieldId": "field_id", "clear": true}
+	]`), 0600))
+
+	client := queries.NewTestClient()
+
+	ios, _, stdout, _ := iostreams.Test()
+	config := editItemConfig{
+		io: ios,
+		opts: editItemOpts{
+			fromFile:  file,
+			projectID: "project_id",
+		},
+		client: client,
+	}
+
+	err := runEditItem(config)
+	assert.NoError(t, err)
+	assert.Equal(t, "", stdout.String())
+}
+
+func TestRunItemEdit_FromFileInvalidEntry(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "items.json")
+	require.NoError(t, os.WriteFile(file, []byte(`[
+		{"id": "item_id_1", "fieldId": "field_id", "text": "new text", "number": 3}
+	]`), 0600))
+
+	ios, _, _, _ := iostreams.Test()
+	config := editItemConfig{
+		io: ios,
+		opts: editItemOpts{
+			fromFile:  file,
+			projectID: "project_id",
+		},
+		client: queries.NewTestClient(),
+	}
+
+	err := runEditItem(config)
+	assert.EqualError(t, err, "invalid entry 1: exactly one of text, number, date, singleSelectOptionId or iterationId is required")
+}
+
+func TestRunItemEdit_FromFileMissingProject(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "items.json")
+	require.NoError(t, os.WriteFile(file, []byte(`[
+		{"id": "item_id_1", "fieldId": "field_id", "text": "new text"}
+	]`), 0600))
+
+	ios, _, _, _ := iostreams.Test()
+	config := editItemConfig{
+		io: ios,
+		opts: editItemOpts{
+			fromFile: file,
+		},
+		client: queries.NewTestClient(),
+	}
+
+	err := runEditItem(config)
+	assert.EqualError(t, err, "invalid entry 1: missing project ID; set it per entry or with `--project-id`")
+}
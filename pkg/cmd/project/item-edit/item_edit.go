@@ -28,6 +28,8 @@ type editItemOpts struct {
 	singleSelectOptionID string
 	iterationID          string
 	clear                bool
+	// bulk editing
+	fromFile string
 	// format
 	exporter cmdutil.Exporter
 }
@@ -67,6 +69,22 @@ func NewCmdEditItem(f *cmdutil.Factory, runF func(config editItemConfig) error)
 			For non-draft issues, the ID of the project is also required, and only a single field value can be updated per invocation.
 
 			Remove project item field value using %[1]s--clear%[1]s flag.
+
+			Field values are validated against the field's type and options before
+			any update is sent to the API.
+
+			To apply many field updates in one command, pass a JSON file to %[1]s--from-file%[1]s
+			(use %[1]s-%[1]s to read from standard input). The file holds an array of objects, each
+			naming an item, a field, and exactly one value to set (or %[1]sclear%[1]s):
+
+				[
+					{"id": "<item-ID>", "fieldId": "<field-ID>", "text": "new text"},
+					{"id": "<item-ID>", "fieldId": "<field-ID>", "number": 3},
+					{"id": "<item-ID>", "fieldId": "<field-ID>", "clear": true}
+				]
+
+			Entries may set %[1]sprojectId%[1]s individually; otherwise %[1]s--project-id%[1]s applies to all.
+			Updates are batched into as few GraphQL requests as possible.
 		`, "`"),
 		Example: heredoc.Doc(`
 			# edit an item's text field value
@@ -74,8 +92,21 @@ func NewCmdEditItem(f *cmdutil.Factory, runF func(config editItemConfig) error)
 
 			# clear an item's field value
 			gh project item-edit --id <item-ID> --field-id <field-ID> --project-id <project-ID> --clear
+
+			# apply field updates from a file
+			gh project item-edit --from-file items.json --project-id <project-ID>
 		`),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.fromFile != "" {
+				if opts.itemID != "" || opts.fieldID != "" || opts.title != "" || opts.body != "" ||
+					opts.text != "" || opts.number != 0 || opts.date != "" ||
+					opts.singleSelectOptionID != "" || opts.iterationID != "" || opts.clear {
+					return cmdutil.FlagErrorf("cannot use item editing flags with `--from-file`")
+				}
+			} else if opts.itemID == "" {
+				return cmdutil.FlagErrorf("`--id` or `--from-file` is required")
+			}
+
 			if err := cmdutil.MutuallyExclusive(
 				"only one of `--text`, `--number`, `--date`, `--single-select-option-id` or `--iteration-id` may be used",
 				opts.text != "",
@@ -95,6 +126,14 @@ func NewCmdEditItem(f *cmdutil.Factory, runF func(config editItemConfig) error)
 				return err
 			}
 
+			if err := cmdutil.MutuallyExclusive(
+				"cannot use `--title` or `--body` in conjunction with `--clear`",
+				opts.title != "" || opts.body != "",
+				opts.clear,
+			); err != nil {
+				return err
+			}
+
 			client, err := client.New(f)
 			if err != nil {
 				return err
@@ -115,7 +154,7 @@ func NewCmdEditItem(f *cmdutil.Factory, runF func(config editItemConfig) error)
 	}
 
 	editItemCmd.Flags().StringVar(&opts.itemID, "id", "", "ID of the item to edit")
-	cmdutil.AddFormatFlags(editItemCmd, &opts.exporter)
+	cmdutil.AddFormatAndJSONFlags(editItemCmd, &opts.exporter, queries.ProjectItemJSONFields)
 
 	editItemCmd.Flags().StringVar(&opts.title, "title", "", "Title of the draft issue item")
 	editItemCmd.Flags().StringVar(&opts.body, "body", "", "Body of the draft issue item")
@@ -128,13 +167,17 @@ func NewCmdEditItem(f *cmdutil.Factory, runF func(config editItemConfig) error)
 	editItemCmd.Flags().StringVar(&opts.singleSelectOptionID, "single-select-option-id", "", "ID of the single select option value to set on the field")
 	editItemCmd.Flags().StringVar(&opts.iterationID, "iteration-id", "", "ID of the iteration value to set on the field")
 	editItemCmd.Flags().BoolVar(&opts.clear, "clear", false, "Remove field value")
-
-	_ = editItemCmd.MarkFlagRequired("id")
+	editItemCmd.Flags().StringVar(&opts.fromFile, "from-file", "", "Apply field updates from a JSON `file` (use \"-\" to read from standard input)")
 
 	return editItemCmd
 }
 
 func runEditItem(config editItemConfig) error {
+	// apply updates from a file in batched mutations
+	if config.opts.fromFile != "" {
+		return runBatchEditItems(config)
+	}
+
 	// when clear flag is used, remove value set to the corresponding field ID
 	if config.opts.clear {
 		return clearItemFieldValue(config)
@@ -271,11 +314,15 @@ func updateItemValues(config editItemConfig) error {
 	if config.opts.date != "" {
 		date, err := time.Parse("2006-01-02", config.opts.date)
 		if err != nil {
-			return err
+			return cmdutil.FlagErrorf("invalid date %q: date must be in YYYY-MM-DD format", config.opts.date)
 		}
 		parsedDate = date
 	}
 
+	if err := validateFieldValue(config); err != nil {
+		return err
+	}
+
 	query, variables := buildUpdateItem(config, parsedDate)
 	err := config.client.Mutate("UpdateItemValues", query, variables)
 	if err != nil {
@@ -289,6 +336,87 @@ func updateItemValues(config editItemConfig) error {
 	return printItemResults(config, &query.Update.Item)
 }
 
+// validateFieldValue checks the value against the field's type and options so
+// that bad input fails with an actionable error instead of an API error.
+func validateFieldValue(config editItemConfig) error {
+	field, iterations, err := config.client.ProjectFieldByIDCached(config.opts.fieldID)
+	if err != nil {
+		return err
+	}
+
+	var wantType string
+	switch {
+	case config.opts.text != "":
+		wantType = "TEXT"
+	case config.opts.number != 0:
+		wantType = "NUMBER"
+	case config.opts.date != "":
+		wantType = "DATE"
+	case config.opts.singleSelectOptionID != "":
+		wantType = "SINGLE_SELECT"
+	case config.opts.iterationID != "":
+		wantType = "ITERATION"
+	}
+
+	dataType := field.DataType()
+	// the title of an item is set like a text field
+	if dataType == "TITLE" {
+		dataType = "TEXT"
+	}
+	if dataType != wantType {
+		if flag := flagForDataType(dataType); flag != "" {
+			return fmt.Errorf("field %q has type %s; set it with `--%s`", field.Name(), field.DataType(), flag)
+		}
+		return fmt.Errorf("field %q has type %s, which cannot be edited", field.Name(), field.DataType())
+	}
+
+	switch wantType {
+	case "SINGLE_SELECT":
+		options := field.Options()
+		for _, o := range options {
+			if o.ID == config.opts.singleSelectOptionID {
+				return nil
+			}
+		}
+		suggestions := make([]string, len(options))
+		for i, o := range options {
+			suggestions[i] = fmt.Sprintf("%q (%s)", o.Name, o.ID)
+		}
+		return fmt.Errorf("field %q has no option with ID %q; available options: %s", field.Name(), config.opts.singleSelectOptionID, strings.Join(suggestions, ", "))
+	case "ITERATION":
+		for _, it := range iterations {
+			if it.ID == config.opts.iterationID {
+				return nil
+			}
+		}
+		suggestions := make([]string, len(iterations))
+		for i, it := range iterations {
+			suggestions[i] = fmt.Sprintf("%q (%s)", it.Title, it.ID)
+		}
+		return fmt.Errorf("field %q has no iteration with ID %q; available iterations: %s", field.Name(), config.opts.iterationID, strings.Join(suggestions, ", "))
+	}
+
+	return nil
+}
+
+// flagForDataType returns the name of the flag that sets values of fields
+// with the given data type.
+func flagForDataType(dataType string) string {
+	switch dataType {
+	case "TEXT":
+		return "text"
+	case "NUMBER":
+		return "number"
+	case "DATE":
+		return "date"
+	case "SINGLE_SELECT":
+		return "single-select-option-id"
+	case "ITERATION":
+		return "iteration-id"
+	}
+	return ""
+}
+
 func fieldIdAndProjectIdPresence(config editItemConfig) error {
 	if config.opts.fieldID == "" && config.opts.projectID == "" {
 		return cmdutil.FlagErrorf("field-id and project-id must be provided")
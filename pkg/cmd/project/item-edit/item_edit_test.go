@@ -24,7 +24,7 @@ func TestNewCmdeditItem(t *testing.T) {
 			name:        "missing-id",
 			cli:         "",
 			wantsErr:    true,
-			wantsErrMsg: "required flag(s) \"id\" not set",
+			wantsErrMsg: "`--id` or `--from-file` is required",
 		},
 		{
 			name:        "invalid-flags",
@@ -32,6 +32,18 @@ func TestNewCmdeditItem(t *testing.T) {
 			wantsErr:    true,
 			wantsErrMsg: "only one of `--text`, `--number`, `--date`, `--single-select-option-id` or `--iteration-id` may be used",
 		},
+		{
+			name:        "clear-with-value",
+			cli:         "--id 123 --text t --clear",
+			wantsErr:    true,
+			wantsErrMsg: "cannot use `--text`, `--number`, `--date`, `--single-select-option-id` or `--iteration-id` in conjunction with `--clear`",
+		},
+		{
+			name:        "clear-with-title",
+			cli:         "--id 123 --title t --clear",
+			wantsErr:    true,
+			wantsErrMsg: "cannot use `--title` or `--body` in conjunction with `--clear`",
+		},
 		{
 			name: "item-id",
 			cli:  "--id 123",
@@ -178,6 +190,8 @@ func TestRunItemEdit_Draft(t *testing.T) {
 			},
 		})
 
+	t.Setenv("XDG_STATE_HOME", t.TempDir())
+
 	client := queries.NewTestClient()
 
 	ios, _, stdout, _ := iostreams.Test()
@@ -201,10 +215,31 @@ func TestRunItemEdit_Draft(t *testing.T) {
 		stdout.String())
 }
 
+func registerFieldQuery(t *testing.T, node map[string]interface{}) {
+	t.Helper()
+
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`{"query":"query ProjectField.*","variables":{"id":"field_id"}}`).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"node": node,
+			},
+		})
+}
+
 func TestRunItemEdit_Text(t *testing.T) {
 	defer gock.Off()
 	// gock.Observe(gock.DumpRequest)
 
+	registerFieldQuery(t, map[string]interface{}{
+		"__typename": "ProjectV2Field",
+		"id":         "field_id",
+		"name":       "Text",
+		"dataType":   "TEXT",
+	})
+
 	// edit item
 	gock.New("https://api.github.com").
 		Post("/graphql").
@@ -229,6 +264,8 @@ func TestRunItemEdit_Text(t *testing.T) {
 			},
 		})
 
+	t.Setenv("XDG_STATE_HOME", t.TempDir())
+
 	client := queries.NewTestClient()
 
 	ios, _, stdout, _ := iostreams.Test()
@@ -252,6 +289,13 @@ func TestRunItemEdit_Number(t *testing.T) {
 	defer gock.Off()
 	// gock.Observe(gock.DumpRequest)
 
+	registerFieldQuery(t, map[string]interface{}{
+		"__typename": "ProjectV2Field",
+		"id":         "field_id",
+		"name":       "Points",
+		"dataType":   "NUMBER",
+	})
+
 	// edit item
 	gock.New("https://api.github.com").
 		Post("/graphql").
@@ -276,6 +320,8 @@ func TestRunItemEdit_Number(t *testing.T) {
 			},
 		})
 
+	t.Setenv("XDG_STATE_HOME", t.TempDir())
+
 	client := queries.NewTestClient()
 
 	ios, _, stdout, _ := iostreams.Test()
@@ -304,6 +350,13 @@ func TestRunItemEdit_Date(t *testing.T) {
 	defer gock.Off()
 	// gock.Observe(gock.DumpRequest)
 
+	registerFieldQuery(t, map[string]interface{}{
+		"__typename": "ProjectV2Field",
+		"id":         "field_id",
+		"name":       "Due",
+		"dataType":   "DATE",
+	})
+
 	// edit item
 	gock.New("https://api.github.com").
 		Post("/graphql").
@@ -328,6 +381,8 @@ func TestRunItemEdit_Date(t *testing.T) {
 			},
 		})
 
+	t.Setenv("XDG_STATE_HOME", t.TempDir())
+
 	client := queries.NewTestClient()
 
 	ios, _, stdout, _ := iostreams.Test()
@@ -351,6 +406,16 @@ func TestRunItemEdit_SingleSelect(t *testing.T) {
 	defer gock.Off()
 	// gock.Observe(gock.DumpRequest)
 
+	registerFieldQuery(t, map[string]interface{}{
+		"__typename": "ProjectV2SingleSelectField",
+		"id":         "field_id",
+		"name":       "Status",
+		"dataType":   "SINGLE_SELECT",
+		"options": []map[string]interface{}{
+			{"id": "option_id", "name": "Done"},
+		},
+	})
+
 	// edit item
 	gock.New("https://api.github.com").
 		Post("/graphql").
@@ -375,6 +440,8 @@ func TestRunItemEdit_SingleSelect(t *testing.T) {
 			},
 		})
 
+	t.Setenv("XDG_STATE_HOME", t.TempDir())
+
 	client := queries.NewTestClient()
 
 	ios, _, stdout, _ := iostreams.Test()
@@ -398,6 +465,19 @@ func TestRunItemEdit_Iteration(t *testing.T) {
 	defer gock.Off()
 	// gock.Observe(gock.DumpRequest)
 
+	registerFieldQuery(t, map[string]interface{}{
+		"__typename": "ProjectV2IterationField",
+		"id":         "field_id",
+		"name":       "Sprint",
+		"dataType":   "ITERATION",
+		"configuration": map[string]interface{}{
+			"iterations": []map[string]interface{}{
+				{"id": "option_id", "title": "Iteration 1"},
+			},
+			"completedIterations": []map[string]interface{}{},
+		},
+	})
+
 	// edit item
 	gock.New("https://api.github.com").
 		Post("/graphql").
@@ -422,6 +502,8 @@ func TestRunItemEdit_Iteration(t *testing.T) {
 			},
 		})
 
+	t.Setenv("XDG_STATE_HOME", t.TempDir())
+
 	client := queries.NewTestClient()
 
 	ios, _, stdout, _ := iostreams.Test()
@@ -450,6 +532,8 @@ func TestRunItemEdit_NoChanges(t *testing.T) {
 	defer gock.Off()
 	// gock.Observe(gock.DumpRequest)
 
+	t.Setenv("XDG_STATE_HOME", t.TempDir())
+
 	client := queries.NewTestClient()
 
 	ios, _, stdout, stderr := iostreams.Test()
@@ -471,6 +555,8 @@ func TestRunItemEdit_InvalidID(t *testing.T) {
 	defer gock.Off()
 	// gock.Observe(gock.DumpRequest)
 
+	t.Setenv("XDG_STATE_HOME", t.TempDir())
+
 	client := queries.NewTestClient()
 	config := editItemConfig{
 		opts: editItemOpts{
@@ -512,6 +598,8 @@ func TestRunItemEdit_Clear(t *testing.T) {
 			},
 		})
 
+	t.Setenv("XDG_STATE_HOME", t.TempDir())
+
 	client := queries.NewTestClient()
 
 	ios, _, stdout, _ := iostreams.Test()
@@ -554,6 +642,8 @@ func TestRunItemEdit_JSON(t *testing.T) {
 			},
 		})
 
+	t.Setenv("XDG_STATE_HOME", t.TempDir())
+
 	client := queries.NewTestClient()
 
 	ios, _, stdout, _ := iostreams.Test()
@@ -575,3 +665,87 @@ func TestRunItemEdit_JSON(t *testing.T) {
 		`{"id":"DI_item_id","title":"a title","body":"a new body","type":"DraftIssue"}`,
 		stdout.String())
 }
+
+func TestRunItemEdit_TypeMismatch(t *testing.T) {
+	defer gock.Off()
+
+	registerFieldQuery(t, map[string]interface{}{
+		"__typename": "ProjectV2Field",
+		"id":         "field_id",
+		"name":       "Points",
+		"dataType":   "NUMBER",
+	})
+
+	t.Setenv("XDG_STATE_HOME", t.TempDir())
+
+	client := queries.NewTestClient()
+
+	ios, _, _, _ := iostreams.Test()
+	config := editItemConfig{
+		io: ios,
+		opts: editItemOpts{
+			text:      "item text",
+			itemID:    "item_id",
+			projectID: "project_id",
+			fieldID:   "field_id",
+		},
+		client: client,
+	}
+
+	err := runEditItem(config)
+	assert.EqualError(t, err, "field \"Points\" has type NUMBER; set it with `--number`")
+}
+
+func TestRunItemEdit_InvalidOption(t *testing.T) {
+	defer gock.Off()
+
+	registerFieldQuery(t, map[string]interface{}{
+		"__typename": "ProjectV2SingleSelectField",
+		"id":         "field_id",
+		"name":       "Status",
+		"dataType":   "SINGLE_SELECT",
+		"options": []map[string]interface{}{
+			{"id": "option_id", "name": "Done"},
+		},
+	})
+
+	t.Setenv("XDG_STATE_HOME", t.TempDir())
+
+	client := queries.NewTestClient()
+
+	ios, _, _, _ := iostreams.Test()
+	config := editItemConfig{
+		io: ios,
+		opts: editItemOpts{
+			singleSelectOptionID: "bad_id",
+			itemID:               "item_id",
+			projectID:            "project_id",
+			fieldID:              "field_id",
+		},
+		client: client,
+	}
+
+	err := runEditItem(config)
+	assert.EqualError(t, err, `field "Status" has no option with ID "bad_id"; available options: "Done" (option_id)`)
+}
+
+func TestRunItemEdit_InvalidDate(t *testing.T) {
+	t.Setenv("XDG_STATE_HOME", t.TempDir())
+
+	client := queries.NewTestClient()
+
+	ios, _, _, _ := iostreams.Test()
+	config := editItemConfig{
+		io: ios,
+		opts: editItemOpts{
+			date:      "01/02/2023",
+			itemID:    "item_id",
+			projectID: "project_id",
+			fieldID:   "field_id",
+		},
+		client: client,
+	}
+
+	err := runEditItem(config)
+	assert.EqualError(t, err, `invalid date "01/02/2023": date must be in YYYY-MM-DD format`)
+}
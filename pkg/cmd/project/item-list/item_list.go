@@ -1,12 +1,18 @@
 package itemlist
 
 import (
+	"bytes"
 	"fmt"
+	"io"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/MakeNowJust/heredoc"
 	"github.com/cli/cli/v2/internal/tableprinter"
 	"github.com/cli/cli/v2/pkg/cmd/project/shared/client"
+	"github.com/cli/cli/v2/pkg/cmd/project/shared/filter"
+	"github.com/cli/cli/v2/pkg/cmd/project/shared/format"
 	"github.com/cli/cli/v2/pkg/cmd/project/shared/queries"
 	"github.com/cli/cli/v2/pkg/cmdutil"
 	"github.com/cli/cli/v2/pkg/iostreams"
@@ -17,9 +23,16 @@ type listOpts struct {
 	limit    int
 	owner    string
 	number   int32
+	filter   string
+	format   string
+	watch    bool
+	interval int
 	exporter cmdutil.Exporter
 }
 
+// defaultInterval is the number of seconds between refreshes in watch mode.
+const defaultInterval = 10
+
 type listConfig struct {
 	io     *iostreams.IOStreams
 	client *queries.Client
@@ -31,9 +44,35 @@ func NewCmdList(f *cmdutil.Factory, runF func(config listConfig) error) *cobra.C
 	listCmd := &cobra.Command{
 		Short: "List the items in a project",
 		Use:   "item-list [<number>]",
+		Long: heredoc.Docf(`
+			List the items in a project.
+
+			The %[1]s--filter%[1]s flag accepts the same qualifier syntax as the project
+			board's search box, e.g. %[1]sstatus:Done assignee:monalisa label:bug%[1]s. Values
+			containing spaces can be quoted, comma-separated values match any of the
+			alternatives, and a %[1]s-%[1]s prefix negates a qualifier. Because the API has no
+			server-side filtering for project items, the filter is applied to the items
+			after up to %[1]s--limit%[1]s of them have been fetched.
+
+			A %[1]s--limit%[1]s of %[1]s0%[1]s fetches all items. With %[1]s--format ndjson%[1]s, items are
+			written one JSON object per line as each page arrives, so even very large
+			projects can be exported without holding every item in memory.
+		`, "`"),
 		Example: heredoc.Doc(`
 			# list the items in the current users's project "1"
 			gh project item-list 1 --owner "@me"
+
+			# list the open bugs that are still in progress
+			gh project item-list 1 --owner "@me" --filter 'status:"In Progress" label:bug'
+
+			# export the items with their field values as CSV
+			gh project item-list 1 --owner "@me" --format csv
+
+			# stream every item of a large project as newline-delimited JSON
+			gh project item-list 1 --owner "@me" --format ndjson --limit 0
+
+			# keep a standup dashboard up to date, highlighting rows that change
+			gh project item-list 1 --owner "@me" --watch
 		`),
 		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -50,6 +89,13 @@ func NewCmdList(f *cmdutil.Factory, runF func(config listConfig) error) *cobra.C
 				opts.number = int32(num)
 			}
 
+			if err := cmdutil.MutuallyExclusive(
+				"cannot use `--watch` with `--format`",
+				opts.watch, opts.format != "",
+			); err != nil {
+				return err
+			}
+
 			config := listConfig{
 				io:     f.IOStreams,
 				client: client,
@@ -64,14 +110,26 @@ func NewCmdList(f *cmdutil.Factory, runF func(config listConfig) error) *cobra.C
 		},
 	}
 
-	listCmd.Flags().StringVar(&opts.owner, "owner", "", "Login of the owner. Use \"@me\" for the current user.")
-	cmdutil.AddFormatFlags(listCmd, &opts.exporter)
-	listCmd.Flags().IntVarP(&opts.limit, "limit", "L", queries.LimitDefault, "Maximum number of items to fetch")
+	listCmd.Flags().StringVar(&opts.owner, "owner", "", "Login of the owner. Use \"@me\" for the current user. Defaults to the owner of the current directory's repository.")
+	listCmd.Flags().StringVar(&opts.filter, "filter", "", "Filter items with the project's search `query`, e.g. \"status:Done\"")
+	cmdutil.AddFormatAndJSONFlagsWithFormats(listCmd, &opts.exporter, &opts.format, []string{"json", "csv", "ndjson"}, queries.ProjectItemsJSONFields)
+	listCmd.Flags().IntVarP(&opts.limit, "limit", "L", queries.LimitDefault, "Maximum number of items to fetch, or 0 for all items")
+	listCmd.Flags().BoolVar(&opts.watch, "watch", false, "Re-query on an interval and re-render the table in place")
+	listCmd.Flags().IntVarP(&opts.interval, "interval", "i", defaultInterval, "Refresh interval in seconds when using `--watch`")
 
 	return listCmd
 }
 
 func runList(config listConfig) error {
+	var itemFilter *filter.Filter
+	if config.opts.filter != "" {
+		var err error
+		itemFilter, err = filter.Parse(config.opts.filter)
+		if err != nil {
+			return fmt.Errorf("invalid value for --filter: %w", err)
+		}
+	}
+
 	canPrompt := config.io.CanPrompt()
 	owner, err := config.client.NewOwner(canPrompt, config.opts.owner)
 	if err != nil {
@@ -87,7 +145,15 @@ func runList(config listConfig) error {
 		config.opts.number = project.Number
 	}
 
-	project, err := config.client.ProjectItems(owner, config.opts.number, config.opts.limit)
+	if config.opts.watch {
+		return runWatch(config, owner, itemFilter)
+	}
+
+	if config.opts.format == "ndjson" {
+		return runStream(config, owner, itemFilter)
+	}
+
+	project, err := fetchProjectItems(config, owner, itemFilter)
 	if err != nil {
 		return err
 	}
@@ -96,9 +162,131 @@ func runList(config listConfig) error {
 		return config.opts.exporter.Write(config.io, project.DetailedItems())
 	}
 
+	if config.opts.format == "csv" {
+		return format.CSVProjectDetailedItems(config.io.Out, project)
+	}
+
 	return printResults(config, project.Items.Nodes, owner.Login)
 }
 
+// fetchProjectItems queries the project's items and applies the --filter
+// selector to them. A limit of 0 pages through every item.
+func fetchProjectItems(config listConfig, owner *queries.Owner, itemFilter *filter.Filter) (*queries.Project, error) {
+	var project *queries.Project
+	var err error
+	if config.opts.limit == 0 {
+		err = config.client.ProjectItemsPaged(owner, config.opts.number, 0, func(p *queries.Project, items []queries.ProjectItem) error {
+			project = p
+			project.Items.Nodes = append(project.Items.Nodes, items...)
+			return nil
+		})
+	} else {
+		project, err = config.client.ProjectItems(owner, config.opts.number, config.opts.limit)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	project.Items.Nodes = filterItems(itemFilter, project.Items.Nodes)
+	return project, nil
+}
+
+// runStream pages through the project's items and writes them as
+// newline-delimited JSON, one page at a time, as each page arrives.
+func runStream(config listConfig, owner *queries.Owner, itemFilter *filter.Filter) error {
+	return config.client.ProjectItemsPaged(owner, config.opts.number, config.opts.limit, func(project *queries.Project, items []queries.ProjectItem) error {
+		return format.NDJSONProjectItems(config.io.Out, project, filterItems(itemFilter, items))
+	})
+}
+
+// filterItems applies the --filter selector to items, in place. A nil filter
+// keeps everything.
+func filterItems(itemFilter *filter.Filter, items []queries.ProjectItem) []queries.ProjectItem {
+	if itemFilter == nil {
+		return items
+	}
+	filtered := items[:0]
+	for _, item := range items {
+		if itemFilter.Matches(item) {
+			filtered = append(filtered, item)
+		}
+	}
+	return filtered
+}
+
+// runWatch re-queries the project's items on an interval and re-renders the
+// table in place, highlighting the rows that changed since the last refresh.
+func runWatch(config listConfig, owner *queries.Owner, itemFilter *filter.Filter) error {
+	interval, err := time.ParseDuration(fmt.Sprintf("%ds", config.opts.interval))
+	if err != nil {
+		return fmt.Errorf("could not parse interval: %w", err)
+	}
+
+	cs := config.io.ColorScheme()
+	previous := map[string]string{}
+	first := true
+
+	out := &bytes.Buffer{}
+	config.io.StartAlternateScreenBuffer()
+	defer config.io.StopAlternateScreenBuffer()
+	for {
+		project, err := fetchProjectItems(config, owner, itemFilter)
+		if err != nil {
+			return err
+		}
+
+		// Write to a temporary buffer so the screen is only cleared once the
+		// new table is ready.
+		out.Reset()
+		current := make(map[string]string, len(project.Items.Nodes))
+		tp := tableprinter.NewWithWriter(out, config.io.IsStdoutTTY(), config.io.TerminalWidth(), cs,
+			tableprinter.WithHeader("Type", "Title", "Number", "Repository", "ID"))
+		for _, i := range project.Items.Nodes {
+			row := itemRow(i)
+			key := strings.Join(row, "\t")
+			current[i.ID()] = key
+			changed := !first && previous[i.ID()] != key
+			if changed {
+				tp.AddField(row[0], tableprinter.WithColor(cs.Yellow))
+				tp.AddField(row[1], tableprinter.WithColor(cs.Yellow))
+				tp.AddField(row[2], tableprinter.WithColor(cs.Yellow))
+				tp.AddField(row[3], tableprinter.WithColor(cs.Yellow))
+				tp.AddField(row[4], tableprinter.WithTruncate(nil), tableprinter.WithColor(cs.Yellow))
+			} else {
+				tp.AddField(row[0])
+				tp.AddField(row[1])
+				tp.AddField(row[2])
+				tp.AddField(row[3])
+				tp.AddField(row[4], tableprinter.WithTruncate(nil))
+			}
+			tp.EndRow()
+		}
+		if err := tp.Render(); err != nil {
+			return err
+		}
+		previous = current
+		first = false
+
+		config.io.RefreshScreen()
+		fmt.Fprintln(config.io.Out, cs.Boldf("Refreshing items every %d seconds. Press Ctrl+C to quit.", config.opts.interval))
+		fmt.Fprintln(config.io.Out)
+		if _, err := io.Copy(config.io.Out, out); err != nil {
+			return err
+		}
+
+		time.Sleep(interval)
+	}
+}
+
+// itemRow is the cell contents of the item's table row.
+func itemRow(item queries.ProjectItem) []string {
+	number := ""
+	if item.Number() != 0 {
+		number = strconv.Itoa(item.Number())
+	}
+	return []string{item.Type(), item.Title(), number, item.Repo(), item.ID()}
+}
+
 func printResults(config listConfig, items []queries.ProjectItem, login string) error {
 	if len(items) == 0 {
 		return cmdutil.NewNoResultsError(fmt.Sprintf("Project %d for owner %s has no items", config.opts.number, login))
@@ -107,15 +295,12 @@ func printResults(config listConfig, items []queries.ProjectItem, login string)
 	tp := tableprinter.New(config.io, tableprinter.WithHeader("Type", "Title", "Number", "Repository", "ID"))
 
 	for _, i := range items {
-		tp.AddField(i.Type())
-		tp.AddField(i.Title())
-		if i.Number() == 0 {
-			tp.AddField("")
-		} else {
-			tp.AddField(strconv.Itoa(i.Number()))
-		}
-		tp.AddField(i.Repo())
-		tp.AddField(i.ID(), tableprinter.WithTruncate(nil))
+		row := itemRow(i)
+		tp.AddField(row[0])
+		tp.AddField(row[1])
+		tp.AddField(row[2])
+		tp.AddField(row[3])
+		tp.AddField(row[4], tableprinter.WithTruncate(nil))
 		tp.EndRow()
 	}
 
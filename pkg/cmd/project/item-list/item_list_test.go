@@ -32,26 +32,72 @@ func TestNewCmdList(t *testing.T) {
 			name: "number",
 			cli:  "123",
 			wants: listOpts{
-				number: 123,
-				limit:  30,
+				number:   123,
+				limit:    30,
+				interval: 10,
 			},
 		},
 		{
 			name: "owner",
 			cli:  "--owner monalisa",
 			wants: listOpts{
-				owner: "monalisa",
-				limit: 30,
+				owner:    "monalisa",
+				limit:    30,
+				interval: 10,
+			},
+		},
+		{
+			name: "filter",
+			cli:  "--filter 'status:Done'",
+			wants: listOpts{
+				filter:   "status:Done",
+				limit:    30,
+				interval: 10,
 			},
 		},
 		{
 			name: "json",
 			cli:  "--format json",
 			wants: listOpts{
-				limit: 30,
+				limit:    30,
+				format:   "json",
+				interval: 10,
 			},
 			wantsExporter: true,
 		},
+		{
+			name: "csv",
+			cli:  "--format csv",
+			wants: listOpts{
+				limit:    30,
+				format:   "csv",
+				interval: 10,
+			},
+		},
+		{
+			name: "ndjson",
+			cli:  "--format ndjson --limit 0",
+			wants: listOpts{
+				limit:    0,
+				format:   "ndjson",
+				interval: 10,
+			},
+		},
+		{
+			name: "watch",
+			cli:  "--watch --interval 5",
+			wants: listOpts{
+				limit:    30,
+				watch:    true,
+				interval: 5,
+			},
+		},
+		{
+			name:        "watch-with-format",
+			cli:         "--watch --format json",
+			wantsErr:    true,
+			wantsErrMsg: "cannot use `--watch` with `--format`",
+		},
 	}
 
 	t.Setenv("GH_TOKEN", "auth-token")
@@ -83,8 +129,12 @@ func TestNewCmdList(t *testing.T) {
 
 			assert.Equal(t, tt.wants.number, gotOpts.number)
 			assert.Equal(t, tt.wants.owner, gotOpts.owner)
+			assert.Equal(t, tt.wants.filter, gotOpts.filter)
+			assert.Equal(t, tt.wants.format, gotOpts.format)
 			assert.Equal(t, tt.wantsExporter, gotOpts.exporter != nil)
 			assert.Equal(t, tt.wants.limit, gotOpts.limit)
+			assert.Equal(t, tt.wants.watch, gotOpts.watch)
+			assert.Equal(t, tt.wants.interval, gotOpts.interval)
 		})
 	}
 }
@@ -124,7 +174,7 @@ func TestRunList_User_tty(t *testing.T) {
 		JSON(map[string]interface{}{
 			"query": "query UserProjectWithItems.*",
 			"variables": map[string]interface{}{
-				"firstItems":  queries.LimitDefault,
+				"firstItems":  queries.LimitMax,
 				"afterItems":  nil,
 				"firstFields": queries.LimitMax,
 				"afterFields": nil,
@@ -234,7 +284,7 @@ func TestRunList_User(t *testing.T) {
 		JSON(map[string]interface{}{
 			"query": "query UserProjectWithItems.*",
 			"variables": map[string]interface{}{
-				"firstItems":  queries.LimitDefault,
+				"firstItems":  queries.LimitMax,
 				"afterItems":  nil,
 				"firstFields": queries.LimitMax,
 				"afterFields": nil,
@@ -306,6 +356,446 @@ func TestRunList_User(t *testing.T) {
 		stdout.String())
 }
 
+func TestRunList_User_filter(t *testing.T) {
+	defer gock.Off()
+	// gock.Observe(gock.DumpRequest)
+
+	// get user ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query UserOrgOwner.*",
+			"variables": map[string]interface{}{
+				"login": "monalisa",
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"id": "an ID",
+				},
+			},
+			"errors": []interface{}{
+				map[string]interface{}{
+					"type": "NOT_FOUND",
+					"path": []string{"organization"},
+				},
+			},
+		})
+
+	// list project items
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		JSON(map[string]interface{}{
+			"query": "query UserProjectWithItems.*",
+			"variables": map[string]interface{}{
+				"firstItems":  queries.LimitMax,
+				"afterItems":  nil,
+				"firstFields": queries.LimitMax,
+				"afterFields": nil,
+				"login":       "monalisa",
+				"number":      1,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"items": map[string]interface{}{
+							"nodes": []map[string]interface{}{
+								{
+									"id": "issue ID",
+									"content": map[string]interface{}{
+										"__typename": "Issue",
+										"title":      "an issue",
+										"number":     1,
+										"repository": map[string]string{
+											"nameWithOwner": "cli/go-gh",
+										},
+									},
+								},
+								{
+									"id": "pull request ID",
+									"content": map[string]interface{}{
+										"__typename": "PullRequest",
+										"title":      "a pull request",
+										"number":     2,
+										"repository": map[string]string{
+											"nameWithOwner": "cli/go-gh",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		})
+
+	client := queries.NewTestClient()
+
+	ios, _, stdout, _ := iostreams.Test()
+	config := listConfig{
+		opts: listOpts{
+			number: 1,
+			owner:  "monalisa",
+			filter: "type:issue",
+		},
+		client: client,
+		io:     ios,
+	}
+
+	err := runList(config)
+	assert.NoError(t, err)
+	assert.Equal(
+		t,
+		"Issue\tan issue\t1\tcli/go-gh\tissue ID\n",
+		stdout.String())
+}
+
+func TestRunList_User_invalidFilter(t *testing.T) {
+	ios, _, _, _ := iostreams.Test()
+	config := listConfig{
+		opts: listOpts{
+			number: 1,
+			owner:  "monalisa",
+			filter: `status:"Done`,
+		},
+		client: queries.NewTestClient(),
+		io:     ios,
+	}
+
+	err := runList(config)
+	assert.EqualError(t, err, `invalid value for --filter: unterminated quote: status:"Done`)
+}
+
+func TestRunList_User_csv(t *testing.T) {
+	defer gock.Off()
+	// gock.Observe(gock.DumpRequest)
+
+	// get user ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query UserOrgOwner.*",
+			"variables": map[string]interface{}{
+				"login": "monalisa",
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"id": "an ID",
+				},
+			},
+			"errors": []interface{}{
+				map[string]interface{}{
+					"type": "NOT_FOUND",
+					"path": []string{"organization"},
+				},
+			},
+		})
+
+	// list project items
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		JSON(map[string]interface{}{
+			"query": "query UserProjectWithItems.*",
+			"variables": map[string]interface{}{
+				"firstItems":  queries.LimitMax,
+				"afterItems":  nil,
+				"firstFields": queries.LimitMax,
+				"afterFields": nil,
+				"login":       "monalisa",
+				"number":      1,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"items": map[string]interface{}{
+							"nodes": []map[string]interface{}{
+								{
+									"id": "issue ID",
+									"content": map[string]interface{}{
+										"__typename": "Issue",
+										"title":      "an issue",
+										"number":     1,
+										"url":        "https://github.com/cli/go-gh/issues/1",
+										"repository": map[string]string{
+											"nameWithOwner": "cli/go-gh",
+										},
+									},
+									"fieldValues": map[string]interface{}{
+										"nodes": []map[string]interface{}{
+											{
+												"__typename": "ProjectV2ItemFieldTextValue",
+												"text":       "an issue",
+												"field": map[string]interface{}{
+													"__typename": "ProjectV2Field",
+													"name":       "Title",
+												},
+											},
+											{
+												"__typename": "ProjectV2ItemFieldSingleSelectValue",
+												"name":       "In Progress",
+												"field": map[string]interface{}{
+													"__typename": "ProjectV2SingleSelectField",
+													"name":       "Status",
+												},
+											},
+											{
+												"__typename": "ProjectV2ItemFieldNumberValue",
+												"number":     3.5,
+												"field": map[string]interface{}{
+													"__typename": "ProjectV2Field",
+													"name":       "Points",
+												},
+											},
+											{
+												"__typename": "ProjectV2ItemFieldIterationValue",
+												"title":      "Iteration 1",
+												"field": map[string]interface{}{
+													"__typename": "ProjectV2IterationField",
+													"name":       "Sprint",
+												},
+											},
+											{
+												"__typename": "ProjectV2ItemFieldLabelValue",
+												"labels": map[string]interface{}{
+													"nodes": []map[string]interface{}{
+														{"name": "bug"},
+														{"name": "help wanted"},
+													},
+												},
+												"field": map[string]interface{}{
+													"__typename": "ProjectV2Field",
+													"name":       "Labels",
+												},
+											},
+											{
+												"__typename": "ProjectV2ItemFieldDateValue",
+												"date":       "2025-09-01",
+												"field": map[string]interface{}{
+													"__typename": "ProjectV2Field",
+													"name":       "Due",
+												},
+											},
+										},
+									},
+								},
+								{
+									"id": "draft issue ID",
+									"content": map[string]interface{}{
+										"id":         "draft issue ID",
+										"title":      "draft issue",
+										"__typename": "DraftIssue",
+									},
+									"fieldValues": map[string]interface{}{
+										"nodes": []map[string]interface{}{
+											{
+												"__typename": "ProjectV2ItemFieldSingleSelectValue",
+												"name":       "Done",
+												"field": map[string]interface{}{
+													"__typename": "ProjectV2SingleSelectField",
+													"name":       "Status",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		})
+
+	client := queries.NewTestClient()
+
+	ios, _, stdout, _ := iostreams.Test()
+	config := listConfig{
+		opts: listOpts{
+			number: 1,
+			owner:  "monalisa",
+			format: "csv",
+		},
+		client: client,
+		io:     ios,
+	}
+
+	err := runList(config)
+	assert.NoError(t, err)
+	assert.Equal(t, heredoc.Doc(`
+		Type,Title,Number,Repository,URL,ID,Status,Points,Sprint,Labels,Due
+		Issue,an issue,1,cli/go-gh,https://github.com/cli/go-gh/issues/1,issue ID,In Progress,3.5,Iteration 1,"bug, help wanted",2025-09-01
+		DraftIssue,draft issue,,,,draft issue ID,Done,,,,
+	`), stdout.String())
+}
+
+func TestRunList_User_ndjson(t *testing.T) {
+	defer gock.Off()
+	// gock.Observe(gock.DumpRequest)
+
+	// get user ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query UserOrgOwner.*",
+			"variables": map[string]interface{}{
+				"login": "monalisa",
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"id": "an ID",
+				},
+			},
+			"errors": []interface{}{
+				map[string]interface{}{
+					"type": "NOT_FOUND",
+					"path": []string{"organization"},
+				},
+			},
+		})
+
+	// list first page of project items
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		JSON(map[string]interface{}{
+			"query": "query UserProjectWithItems.*",
+			"variables": map[string]interface{}{
+				"firstItems":  queries.LimitMax,
+				"afterItems":  nil,
+				"firstFields": queries.LimitMax,
+				"afterFields": nil,
+				"login":       "monalisa",
+				"number":      1,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"fields": map[string]interface{}{
+							"nodes": []map[string]interface{}{
+								{
+									"__typename": "ProjectV2SingleSelectField",
+									"id":         "status ID",
+									"name":       "Status",
+								},
+							},
+						},
+						"items": map[string]interface{}{
+							"nodes": []map[string]interface{}{
+								{
+									"id": "issue ID",
+									"content": map[string]interface{}{
+										"__typename": "Issue",
+										"title":      "an issue",
+										"number":     1,
+										"url":        "https://github.com/cli/go-gh/issues/1",
+										"repository": map[string]string{
+											"nameWithOwner": "cli/go-gh",
+										},
+									},
+									"fieldValues": map[string]interface{}{
+										"nodes": []map[string]interface{}{
+											{
+												"__typename": "ProjectV2ItemFieldSingleSelectValue",
+												"name":       "Done",
+												"field": map[string]interface{}{
+													"__typename": "ProjectV2SingleSelectField",
+													"id":         "status ID",
+													"name":       "Status",
+												},
+											},
+										},
+									},
+								},
+							},
+							"pageInfo": map[string]interface{}{
+								"hasNextPage": true,
+								"endCursor":   "cursor 1",
+							},
+						},
+					},
+				},
+			},
+		})
+
+	// list second page of project items
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		JSON(map[string]interface{}{
+			"query": "query UserProjectWithItems.*",
+			"variables": map[string]interface{}{
+				"firstItems":  queries.LimitMax,
+				"afterItems":  "cursor 1",
+				"firstFields": queries.LimitMax,
+				"afterFields": nil,
+				"login":       "monalisa",
+				"number":      1,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"items": map[string]interface{}{
+							"nodes": []map[string]interface{}{
+								{
+									"id": "draft issue ID",
+									"content": map[string]interface{}{
+										"id":         "draft issue ID",
+										"title":      "draft issue",
+										"__typename": "DraftIssue",
+									},
+								},
+							},
+							"pageInfo": map[string]interface{}{
+								"hasNextPage": false,
+								"endCursor":   "cursor 2",
+							},
+						},
+					},
+				},
+			},
+		})
+
+	client := queries.NewTestClient()
+
+	ios, _, stdout, _ := iostreams.Test()
+	config := listConfig{
+		opts: listOpts{
+			number: 1,
+			owner:  "monalisa",
+			format: "ndjson",
+		},
+		client: client,
+		io:     ios,
+	}
+
+	err := runList(config)
+	assert.NoError(t, err)
+	assert.Equal(t, heredoc.Doc(`
+		{"content":{"body":"","number":1,"repository":"cli/go-gh","title":"an issue","type":"Issue","url":"https://github.com/cli/go-gh/issues/1"},"id":"issue ID","status":"Done"}
+		{"content":{"body":"","id":"draft issue ID","title":"draft issue","type":"DraftIssue"},"id":"draft issue ID"}
+	`), stdout.String())
+}
+
 func TestRunList_Org(t *testing.T) {
 	defer gock.Off()
 	// gock.Observe(gock.DumpRequest)
@@ -341,7 +831,7 @@ func TestRunList_Org(t *testing.T) {
 		JSON(map[string]interface{}{
 			"query": "query OrgProjectWithItems.*",
 			"variables": map[string]interface{}{
-				"firstItems":  queries.LimitDefault,
+				"firstItems":  queries.LimitMax,
 				"afterItems":  nil,
 				"firstFields": queries.LimitMax,
 				"afterFields": nil,
@@ -439,7 +929,7 @@ func TestRunList_Me(t *testing.T) {
 		JSON(map[string]interface{}{
 			"query": "query ViewerProjectWithItems.*",
 			"variables": map[string]interface{}{
-				"firstItems":  queries.LimitDefault,
+				"firstItems":  queries.LimitMax,
 				"afterItems":  nil,
 				"firstFields": queries.LimitMax,
 				"afterFields": nil,
@@ -545,7 +1035,7 @@ func TestRunList_JSON(t *testing.T) {
 		JSON(map[string]interface{}{
 			"query": "query UserProjectWithItems.*",
 			"variables": map[string]interface{}{
-				"firstItems":  queries.LimitDefault,
+				"firstItems":  queries.LimitMax,
 				"afterItems":  nil,
 				"firstFields": queries.LimitMax,
 				"afterFields": nil,
@@ -0,0 +1,205 @@
+package itemunarchive
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/text"
+	"github.com/cli/cli/v2/pkg/cmd/project/shared/client"
+	"github.com/cli/cli/v2/pkg/cmd/project/shared/queries"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/shurcooL/githubv4"
+	"github.com/spf13/cobra"
+)
+
+type unarchiveItemOpts struct {
+	owner     string
+	number    int32
+	itemID    string
+	query     string
+	allDone   bool
+	limit     int
+	projectID string
+	exporter  cmdutil.Exporter
+}
+
+type unarchiveItemConfig struct {
+	client *queries.Client
+	opts   unarchiveItemOpts
+	io     *iostreams.IOStreams
+}
+
+type unarchiveProjectItemMutation struct {
+	UnarchiveProjectItem struct {
+		ProjectV2Item queries.ProjectItem `graphql:"item"`
+	} `graphql:"unarchiveProjectV2Item(input:$input)"`
+}
+
+func NewCmdUnarchiveItem(f *cmdutil.Factory, runF func(config unarchiveItemConfig) error) *cobra.Command {
+	opts := unarchiveItemOpts{}
+	unarchiveItemCmd := &cobra.Command{
+		Short: "Unarchive an item in a project",
+		Use:   "item-unarchive [<number>]",
+		Example: heredoc.Doc(`
+			# unarchive an item in the current user's project "1"
+			gh project item-unarchive 1 --owner "@me" --id <item-ID>
+
+			# unarchive all items whose title contains "bug" in the current user's project "1"
+			gh project item-unarchive 1 --owner "@me" --query bug
+		`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := client.New(f)
+			if err != nil {
+				return err
+			}
+
+			if len(args) == 1 {
+				num, err := strconv.ParseInt(args[0], 10, 32)
+				if err != nil {
+					return cmdutil.FlagErrorf("invalid number: %v", args[0])
+				}
+				opts.number = int32(num)
+			}
+
+			if err := cmdutil.MutuallyExclusive(
+				"specify only one of `--id`, `--query`, or `--all-done`",
+				opts.itemID != "", opts.query != "", opts.allDone,
+			); err != nil {
+				return err
+			}
+			if opts.itemID == "" && opts.query == "" && !opts.allDone {
+				return cmdutil.FlagErrorf("specify one of `--id`, `--query`, or `--all-done`")
+			}
+
+			config := unarchiveItemConfig{
+				client: client,
+				opts:   opts,
+				io:     f.IOStreams,
+			}
+
+			// allow testing of the command without actually running it
+			if runF != nil {
+				return runF(config)
+			}
+			return runUnarchiveItem(config)
+		},
+	}
+
+	unarchiveItemCmd.Flags().StringVar(&opts.owner, "owner", "", "Login of the owner. Use \"@me\" for the current user. Defaults to the owner of the current directory's repository.")
+	unarchiveItemCmd.Flags().StringVar(&opts.itemID, "id", "", "ID of the item to unarchive")
+	unarchiveItemCmd.Flags().StringVar(&opts.query, "query", "", "Unarchive all items whose title contains the query")
+	unarchiveItemCmd.Flags().BoolVar(&opts.allDone, "all-done", false, "Unarchive all items in the Done status")
+	unarchiveItemCmd.Flags().IntVarP(&opts.limit, "limit", "L", queries.LimitDefault, "Maximum number of items to fetch when using `--query` or `--all-done`")
+	cmdutil.AddFormatAndJSONFlags(unarchiveItemCmd, &opts.exporter, queries.ProjectItemJSONFields)
+
+	return unarchiveItemCmd
+}
+
+func runUnarchiveItem(config unarchiveItemConfig) error {
+	canPrompt := config.io.CanPrompt()
+	owner, err := config.client.NewOwner(canPrompt, config.opts.owner)
+	if err != nil {
+		return err
+	}
+
+	if config.opts.itemID == "" {
+		return runUnarchiveItems(config, owner)
+	}
+
+	project, err := config.client.NewProject(canPrompt, owner, config.opts.number, false)
+	if err != nil {
+		return err
+	}
+	config.opts.projectID = project.ID
+
+	query, variables := unarchiveItemArgs(config)
+	err = config.client.Mutate("UnarchiveProjectItem", query, variables)
+	if err != nil {
+		return err
+	}
+
+	if config.opts.exporter != nil {
+		return config.opts.exporter.Write(config.io, query.UnarchiveProjectItem.ProjectV2Item)
+	}
+
+	return printResults(config)
+}
+
+// runUnarchiveItems unarchives every item matched by the --query or
+// --all-done selectors.
+func runUnarchiveItems(config unarchiveItemConfig, owner *queries.Owner) error {
+	project, err := config.client.ProjectItems(owner, config.opts.number, config.opts.limit)
+	if err != nil {
+		return err
+	}
+
+	items := selectItems(config.opts, project.Items.Nodes)
+	if len(items) == 0 {
+		return cmdutil.NewNoResultsError(fmt.Sprintf("Project %d for owner %s has no matching items", project.Number, owner.Login))
+	}
+
+	ids := make([]string, len(items))
+	for i, item := range items {
+		ids[i] = item.ID()
+	}
+
+	if err := config.client.ArchiveProjectItems(project.ID, ids, true); err != nil {
+		return err
+	}
+
+	if config.opts.exporter != nil {
+		v := make([]map[string]interface{}, len(items))
+		for i, item := range items {
+			v[i] = item.ExportData(nil)
+		}
+		return config.opts.exporter.Write(config.io, map[string]interface{}{
+			"items":      v,
+			"totalCount": len(items),
+		})
+	}
+
+	if !config.io.IsStdoutTTY() {
+		return nil
+	}
+
+	_, err = fmt.Fprintf(config.io.Out, "Unarchived %s\n", text.Pluralize(len(items), "item"))
+	return err
+}
+
+// selectItems filters the project's items down to those matched by the
+// --query or --all-done selectors.
+func selectItems(opts unarchiveItemOpts, items []queries.ProjectItem) []queries.ProjectItem {
+	var selected []queries.ProjectItem
+	for _, item := range items {
+		if opts.allDone && !strings.EqualFold(item.SingleSelectValueByName("Status"), "Done") {
+			continue
+		}
+		if opts.query != "" && !strings.Contains(strings.ToLower(item.Title()), strings.ToLower(opts.query)) {
+			continue
+		}
+		selected = append(selected, item)
+	}
+	return selected
+}
+
+func unarchiveItemArgs(config unarchiveItemConfig) (*unarchiveProjectItemMutation, map[string]interface{}) {
+	return &unarchiveProjectItemMutation{}, map[string]interface{}{
+		"input": githubv4.UnarchiveProjectV2ItemInput{
+			ProjectID: githubv4.ID(config.opts.projectID),
+			ItemID:    githubv4.ID(config.opts.itemID),
+		},
+	}
+}
+
+func printResults(config unarchiveItemConfig) error {
+	if !config.io.IsStdoutTTY() {
+		return nil
+	}
+
+	_, err := fmt.Fprintf(config.io.Out, "Unarchived item\n")
+	return err
+}
@@ -0,0 +1,329 @@
+package itemunarchive
+
+import (
+	"testing"
+
+	"github.com/cli/cli/v2/pkg/cmd/project/shared/queries"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestNewCmdUnarchiveItem(t *testing.T) {
+	tests := []struct {
+		name          string
+		cli           string
+		wants         unarchiveItemOpts
+		wantsErr      bool
+		wantsErrMsg   string
+		wantsExporter bool
+	}{
+		{
+			name:        "missing-selector",
+			cli:         "",
+			wantsErr:    true,
+			wantsErrMsg: "specify one of `--id`, `--query`, or `--all-done`",
+		},
+		{
+			name:        "id-and-all-done",
+			cli:         "--id 123 --all-done",
+			wantsErr:    true,
+			wantsErrMsg: "specify only one of `--id`, `--query`, or `--all-done`",
+		},
+		{
+			name:        "not-a-number",
+			cli:         "x --id 123",
+			wantsErr:    true,
+			wantsErrMsg: "invalid number: x",
+		},
+		{
+			name: "id",
+			cli:  "--id 123",
+			wants: unarchiveItemOpts{
+				itemID: "123",
+			},
+		},
+		{
+			name: "number",
+			cli:  "456 --id 123",
+			wants: unarchiveItemOpts{
+				number: 456,
+				itemID: "123",
+			},
+		},
+		{
+			name: "owner",
+			cli:  "--owner monalisa --id 123",
+			wants: unarchiveItemOpts{
+				owner:  "monalisa",
+				itemID: "123",
+			},
+		},
+		{
+			name: "query",
+			cli:  "--query bug",
+			wants: unarchiveItemOpts{
+				query: "bug",
+			},
+		},
+		{
+			name: "all-done",
+			cli:  "--all-done",
+			wants: unarchiveItemOpts{
+				allDone: true,
+			},
+		},
+		{
+			name: "json",
+			cli:  "--format json --id 123",
+			wants: unarchiveItemOpts{
+				itemID: "123",
+			},
+			wantsExporter: true,
+		},
+	}
+
+	t.Setenv("GH_TOKEN", "auth-token")
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ios, _, _, _ := iostreams.Test()
+			f := &cmdutil.Factory{
+				IOStreams: ios,
+			}
+
+			argv, err := shlex.Split(tt.cli)
+			assert.NoError(t, err)
+
+			var gotOpts unarchiveItemOpts
+			cmd := NewCmdUnarchiveItem(f, func(config unarchiveItemConfig) error {
+				gotOpts = config.opts
+				return nil
+			})
+
+			cmd.SetArgs(argv)
+			_, err = cmd.ExecuteC()
+			if tt.wantsErr {
+				assert.Error(t, err)
+				assert.Equal(t, tt.wantsErrMsg, err.Error())
+				return
+			}
+			assert.NoError(t, err)
+
+			assert.Equal(t, tt.wants.number, gotOpts.number)
+			assert.Equal(t, tt.wants.owner, gotOpts.owner)
+			assert.Equal(t, tt.wants.itemID, gotOpts.itemID)
+			assert.Equal(t, tt.wants.query, gotOpts.query)
+			assert.Equal(t, tt.wants.allDone, gotOpts.allDone)
+			assert.Equal(t, tt.wantsExporter, gotOpts.exporter != nil)
+		})
+	}
+}
+
+func TestRunUnarchive_User(t *testing.T) {
+	defer gock.Off()
+	gock.Observe(gock.DumpRequest)
+
+	// get user ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query UserOrgOwner.*",
+			"variables": map[string]interface{}{
+				"login": "monalisa",
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"id": "an ID",
+				},
+			},
+			"errors": []interface{}{
+				map[string]interface{}{
+					"type": "NOT_FOUND",
+					"path": []string{"organization"},
+				},
+			},
+		})
+
+	// get project ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query UserProject.*",
+			"variables": map[string]interface{}{
+				"login":       "monalisa",
+				"number":      1,
+				"firstItems":  0,
+				"afterItems":  nil,
+				"firstFields": 0,
+				"afterFields": nil,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"id": "an ID",
+					},
+				},
+			},
+		})
+
+	// unarchive item
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`{"query":"mutation UnarchiveProjectItem.*","variables":{"input":{"projectId":"an ID","itemId":"item ID"}}}`).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"unarchiveProjectV2Item": map[string]interface{}{
+					"item": map[string]interface{}{
+						"id": "item ID",
+					},
+				},
+			},
+		})
+
+	client := queries.NewTestClient()
+
+	ios, _, stdout, _ := iostreams.Test()
+	ios.SetStdoutTTY(true)
+	config := unarchiveItemConfig{
+		opts: unarchiveItemOpts{
+			owner:  "monalisa",
+			number: 1,
+			itemID: "item ID",
+		},
+		client: client,
+		io:     ios,
+	}
+
+	err := runUnarchiveItem(config)
+	assert.NoError(t, err)
+	assert.Equal(
+		t,
+		"Unarchived item\n",
+		stdout.String())
+}
+
+func TestRunUnarchive_User_Query(t *testing.T) {
+	defer gock.Off()
+	gock.Observe(gock.DumpRequest)
+
+	// get user ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query UserOrgOwner.*",
+			"variables": map[string]interface{}{
+				"login": "monalisa",
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"id": "an ID",
+				},
+			},
+			"errors": []interface{}{
+				map[string]interface{}{
+					"type": "NOT_FOUND",
+					"path": []string{"organization"},
+				},
+			},
+		})
+
+	// list project items
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query UserProjectWithItems.*",
+			"variables": map[string]interface{}{
+				"login":       "monalisa",
+				"number":      1,
+				"firstItems":  queries.LimitDefault,
+				"afterItems":  nil,
+				"firstFields": queries.LimitMax,
+				"afterFields": nil,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"number": 1,
+						"id":     "project ID",
+						"items": map[string]interface{}{
+							"totalCount": 2,
+							"nodes": []map[string]interface{}{
+								{
+									"id": "item 1",
+									"content": map[string]interface{}{
+										"__typename": "Issue",
+										"title":      "a bug",
+									},
+								},
+								{
+									"id": "item 2",
+									"content": map[string]interface{}{
+										"__typename": "Issue",
+										"title":      "another bug",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		})
+
+	// unarchive the matched items in one batch
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`{"query":"mutation UnarchiveProjectItems.*","variables":{"input0":{"projectId":"project ID","itemId":"item 1"},"input1":{"projectId":"project ID","itemId":"item 2"}}}`).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"alias0": map[string]interface{}{
+					"clientMutationId": nil,
+				},
+				"alias1": map[string]interface{}{
+					"clientMutationId": nil,
+				},
+			},
+		})
+
+	client := queries.NewTestClient()
+
+	ios, _, stdout, _ := iostreams.Test()
+	ios.SetStdoutTTY(true)
+	config := unarchiveItemConfig{
+		opts: unarchiveItemOpts{
+			owner:  "monalisa",
+			number: 1,
+			query:  "bug",
+			limit:  queries.LimitDefault,
+		},
+		client: client,
+		io:     ios,
+	}
+
+	err := runUnarchiveItem(config)
+	assert.NoError(t, err)
+	assert.Equal(
+		t,
+		"Unarchived 2 items\n",
+		stdout.String())
+}
@@ -0,0 +1,131 @@
+package iterationlist
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/tableprinter"
+	"github.com/cli/cli/v2/pkg/cmd/project/shared/client"
+	"github.com/cli/cli/v2/pkg/cmd/project/shared/queries"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type listOpts struct {
+	owner    string
+	number   int32
+	exporter cmdutil.Exporter
+}
+
+type listConfig struct {
+	io     *iostreams.IOStreams
+	client *queries.Client
+	opts   listOpts
+}
+
+func NewCmdList(f *cmdutil.Factory, runF func(config listConfig) error) *cobra.Command {
+	opts := listOpts{}
+	listCmd := &cobra.Command{
+		Short: "List the iterations of a project's iteration fields",
+		Use:   "iteration-list [<number>]",
+		Example: heredoc.Doc(`
+			# list iterations in the current user's project "1"
+			gh project iteration-list 1 --owner "@me"
+		`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := client.New(f)
+			if err != nil {
+				return err
+			}
+
+			if len(args) == 1 {
+				num, err := strconv.ParseInt(args[0], 10, 32)
+				if err != nil {
+					return cmdutil.FlagErrorf("invalid number: %v", args[0])
+				}
+				opts.number = int32(num)
+			}
+
+			config := listConfig{
+				io:     f.IOStreams,
+				client: client,
+				opts:   opts,
+			}
+
+			// allow testing of the command without actually running it
+			if runF != nil {
+				return runF(config)
+			}
+			return runList(config)
+		},
+	}
+
+	listCmd.Flags().StringVar(&opts.owner, "owner", "", "Login of the owner. Use \"@me\" for the current user. Defaults to the owner of the current directory's repository.")
+	cmdutil.AddFormatAndJSONFlags(listCmd, &opts.exporter, queries.FieldsJSONFields)
+
+	return listCmd
+}
+
+func runList(config listConfig) error {
+	canPrompt := config.io.CanPrompt()
+	owner, err := config.client.NewOwner(canPrompt, config.opts.owner)
+	if err != nil {
+		return err
+	}
+
+	// no need to fetch the project if we already have the number
+	if config.opts.number == 0 {
+		project, err := config.client.NewProject(canPrompt, owner, config.opts.number, false)
+		if err != nil {
+			return err
+		}
+		config.opts.number = project.Number
+	}
+
+	fields, err := config.client.ProjectIterationFields(owner, config.opts.number)
+	if err != nil {
+		return err
+	}
+
+	if config.opts.exporter != nil {
+		return config.opts.exporter.Write(config.io, fields)
+	}
+
+	return printResults(config, fields.Nodes, owner.Login)
+}
+
+func printResults(config listConfig, fields []queries.ProjectIterationField, login string) error {
+	if len(fields) == 0 {
+		return cmdutil.NewNoResultsError(fmt.Sprintf("Project %d for owner %s has no iteration fields", config.opts.number, login))
+	}
+
+	tp := tableprinter.New(config.io, tableprinter.WithHeader("Field", "Title", "Start date", "Duration", "State", "ID"))
+
+	addIteration := func(field queries.ProjectIterationField, iteration queries.ProjectIteration, state string) {
+		tp.AddField(field.Name)
+		tp.AddField(iteration.Title)
+		tp.AddField(iteration.StartDate)
+		tp.AddField(fmt.Sprintf("%d days", iteration.Duration))
+		tp.AddField(state)
+		tp.AddField(iteration.ID, tableprinter.WithTruncate(nil))
+		tp.EndRow()
+	}
+
+	for _, f := range fields {
+		for i, iteration := range f.Configuration.Iterations {
+			state := "upcoming"
+			if i == 0 {
+				state = "current"
+			}
+			addIteration(f, iteration, state)
+		}
+		for _, iteration := range f.Configuration.CompletedIterations {
+			addIteration(f, iteration, "completed")
+		}
+	}
+
+	return tp.Render()
+}
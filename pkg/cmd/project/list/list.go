@@ -66,10 +66,10 @@ func NewCmdList(f *cmdutil.Factory, runF func(config listConfig) error) *cobra.C
 			return runList(config)
 		},
 	}
-	listCmd.Flags().StringVar(&opts.owner, "owner", "", "Login of the owner")
+	listCmd.Flags().StringVar(&opts.owner, "owner", "", "Login of the owner. Use \"@me\" for the current user. Defaults to the owner of the current directory's repository.")
 	listCmd.Flags().BoolVarP(&opts.closed, "closed", "", false, "Include closed projects")
 	listCmd.Flags().BoolVarP(&opts.web, "web", "w", false, "Open projects list in the browser")
-	cmdutil.AddFormatFlags(listCmd, &opts.exporter)
+	cmdutil.AddFormatAndJSONFlags(listCmd, &opts.exporter, queries.ProjectsJSONFields)
 	listCmd.Flags().IntVarP(&opts.limit, "limit", "L", queries.LimitDefault, "Maximum number of projects to fetch")
 
 	return listCmd
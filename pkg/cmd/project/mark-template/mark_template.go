@@ -81,7 +81,7 @@ func NewCmdMarkTemplate(f *cmdutil.Factory, runF func(config markTemplateConfig)
 
 	markTemplateCmd.Flags().StringVar(&opts.owner, "owner", "", "Login of the org owner.")
 	markTemplateCmd.Flags().BoolVar(&opts.undo, "undo", false, "Unmark the project as a template.")
-	cmdutil.AddFormatFlags(markTemplateCmd, &opts.exporter)
+	cmdutil.AddFormatAndJSONFlags(markTemplateCmd, &opts.exporter, queries.ProjectJSONFields)
 
 	return markTemplateCmd
 }
@@ -0,0 +1,318 @@
+package mirror
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/pkg/cmd/project/shared/client"
+	"github.com/cli/cli/v2/pkg/cmd/project/shared/queries"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/shurcooL/githubv4"
+	"github.com/spf13/cobra"
+)
+
+type mirrorOpts struct {
+	source       string
+	dest         string
+	mapFields    []string
+	sourceOwner  string
+	sourceNumber int32
+	destOwner    string
+	destNumber   int32
+}
+
+type mirrorConfig struct {
+	io     *iostreams.IOStreams
+	client *queries.Client
+	opts   mirrorOpts
+}
+
+type addProjectItemMutation struct {
+	CreateProjectItem struct {
+		ProjectV2Item queries.ProjectItem `graphql:"item"`
+	} `graphql:"addProjectV2ItemById(input:$input)"`
+}
+
+type updateProjectV2FieldValueMutation struct {
+	UpdateProjectV2ItemFieldValue struct {
+		Item queries.ProjectItem `graphql:"projectV2Item"`
+	} `graphql:"updateProjectV2ItemFieldValue(input:$input)"`
+}
+
+func NewCmdMirror(f *cmdutil.Factory, runF func(config mirrorConfig) error) *cobra.Command {
+	opts := mirrorOpts{}
+	mirrorCmd := &cobra.Command{
+		Short: "Mirror the items of a project into another project",
+		Use:   "mirror",
+		Long: heredoc.Docf(`
+			Mirror the items of a source project into a destination project, one way.
+
+			Issues and pull requests on the source project that are not yet present on
+			the destination project are added to it. With %[1]s--map-field%[1]s, the value of the
+			named source field is copied to the named destination field for every mirrored
+			item; single-select values are matched to the destination options by name.
+
+			Draft issues are not mirrored, and items removed from the source project are
+			left on the destination project.
+		`, "`"),
+		Example: heredoc.Doc(`
+			# mirror the items of org1's project "5" into org2's project "7"
+			gh project mirror --source org1/5 --dest org2/7
+
+			# also keep the Status field in sync
+			gh project mirror --source org1/5 --dest org2/7 --map-field Status=Status
+		`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := client.New(f)
+			if err != nil {
+				return err
+			}
+
+			if opts.sourceOwner, opts.sourceNumber, err = parseProjectRef(opts.source); err != nil {
+				return cmdutil.FlagErrorf("invalid `--source` value %q: expected owner/number", opts.source)
+			}
+			if opts.destOwner, opts.destNumber, err = parseProjectRef(opts.dest); err != nil {
+				return cmdutil.FlagErrorf("invalid `--dest` value %q: expected owner/number", opts.dest)
+			}
+
+			for _, spec := range opts.mapFields {
+				source, dest, ok := strings.Cut(spec, "=")
+				if !ok || source == "" || dest == "" {
+					return cmdutil.FlagErrorf("invalid `--map-field` value %q: expected source=destination", spec)
+				}
+			}
+
+			config := mirrorConfig{
+				io:     f.IOStreams,
+				client: client,
+				opts:   opts,
+			}
+
+			// allow testing of the command without actually running it
+			if runF != nil {
+				return runF(config)
+			}
+			return runMirror(config)
+		},
+	}
+
+	mirrorCmd.Flags().StringVar(&opts.source, "source", "", "Source project in `owner/number` format")
+	mirrorCmd.Flags().StringVar(&opts.dest, "dest", "", "Destination project in `owner/number` format")
+	mirrorCmd.Flags().StringArrayVar(&opts.mapFields, "map-field", nil, "Copy a source field value to a destination field in `source=destination` format (may be repeated)")
+
+	_ = mirrorCmd.MarkFlagRequired("source")
+	_ = mirrorCmd.MarkFlagRequired("dest")
+
+	return mirrorCmd
+}
+
+// parseProjectRef splits an owner/number project reference as accepted by the
+// --source and --dest flags.
+func parseProjectRef(ref string) (string, int32, error) {
+	owner, number, ok := strings.Cut(ref, "/")
+	if !ok || owner == "" {
+		return "", 0, fmt.Errorf("invalid project reference: %q", ref)
+	}
+	num, err := strconv.ParseInt(number, 10, 32)
+	if err != nil {
+		return "", 0, fmt.Errorf("invalid project number: %q", number)
+	}
+	return owner, int32(num), nil
+}
+
+func runMirror(config mirrorConfig) error {
+	canPrompt := config.io.CanPrompt()
+	sourceOwner, err := config.client.NewOwner(canPrompt, config.opts.sourceOwner)
+	if err != nil {
+		return err
+	}
+
+	destOwner, err := config.client.NewOwner(canPrompt, config.opts.destOwner)
+	if err != nil {
+		return err
+	}
+
+	destProject, err := config.client.NewProject(canPrompt, destOwner, config.opts.destNumber, false)
+	if err != nil {
+		return err
+	}
+
+	// fieldMap translates lowercased source field names to destination field names.
+	fieldMap := make(map[string]string, len(config.opts.mapFields))
+	for _, spec := range config.opts.mapFields {
+		source, dest, _ := strings.Cut(spec, "=")
+		fieldMap[strings.ToLower(source)] = dest
+	}
+
+	source, err := config.client.ProjectItems(sourceOwner, config.opts.sourceNumber, queries.LimitMax)
+	if err != nil {
+		return err
+	}
+
+	dest, err := config.client.ProjectItems(destOwner, config.opts.destNumber, queries.LimitMax)
+	if err != nil {
+		return err
+	}
+	// destItemIDs maps the content URL of every issue and pull request already
+	// on the destination project to its item ID.
+	destItemIDs := make(map[string]string, len(dest.Items.Nodes))
+	for _, item := range dest.Items.Nodes {
+		if url := item.URL(); url != "" {
+			destItemIDs[url] = item.Id
+		}
+	}
+
+	destFields := make(map[string]queries.ProjectField)
+	if len(fieldMap) > 0 {
+		fields, err := config.client.ProjectFields(destOwner, config.opts.destNumber, queries.LimitMax)
+		if err != nil {
+			return err
+		}
+		for _, f := range fields.Fields.Nodes {
+			destFields[strings.ToLower(f.Name())] = f
+		}
+	}
+
+	config.io.StartProgressIndicator()
+	var mirrored, added, failed int
+	for _, item := range source.Items.Nodes {
+		if item.Type() == "DraftIssue" {
+			continue
+		}
+		wasAdded, err := mirrorItem(config, destProject.ID, destItemIDs, destFields, fieldMap, item)
+		if err != nil {
+			config.io.StopProgressIndicator()
+			fmt.Fprintf(config.io.ErrOut, "%q: %v\n", item.Title(), err)
+			failed++
+			config.io.StartProgressIndicator()
+			continue
+		}
+		mirrored++
+		if wasAdded {
+			added++
+		}
+	}
+	config.io.StopProgressIndicator()
+
+	if failed > 0 {
+		return fmt.Errorf("failed to mirror %d of %d items", failed, mirrored+failed)
+	}
+
+	if config.io.IsStdoutTTY() {
+		_, err = fmt.Fprintf(config.io.Out, "Mirrored %d items into %s/%d (%d added)\n", mirrored, config.opts.destOwner, config.opts.destNumber, added)
+		return err
+	}
+	return nil
+}
+
+// mirrorItem ensures that item is present on the destination project and that
+// its mapped field values match the source. It reports whether the item had to
+// be added.
+func mirrorItem(config mirrorConfig, projectID string, destItemIDs map[string]string, destFields map[string]queries.ProjectField, fieldMap map[string]string, item queries.ProjectItem) (bool, error) {
+	itemID, exists := destItemIDs[item.URL()]
+	if !exists {
+		contentID, err := config.client.IssueOrPullRequestID(item.URL())
+		if err != nil {
+			return false, err
+		}
+		addQuery := &addProjectItemMutation{}
+		variables := map[string]interface{}{
+			"input": githubv4.AddProjectV2ItemByIdInput{
+				ProjectID: githubv4.ID(projectID),
+				ContentID: githubv4.ID(contentID),
+			},
+		}
+		if err := config.client.Mutate("AddItem", addQuery, variables); err != nil {
+			return false, err
+		}
+		itemID = addQuery.CreateProjectItem.ProjectV2Item.Id
+	}
+
+	for _, v := range item.FieldValues.Nodes {
+		field, value, ok, err := mirroredFieldValue(destFields, fieldMap, v)
+		if err != nil {
+			return !exists, err
+		}
+		if !ok {
+			continue
+		}
+		updateQuery := &updateProjectV2FieldValueMutation{}
+		variables := map[string]interface{}{
+			"input": githubv4.UpdateProjectV2ItemFieldValueInput{
+				ProjectID: githubv4.ID(projectID),
+				ItemID:    githubv4.ID(itemID),
+				FieldID:   githubv4.ID(field.ID()),
+				Value:     value,
+			},
+		}
+		if err := config.client.Mutate("UpdateItemValues", updateQuery, variables); err != nil {
+			return !exists, err
+		}
+	}
+
+	return !exists, nil
+}
+
+// mirroredFieldValue translates a field value of a source item into a value
+// for the mapped field of the destination project. Values of fields that are
+// not mapped, and value types that cannot be set through the API, such as
+// iterations and labels, are skipped.
+func mirroredFieldValue(destFields map[string]queries.ProjectField, fieldMap map[string]string, v queries.FieldValueNodes) (queries.ProjectField, githubv4.ProjectV2FieldValue, bool, error) {
+	var name string
+	var optionName string
+	var value githubv4.ProjectV2FieldValue
+
+	switch v.Type {
+	case "ProjectV2ItemFieldTextValue":
+		name = v.ProjectV2ItemFieldTextValue.Field.Name()
+		value = githubv4.ProjectV2FieldValue{
+			Text: githubv4.NewString(githubv4.String(v.ProjectV2ItemFieldTextValue.Text)),
+		}
+	case "ProjectV2ItemFieldNumberValue":
+		name = v.ProjectV2ItemFieldNumberValue.Field.Name()
+		value = githubv4.ProjectV2FieldValue{
+			Number: githubv4.NewFloat(githubv4.Float(v.ProjectV2ItemFieldNumberValue.Number)),
+		}
+	case "ProjectV2ItemFieldDateValue":
+		name = v.ProjectV2ItemFieldDateValue.Field.Name()
+		date, err := time.Parse("2006-01-02", v.ProjectV2ItemFieldDateValue.Date)
+		if err != nil {
+			return queries.ProjectField{}, value, false, fmt.Errorf("invalid date %q for field %q", v.ProjectV2ItemFieldDateValue.Date, name)
+		}
+		value = githubv4.ProjectV2FieldValue{
+			Date: githubv4.NewDate(githubv4.Date{Time: date}),
+		}
+	case "ProjectV2ItemFieldSingleSelectValue":
+		name = v.ProjectV2ItemFieldSingleSelectValue.Field.Name()
+		optionName = v.ProjectV2ItemFieldSingleSelectValue.Name
+	default:
+		return queries.ProjectField{}, value, false, nil
+	}
+
+	destName, ok := fieldMap[strings.ToLower(name)]
+	if !ok {
+		return queries.ProjectField{}, value, false, nil
+	}
+	field, ok := destFields[strings.ToLower(destName)]
+	if !ok {
+		return queries.ProjectField{}, value, false, fmt.Errorf("destination project has no field named %q", destName)
+	}
+
+	if optionName != "" {
+		for _, opt := range field.SingleSelectField.Options {
+			if strings.EqualFold(opt.Name, optionName) {
+				return field, githubv4.ProjectV2FieldValue{
+					SingleSelectOptionID: githubv4.NewString(githubv4.String(opt.ID)),
+				}, true, nil
+			}
+		}
+		return queries.ProjectField{}, value, false, fmt.Errorf("%q is not an option of field %q", optionName, field.Name())
+	}
+
+	return field, value, true, nil
+}
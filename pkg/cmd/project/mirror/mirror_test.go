@@ -0,0 +1,471 @@
+package mirror
+
+import (
+	"testing"
+
+	"github.com/cli/cli/v2/pkg/cmd/project/shared/queries"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestNewCmdMirror(t *testing.T) {
+	tests := []struct {
+		name        string
+		cli         string
+		wants       mirrorOpts
+		wantsErr    bool
+		wantsErrMsg string
+	}{
+		{
+			name:        "missing-required-flags",
+			cli:         "",
+			wantsErr:    true,
+			wantsErrMsg: `required flag(s) "dest", "source" not set`,
+		},
+		{
+			name: "source-and-dest",
+			cli:  "--source org1/5 --dest org2/7",
+			wants: mirrorOpts{
+				source:       "org1/5",
+				dest:         "org2/7",
+				sourceOwner:  "org1",
+				sourceNumber: 5,
+				destOwner:    "org2",
+				destNumber:   7,
+			},
+		},
+		{
+			name: "map-field",
+			cli:  "--source org1/5 --dest org2/7 --map-field Status=Status --map-field Size=Estimate",
+			wants: mirrorOpts{
+				source:       "org1/5",
+				dest:         "org2/7",
+				sourceOwner:  "org1",
+				sourceNumber: 5,
+				destOwner:    "org2",
+				destNumber:   7,
+				mapFields:    []string{"Status=Status", "Size=Estimate"},
+			},
+		},
+		{
+			name:        "invalid-source",
+			cli:         "--source org1 --dest org2/7",
+			wantsErr:    true,
+			wantsErrMsg: "invalid `--source` value \"org1\": expected owner/number",
+		},
+		{
+			name:        "invalid-dest",
+			cli:         "--source org1/5 --dest org2/x",
+			wantsErr:    true,
+			wantsErrMsg: "invalid `--dest` value \"org2/x\": expected owner/number",
+		},
+		{
+			name:        "invalid-map-field",
+			cli:         "--source org1/5 --dest org2/7 --map-field Status",
+			wantsErr:    true,
+			wantsErrMsg: "invalid `--map-field` value \"Status\": expected source=destination",
+		},
+	}
+
+	t.Setenv("GH_TOKEN", "auth-token")
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ios, _, _, _ := iostreams.Test()
+			f := &cmdutil.Factory{
+				IOStreams: ios,
+			}
+
+			argv, err := shlex.Split(tt.cli)
+			assert.NoError(t, err)
+
+			var gotOpts mirrorOpts
+			cmd := NewCmdMirror(f, func(config mirrorConfig) error {
+				gotOpts = config.opts
+				return nil
+			})
+
+			cmd.SetArgs(argv)
+			_, err = cmd.ExecuteC()
+			if tt.wantsErr {
+				assert.Error(t, err)
+				assert.Equal(t, tt.wantsErrMsg, err.Error())
+				return
+			}
+			assert.NoError(t, err)
+
+			assert.Equal(t, tt.wants.source, gotOpts.source)
+			assert.Equal(t, tt.wants.dest, gotOpts.dest)
+			assert.Equal(t, tt.wants.sourceOwner, gotOpts.sourceOwner)
+			assert.Equal(t, tt.wants.sourceNumber, gotOpts.sourceNumber)
+			assert.Equal(t, tt.wants.destOwner, gotOpts.destOwner)
+			assert.Equal(t, tt.wants.destNumber, gotOpts.destNumber)
+			assert.Equal(t, tt.wants.mapFields, gotOpts.mapFields)
+		})
+	}
+}
+
+func TestRunMirror(t *testing.T) {
+	defer gock.Off()
+	// gock.Observe(gock.DumpRequest)
+
+	// get source org ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query UserOrgOwner.*",
+			"variables": map[string]string{
+				"login": "org1",
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"organization": map[string]interface{}{
+					"id":    "org1 ID",
+					"login": "org1",
+				},
+			},
+			"errors": []interface{}{
+				map[string]interface{}{
+					"type": "NOT_FOUND",
+					"path": []string{"user"},
+				},
+			},
+		})
+
+	// get dest org ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query UserOrgOwner.*",
+			"variables": map[string]string{
+				"login": "org2",
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"organization": map[string]interface{}{
+					"id":    "org2 ID",
+					"login": "org2",
+				},
+			},
+			"errors": []interface{}{
+				map[string]interface{}{
+					"type": "NOT_FOUND",
+					"path": []string{"user"},
+				},
+			},
+		})
+
+	// get dest project ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query OrgProject.*",
+			"variables": map[string]interface{}{
+				"login":       "org2",
+				"number":      7,
+				"firstItems":  0,
+				"afterItems":  nil,
+				"firstFields": 0,
+				"afterFields": nil,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"organization": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"id":     "dest ID",
+						"number": 7,
+					},
+				},
+			},
+		})
+
+	// get source project items
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		JSON(map[string]interface{}{
+			"query": "query OrgProjectWithItems.*",
+			"variables": map[string]interface{}{
+				"firstItems":  queries.LimitMax,
+				"afterItems":  nil,
+				"firstFields": queries.LimitMax,
+				"afterFields": nil,
+				"login":       "org1",
+				"number":      5,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"organization": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"items": map[string]interface{}{
+							"nodes": []map[string]interface{}{
+								{
+									"id": "new source item ID",
+									"content": map[string]interface{}{
+										"__typename": "Issue",
+										"title":      "a new issue",
+										"url":        "https://github.com/org1/repo/issues/1",
+									},
+									"fieldValues": map[string]interface{}{
+										"nodes": []map[string]interface{}{
+											{
+												"__typename": "ProjectV2ItemFieldSingleSelectValue",
+												"name":       "In Progress",
+												"field": map[string]interface{}{
+													"__typename": "ProjectV2SingleSelectField",
+													"name":       "Status",
+												},
+											},
+										},
+									},
+								},
+								{
+									"id": "known source item ID",
+									"content": map[string]interface{}{
+										"__typename": "Issue",
+										"title":      "a known issue",
+										"url":        "https://github.com/org1/repo/issues/2",
+									},
+									"fieldValues": map[string]interface{}{
+										"nodes": []map[string]interface{}{
+											{
+												"__typename": "ProjectV2ItemFieldSingleSelectValue",
+												"name":       "Done",
+												"field": map[string]interface{}{
+													"__typename": "ProjectV2SingleSelectField",
+													"name":       "Status",
+												},
+											},
+										},
+									},
+								},
+								{
+									"id": "draft item ID",
+									"content": map[string]interface{}{
+										"__typename": "DraftIssue",
+										"title":      "a draft",
+										"body":       "draft body",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		})
+
+	// get dest project items
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		JSON(map[string]interface{}{
+			"query": "query OrgProjectWithItems.*",
+			"variables": map[string]interface{}{
+				"firstItems":  queries.LimitMax,
+				"afterItems":  nil,
+				"firstFields": queries.LimitMax,
+				"afterFields": nil,
+				"login":       "org2",
+				"number":      7,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"organization": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"items": map[string]interface{}{
+							"nodes": []map[string]interface{}{
+								{
+									"id": "known dest item ID",
+									"content": map[string]interface{}{
+										"__typename": "Issue",
+										"title":      "a known issue",
+										"url":        "https://github.com/org1/repo/issues/2",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		})
+
+	// get dest project fields
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		JSON(map[string]interface{}{
+			"query": "query OrgProjectWithFields.*",
+			"variables": map[string]interface{}{
+				"firstItems":  queries.LimitMax,
+				"afterItems":  nil,
+				"firstFields": queries.LimitMax,
+				"afterFields": nil,
+				"login":       "org2",
+				"number":      7,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"organization": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"fields": map[string]interface{}{
+							"nodes": []map[string]interface{}{
+								{
+									"__typename": "ProjectV2SingleSelectField",
+									"id":         "dest status ID",
+									"name":       "Status",
+									"options": []map[string]string{
+										{"id": "in progress option ID", "name": "In Progress"},
+										{"id": "done option ID", "name": "Done"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		})
+
+	// resolve the content ID of the new item
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query GetIssueOrPullRequest.*",
+			"variables": map[string]interface{}{
+				"url": "https://github.com/org1/repo/issues/1",
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"resource": map[string]interface{}{
+					"id":         "new content ID",
+					"__typename": "Issue",
+				},
+			},
+		})
+
+	// add the new item to the dest project
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`{"query":"mutation AddItem.*","variables":{"input":{"projectId":"dest ID","contentId":"new content ID"}}}`).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"addProjectV2ItemById": map[string]interface{}{
+					"item": map[string]interface{}{
+						"id": "new dest item ID",
+					},
+				},
+			},
+		})
+
+	// set the mapped single-select value on the new item
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`{"query":"mutation UpdateItemValues.*","variables":{"input":{"projectId":"dest ID","itemId":"new dest item ID","fieldId":"dest status ID","value":{"singleSelectOptionId":"in progress option ID"}}}}`).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"updateProjectV2ItemFieldValue": map[string]interface{}{
+					"projectV2Item": map[string]interface{}{
+						"id": "new dest item ID",
+					},
+				},
+			},
+		})
+
+	// set the mapped single-select value on the known item
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`{"query":"mutation UpdateItemValues.*","variables":{"input":{"projectId":"dest ID","itemId":"known dest item ID","fieldId":"dest status ID","value":{"singleSelectOptionId":"done option ID"}}}}`).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"updateProjectV2ItemFieldValue": map[string]interface{}{
+					"projectV2Item": map[string]interface{}{
+						"id": "known dest item ID",
+					},
+				},
+			},
+		})
+
+	client := queries.NewTestClient()
+
+	ios, _, stdout, _ := iostreams.Test()
+	ios.SetStdoutTTY(true)
+	config := mirrorConfig{
+		io: ios,
+		opts: mirrorOpts{
+			sourceOwner:  "org1",
+			sourceNumber: 5,
+			destOwner:    "org2",
+			destNumber:   7,
+			mapFields:    []string{"Status=Status"},
+		},
+		client: client,
+	}
+
+	err := runMirror(config)
+	assert.NoError(t, err)
+	assert.Equal(t, "Mirrored 2 items into org2/7 (1 added)\n", stdout.String())
+}
+
+func TestRunMirror_UnknownDestField(t *testing.T) {
+	tests := []struct {
+		name      string
+		fieldMap  map[string]string
+		valueType string
+		wantOk    bool
+		wantErr   string
+	}{
+		{
+			name:      "unmapped field is skipped",
+			fieldMap:  map[string]string{},
+			valueType: "ProjectV2ItemFieldTextValue",
+		},
+		{
+			name:      "mapped field missing on destination",
+			fieldMap:  map[string]string{"notes": "Remarks"},
+			valueType: "ProjectV2ItemFieldTextValue",
+			wantErr:   `destination project has no field named "Remarks"`,
+		},
+		{
+			name:      "unsupported value type is skipped",
+			fieldMap:  map[string]string{"notes": "Remarks"},
+			valueType: "ProjectV2ItemFieldLabelValue",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := queries.FieldValueNodes{Type: tt.valueType}
+			v.ProjectV2ItemFieldTextValue.Text = "some notes"
+			v.ProjectV2ItemFieldTextValue.Field.Field.Name = "Notes"
+			v.ProjectV2ItemFieldTextValue.Field.TypeName = "ProjectV2Field"
+
+			_, _, ok, err := mirroredFieldValue(map[string]queries.ProjectField{}, tt.fieldMap, v)
+			assert.Equal(t, tt.wantOk, ok)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
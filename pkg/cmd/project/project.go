@@ -2,6 +2,8 @@ package project
 
 import (
 	"github.com/MakeNowJust/heredoc"
+	cmdAccess "github.com/cli/cli/v2/pkg/cmd/project/access"
+	cmdBoard "github.com/cli/cli/v2/pkg/cmd/project/board"
 	cmdClose "github.com/cli/cli/v2/pkg/cmd/project/close"
 	cmdCopy "github.com/cli/cli/v2/pkg/cmd/project/copy"
 	cmdCreate "github.com/cli/cli/v2/pkg/cmd/project/create"
@@ -9,18 +11,35 @@ import (
 	cmdEdit "github.com/cli/cli/v2/pkg/cmd/project/edit"
 	cmdFieldCreate "github.com/cli/cli/v2/pkg/cmd/project/field-create"
 	cmdFieldDelete "github.com/cli/cli/v2/pkg/cmd/project/field-delete"
+	cmdFieldEdit "github.com/cli/cli/v2/pkg/cmd/project/field-edit"
 	cmdFieldList "github.com/cli/cli/v2/pkg/cmd/project/field-list"
+	cmdImport "github.com/cli/cli/v2/pkg/cmd/project/import"
+	cmdInsights "github.com/cli/cli/v2/pkg/cmd/project/insights"
 	cmdItemAdd "github.com/cli/cli/v2/pkg/cmd/project/item-add"
 	cmdItemArchive "github.com/cli/cli/v2/pkg/cmd/project/item-archive"
+	cmdItemConvert "github.com/cli/cli/v2/pkg/cmd/project/item-convert"
 	cmdItemCreate "github.com/cli/cli/v2/pkg/cmd/project/item-create"
 	cmdItemDelete "github.com/cli/cli/v2/pkg/cmd/project/item-delete"
 	cmdItemEdit "github.com/cli/cli/v2/pkg/cmd/project/item-edit"
 	cmdItemList "github.com/cli/cli/v2/pkg/cmd/project/item-list"
+	cmdItemUnarchive "github.com/cli/cli/v2/pkg/cmd/project/item-unarchive"
+	cmdIterationList "github.com/cli/cli/v2/pkg/cmd/project/iteration-list"
 	cmdLink "github.com/cli/cli/v2/pkg/cmd/project/link"
 	cmdList "github.com/cli/cli/v2/pkg/cmd/project/list"
 	cmdTemplate "github.com/cli/cli/v2/pkg/cmd/project/mark-template"
+	cmdMirror "github.com/cli/cli/v2/pkg/cmd/project/mirror"
+	cmdReport "github.com/cli/cli/v2/pkg/cmd/project/report"
+	cmdRoadmap "github.com/cli/cli/v2/pkg/cmd/project/roadmap"
+	cmdStatusUpdateCreate "github.com/cli/cli/v2/pkg/cmd/project/status-update-create"
+	cmdStatusUpdateList "github.com/cli/cli/v2/pkg/cmd/project/status-update-list"
 	cmdUnlink "github.com/cli/cli/v2/pkg/cmd/project/unlink"
 	cmdView "github.com/cli/cli/v2/pkg/cmd/project/view"
+	cmdViewCreate "github.com/cli/cli/v2/pkg/cmd/project/view-create"
+	cmdViewEdit "github.com/cli/cli/v2/pkg/cmd/project/view-edit"
+	cmdViewList "github.com/cli/cli/v2/pkg/cmd/project/view-list"
+	cmdWorkflowDisable "github.com/cli/cli/v2/pkg/cmd/project/workflow-disable"
+	cmdWorkflowEnable "github.com/cli/cli/v2/pkg/cmd/project/workflow-enable"
+	cmdWorkflowList "github.com/cli/cli/v2/pkg/cmd/project/workflow-list"
 	"github.com/cli/cli/v2/pkg/cmdutil"
 	"github.com/spf13/cobra"
 )
@@ -42,26 +61,51 @@ func NewCmdProject(f *cmdutil.Factory) *cobra.Command {
 	cmd.AddCommand(cmdList.NewCmdList(f, nil))
 	cmd.AddCommand(cmdCreate.NewCmdCreate(f, nil))
 	cmd.AddCommand(cmdCopy.NewCmdCopy(f, nil))
+	cmd.AddCommand(cmdMirror.NewCmdMirror(f, nil))
 	cmd.AddCommand(cmdClose.NewCmdClose(f, nil))
 	cmd.AddCommand(cmdDelete.NewCmdDelete(f, nil))
 	cmd.AddCommand(cmdEdit.NewCmdEdit(f, nil))
 	cmd.AddCommand(cmdLink.NewCmdLink(f, nil))
 	cmd.AddCommand(cmdView.NewCmdView(f, nil))
+	cmd.AddCommand(cmdBoard.NewCmdBoard(f, nil))
 	cmd.AddCommand(cmdTemplate.NewCmdMarkTemplate(f, nil))
 	cmd.AddCommand(cmdUnlink.NewCmdUnlink(f, nil))
+	cmd.AddCommand(cmdInsights.NewCmdInsights(f, nil))
+	cmd.AddCommand(cmdReport.NewCmdReport(f, nil))
+	cmd.AddCommand(cmdRoadmap.NewCmdRoadmap(f, nil))
+	cmd.AddCommand(cmdAccess.NewCmdAccess(f))
 
 	// items
 	cmd.AddCommand(cmdItemList.NewCmdList(f, nil))
 	cmd.AddCommand(cmdItemCreate.NewCmdCreateItem(f, nil))
 	cmd.AddCommand(cmdItemAdd.NewCmdAddItem(f, nil))
 	cmd.AddCommand(cmdItemEdit.NewCmdEditItem(f, nil))
+	cmd.AddCommand(cmdItemConvert.NewCmdConvertItem(f, nil))
 	cmd.AddCommand(cmdItemArchive.NewCmdArchiveItem(f, nil))
+	cmd.AddCommand(cmdItemUnarchive.NewCmdUnarchiveItem(f, nil))
 	cmd.AddCommand(cmdItemDelete.NewCmdDeleteItem(f, nil))
+	cmd.AddCommand(cmdImport.NewCmdImport(f, nil))
 
 	// fields
 	cmd.AddCommand(cmdFieldList.NewCmdList(f, nil))
 	cmd.AddCommand(cmdFieldCreate.NewCmdCreateField(f, nil))
+	cmd.AddCommand(cmdFieldEdit.NewCmdEditField(f, nil))
 	cmd.AddCommand(cmdFieldDelete.NewCmdDeleteField(f, nil))
+	cmd.AddCommand(cmdIterationList.NewCmdList(f, nil))
+
+	// views
+	cmd.AddCommand(cmdViewList.NewCmdList(f, nil))
+	cmd.AddCommand(cmdViewCreate.NewCmdCreateView(f, nil))
+	cmd.AddCommand(cmdViewEdit.NewCmdEditView(f, nil))
+
+	// status updates
+	cmd.AddCommand(cmdStatusUpdateList.NewCmdList(f, nil))
+	cmd.AddCommand(cmdStatusUpdateCreate.NewCmdCreateStatusUpdate(f, nil))
+
+	// workflows
+	cmd.AddCommand(cmdWorkflowList.NewCmdList(f, nil))
+	cmd.AddCommand(cmdWorkflowEnable.NewCmdEnableWorkflow(f, nil))
+	cmd.AddCommand(cmdWorkflowDisable.NewCmdDisableWorkflow(f, nil))
 
 	return cmd
 }
@@ -0,0 +1,229 @@
+package report
+
+import (
+	"fmt"
+	"html"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/pkg/cmd/project/shared/client"
+	"github.com/cli/cli/v2/pkg/cmd/project/shared/queries"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type reportOpts struct {
+	owner   string
+	number  int32
+	limit   int
+	groupBy string
+	format  string
+}
+
+type reportConfig struct {
+	io     *iostreams.IOStreams
+	client *queries.Client
+	opts   reportOpts
+}
+
+// reportItem is one line of the report, derived from the item maps produced
+// by the project's DetailedItems serialization.
+type reportItem struct {
+	title     string
+	url       string
+	assignees []string
+}
+
+func NewCmdReport(f *cmdutil.Factory, runF func(config reportConfig) error) *cobra.Command {
+	opts := reportOpts{}
+	reportCmd := &cobra.Command{
+		Short: "Generate a grouped report of the items in a project",
+		Use:   "report [<number>]",
+		Long: heredoc.Docf(`
+			Generate a human-readable report of the items in a project, grouped by a
+			field, suitable for pasting into release notes or wikis.
+
+			The report is written as Markdown by default; use %[1]s--format html%[1]s for an
+			HTML fragment instead.
+		`, "`"),
+		Example: heredoc.Doc(`
+			# report the current user's project "1" grouped by status
+			gh project report 1 --owner "@me" --group-by status
+
+			# generate an HTML report grouped by iteration
+			gh project report 1 --owner "@me" --group-by iteration --format html
+		`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := client.New(f)
+			if err != nil {
+				return err
+			}
+
+			if len(args) == 1 {
+				num, err := strconv.ParseInt(args[0], 10, 32)
+				if err != nil {
+					return cmdutil.FlagErrorf("invalid number: %v", args[0])
+				}
+				opts.number = int32(num)
+			}
+
+			config := reportConfig{
+				io:     f.IOStreams,
+				client: client,
+				opts:   opts,
+			}
+
+			// allow testing of the command without actually running it
+			if runF != nil {
+				return runF(config)
+			}
+			return runReport(config)
+		},
+	}
+
+	reportCmd.Flags().StringVar(&opts.owner, "owner", "", "Login of the owner. Use \"@me\" for the current user. Defaults to the owner of the current directory's repository.")
+	reportCmd.Flags().StringVar(&opts.groupBy, "group-by", "Status", "Project `field` to group items by")
+	cmdutil.StringEnumFlag(reportCmd, &opts.format, "format", "", "markdown", []string{"markdown", "html"}, "Output format")
+	reportCmd.Flags().IntVarP(&opts.limit, "limit", "L", queries.LimitDefault, "Maximum number of items to fetch")
+
+	return reportCmd
+}
+
+func runReport(config reportConfig) error {
+	canPrompt := config.io.CanPrompt()
+	owner, err := config.client.NewOwner(canPrompt, config.opts.owner)
+	if err != nil {
+		return err
+	}
+
+	// no need to fetch the project if we already have the number
+	if config.opts.number == 0 {
+		project, err := config.client.NewProject(canPrompt, owner, config.opts.number, false)
+		if err != nil {
+			return err
+		}
+		config.opts.number = project.Number
+	}
+
+	project, err := config.client.ProjectItems(owner, config.opts.number, config.opts.limit)
+	if err != nil {
+		return err
+	}
+
+	if len(project.Items.Nodes) == 0 {
+		return cmdutil.NewNoResultsError(fmt.Sprintf("Project %d for owner %s has no items", config.opts.number, owner.Login))
+	}
+
+	groups, order := groupItems(project, config.opts.groupBy)
+
+	if config.opts.format == "html" {
+		return printHTML(config.io.Out, project.Title, groups, order)
+	}
+	return printMarkdown(config.io.Out, project.Title, groups, order)
+}
+
+// groupItems buckets the project's serialized items by their value for the
+// named field, returning the groups and the order they first appeared in.
+func groupItems(project *queries.Project, groupBy string) (map[string][]reportItem, []string) {
+	key := camelCase(groupBy)
+	groups := make(map[string][]reportItem)
+	var order []string
+
+	for _, item := range project.DetailedItems()["items"].([]map[string]interface{}) {
+		group := groupLabel(item[key], groupBy)
+		if _, seen := groups[group]; !seen {
+			order = append(order, group)
+		}
+
+		ri := reportItem{}
+		if content, ok := item["content"].(map[string]interface{}); ok {
+			ri.title, _ = content["title"].(string)
+			ri.url, _ = content["url"].(string)
+		}
+		if assignees, ok := item["assignees"].([]string); ok {
+			ri.assignees = assignees
+		}
+		groups[group] = append(groups[group], ri)
+	}
+
+	return groups, order
+}
+
+// groupLabel renders a serialized field value as a group heading. Items
+// without a value for the field fall into the "(none)" group.
+func groupLabel(value interface{}, groupBy string) string {
+	switch v := value.(type) {
+	case string:
+		if v != "" {
+			return v
+		}
+	case []string:
+		if len(v) > 0 {
+			return strings.Join(v, ", ")
+		}
+	case map[string]interface{}:
+		if title, ok := v["title"].(string); ok && title != "" {
+			return title
+		}
+	case float32:
+		return strconv.FormatFloat(float64(v), 'f', -1, 32)
+	}
+	return "(none)"
+}
+
+func printMarkdown(w io.Writer, title string, groups map[string][]reportItem, order []string) error {
+	if _, err := fmt.Fprintf(w, "# %s\n", title); err != nil {
+		return err
+	}
+	for _, group := range order {
+		fmt.Fprintf(w, "\n## %s\n\n", group)
+		for _, item := range groups[group] {
+			if item.url != "" {
+				fmt.Fprintf(w, "- [%s](%s)", item.title, item.url)
+			} else {
+				fmt.Fprintf(w, "- %s", item.title)
+			}
+			if len(item.assignees) > 0 {
+				fmt.Fprintf(w, " (@%s)", strings.Join(item.assignees, ", @"))
+			}
+			fmt.Fprintln(w)
+		}
+	}
+	return nil
+}
+
+func printHTML(w io.Writer, title string, groups map[string][]reportItem, order []string) error {
+	if _, err := fmt.Fprintf(w, "<h1>%s</h1>\n", html.EscapeString(title)); err != nil {
+		return err
+	}
+	for _, group := range order {
+		fmt.Fprintf(w, "<h2>%s</h2>\n<ul>\n", html.EscapeString(group))
+		for _, item := range groups[group] {
+			fmt.Fprint(w, "<li>")
+			if item.url != "" {
+				fmt.Fprintf(w, "<a href=%q>%s</a>", item.url, html.EscapeString(item.title))
+			} else {
+				fmt.Fprint(w, html.EscapeString(item.title))
+			}
+			if len(item.assignees) > 0 {
+				fmt.Fprintf(w, " (@%s)", html.EscapeString(strings.Join(item.assignees, ", @")))
+			}
+			fmt.Fprintln(w, "</li>")
+		}
+		fmt.Fprintln(w, "</ul>")
+	}
+	return nil
+}
+
+// camelCase converts a field name to the key used for it in the project's
+// serialized items.
+func camelCase(s string) string {
+	if len(s) == 0 {
+		return ""
+	}
+	return strings.ToLower(s[0:1]) + s[1:]
+}
@@ -0,0 +1,239 @@
+package report
+
+import (
+	"testing"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/pkg/cmd/project/shared/queries"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestNewCmdReport(t *testing.T) {
+	tests := []struct {
+		name        string
+		cli         string
+		wants       reportOpts
+		wantsErr    bool
+		wantsErrMsg string
+	}{
+		{
+			name:        "not-a-number",
+			cli:         "x",
+			wantsErr:    true,
+			wantsErrMsg: "invalid number: x",
+		},
+		{
+			name: "defaults",
+			cli:  "123",
+			wants: reportOpts{
+				number:  123,
+				groupBy: "Status",
+				format:  "markdown",
+				limit:   30,
+			},
+		},
+		{
+			name: "group-by-and-format",
+			cli:  "123 --owner monalisa --group-by iteration --format html",
+			wants: reportOpts{
+				number:  123,
+				owner:   "monalisa",
+				groupBy: "iteration",
+				format:  "html",
+				limit:   30,
+			},
+		},
+		{
+			name:        "invalid-format",
+			cli:         "123 --format csv",
+			wantsErr:    true,
+			wantsErrMsg: "invalid argument \"csv\" for \"--format\" flag: valid values are {markdown|html}",
+		},
+	}
+
+	t.Setenv("GH_TOKEN", "auth-token")
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ios, _, _, _ := iostreams.Test()
+			f := &cmdutil.Factory{
+				IOStreams: ios,
+			}
+
+			argv, err := shlex.Split(tt.cli)
+			assert.NoError(t, err)
+
+			var gotOpts reportOpts
+			cmd := NewCmdReport(f, func(config reportConfig) error {
+				gotOpts = config.opts
+				return nil
+			})
+
+			cmd.SetArgs(argv)
+			_, err = cmd.ExecuteC()
+			if tt.wantsErr {
+				assert.Error(t, err)
+				assert.Equal(t, tt.wantsErrMsg, err.Error())
+				return
+			}
+			assert.NoError(t, err)
+
+			assert.Equal(t, tt.wants.number, gotOpts.number)
+			assert.Equal(t, tt.wants.owner, gotOpts.owner)
+			assert.Equal(t, tt.wants.groupBy, gotOpts.groupBy)
+			assert.Equal(t, tt.wants.format, gotOpts.format)
+			assert.Equal(t, tt.wants.limit, gotOpts.limit)
+		})
+	}
+}
+
+func TestRunReport_User(t *testing.T) {
+	defer gock.Off()
+	// gock.Observe(gock.DumpRequest)
+
+	// get user ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query UserOrgOwner.*",
+			"variables": map[string]interface{}{
+				"login": "monalisa",
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"id": "an ID",
+				},
+			},
+			"errors": []interface{}{
+				map[string]interface{}{
+					"type": "NOT_FOUND",
+					"path": []string{"organization"},
+				},
+			},
+		})
+
+	// list project items
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		JSON(map[string]interface{}{
+			"query": "query UserProjectWithItems.*",
+			"variables": map[string]interface{}{
+				"firstItems":  queries.LimitDefault,
+				"afterItems":  nil,
+				"firstFields": queries.LimitMax,
+				"afterFields": nil,
+				"login":       "monalisa",
+				"number":      1,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"title": "my project",
+						"fields": map[string]interface{}{
+							"nodes": []map[string]interface{}{
+								{
+									"__typename": "ProjectV2SingleSelectField",
+									"id":         "status ID",
+									"name":       "Status",
+								},
+								{
+									"__typename": "ProjectV2Field",
+									"id":         "assignees ID",
+									"name":       "Assignees",
+								},
+							},
+						},
+						"items": map[string]interface{}{
+							"nodes": []map[string]interface{}{
+								{
+									"id": "issue ID",
+									"content": map[string]interface{}{
+										"__typename": "Issue",
+										"title":      "an issue",
+										"number":     1,
+										"url":        "https://github.com/cli/go-gh/issues/1",
+										"repository": map[string]string{
+											"nameWithOwner": "cli/go-gh",
+										},
+									},
+									"fieldValues": map[string]interface{}{
+										"nodes": []map[string]interface{}{
+											{
+												"__typename": "ProjectV2ItemFieldSingleSelectValue",
+												"name":       "Done",
+												"field": map[string]interface{}{
+													"__typename": "ProjectV2SingleSelectField",
+													"id":         "status ID",
+													"name":       "Status",
+												},
+											},
+											{
+												"__typename": "ProjectV2ItemFieldUserValue",
+												"users": map[string]interface{}{
+													"nodes": []map[string]interface{}{
+														{"login": "monalisa"},
+													},
+												},
+												"field": map[string]interface{}{
+													"__typename": "ProjectV2Field",
+													"id":         "assignees ID",
+													"name":       "Assignees",
+												},
+											},
+										},
+									},
+								},
+								{
+									"id": "draft issue ID",
+									"content": map[string]interface{}{
+										"id":         "draft issue ID",
+										"title":      "draft issue",
+										"__typename": "DraftIssue",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		})
+
+	client := queries.NewTestClient()
+
+	ios, _, stdout, _ := iostreams.Test()
+	config := reportConfig{
+		opts: reportOpts{
+			number:  1,
+			owner:   "monalisa",
+			groupBy: "Status",
+			format:  "markdown",
+		},
+		client: client,
+		io:     ios,
+	}
+
+	err := runReport(config)
+	assert.NoError(t, err)
+	assert.Equal(t, heredoc.Doc(`
+		# my project
+
+		## Done
+
+		- [an issue](https://github.com/cli/go-gh/issues/1) (@monalisa)
+
+		## (none)
+
+		- draft issue
+	`), stdout.String())
+}
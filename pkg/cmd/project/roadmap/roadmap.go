@@ -0,0 +1,305 @@
+package roadmap
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/text"
+	"github.com/cli/cli/v2/pkg/cmd/project/shared/client"
+	"github.com/cli/cli/v2/pkg/cmd/project/shared/queries"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type roadmapOpts struct {
+	owner          string
+	number         int32
+	dateField      string
+	iterationField string
+	limit          int
+}
+
+type roadmapConfig struct {
+	io     *iostreams.IOStreams
+	client *queries.Client
+	opts   roadmapOpts
+}
+
+// span is one row of the roadmap: an item placed on the timeline. Items with
+// a date value cover a single day; items with an iteration value cover the
+// iteration's duration.
+type span struct {
+	title string
+	start time.Time
+	end   time.Time
+}
+
+// titleWidth is the width of the roadmap's title column.
+const titleWidth = 30
+
+func NewCmdRoadmap(f *cmdutil.Factory, runF func(config roadmapConfig) error) *cobra.Command {
+	opts := roadmapOpts{}
+	roadmapCmd := &cobra.Command{
+		Short: "View a project as a timeline",
+		Use:   "roadmap [<number>]",
+		Long: heredoc.Docf(`
+			View the items of a project as a timeline, placed by the value of one of
+			the project's date or iteration fields.
+
+			With %[1]s--date-field%[1]s, each item is marked on the day of its date value.
+			With %[1]s--iteration-field%[1]s, each item covers its iteration's date range. If
+			neither flag is given, the project's first iteration field is used, falling
+			back to its first date field. Items without a value for the chosen field
+			are left off the timeline.
+		`, "`"),
+		Example: heredoc.Doc(`
+			# view monalisa's project "1" as a timeline by its first iteration or date field
+			gh project roadmap 1 --owner monalisa
+
+			# place items by their "Target date" field value
+			gh project roadmap 1 --owner monalisa --date-field "Target date"
+		`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := cmdutil.MutuallyExclusive(
+				"cannot use `--date-field` with `--iteration-field`",
+				opts.dateField != "", opts.iterationField != "",
+			); err != nil {
+				return err
+			}
+
+			client, err := client.New(f)
+			if err != nil {
+				return err
+			}
+
+			if len(args) == 1 {
+				num, err := strconv.ParseInt(args[0], 10, 32)
+				if err != nil {
+					return cmdutil.FlagErrorf("invalid number: %v", args[0])
+				}
+				opts.number = int32(num)
+			}
+
+			config := roadmapConfig{
+				io:     f.IOStreams,
+				client: client,
+				opts:   opts,
+			}
+
+			// allow testing of the command without actually running it
+			if runF != nil {
+				return runF(config)
+			}
+			return runRoadmap(config)
+		},
+	}
+
+	roadmapCmd.Flags().StringVar(&opts.owner, "owner", "", "Login of the owner. Use \"@me\" for the current user. Defaults to the owner of the current directory's repository.")
+	roadmapCmd.Flags().StringVar(&opts.dateField, "date-field", "", "Name of the date `field` to place items by")
+	roadmapCmd.Flags().StringVar(&opts.iterationField, "iteration-field", "", "Name of the iteration `field` to place items by")
+	roadmapCmd.Flags().IntVarP(&opts.limit, "limit", "L", queries.LimitDefault, "Maximum number of items to fetch")
+
+	return roadmapCmd
+}
+
+func runRoadmap(config roadmapConfig) error {
+	canPrompt := config.io.CanPrompt()
+	owner, err := config.client.NewOwner(canPrompt, config.opts.owner)
+	if err != nil {
+		return err
+	}
+
+	// no need to fetch the project if we already have the number
+	if config.opts.number == 0 {
+		project, err := config.client.NewProject(canPrompt, owner, config.opts.number, false)
+		if err != nil {
+			return err
+		}
+		config.opts.number = project.Number
+	}
+
+	project, err := config.client.ProjectItems(owner, config.opts.number, config.opts.limit)
+	if err != nil {
+		return err
+	}
+
+	fieldName := config.opts.dateField
+	if fieldName == "" {
+		fieldName = config.opts.iterationField
+	}
+	if fieldName == "" {
+		fieldName = detectField(project.Items.Nodes)
+		if fieldName == "" {
+			return cmdutil.NewNoResultsError(fmt.Sprintf("Project %d for owner %s has no date or iteration field values", config.opts.number, owner.Login))
+		}
+	}
+
+	spans, skipped := collectSpans(project.Items.Nodes, fieldName)
+	if len(spans) == 0 {
+		return cmdutil.NewNoResultsError(fmt.Sprintf("Project %d for owner %s has no items with a value for %q", config.opts.number, owner.Login, fieldName))
+	}
+
+	return printRoadmap(config, fieldName, spans, skipped)
+}
+
+// detectField picks the field the roadmap is placed by when no flag is given:
+// the first iteration field with a value, falling back to the first date field.
+func detectField(items []queries.ProjectItem) string {
+	var dateField string
+	for _, item := range items {
+		for _, v := range item.FieldValues.Nodes {
+			switch v.Type {
+			case "ProjectV2ItemFieldIterationValue":
+				return v.ProjectV2ItemFieldIterationValue.Field.Name()
+			case "ProjectV2ItemFieldDateValue":
+				if dateField == "" {
+					dateField = v.ProjectV2ItemFieldDateValue.Field.Name()
+				}
+			}
+		}
+	}
+	return dateField
+}
+
+// collectSpans builds the timeline rows for the items that have a value for
+// the chosen field, and counts the items that do not.
+func collectSpans(items []queries.ProjectItem, fieldName string) ([]span, int) {
+	spans := make([]span, 0, len(items))
+	skipped := 0
+	for _, item := range items {
+		s, ok := itemSpan(item, fieldName)
+		if !ok {
+			skipped++
+			continue
+		}
+		spans = append(spans, s)
+	}
+	sort.SliceStable(spans, func(i, j int) bool {
+		if spans[i].start.Equal(spans[j].start) {
+			return spans[i].end.Before(spans[j].end)
+		}
+		return spans[i].start.Before(spans[j].start)
+	})
+	return spans, skipped
+}
+
+func itemSpan(item queries.ProjectItem, fieldName string) (span, bool) {
+	for _, v := range item.FieldValues.Nodes {
+		switch v.Type {
+		case "ProjectV2ItemFieldDateValue":
+			if v.ProjectV2ItemFieldDateValue.Field.Name() != fieldName {
+				continue
+			}
+			date, err := time.Parse("2006-01-02", v.ProjectV2ItemFieldDateValue.Date)
+			if err != nil {
+				continue
+			}
+			return span{title: item.Title(), start: date, end: date}, true
+		case "ProjectV2ItemFieldIterationValue":
+			iteration := v.ProjectV2ItemFieldIterationValue
+			if iteration.Field.Name() != fieldName {
+				continue
+			}
+			start, err := time.Parse("2006-01-02", iteration.StartDate)
+			if err != nil {
+				continue
+			}
+			return span{title: item.Title(), start: start, end: start.AddDate(0, 0, iteration.Duration-1)}, true
+		}
+	}
+	return span{}, false
+}
+
+func printRoadmap(config roadmapConfig, fieldName string, spans []span, skipped int) error {
+	cs := config.io.ColorScheme()
+	out := config.io.Out
+
+	barWidth := config.io.TerminalWidth() - titleWidth - 2
+	if barWidth < 10 {
+		barWidth = 10
+	}
+
+	min := spans[0].start
+	max := spans[0].end
+	for _, s := range spans {
+		if s.start.Before(min) {
+			min = s.start
+		}
+		if s.end.After(max) {
+			max = s.end
+		}
+	}
+	// widen a single-day window so the scale has a direction
+	if !max.After(min) {
+		max = min.AddDate(0, 0, 1)
+	}
+
+	// column maps a day onto the timeline
+	column := func(t time.Time) int {
+		c := int(float64(barWidth-1) * float64(t.Sub(min)) / float64(max.Sub(min)))
+		if c < 0 {
+			c = 0
+		}
+		if c > barWidth-1 {
+			c = barWidth - 1
+		}
+		return c
+	}
+
+	if config.io.IsStdoutTTY() {
+		fmt.Fprintf(out, "Roadmap by %q, %s to %s\n\n", fieldName, min.Format("Jan 2, 2006"), max.Format("Jan 2, 2006"))
+	}
+
+	fmt.Fprintf(out, "%-*s %s\n", titleWidth, "", cs.Gray(monthAxis(min, max, barWidth, column)))
+	for _, s := range spans {
+		bar := make([]rune, barWidth)
+		for i := range bar {
+			bar[i] = ' '
+		}
+		startCol := column(s.start)
+		endCol := column(s.end)
+		if startCol == endCol {
+			bar[startCol] = '◆'
+		} else {
+			for i := startCol; i <= endCol; i++ {
+				bar[i] = '■'
+			}
+		}
+		title := text.Truncate(titleWidth, text.RemoveExcessiveWhitespace(s.title))
+		fmt.Fprintf(out, "%-*s %s\n", titleWidth, title, cs.Cyan(strings.TrimRight(string(bar), " ")))
+	}
+
+	if skipped > 0 && config.io.IsStdoutTTY() {
+		fmt.Fprintf(out, "\nOmitted %d items with no %q value\n", skipped, fieldName)
+	}
+	return nil
+}
+
+// monthAxis is the timeline's header: a month label at each column where a
+// new month begins.
+func monthAxis(min, max time.Time, barWidth int, column func(time.Time) int) string {
+	axis := make([]rune, barWidth)
+	for i := range axis {
+		axis[i] = ' '
+	}
+	for t := time.Date(min.Year(), min.Month(), 1, 0, 0, 0, 0, time.UTC); !t.After(max); t = t.AddDate(0, 1, 0) {
+		if t.Before(min) {
+			continue
+		}
+		col := column(t)
+		label := t.Format("Jan")
+		if col+len(label) > barWidth {
+			break
+		}
+		for i, r := range label {
+			axis[col+i] = r
+		}
+	}
+	return strings.TrimRight(string(axis), " ")
+}
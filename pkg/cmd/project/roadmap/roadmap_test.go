@@ -0,0 +1,243 @@
+package roadmap
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cli/cli/v2/pkg/cmd/project/shared/queries"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestNewCmdRoadmap(t *testing.T) {
+	tests := []struct {
+		name        string
+		cli         string
+		wants       roadmapOpts
+		wantsErr    bool
+		wantsErrMsg string
+	}{
+		{
+			name:        "not-a-number",
+			cli:         "x",
+			wantsErr:    true,
+			wantsErrMsg: "invalid number: x",
+		},
+		{
+			name: "number",
+			cli:  "123",
+			wants: roadmapOpts{
+				number: 123,
+				limit:  30,
+			},
+		},
+		{
+			name: "date-field",
+			cli:  "--owner monalisa --date-field 'Target date'",
+			wants: roadmapOpts{
+				owner:     "monalisa",
+				dateField: "Target date",
+				limit:     30,
+			},
+		},
+		{
+			name: "iteration-field",
+			cli:  "--iteration-field Sprint",
+			wants: roadmapOpts{
+				iterationField: "Sprint",
+				limit:          30,
+			},
+		},
+		{
+			name:        "date-field-with-iteration-field",
+			cli:         "--date-field due --iteration-field Sprint",
+			wantsErr:    true,
+			wantsErrMsg: "cannot use `--date-field` with `--iteration-field`",
+		},
+	}
+
+	t.Setenv("GH_TOKEN", "auth-token")
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ios, _, _, _ := iostreams.Test()
+			f := &cmdutil.Factory{
+				IOStreams: ios,
+			}
+
+			argv, err := shlex.Split(tt.cli)
+			assert.NoError(t, err)
+
+			var gotOpts roadmapOpts
+			cmd := NewCmdRoadmap(f, func(config roadmapConfig) error {
+				gotOpts = config.opts
+				return nil
+			})
+
+			cmd.SetArgs(argv)
+			_, err = cmd.ExecuteC()
+			if tt.wantsErr {
+				assert.Error(t, err)
+				assert.Equal(t, tt.wantsErrMsg, err.Error())
+				return
+			}
+			assert.NoError(t, err)
+
+			assert.Equal(t, tt.wants.number, gotOpts.number)
+			assert.Equal(t, tt.wants.owner, gotOpts.owner)
+			assert.Equal(t, tt.wants.dateField, gotOpts.dateField)
+			assert.Equal(t, tt.wants.iterationField, gotOpts.iterationField)
+			assert.Equal(t, tt.wants.limit, gotOpts.limit)
+		})
+	}
+}
+
+func TestCollectSpans(t *testing.T) {
+	var sprint queries.ProjectItem
+	sprint.Content.TypeName = "Issue"
+	sprint.Content.Issue.Title = "in a sprint"
+	var iteration queries.FieldValueNodes
+	iteration.Type = "ProjectV2ItemFieldIterationValue"
+	iteration.ProjectV2ItemFieldIterationValue.Title = "Iteration 1"
+	iteration.ProjectV2ItemFieldIterationValue.StartDate = "2025-09-01"
+	iteration.ProjectV2ItemFieldIterationValue.Duration = 14
+	iteration.ProjectV2ItemFieldIterationValue.Field.TypeName = "ProjectV2IterationField"
+	iteration.ProjectV2ItemFieldIterationValue.Field.IterationField.Name = "Sprint"
+	sprint.FieldValues.Nodes = []queries.FieldValueNodes{iteration}
+
+	var unplaced queries.ProjectItem
+	unplaced.Content.TypeName = "Issue"
+	unplaced.Content.Issue.Title = "no sprint"
+
+	spans, skipped := collectSpans([]queries.ProjectItem{unplaced, sprint}, "Sprint")
+	assert.Equal(t, 1, skipped)
+	assert.Equal(t, []span{
+		{
+			title: "in a sprint",
+			start: time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC),
+			end:   time.Date(2025, 9, 14, 0, 0, 0, 0, time.UTC),
+		},
+	}, spans)
+}
+
+func TestRunRoadmap_User(t *testing.T) {
+	defer gock.Off()
+	// gock.Observe(gock.DumpRequest)
+
+	// get user ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query UserOrgOwner.*",
+			"variables": map[string]interface{}{
+				"login": "monalisa",
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"id": "an ID",
+				},
+			},
+			"errors": []interface{}{
+				map[string]interface{}{
+					"type": "NOT_FOUND",
+					"path": []string{"organization"},
+				},
+			},
+		})
+
+	// list project items
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		JSON(map[string]interface{}{
+			"query": "query UserProjectWithItems.*",
+			"variables": map[string]interface{}{
+				"firstItems":  queries.LimitDefault,
+				"afterItems":  nil,
+				"firstFields": queries.LimitMax,
+				"afterFields": nil,
+				"login":       "monalisa",
+				"number":      1,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"items": map[string]interface{}{
+							"nodes": []map[string]interface{}{
+								{
+									"id": "issue ID",
+									"content": map[string]interface{}{
+										"__typename": "Issue",
+										"title":      "an issue",
+									},
+									"fieldValues": map[string]interface{}{
+										"nodes": []map[string]interface{}{
+											{
+												"__typename": "ProjectV2ItemFieldDateValue",
+												"date":       "2025-09-01",
+												"field": map[string]interface{}{
+													"__typename": "ProjectV2Field",
+													"name":       "Target date",
+												},
+											},
+										},
+									},
+								},
+								{
+									"id": "pull request ID",
+									"content": map[string]interface{}{
+										"__typename": "PullRequest",
+										"title":      "a pull request",
+									},
+									"fieldValues": map[string]interface{}{
+										"nodes": []map[string]interface{}{
+											{
+												"__typename": "ProjectV2ItemFieldDateValue",
+												"date":       "2025-09-15",
+												"field": map[string]interface{}{
+													"__typename": "ProjectV2Field",
+													"name":       "Target date",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		})
+
+	client := queries.NewTestClient()
+
+	ios, _, stdout, _ := iostreams.Test()
+	config := roadmapConfig{
+		opts: roadmapOpts{
+			number:    1,
+			owner:     "monalisa",
+			dateField: "Target date",
+		},
+		client: client,
+		io:     ios,
+	}
+
+	err := runRoadmap(config)
+	assert.NoError(t, err)
+	assert.Equal(t,
+		fmt.Sprintf("%-30s %s\n", "", "Sep")+
+			fmt.Sprintf("%-30s %s\n", "an issue", "◆")+
+			fmt.Sprintf("%-30s %s\n", "a pull request", strings.Repeat(" ", 47)+"◆"),
+		stdout.String())
+}
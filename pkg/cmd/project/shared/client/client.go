@@ -3,8 +3,11 @@ package client
 import (
 	"os"
 
+	"github.com/cli/cli/v2/internal/ghversion"
 	"github.com/cli/cli/v2/pkg/cmd/project/shared/queries"
 	"github.com/cli/cli/v2/pkg/cmdutil"
+
+	ghauth "github.com/cli/go-gh/v2/pkg/auth"
 )
 
 func New(f *cmdutil.Factory) (*queries.Client, error) {
@@ -18,5 +21,25 @@ func New(f *cmdutil.Factory) (*queries.Client, error) {
 	if err != nil {
 		return nil, err
 	}
-	return queries.NewClient(httpClient, os.Getenv("GH_HOST"), f.IOStreams), nil
+
+	// Projects shipped in GHES 3.9; fail with the version requirement before
+	// the first API call instead of surfacing a confusing 404 from the server.
+	host, _ := ghauth.DefaultHost()
+	if err := ghversion.CheckCapability(ghversion.NewDetector(httpClient), host, ghversion.ProjectsV2); err != nil {
+		return nil, err
+	}
+
+	client := queries.NewClient(httpClient, os.Getenv("GH_HOST"), f.IOStreams)
+	if f.BaseRepo != nil {
+		// When no owner is provided, fall back to the owner of the
+		// repository in the current directory.
+		client.SetDefaultOwner(func() (string, error) {
+			repo, err := f.BaseRepo()
+			if err != nil {
+				return "", err
+			}
+			return repo.RepoOwner(), nil
+		})
+	}
+	return client, nil
 }
@@ -0,0 +1,276 @@
+// Package filter matches project items against the qualifier syntax used by
+// the project board's search box, e.g. "status:Done assignee:monalisa".
+// The GraphQL API does not support filtering project items server-side, so
+// filters are applied to the items after they have been fetched.
+package filter
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cli/cli/v2/pkg/cmd/project/shared/queries"
+)
+
+// A Filter is a parsed search query. An item matches the filter when it
+// matches every term.
+type Filter struct {
+	terms []term
+}
+
+// A term is a single qualifier such as "status:Done", "-label:bug", or a bare
+// word matched against the item title. Comma-separated values are
+// alternatives: "status:Todo,Done" matches either status.
+type term struct {
+	field   string // lowercased qualifier; empty for free text
+	values  []string
+	negated bool
+}
+
+// Parse parses a search query into a Filter. Values containing spaces can be
+// quoted, e.g. `status:"In Progress"`.
+func Parse(query string) (*Filter, error) {
+	tokens, err := tokenize(query)
+	if err != nil {
+		return nil, err
+	}
+
+	var terms []term
+	for _, token := range tokens {
+		negated := strings.HasPrefix(token, "-")
+		token = strings.TrimPrefix(token, "-")
+		if token == "" {
+			continue
+		}
+
+		key, rawValue, isQualifier := cut(token, ':')
+		if !isQualifier || key == "" {
+			terms = append(terms, term{values: []string{unquote(token)}, negated: negated})
+			continue
+		}
+
+		var values []string
+		for _, v := range split(rawValue, ',') {
+			if v := unquote(v); v != "" {
+				values = append(values, v)
+			}
+		}
+		if len(values) == 0 {
+			return nil, fmt.Errorf("no value for qualifier %q", key)
+		}
+
+		terms = append(terms, term{field: strings.ToLower(unquote(key)), values: values, negated: negated})
+	}
+
+	return &Filter{terms: terms}, nil
+}
+
+// Matches reports whether the item matches every term of the filter.
+func (f *Filter) Matches(item queries.ProjectItem) bool {
+	for _, t := range f.terms {
+		if t.matches(item) == t.negated {
+			return false
+		}
+	}
+	return true
+}
+
+func (t term) matches(item queries.ProjectItem) bool {
+	for _, v := range t.values {
+		switch t.field {
+		case "":
+			if strings.Contains(strings.ToLower(item.Title()), strings.ToLower(v)) {
+				return true
+			}
+		case "type":
+			if itemType(v) == item.Type() {
+				return true
+			}
+		case "repo", "repository":
+			if strings.EqualFold(v, item.Repo()) {
+				return true
+			}
+		case "no":
+			if !hasField(item, strings.ToLower(v)) {
+				return true
+			}
+		default:
+			for _, node := range item.FieldValues.Nodes {
+				if fieldMatches(fieldName(node), t.field) && valueMatches(node, v) {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// itemType normalizes the value of a "type:" qualifier to an item type.
+func itemType(v string) string {
+	switch strings.ToLower(v) {
+	case "issue":
+		return "Issue"
+	case "pr", "pullrequest":
+		return "PullRequest"
+	case "draft", "draftissue":
+		return "DraftIssue"
+	default:
+		return v
+	}
+}
+
+// fieldMatches reports whether a field name matches a lowercased qualifier,
+// tolerating the singular form, e.g. "assignee" matches the "Assignees" field.
+func fieldMatches(name, qualifier string) bool {
+	name = strings.ToLower(name)
+	return name == qualifier || name == qualifier+"s"
+}
+
+func hasField(item queries.ProjectItem, qualifier string) bool {
+	for _, node := range item.FieldValues.Nodes {
+		if fieldMatches(fieldName(node), qualifier) {
+			return true
+		}
+	}
+	return false
+}
+
+func fieldName(v queries.FieldValueNodes) string {
+	switch v.Type {
+	case "ProjectV2ItemFieldDateValue":
+		return v.ProjectV2ItemFieldDateValue.Field.Name()
+	case "ProjectV2ItemFieldIterationValue":
+		return v.ProjectV2ItemFieldIterationValue.Field.Name()
+	case "ProjectV2ItemFieldLabelValue":
+		return v.ProjectV2ItemFieldLabelValue.Field.Name()
+	case "ProjectV2ItemFieldNumberValue":
+		return v.ProjectV2ItemFieldNumberValue.Field.Name()
+	case "ProjectV2ItemFieldSingleSelectValue":
+		return v.ProjectV2ItemFieldSingleSelectValue.Field.Name()
+	case "ProjectV2ItemFieldTextValue":
+		return v.ProjectV2ItemFieldTextValue.Field.Name()
+	case "ProjectV2ItemFieldMilestoneValue":
+		return v.ProjectV2ItemFieldMilestoneValue.Field.Name()
+	case "ProjectV2ItemFieldPullRequestValue":
+		return v.ProjectV2ItemFieldPullRequestValue.Field.Name()
+	case "ProjectV2ItemFieldRepositoryValue":
+		return v.ProjectV2ItemFieldRepositoryValue.Field.Name()
+	case "ProjectV2ItemFieldUserValue":
+		return v.ProjectV2ItemFieldUserValue.Field.Name()
+	case "ProjectV2ItemFieldReviewerValue":
+		return v.ProjectV2ItemFieldReviewerValue.Field.Name()
+	default:
+		return ""
+	}
+}
+
+func valueMatches(v queries.FieldValueNodes, want string) bool {
+	switch v.Type {
+	case "ProjectV2ItemFieldDateValue":
+		return v.ProjectV2ItemFieldDateValue.Date == want
+	case "ProjectV2ItemFieldIterationValue":
+		return strings.EqualFold(v.ProjectV2ItemFieldIterationValue.Title, want)
+	case "ProjectV2ItemFieldLabelValue":
+		for _, label := range v.ProjectV2ItemFieldLabelValue.Labels.Nodes {
+			if strings.EqualFold(label.Name, want) {
+				return true
+			}
+		}
+	case "ProjectV2ItemFieldNumberValue":
+		return strconv.FormatFloat(float64(v.ProjectV2ItemFieldNumberValue.Number), 'f', -1, 32) == want
+	case "ProjectV2ItemFieldSingleSelectValue":
+		return strings.EqualFold(v.ProjectV2ItemFieldSingleSelectValue.Name, want)
+	case "ProjectV2ItemFieldTextValue":
+		return strings.EqualFold(v.ProjectV2ItemFieldTextValue.Text, want)
+	case "ProjectV2ItemFieldMilestoneValue":
+		return strings.EqualFold(v.ProjectV2ItemFieldMilestoneValue.Milestone.Title, want)
+	case "ProjectV2ItemFieldPullRequestValue":
+		for _, pr := range v.ProjectV2ItemFieldPullRequestValue.PullRequests.Nodes {
+			if strings.EqualFold(pr.Url, want) {
+				return true
+			}
+		}
+	case "ProjectV2ItemFieldRepositoryValue":
+		url := v.ProjectV2ItemFieldRepositoryValue.Repository.Url
+		return strings.EqualFold(url, want) || strings.HasSuffix(strings.ToLower(url), "/"+strings.ToLower(want))
+	case "ProjectV2ItemFieldUserValue":
+		for _, user := range v.ProjectV2ItemFieldUserValue.Users.Nodes {
+			if strings.EqualFold(user.Login, want) {
+				return true
+			}
+		}
+	case "ProjectV2ItemFieldReviewerValue":
+		for _, reviewer := range v.ProjectV2ItemFieldReviewerValue.Reviewers.Nodes {
+			switch reviewer.Type {
+			case "Team":
+				if strings.EqualFold(reviewer.Team.Name, want) {
+					return true
+				}
+			case "User":
+				if strings.EqualFold(reviewer.User.Login, want) {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// tokenize splits a query on whitespace, keeping double-quoted sections
+// together. Quotes are preserved for cut and split to honor.
+func tokenize(query string) ([]string, error) {
+	var tokens []string
+	var current strings.Builder
+	inQuotes := false
+	for _, r := range query {
+		switch {
+		case r == '"':
+			inQuotes = !inQuotes
+			current.WriteRune(r)
+		case (r == ' ' || r == '\t') && !inQuotes:
+			if current.Len() > 0 {
+				tokens = append(tokens, current.String())
+				current.Reset()
+			}
+		default:
+			current.WriteRune(r)
+		}
+	}
+	if inQuotes {
+		return nil, fmt.Errorf("unterminated quote: %s", query)
+	}
+	if current.Len() > 0 {
+		tokens = append(tokens, current.String())
+	}
+	return tokens, nil
+}
+
+// cut splits a token at the first unquoted occurrence of sep.
+func cut(token string, sep rune) (before, after string, found bool) {
+	inQuotes := false
+	for i, r := range token {
+		if r == '"' {
+			inQuotes = !inQuotes
+		} else if r == sep && !inQuotes {
+			return token[:i], token[i+len(string(sep)):], true
+		}
+	}
+	return token, "", false
+}
+
+// split splits a string at every unquoted occurrence of sep.
+func split(s string, sep rune) []string {
+	var parts []string
+	for {
+		before, after, found := cut(s, sep)
+		parts = append(parts, before)
+		if !found {
+			return parts
+		}
+		s = after
+	}
+}
+
+func unquote(s string) string {
+	return strings.ReplaceAll(s, `"`, "")
+}
@@ -0,0 +1,118 @@
+package filter
+
+import (
+	"testing"
+
+	"github.com/cli/cli/v2/pkg/cmd/project/shared/queries"
+	"github.com/stretchr/testify/assert"
+)
+
+func singleSelectValue(field, name string) queries.FieldValueNodes {
+	var v queries.FieldValueNodes
+	v.Type = "ProjectV2ItemFieldSingleSelectValue"
+	v.ProjectV2ItemFieldSingleSelectValue.Name = name
+	v.ProjectV2ItemFieldSingleSelectValue.Field.TypeName = "ProjectV2SingleSelectField"
+	v.ProjectV2ItemFieldSingleSelectValue.Field.SingleSelectField.Name = field
+	return v
+}
+
+func userValue(field string, logins ...string) queries.FieldValueNodes {
+	var v queries.FieldValueNodes
+	v.Type = "ProjectV2ItemFieldUserValue"
+	for _, login := range logins {
+		v.ProjectV2ItemFieldUserValue.Users.Nodes = append(v.ProjectV2ItemFieldUserValue.Users.Nodes, struct{ Login string }{Login: login})
+	}
+	v.ProjectV2ItemFieldUserValue.Field.TypeName = "ProjectV2Field"
+	v.ProjectV2ItemFieldUserValue.Field.Field.Name = field
+	return v
+}
+
+func issueItem() queries.ProjectItem {
+	var item queries.ProjectItem
+	item.Content.TypeName = "Issue"
+	item.Content.Issue.Title = "Fix the parser"
+	item.Content.Issue.Number = 1
+	item.Content.Issue.Repository.NameWithOwner = "cli/go-gh"
+	item.FieldValues.Nodes = []queries.FieldValueNodes{
+		singleSelectValue("Status", "In Progress"),
+		userValue("Assignees", "monalisa", "hubot"),
+	}
+	return item
+}
+
+func TestParseErrors(t *testing.T) {
+	_, err := Parse(`status:"In Progress`)
+	assert.EqualError(t, err, `unterminated quote: status:"In Progress`)
+
+	_, err = Parse("status:")
+	assert.EqualError(t, err, `no value for qualifier "status"`)
+}
+
+func TestFilterMatches(t *testing.T) {
+	item := issueItem()
+
+	var draft queries.ProjectItem
+	draft.Content.TypeName = "DraftIssue"
+	draft.Content.DraftIssue.Title = "a draft"
+
+	tests := []struct {
+		name  string
+		query string
+		item  queries.ProjectItem
+		want  bool
+	}{
+		{name: "free text", query: "parser", item: item, want: true},
+		{name: "free text no match", query: "printer", item: item, want: false},
+		{name: "quoted value with space", query: `status:"In Progress"`, item: item, want: true},
+		{name: "case insensitive", query: `status:"in progress"`, item: item, want: true},
+		{name: "status no match", query: "status:Done", item: item, want: false},
+		{name: "alternatives", query: `status:Done,"In Progress"`, item: item, want: true},
+		{name: "singular qualifier", query: "assignee:hubot", item: item, want: true},
+		{name: "negated", query: "-assignee:hubot", item: item, want: false},
+		{name: "negated no match", query: "-assignee:octocat", item: item, want: true},
+		{name: "type", query: "type:issue", item: item, want: true},
+		{name: "type draft", query: "type:draft", item: draft, want: true},
+		{name: "repo", query: "repo:cli/go-gh", item: item, want: true},
+		{name: "no qualifier", query: "no:assignee", item: item, want: false},
+		{name: "no qualifier match", query: "no:iteration", item: item, want: true},
+		{name: "all terms must match", query: "status:Done parser", item: item, want: false},
+		{name: "multiple terms", query: `assignee:monalisa status:"In Progress"`, item: item, want: true},
+		{name: "unknown field", query: "points:5", item: item, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, err := Parse(tt.query)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, f.Matches(tt.item))
+		})
+	}
+}
+
+func TestValueMatches(t *testing.T) {
+	var number queries.FieldValueNodes
+	number.Type = "ProjectV2ItemFieldNumberValue"
+	number.ProjectV2ItemFieldNumberValue.Number = 3.5
+
+	var date queries.FieldValueNodes
+	date.Type = "ProjectV2ItemFieldDateValue"
+	date.ProjectV2ItemFieldDateValue.Date = "2025-09-01"
+
+	var labels queries.FieldValueNodes
+	labels.Type = "ProjectV2ItemFieldLabelValue"
+	labels.ProjectV2ItemFieldLabelValue.Labels.Nodes = []struct{ Name string }{
+		{Name: "bug"},
+		{Name: "help wanted"},
+	}
+
+	var iteration queries.FieldValueNodes
+	iteration.Type = "ProjectV2ItemFieldIterationValue"
+	iteration.ProjectV2ItemFieldIterationValue.Title = "Iteration 1"
+
+	assert.True(t, valueMatches(number, "3.5"))
+	assert.False(t, valueMatches(number, "3"))
+	assert.True(t, valueMatches(date, "2025-09-01"))
+	assert.True(t, valueMatches(labels, "help wanted"))
+	assert.False(t, valueMatches(labels, "docs"))
+	assert.True(t, valueMatches(iteration, "iteration 1"))
+}
@@ -0,0 +1,144 @@
+package format
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/cli/cli/v2/pkg/cmd/project/shared/queries"
+)
+
+// CSVProjectDetailedItems writes the items of a project as CSV. Each item
+// occupies one row; field values are flattened into one column per project
+// field, in the order the fields are first seen across the items.
+func CSVProjectDetailedItems(w io.Writer, project *queries.Project) error {
+	header := []string{"Type", "Title", "Number", "Repository", "URL", "ID"}
+	fieldColumns := map[string]int{}
+	for _, item := range project.Items.Nodes {
+		for _, v := range item.FieldValues.Nodes {
+			name, ok := fieldValueColumn(v)
+			if !ok {
+				continue
+			}
+			if _, seen := fieldColumns[name]; !seen {
+				fieldColumns[name] = len(header)
+				header = append(header, name)
+			}
+		}
+	}
+
+	cw := csv.NewWriter(w)
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+
+	for _, item := range project.Items.Nodes {
+		row := make([]string, len(header))
+		row[0] = item.Type()
+		row[1] = item.Title()
+		if item.Number() != 0 {
+			row[2] = strconv.Itoa(item.Number())
+		}
+		row[3] = item.Repo()
+		row[4] = item.URL()
+		row[5] = item.ID()
+		for _, v := range item.FieldValues.Nodes {
+			name, ok := fieldValueColumn(v)
+			if !ok {
+				continue
+			}
+			row[fieldColumns[name]] = fieldValueString(v)
+		}
+		if err := cw.Write(row); err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+// fieldValueColumn returns the column name for a field value. The built-in
+// Title field is skipped because the title is already a fixed column.
+func fieldValueColumn(v queries.FieldValueNodes) (string, bool) {
+	var name string
+	switch v.Type {
+	case "ProjectV2ItemFieldDateValue":
+		name = v.ProjectV2ItemFieldDateValue.Field.Name()
+	case "ProjectV2ItemFieldIterationValue":
+		name = v.ProjectV2ItemFieldIterationValue.Field.Name()
+	case "ProjectV2ItemFieldLabelValue":
+		name = v.ProjectV2ItemFieldLabelValue.Field.Name()
+	case "ProjectV2ItemFieldNumberValue":
+		name = v.ProjectV2ItemFieldNumberValue.Field.Name()
+	case "ProjectV2ItemFieldSingleSelectValue":
+		name = v.ProjectV2ItemFieldSingleSelectValue.Field.Name()
+	case "ProjectV2ItemFieldTextValue":
+		name = v.ProjectV2ItemFieldTextValue.Field.Name()
+	case "ProjectV2ItemFieldMilestoneValue":
+		name = v.ProjectV2ItemFieldMilestoneValue.Field.Name()
+	case "ProjectV2ItemFieldPullRequestValue":
+		name = v.ProjectV2ItemFieldPullRequestValue.Field.Name()
+	case "ProjectV2ItemFieldRepositoryValue":
+		name = v.ProjectV2ItemFieldRepositoryValue.Field.Name()
+	case "ProjectV2ItemFieldUserValue":
+		name = v.ProjectV2ItemFieldUserValue.Field.Name()
+	case "ProjectV2ItemFieldReviewerValue":
+		name = v.ProjectV2ItemFieldReviewerValue.Field.Name()
+	}
+	if name == "" || name == "Title" {
+		return "", false
+	}
+	return name, true
+}
+
+// fieldValueString flattens a field value into a single cell, joining
+// multi-valued fields with commas.
+func fieldValueString(v queries.FieldValueNodes) string {
+	switch v.Type {
+	case "ProjectV2ItemFieldDateValue":
+		return v.ProjectV2ItemFieldDateValue.Date
+	case "ProjectV2ItemFieldIterationValue":
+		return v.ProjectV2ItemFieldIterationValue.Title
+	case "ProjectV2ItemFieldLabelValue":
+		names := make([]string, 0, len(v.ProjectV2ItemFieldLabelValue.Labels.Nodes))
+		for _, l := range v.ProjectV2ItemFieldLabelValue.Labels.Nodes {
+			names = append(names, l.Name)
+		}
+		return strings.Join(names, ", ")
+	case "ProjectV2ItemFieldNumberValue":
+		return strconv.FormatFloat(float64(v.ProjectV2ItemFieldNumberValue.Number), 'f', -1, 32)
+	case "ProjectV2ItemFieldSingleSelectValue":
+		return v.ProjectV2ItemFieldSingleSelectValue.Name
+	case "ProjectV2ItemFieldTextValue":
+		return v.ProjectV2ItemFieldTextValue.Text
+	case "ProjectV2ItemFieldMilestoneValue":
+		return v.ProjectV2ItemFieldMilestoneValue.Milestone.Title
+	case "ProjectV2ItemFieldPullRequestValue":
+		urls := make([]string, 0, len(v.ProjectV2ItemFieldPullRequestValue.PullRequests.Nodes))
+		for _, pr := range v.ProjectV2ItemFieldPullRequestValue.PullRequests.Nodes {
+			urls = append(urls, pr.Url)
+		}
+		return strings.Join(urls, ", ")
+	case "ProjectV2ItemFieldRepositoryValue":
+		return v.ProjectV2ItemFieldRepositoryValue.Repository.Url
+	case "ProjectV2ItemFieldUserValue":
+		logins := make([]string, 0, len(v.ProjectV2ItemFieldUserValue.Users.Nodes))
+		for _, u := range v.ProjectV2ItemFieldUserValue.Users.Nodes {
+			logins = append(logins, u.Login)
+		}
+		return strings.Join(logins, ", ")
+	case "ProjectV2ItemFieldReviewerValue":
+		reviewers := make([]string, 0, len(v.ProjectV2ItemFieldReviewerValue.Reviewers.Nodes))
+		for _, r := range v.ProjectV2ItemFieldReviewerValue.Reviewers.Nodes {
+			if r.Type == "Team" {
+				reviewers = append(reviewers, r.Team.Name)
+			} else {
+				reviewers = append(reviewers, r.User.Login)
+			}
+		}
+		return strings.Join(reviewers, ", ")
+	}
+	return ""
+}
@@ -0,0 +1,55 @@
+package format
+
+import (
+	"testing"
+
+	"github.com/cli/cli/v2/pkg/cmd/project/shared/queries"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFieldValueColumn(t *testing.T) {
+	var title queries.FieldValueNodes
+	title.Type = "ProjectV2ItemFieldTextValue"
+	title.ProjectV2ItemFieldTextValue.Text = "an issue"
+	title.ProjectV2ItemFieldTextValue.Field.TypeName = "ProjectV2Field"
+	title.ProjectV2ItemFieldTextValue.Field.Field.Name = "Title"
+
+	_, ok := fieldValueColumn(title)
+	assert.False(t, ok, "the built-in Title field should be skipped")
+
+	var notes queries.FieldValueNodes
+	notes.Type = "ProjectV2ItemFieldTextValue"
+	notes.ProjectV2ItemFieldTextValue.Text = "some notes"
+	notes.ProjectV2ItemFieldTextValue.Field.TypeName = "ProjectV2Field"
+	notes.ProjectV2ItemFieldTextValue.Field.Field.Name = "Notes"
+
+	name, ok := fieldValueColumn(notes)
+	assert.True(t, ok)
+	assert.Equal(t, "Notes", name)
+}
+
+func TestFieldValueString(t *testing.T) {
+	var milestone queries.FieldValueNodes
+	milestone.Type = "ProjectV2ItemFieldMilestoneValue"
+	milestone.ProjectV2ItemFieldMilestoneValue.Milestone.Title = "v1.0"
+
+	var number queries.FieldValueNodes
+	number.Type = "ProjectV2ItemFieldNumberValue"
+	number.ProjectV2ItemFieldNumberValue.Number = 3.5
+
+	var users queries.FieldValueNodes
+	users.Type = "ProjectV2ItemFieldUserValue"
+	users.ProjectV2ItemFieldUserValue.Users.Nodes = []struct{ Login string }{
+		{Login: "monalisa"},
+		{Login: "hubot"},
+	}
+
+	var repository queries.FieldValueNodes
+	repository.Type = "ProjectV2ItemFieldRepositoryValue"
+	repository.ProjectV2ItemFieldRepositoryValue.Repository.Url = "https://github.com/cli/go-gh"
+
+	assert.Equal(t, "v1.0", fieldValueString(milestone))
+	assert.Equal(t, "3.5", fieldValueString(number))
+	assert.Equal(t, "monalisa, hubot", fieldValueString(users))
+	assert.Equal(t, "https://github.com/cli/go-gh", fieldValueString(repository))
+}
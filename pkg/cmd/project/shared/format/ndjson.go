@@ -0,0 +1,22 @@
+package format
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/cli/cli/v2/pkg/cmd/project/shared/queries"
+)
+
+// NDJSONProjectItems writes the given items as newline-delimited JSON, one
+// object per line in the same shape as the JSON export's items. The caller
+// invokes it once per fetched page, so items are emitted as they arrive
+// instead of being materialized all at once.
+func NDJSONProjectItems(w io.Writer, project *queries.Project, items []queries.ProjectItem) error {
+	enc := json.NewEncoder(w)
+	for _, item := range project.SerializedItems(items) {
+		if err := enc.Encode(item); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,41 @@
+package format
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/cli/cli/v2/pkg/cmd/project/shared/queries"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNDJSONProjectItems(t *testing.T) {
+	var field queries.ProjectField
+	field.TypeName = "ProjectV2Field"
+	field.Field.ID = "status ID"
+	field.Field.Name = "Status"
+
+	project := &queries.Project{}
+	project.Fields.Nodes = []queries.ProjectField{field}
+
+	var draft queries.ProjectItem
+	draft.Id = "item ID"
+	draft.Content.TypeName = "DraftIssue"
+	draft.Content.DraftIssue.Title = "a draft"
+	var status queries.FieldValueNodes
+	status.Type = "ProjectV2ItemFieldTextValue"
+	status.ProjectV2ItemFieldTextValue.Text = "Done"
+	status.ProjectV2ItemFieldTextValue.Field = field
+	draft.FieldValues.Nodes = []queries.FieldValueNodes{status}
+
+	var bare queries.ProjectItem
+	bare.Id = "second ID"
+
+	buf := &bytes.Buffer{}
+	assert.NoError(t, NDJSONProjectItems(buf, project, []queries.ProjectItem{draft, bare}))
+
+	lines := strings.Split(strings.TrimRight(buf.String(), "\n"), "\n")
+	assert.Len(t, lines, 2)
+	assert.JSONEq(t, `{"id":"item ID","content":{"title":"a draft","body":"","type":"DraftIssue"},"status":"Done"}`, lines[0])
+	assert.JSONEq(t, `{"id":"second ID","content":null}`, lines[1])
+}
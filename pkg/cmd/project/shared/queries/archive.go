@@ -0,0 +1,35 @@
+package queries
+
+import (
+	"github.com/shurcooL/githubv4"
+)
+
+// archiveItemResult receives the aliased response for one archive or
+// unarchive mutation in a batch.
+type archiveItemResult struct {
+	ClientMutationID string `graphql:"clientMutationId"`
+}
+
+// ArchiveProjectItems archives the given items, or unarchives them if undo is
+// true, submitting the mutations in batched GraphQL requests.
+func (c *Client) ArchiveProjectItems(projectID string, itemIDs []string, undo bool) error {
+	name := "ArchiveProjectItems"
+	batch := NewBatchMutation()
+	for _, itemID := range itemIDs {
+		if undo {
+			name = "UnarchiveProjectItems"
+			batch.Add("unarchiveProjectV2Item", githubv4.UnarchiveProjectV2ItemInput{
+				ProjectID: githubv4.ID(projectID),
+				ItemID:    githubv4.ID(itemID),
+			}, archiveItemResult{})
+		} else {
+			batch.Add("archiveProjectV2Item", githubv4.ArchiveProjectV2ItemInput{
+				ProjectID: githubv4.ID(projectID),
+				ItemID:    githubv4.ID(itemID),
+			}, archiveItemResult{})
+		}
+	}
+
+	_, err := c.MutateBatch(name, batch)
+	return err
+}
@@ -0,0 +1,82 @@
+package queries
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// mutationBatchSize is the number of aliased mutations submitted per GraphQL
+// request when mutating items in bulk.
+const mutationBatchSize = 25
+
+// BatchMutation collects mutations so that they can be submitted as aliased
+// copies in a single GraphQL request instead of one request per item, which
+// avoids secondary rate limits when operating on large projects.
+type BatchMutation struct {
+	entries []batchEntry
+}
+
+type batchEntry struct {
+	mutation string
+	input    interface{}
+	result   reflect.Type
+}
+
+func NewBatchMutation() *BatchMutation {
+	return &BatchMutation{}
+}
+
+// Add appends one mutation to the batch. The mutation is the GraphQL mutation
+// field name, input is its typed input object, and result is a value of the
+// struct type that describes the selection set and receives the response.
+func (b *BatchMutation) Add(mutation string, input interface{}, result interface{}) {
+	b.entries = append(b.entries, batchEntry{
+		mutation: mutation,
+		input:    input,
+		result:   reflect.TypeOf(result),
+	})
+}
+
+// Len returns the number of mutations in the batch.
+func (b *BatchMutation) Len() int {
+	return len(b.entries)
+}
+
+// MutateBatch submits the batch in chunks of mutationBatchSize aliased
+// mutations per GraphQL request, and returns the per-mutation results in the
+// order the mutations were added.
+func (c *Client) MutateBatch(name string, batch *BatchMutation) ([]interface{}, error) {
+	results := make([]interface{}, 0, len(batch.entries))
+
+	for start := 0; start < len(batch.entries); start += mutationBatchSize {
+		end := start + mutationBatchSize
+		if end > len(batch.entries) {
+			end = len(batch.entries)
+		}
+
+		chunk := batch.entries[start:end]
+		fields := make([]reflect.StructField, len(chunk))
+		variables := make(map[string]interface{}, len(chunk))
+		for i, entry := range chunk {
+			varName := fmt.Sprintf("input%d", start+i)
+			variables[varName] = entry.input
+			fields[i] = reflect.StructField{
+				Name: fmt.Sprintf("Alias%d", start+i),
+				Type: entry.result,
+				Tag:  reflect.StructTag(fmt.Sprintf(`graphql:"alias%d: %s(input: $%s)"`, start+i, entry.mutation, varName)),
+			}
+		}
+
+		query := reflect.New(reflect.StructOf(fields)).Interface()
+		if err := c.Mutate(name, query, variables); err != nil {
+			return nil, err
+		}
+
+		response := reflect.ValueOf(query).Elem()
+		for i := range chunk {
+			results = append(results, response.Field(i).Interface())
+		}
+	}
+
+	return results, nil
+}
@@ -115,15 +115,17 @@ func TestJSONProjectField_SingleSelectType(t *testing.T) {
 			Name: "name",
 		},
 		{
-			ID:   "456",
-			Name: "name2",
+			ID:          "456",
+			Name:        "name2",
+			Color:       "RED",
+			Description: "a description",
 		},
 	}
 
 	b, err := json.Marshal(field.ExportData(nil))
 	assert.NoError(t, err)
 
-	assert.JSONEq(t, `{"id":"123","name":"name","type":"ProjectV2SingleSelectField","options":[{"id":"123","name":"name"},{"id":"456","name":"name2"}]}`, string(b))
+	assert.JSONEq(t, `{"id":"123","name":"name","type":"ProjectV2SingleSelectField","options":[{"id":"123","name":"name"},{"id":"456","name":"name2","color":"RED","description":"a description"}]}`, string(b))
 }
 
 func TestJSONProjectField_ProjectV2IterationField(t *testing.T) {
@@ -0,0 +1,161 @@
+package queries
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/cli/cli/v2/internal/config"
+)
+
+// Project field schemas rarely change, but scripted loops refetch them on
+// every invocation. fieldCache stores field metadata per project in the gh
+// state directory. Entries expire after a TTL and carry a fingerprint of
+// their content, so truncated or tampered entries are refetched instead of
+// being served.
+
+const fieldCacheTTL = 24 * time.Hour
+
+type fieldCacheEntry struct {
+	CachedAt time.Time       `json:"cached_at"`
+	ETag     string          `json:"etag"`
+	Data     json.RawMessage `json:"data"`
+}
+
+type fieldCache struct {
+	dir string
+	ttl time.Duration
+}
+
+// newFieldCache returns the on-disk field metadata cache, or nil if caching
+// is disabled. GH_PROJECT_FIELD_CACHE_TTL overrides the default TTL; setting
+// it to 0 disables the cache entirely.
+func newFieldCache() *fieldCache {
+	ttl := fieldCacheTTL
+	if spec := os.Getenv("GH_PROJECT_FIELD_CACHE_TTL"); spec != "" {
+		d, err := time.ParseDuration(spec)
+		if err != nil {
+			return nil
+		}
+		ttl = d
+	}
+	if ttl <= 0 {
+		return nil
+	}
+	return &fieldCache{
+		dir: filepath.Join(config.StateDir(), "project-fields"),
+		ttl: ttl,
+	}
+}
+
+func (fc *fieldCache) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(fc.dir, hex.EncodeToString(sum[:16])+".json")
+}
+
+// get unmarshals a fresh cache entry for key into v, reporting whether one
+// was found.
+func (fc *fieldCache) get(key string, v interface{}) bool {
+	data, err := os.ReadFile(fc.path(key))
+	if err != nil {
+		return false
+	}
+	var entry fieldCacheEntry
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return false
+	}
+	if time.Since(entry.CachedAt) > fc.ttl {
+		return false
+	}
+	if etag(entry.Data) != entry.ETag {
+		return false
+	}
+	return json.Unmarshal(entry.Data, v) == nil
+}
+
+// set stores v under key. Failures are ignored: the cache is best-effort and
+// the caller already has the data it needs.
+func (fc *fieldCache) set(key string, v interface{}) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return
+	}
+	entry, err := json.Marshal(fieldCacheEntry{
+		CachedAt: time.Now(),
+		ETag:     etag(data),
+		Data:     data,
+	})
+	if err != nil {
+		return
+	}
+	if err := os.MkdirAll(fc.dir, 0755); err != nil {
+		return
+	}
+	_ = os.WriteFile(fc.path(key), entry, 0600)
+}
+
+func etag(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// InvalidateFieldCache drops all cached field metadata. It is called by
+// commands that change a project's field schema.
+func InvalidateFieldCache() {
+	if fc := newFieldCache(); fc != nil {
+		_ = os.RemoveAll(fc.dir)
+	}
+}
+
+// ProjectFieldsCached is ProjectFields backed by the on-disk cache. Only the
+// project's fields are populated on a cache hit.
+func (c *Client) ProjectFieldsCached(o *Owner, number int32, limit int) (*Project, error) {
+	fc := newFieldCache()
+	key := fmt.Sprintf("fields/%s/%s/%d/%d", o.Type, o.Login, number, limit)
+	if fc != nil {
+		var fields ProjectFields
+		if fc.get(key, &fields) {
+			return &Project{Number: number, Fields: fields}, nil
+		}
+	}
+
+	project, err := c.ProjectFields(o, number, limit)
+	if err != nil {
+		return project, err
+	}
+	if fc != nil {
+		fc.set(key, project.Fields)
+	}
+	return project, nil
+}
+
+// fieldWithIterations is the cached form of a single field configuration.
+type fieldWithIterations struct {
+	Field      ProjectField
+	Iterations []ProjectIteration
+}
+
+// ProjectFieldByIDCached is ProjectFieldByID backed by the on-disk cache.
+func (c *Client) ProjectFieldByIDCached(fieldID string) (*ProjectField, []ProjectIteration, error) {
+	fc := newFieldCache()
+	key := "field/" + fieldID
+	if fc != nil {
+		var cached fieldWithIterations
+		if fc.get(key, &cached) {
+			return &cached.Field, cached.Iterations, nil
+		}
+	}
+
+	field, iterations, err := c.ProjectFieldByID(fieldID)
+	if err != nil {
+		return field, iterations, err
+	}
+	if fc != nil {
+		fc.set(key, fieldWithIterations{Field: *field, Iterations: iterations})
+	}
+	return field, iterations, nil
+}
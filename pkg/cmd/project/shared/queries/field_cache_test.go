@@ -0,0 +1,79 @@
+package queries
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFieldCacheRoundTrip(t *testing.T) {
+	t.Setenv("XDG_STATE_HOME", t.TempDir())
+
+	fc := newFieldCache()
+	assert.NotNil(t, fc)
+
+	fields := ProjectFields{TotalCount: 1}
+	fields.Nodes = []ProjectField{{TypeName: "ProjectV2Field"}}
+	fc.set("fields/USER/monalisa/1/30", fields)
+
+	var got ProjectFields
+	assert.True(t, fc.get("fields/USER/monalisa/1/30", &got))
+	assert.Equal(t, fields, got)
+
+	assert.False(t, fc.get("fields/USER/monalisa/2/30", &got))
+}
+
+func TestFieldCacheExpiry(t *testing.T) {
+	t.Setenv("XDG_STATE_HOME", t.TempDir())
+
+	fc := newFieldCache()
+	fc.set("key", ProjectFields{TotalCount: 1})
+
+	fc.ttl = -time.Second
+	var got ProjectFields
+	assert.False(t, fc.get("key", &got))
+}
+
+func TestFieldCacheRejectsTamperedEntry(t *testing.T) {
+	t.Setenv("XDG_STATE_HOME", t.TempDir())
+
+	fc := newFieldCache()
+	fc.set("key", ProjectFields{TotalCount: 1})
+
+	// rewrite the entry's data without updating the fingerprint
+	path := fc.path("key")
+	data, err := os.ReadFile(path)
+	assert.NoError(t, err)
+	var entry fieldCacheEntry
+	assert.NoError(t, json.Unmarshal(data, &entry))
+	entry.Data = []byte(`{"totalCount":99}`)
+	data, err = json.Marshal(entry)
+	assert.NoError(t, err)
+	assert.NoError(t, os.WriteFile(path, data, 0600))
+
+	var got ProjectFields
+	assert.False(t, fc.get("key", &got))
+}
+
+func TestFieldCacheDisabled(t *testing.T) {
+	t.Setenv("GH_PROJECT_FIELD_CACHE_TTL", "0")
+	assert.Nil(t, newFieldCache())
+
+	t.Setenv("GH_PROJECT_FIELD_CACHE_TTL", "not-a-duration")
+	assert.Nil(t, newFieldCache())
+}
+
+func TestInvalidateFieldCache(t *testing.T) {
+	t.Setenv("XDG_STATE_HOME", t.TempDir())
+
+	fc := newFieldCache()
+	fc.set("key", ProjectFields{TotalCount: 1})
+
+	InvalidateFieldCache()
+
+	var got ProjectFields
+	assert.False(t, fc.get("key", &got))
+}
@@ -0,0 +1,155 @@
+package queries
+
+import (
+	"strings"
+
+	"github.com/shurcooL/githubv4"
+)
+
+// InsightItem is the minimal item data needed to build insights time series:
+// when the item was created, when its content was closed, and its
+// single-select field values for grouping.
+type InsightItem struct {
+	CreatedAt string
+	Content   struct {
+		TypeName string `graphql:"__typename"`
+		Issue    struct {
+			ClosedAt string
+		} `graphql:"... on Issue"`
+		PullRequest struct {
+			ClosedAt string
+		} `graphql:"... on PullRequest"`
+	}
+	FieldValues struct {
+		Nodes []struct {
+			Type                                string `graphql:"__typename"`
+			ProjectV2ItemFieldSingleSelectValue struct {
+				Name  string
+				Field ProjectField
+			} `graphql:"... on ProjectV2ItemFieldSingleSelectValue"`
+		}
+	} `graphql:"fieldValues(first: 100)"` // hardcoded to 100 for now on the assumption that this is a reasonable limit
+}
+
+// ClosedAt returns when the item's content was closed, or the empty string if
+// it is still open or is a draft issue.
+func (i InsightItem) ClosedAt() string {
+	switch i.Content.TypeName {
+	case "Issue":
+		return i.Content.Issue.ClosedAt
+	case "PullRequest":
+		return i.Content.PullRequest.ClosedAt
+	}
+	return ""
+}
+
+// FieldValueByName returns the item's single-select value for the named
+// field, matching the field name case-insensitively.
+func (i InsightItem) FieldValueByName(name string) string {
+	for _, v := range i.FieldValues.Nodes {
+		if v.Type != "ProjectV2ItemFieldSingleSelectValue" {
+			continue
+		}
+		if strings.EqualFold(v.ProjectV2ItemFieldSingleSelectValue.Field.Name(), name) {
+			return v.ProjectV2ItemFieldSingleSelectValue.Name
+		}
+	}
+	return ""
+}
+
+// InsightItems is a connection of insight items.
+type InsightItems struct {
+	TotalCount int
+	Nodes      []InsightItem
+	PageInfo   PageInfo
+}
+
+// projectWithInsightItems queries only the item data needed for insights.
+type projectWithInsightItems struct {
+	Items InsightItems `graphql:"items(first: $firstItems, after: $afterItems)"`
+}
+
+type userOwnerWithInsightItems struct {
+	Owner struct {
+		Project projectWithInsightItems `graphql:"projectV2(number: $number)"`
+	} `graphql:"user(login: $login)"`
+}
+
+type orgOwnerWithInsightItems struct {
+	Owner struct {
+		Project projectWithInsightItems `graphql:"projectV2(number: $number)"`
+	} `graphql:"organization(login: $login)"`
+}
+
+type viewerOwnerWithInsightItems struct {
+	Owner struct {
+		Project projectWithInsightItems `graphql:"projectV2(number: $number)"`
+	} `graphql:"viewer"`
+}
+
+// ProjectInsightItems returns the insight item data of a project. If the
+// OwnerType is VIEWER, no login is required. If limit is 0, the default limit
+// is used.
+func (c *Client) ProjectInsightItems(o *Owner, number int32, limit int) (*InsightItems, error) {
+	if limit == 0 {
+		limit = LimitDefault
+	}
+
+	first := LimitMax
+	if limit < first {
+		first = limit
+	}
+	variables := map[string]interface{}{
+		"firstItems": githubv4.Int(first),
+		"afterItems": (*githubv4.String)(nil),
+		"number":     githubv4.Int(number),
+	}
+
+	var project *projectWithInsightItems
+	var queryName string
+	var query interface{}
+	switch o.Type {
+	case UserOwner:
+		variables["login"] = githubv4.String(o.Login)
+		q := &userOwnerWithInsightItems{} // must be a pointer to work with graphql queries
+		project = &q.Owner.Project
+		query = q
+		queryName = "UserProjectWithInsightItems"
+	case OrgOwner:
+		variables["login"] = githubv4.String(o.Login)
+		q := &orgOwnerWithInsightItems{} // must be a pointer to work with graphql queries
+		project = &q.Owner.Project
+		query = q
+		queryName = "OrgProjectWithInsightItems"
+	case ViewerOwner:
+		q := &viewerOwnerWithInsightItems{} // must be a pointer to work with graphql queries
+		project = &q.Owner.Project
+		query = q
+		queryName = "ViewerProjectWithInsightItems"
+	}
+
+	items := InsightItems{}
+	for {
+		if err := c.doQuery(queryName, query, variables); err != nil {
+			return &items, err
+		}
+
+		items.TotalCount = project.Items.TotalCount
+		items.Nodes = append(items.Nodes, project.Items.Nodes...)
+
+		if !project.Items.PageInfo.HasNextPage || len(items.Nodes) >= limit {
+			break
+		}
+
+		if len(items.Nodes)+LimitMax > limit {
+			variables["firstItems"] = githubv4.Int(limit - len(items.Nodes))
+		}
+		cursor := project.Items.PageInfo.EndCursor
+		variables["afterItems"] = githubv4.NewString(cursor)
+	}
+
+	if len(items.Nodes) > limit {
+		items.Nodes = items.Nodes[:limit]
+	}
+	return &items, nil
+}
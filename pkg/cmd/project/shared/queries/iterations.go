@@ -0,0 +1,151 @@
+package queries
+
+import (
+	"github.com/shurcooL/githubv4"
+)
+
+// ProjectIteration is a single scheduled iteration of an iteration field.
+type ProjectIteration struct {
+	ID        string
+	Title     string
+	StartDate string
+	Duration  int32
+}
+
+func (i ProjectIteration) ExportData(_ []string) map[string]interface{} {
+	return map[string]interface{}{
+		"id":        i.ID,
+		"title":     i.Title,
+		"startDate": i.StartDate,
+		"duration":  i.Duration,
+	}
+}
+
+// ProjectIterationField is an iteration field together with its schedule.
+type ProjectIterationField struct {
+	ID            string
+	Name          string
+	Configuration struct {
+		Iterations          []ProjectIteration
+		CompletedIterations []ProjectIteration
+	}
+}
+
+func (f ProjectIterationField) ExportData(_ []string) map[string]interface{} {
+	iterations := make([]map[string]interface{}, len(f.Configuration.Iterations))
+	for i, it := range f.Configuration.Iterations {
+		iterations[i] = it.ExportData(nil)
+	}
+	completed := make([]map[string]interface{}, len(f.Configuration.CompletedIterations))
+	for i, it := range f.Configuration.CompletedIterations {
+		completed[i] = it.ExportData(nil)
+	}
+	return map[string]interface{}{
+		"id":                  f.ID,
+		"name":                f.Name,
+		"iterations":          iterations,
+		"completedIterations": completed,
+	}
+}
+
+// ProjectIterationFields is the set of iteration fields in a project.
+type ProjectIterationFields struct {
+	Nodes []ProjectIterationField
+}
+
+func (p ProjectIterationFields) ExportData(_ []string) map[string]interface{} {
+	fields := make([]map[string]interface{}, len(p.Nodes))
+	for i := range p.Nodes {
+		fields[i] = p.Nodes[i].ExportData(nil)
+	}
+	return map[string]interface{}{
+		"fields":     fields,
+		"totalCount": len(p.Nodes),
+	}
+}
+
+type iterationFieldNode struct {
+	TypeName       string                `graphql:"__typename"`
+	IterationField ProjectIterationField `graphql:"... on ProjectV2IterationField"`
+}
+
+// projectWithIterations queries only the iteration fields of a project.
+type projectWithIterations struct {
+	Fields struct {
+		Nodes    []iterationFieldNode
+		PageInfo PageInfo
+	} `graphql:"fields(first: $firstFields, after: $afterFields)"`
+}
+
+type userOwnerWithIterations struct {
+	Owner struct {
+		Project projectWithIterations `graphql:"projectV2(number: $number)"`
+	} `graphql:"user(login: $login)"`
+}
+
+type orgOwnerWithIterations struct {
+	Owner struct {
+		Project projectWithIterations `graphql:"projectV2(number: $number)"`
+	} `graphql:"organization(login: $login)"`
+}
+
+type viewerOwnerWithIterations struct {
+	Owner struct {
+		Project projectWithIterations `graphql:"projectV2(number: $number)"`
+	} `graphql:"viewer"`
+}
+
+// ProjectIterationFields returns the iteration fields of a project together
+// with their schedules. If the OwnerType is VIEWER, no login is required.
+func (c *Client) ProjectIterationFields(o *Owner, number int32) (*ProjectIterationFields, error) {
+	variables := map[string]interface{}{
+		"firstFields": githubv4.Int(LimitMax),
+		"afterFields": (*githubv4.String)(nil),
+		"number":      githubv4.Int(number),
+	}
+
+	var project *projectWithIterations
+	var queryName string
+	var query interface{}
+	switch o.Type {
+	case UserOwner:
+		variables["login"] = githubv4.String(o.Login)
+		q := &userOwnerWithIterations{} // must be a pointer to work with graphql queries
+		project = &q.Owner.Project
+		query = q
+		queryName = "UserProjectWithIterations"
+	case OrgOwner:
+		variables["login"] = githubv4.String(o.Login)
+		q := &orgOwnerWithIterations{} // must be a pointer to work with graphql queries
+		project = &q.Owner.Project
+		query = q
+		queryName = "OrgProjectWithIterations"
+	case ViewerOwner:
+		q := &viewerOwnerWithIterations{} // must be a pointer to work with graphql queries
+		project = &q.Owner.Project
+		query = q
+		queryName = "ViewerProjectWithIterations"
+	}
+
+	fields := ProjectIterationFields{}
+	for {
+		if err := c.doQuery(queryName, query, variables); err != nil {
+			return &fields, err
+		}
+
+		for _, n := range project.Fields.Nodes {
+			if n.TypeName == "ProjectV2IterationField" {
+				fields.Nodes = append(fields.Nodes, n.IterationField)
+			}
+		}
+
+		if !project.Fields.PageInfo.HasNextPage {
+			break
+		}
+
+		cursor := project.Fields.PageInfo.EndCursor
+		variables["afterFields"] = githubv4.NewString(cursor)
+	}
+
+	return &fields, nil
+}
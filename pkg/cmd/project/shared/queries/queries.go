@@ -39,6 +39,14 @@ func WithPrompter(p iprompter) TestClientOpt {
 	}
 }
 
+// WithDefaultOwner is a test option to set the fallback owner lookup for the
+// test client.
+func WithDefaultOwner(f func() (string, error)) TestClientOpt {
+	return func(c *Client) {
+		c.defaultOwner = f
+	}
+}
+
 func NewTestClient(opts ...TestClientOpt) *Client {
 	apiClient := &hostScopedClient{
 		hostname: "github.com",
@@ -82,6 +90,23 @@ type Client struct {
 	apiClient graphqlClient
 	spinner   bool
 	prompter  iprompter
+
+	// defaultOwner, when set, resolves an owner login to fall back on when
+	// the caller does not provide one, e.g. from the repository in the
+	// current directory.
+	defaultOwner func() (string, error)
+
+	// ownerCache and projectCache remember lookups for the rest of the
+	// invocation so commands that resolve the same owner or project several
+	// times only query the API once.
+	ownerCache   map[string]*Owner
+	projectCache map[string]*Project
+}
+
+// SetDefaultOwner registers a fallback used by NewOwner to resolve the owner
+// when no login is provided.
+func (c *Client) SetDefaultOwner(f func() (string, error)) {
+	c.defaultOwner = f
 }
 
 const (
@@ -162,6 +187,18 @@ func (p Project) DetailedItems() map[string]interface{} {
 	}
 }
 
+// JSON field names available to the --json flag, matching the keys of the
+// corresponding ExportData methods.
+var (
+	ProjectJSONFields      = []string{"closed", "fields", "id", "items", "number", "owner", "public", "readme", "shortDescription", "title", "url"}
+	ProjectsJSONFields     = []string{"projects", "totalCount"}
+	ProjectItemJSONFields  = []string{"body", "id", "title", "type", "url"}
+	ProjectItemsJSONFields = []string{"items", "totalCount"}
+	FieldJSONFields        = []string{"description", "id", "name", "options", "type"}
+	FieldsJSONFields       = []string{"fields", "totalCount"}
+	CollaboratorJSONFields = []string{"login", "role", "type"}
+)
+
 func (p Project) ExportData(_ []string) map[string]interface{} {
 	return map[string]interface{}{
 		"number":           p.Number,
@@ -489,6 +526,20 @@ func (p ProjectItem) Repo() string {
 	return ""
 }
 
+// SingleSelectValueByName returns the item's single-select value for the named
+// field, matching the field name case-insensitively.
+func (p ProjectItem) SingleSelectValueByName(name string) string {
+	for _, v := range p.FieldValues.Nodes {
+		if v.Type != "ProjectV2ItemFieldSingleSelectValue" {
+			continue
+		}
+		if strings.EqualFold(v.ProjectV2ItemFieldSingleSelectValue.Field.Name(), name) {
+			return v.ProjectV2ItemFieldSingleSelectValue.Name
+		}
+	}
+	return ""
+}
+
 // URL is the URL of the project item. Note the draft issues do not have URLs
 func (p ProjectItem) URL() string {
 	switch p.Content.TypeName {
@@ -566,6 +617,79 @@ func (c *Client) ProjectItems(o *Owner, number int32, limit int) (*Project, erro
 	return project, nil
 }
 
+// ProjectItemsPaged fetches the items of a project page by page, calling emit
+// with each page as it arrives instead of accumulating them, so very large
+// projects can be exported without holding every item in memory. Unlike
+// ProjectItems, a limit of 0 means no limit. The project passed to emit has no
+// items of its own; it carries the fields and total count needed to serialize
+// a page.
+func (c *Client) ProjectItemsPaged(o *Owner, number int32, limit int, emit func(project *Project, items []ProjectItem) error) error {
+	// set first to the min of limit and LimitMax
+	first := LimitMax
+	if limit > 0 && limit < first {
+		first = limit
+	}
+
+	variables := map[string]interface{}{
+		"firstItems":  githubv4.Int(first),
+		"afterItems":  (*githubv4.String)(nil),
+		"firstFields": githubv4.Int(LimitMax),
+		"afterFields": (*githubv4.String)(nil),
+		"number":      githubv4.Int(number),
+	}
+
+	var query pager[ProjectItem]
+	var queryName string
+	switch o.Type {
+	case UserOwner:
+		variables["login"] = githubv4.String(o.Login)
+		query = &userOwnerWithItems{} // must be a pointer to work with graphql queries
+		queryName = "UserProjectWithItems"
+	case OrgOwner:
+		variables["login"] = githubv4.String(o.Login)
+		query = &orgOwnerWithItems{} // must be a pointer to work with graphql queries
+		queryName = "OrgProjectWithItems"
+	case ViewerOwner:
+		query = &viewerOwnerWithItems{} // must be a pointer to work with graphql queries
+		queryName = "ViewerProjectWithItems"
+	}
+	if err := c.doQuery(queryName, query, variables); err != nil {
+		return err
+	}
+
+	// detach the project from the query so fetching the next page does not
+	// overwrite what emit sees
+	project := *query.Project()
+	project.Items.Nodes = nil
+
+	count := len(query.Nodes())
+	if err := emit(&project, query.Nodes()); err != nil {
+		return err
+	}
+
+	hasNextPage := query.HasNextPage()
+	cursor := query.EndCursor()
+	for hasNextPage && (limit == 0 || count < limit) {
+		if limit > 0 && count+LimitMax > limit {
+			variables["firstItems"] = githubv4.Int(limit - count)
+		}
+
+		// set the cursor to the end of the last page
+		variables["afterItems"] = (*githubv4.String)(&cursor)
+		if err := c.doQuery(queryName, query, variables); err != nil {
+			return err
+		}
+
+		count += len(query.Nodes())
+		if err := emit(&project, query.Nodes()); err != nil {
+			return err
+		}
+		hasNextPage = query.HasNextPage()
+		cursor = query.EndCursor()
+	}
+	return nil
+}
+
 // pager is an interface for paginating over the attributes of a Project.
 type pager[N projectAttribute] interface {
 	HasNextPage() bool
@@ -720,20 +844,23 @@ func paginateAttributes[N projectAttribute](c *Client, p pager[N], variables map
 type ProjectField struct {
 	TypeName string `graphql:"__typename"`
 	Field    struct {
-		ID       string
-		Name     string
-		DataType string
+		ID          string
+		Name        string
+		DataType    string
+		Description string
 	} `graphql:"... on ProjectV2Field"`
 	IterationField struct {
-		ID       string
-		Name     string
-		DataType string
+		ID          string
+		Name        string
+		DataType    string
+		Description string
 	} `graphql:"... on ProjectV2IterationField"`
 	SingleSelectField struct {
-		ID       string
-		Name     string
-		DataType string
-		Options  []SingleSelectFieldOptions
+		ID          string
+		Name        string
+		DataType    string
+		Description string
+		Options     []SingleSelectFieldOptions
 	} `graphql:"... on ProjectV2SingleSelectField"`
 }
 
@@ -766,16 +893,50 @@ func (p ProjectField) Type() string {
 	return p.TypeName
 }
 
+// Description is the description of the project field.
+func (p ProjectField) Description() string {
+	if p.TypeName == "ProjectV2Field" {
+		return p.Field.Description
+	} else if p.TypeName == "ProjectV2IterationField" {
+		return p.IterationField.Description
+	} else if p.TypeName == "ProjectV2SingleSelectField" {
+		return p.SingleSelectField.Description
+	}
+	return ""
+}
+
+// DataType is the data type of the project field, such as TEXT or SINGLE_SELECT.
+func (p ProjectField) DataType() string {
+	if p.TypeName == "ProjectV2Field" {
+		return p.Field.DataType
+	} else if p.TypeName == "ProjectV2IterationField" {
+		return p.IterationField.DataType
+	} else if p.TypeName == "ProjectV2SingleSelectField" {
+		return p.SingleSelectField.DataType
+	}
+	return ""
+}
+
 type SingleSelectFieldOptions struct {
-	ID   string
-	Name string
+	ID          string
+	Name        string
+	Color       string
+	Description string
 }
 
 func (f SingleSelectFieldOptions) ExportData(_ []string) map[string]interface{} {
-	return map[string]interface{}{
+	v := map[string]interface{}{
 		"id":   f.ID,
 		"name": f.Name,
 	}
+	// Emulate omitempty
+	if f.Color != "" {
+		v["color"] = f.Color
+	}
+	if f.Description != "" {
+		v["description"] = f.Description
+	}
+	return v
 }
 
 func (p ProjectField) Options() []SingleSelectFieldOptions {
@@ -783,8 +944,10 @@ func (p ProjectField) Options() []SingleSelectFieldOptions {
 		var options []SingleSelectFieldOptions
 		for _, o := range p.SingleSelectField.Options {
 			options = append(options, SingleSelectFieldOptions{
-				ID:   o.ID,
-				Name: o.Name,
+				ID:          o.ID,
+				Name:        o.Name,
+				Color:       o.Color,
+				Description: o.Description,
 			})
 		}
 		return options
@@ -799,6 +962,9 @@ func (p ProjectField) ExportData(_ []string) map[string]interface{} {
 		"type": p.Type(),
 	}
 	// Emulate omitempty
+	if description := p.Description(); description != "" {
+		v["description"] = description
+	}
 	if opts := p.Options(); len(opts) != 0 {
 		options := make([]map[string]interface{}, len(opts))
 		for i, opt := range opts {
@@ -877,6 +1043,41 @@ func (c *Client) ProjectFields(o *Owner, number int32, limit int) (*Project, err
 	return project, nil
 }
 
+// fieldNodeQuery looks up a single field configuration by its node ID. The
+// iterations of an iteration field are included so that values can be
+// validated against them.
+type fieldNodeQuery struct {
+	Node struct {
+		ProjectField
+		Iterations struct {
+			Configuration struct {
+				Iterations          []ProjectIteration
+				CompletedIterations []ProjectIteration
+			}
+		} `graphql:"... on ProjectV2IterationField"`
+	} `graphql:"node(id: $id)"`
+}
+
+// ProjectFieldByID returns the field with the given node ID. For an iteration
+// field, the field's iterations, including completed ones, are also returned.
+func (c *Client) ProjectFieldByID(fieldID string) (*ProjectField, []ProjectIteration, error) {
+	query := &fieldNodeQuery{}
+	variables := map[string]interface{}{
+		"id": githubv4.ID(fieldID),
+	}
+
+	if err := c.doQuery("ProjectField", query, variables); err != nil {
+		return nil, nil, err
+	}
+
+	if query.Node.TypeName == "" {
+		return nil, nil, fmt.Errorf("field %q not found", fieldID)
+	}
+
+	iterations := append(query.Node.Iterations.Configuration.Iterations, query.Node.Iterations.Configuration.CompletedIterations...)
+	return &query.Node.ProjectField, iterations, nil
+}
+
 // viewerLogin is used to query the Login of the viewer.
 type viewerLogin struct {
 	Viewer struct {
@@ -1041,6 +1242,76 @@ type issueOrPullRequest struct {
 	} `graphql:"resource(url: $url)"`
 }
 
+// searchIssueOrPullRequestIDs is used to query the global ids of the issues
+// and pull requests matching a search query.
+type searchIssueOrPullRequestIDs struct {
+	Search struct {
+		IssueCount int
+		PageInfo   PageInfo
+		Nodes      []struct {
+			Typename string `graphql:"__typename"`
+			Issue    struct {
+				ID string
+			} `graphql:"... on Issue"`
+			PullRequest struct {
+				ID string
+			} `graphql:"... on PullRequest"`
+		}
+	} `graphql:"search(query: $query, type: ISSUE, first: $first, after: $after)"`
+}
+
+// SearchIssueOrPullRequestIDs returns the IDs of the issues and pull requests
+// matching the search query, up to limit, along with the total number of
+// matches.
+func (c *Client) SearchIssueOrPullRequestIDs(searchQuery string, limit int) ([]string, int, error) {
+	if limit == 0 {
+		limit = LimitDefault
+	}
+
+	first := LimitMax
+	if limit < first {
+		first = limit
+	}
+	variables := map[string]interface{}{
+		"query": githubv4.String(searchQuery),
+		"first": githubv4.Int(first),
+		"after": (*githubv4.String)(nil),
+	}
+
+	var ids []string
+	var total int
+	for {
+		query := &searchIssueOrPullRequestIDs{}
+		if err := c.doQuery("SearchIssueOrPullRequestIDs", query, variables); err != nil {
+			return nil, 0, err
+		}
+
+		total = query.Search.IssueCount
+		for _, node := range query.Search.Nodes {
+			switch node.Typename {
+			case "Issue":
+				ids = append(ids, node.Issue.ID)
+			case "PullRequest":
+				ids = append(ids, node.PullRequest.ID)
+			}
+		}
+
+		if !query.Search.PageInfo.HasNextPage || len(ids) >= limit {
+			break
+		}
+
+		if len(ids)+LimitMax > limit {
+			variables["first"] = githubv4.Int(limit - len(ids))
+		}
+		variables["after"] = githubv4.NewString(query.Search.PageInfo.EndCursor)
+	}
+
+	if len(ids) > limit {
+		ids = ids[:limit]
+	}
+	return ids, total, nil
+}
+
 // IssueOrPullRequestID returns the ID of the issue or pull request from a URL.
 func (c *Client) IssueOrPullRequestID(rawURL string) (string, error) {
 	uri, err := url.Parse(rawURL)
@@ -1182,20 +1453,36 @@ type Owner struct {
 // NewOwner creates a project Owner
 // If canPrompt is false, login is required as we cannot prompt for it.
 // If login is not empty, it is used to lookup the project owner.
-// If login is empty, interactive mode is used to select an owner.
-// from the current viewer and their organizations
+// The special login "@me" is the current user.
+// If login is empty, the owner of the repository in the current directory is
+// used when one can be determined, and interactive mode is used to select an
+// owner from the current viewer and their organizations otherwise.
+// Owners are cached per invocation, so resolving the same login again does
+// not query the API.
 func (c *Client) NewOwner(canPrompt bool, login string) (*Owner, error) {
+	if login == "" && c.defaultOwner != nil {
+		if inferred, err := c.defaultOwner(); err == nil {
+			login = inferred
+		}
+	}
+
+	if owner, ok := c.ownerCache[login]; ok && login != "" {
+		return owner, nil
+	}
+
 	if login != "" {
 		id, ownerType, err := c.OwnerIDAndType(login)
 		if err != nil {
 			return nil, err
 		}
 
-		return &Owner{
+		owner := &Owner{
 			Login: login,
 			Type:  ownerType,
 			ID:    id,
-		}, nil
+		}
+		c.cacheOwner(owner)
+		return owner, nil
 	}
 
 	if !canPrompt {
@@ -1218,11 +1505,20 @@ func (c *Client) NewOwner(canPrompt bool, login string) (*Owner, error) {
 	}
 
 	l := logins[answerIndex]
-	return &Owner{
+	owner := &Owner{
 		Login: l.Login,
 		Type:  l.Type,
 		ID:    l.ID,
-	}, nil
+	}
+	c.cacheOwner(owner)
+	return owner, nil
+}
+
+func (c *Client) cacheOwner(owner *Owner) {
+	if c.ownerCache == nil {
+		c.ownerCache = map[string]*Owner{}
+	}
+	c.ownerCache[owner.Login] = owner
 }
 
 // NewProject creates a project based on the owner and project number
@@ -1230,8 +1526,15 @@ func (c *Client) NewOwner(canPrompt bool, login string) (*Owner, error) {
 // if number is 0 it will prompt the user to select a project interactively
 // otherwise it will make a request to get the project by number
 // set `fields“ to true to get the project's field data
+// Projects looked up by number are cached per invocation, so resolving the
+// same project again does not query the API.
 func (c *Client) NewProject(canPrompt bool, o *Owner, number int32, fields bool) (*Project, error) {
 	if number != 0 {
+		cacheKey := fmt.Sprintf("%s/%s/%d/%t", o.Type, o.Login, number, fields)
+		if project, ok := c.projectCache[cacheKey]; ok {
+			return project, nil
+		}
+
 		variables := map[string]interface{}{
 			"number":      githubv4.Int(number),
 			"firstItems":  githubv4.Int(0),
@@ -1243,22 +1546,37 @@ func (c *Client) NewProject(canPrompt bool, o *Owner, number int32, fields bool)
 		if fields {
 			variables["firstFields"] = githubv4.Int(LimitMax)
 		}
+
+		var project *Project
 		if o.Type == UserOwner {
 			var query userOwner
 			variables["login"] = githubv4.String(o.Login)
-			err := c.doQuery("UserProject", &query, variables)
-			return &query.Owner.Project, err
+			if err := c.doQuery("UserProject", &query, variables); err != nil {
+				return nil, err
+			}
+			project = &query.Owner.Project
 		} else if o.Type == OrgOwner {
 			variables["login"] = githubv4.String(o.Login)
 			var query orgOwner
-			err := c.doQuery("OrgProject", &query, variables)
-			return &query.Owner.Project, err
+			if err := c.doQuery("OrgProject", &query, variables); err != nil {
+				return nil, err
+			}
+			project = &query.Owner.Project
 		} else if o.Type == ViewerOwner {
 			var query viewerOwner
-			err := c.doQuery("ViewerProject", &query, variables)
-			return &query.Owner.Project, err
+			if err := c.doQuery("ViewerProject", &query, variables); err != nil {
+				return nil, err
+			}
+			project = &query.Owner.Project
+		} else {
+			return nil, errors.New("unknown owner type")
+		}
+
+		if c.projectCache == nil {
+			c.projectCache = map[string]*Project{}
 		}
-		return nil, errors.New("unknown owner type")
+		c.projectCache[cacheKey] = project
+		return project, nil
 	}
 
 	if !canPrompt {
@@ -1445,6 +1763,99 @@ func (c *Client) UnlinkProjectFromTeam(projectID string, teamID string) error {
 	return c.Mutate("UnlinkProjectV2FromTeam", &mutation, variables)
 }
 
+// ProjectCollaborator is a user or team with a role on a project.
+type ProjectCollaborator struct {
+	TypeName string `graphql:"__typename"`
+	Role     string
+	User     struct {
+		Login string
+	} `graphql:"... on User"`
+	Team struct {
+		Slug string
+	} `graphql:"... on Team"`
+}
+
+// Login returns the login of a user collaborator or the slug of a team collaborator.
+func (c ProjectCollaborator) Login() string {
+	if c.TypeName == "Team" {
+		return c.Team.Slug
+	}
+	return c.User.Login
+}
+
+func (c *ProjectCollaborator) ExportData(_ []string) map[string]interface{} {
+	return map[string]interface{}{
+		"type":  c.TypeName,
+		"login": c.Login(),
+		"role":  c.Role,
+	}
+}
+
+type projectCollaboratorsQuery struct {
+	Node struct {
+		Project struct {
+			Collaborators struct {
+				PageInfo PageInfo
+				Nodes    []ProjectCollaborator
+			} `graphql:"collaborators(first: $first, after: $after)"`
+		} `graphql:"... on ProjectV2"`
+	} `graphql:"node(id: $id)"`
+}
+
+// ProjectCollaborators returns the users and teams with direct access to the project.
+// If limit is 0, the default limit is used.
+func (c *Client) ProjectCollaborators(projectID string, limit int) ([]ProjectCollaborator, error) {
+	if limit == 0 {
+		limit = LimitDefault
+	}
+
+	// set first to the min of limit and LimitMax
+	first := LimitMax
+	if limit < first {
+		first = limit
+	}
+	variables := map[string]interface{}{
+		"id":    githubv4.ID(projectID),
+		"first": githubv4.Int(first),
+		"after": (*githubv4.String)(nil),
+	}
+
+	var collaborators []ProjectCollaborator
+	for {
+		var query projectCollaboratorsQuery
+		if err := c.doQuery("ProjectCollaborators", &query, variables); err != nil {
+			return nil, err
+		}
+		collaborators = append(collaborators, query.Node.Project.Collaborators.Nodes...)
+		if len(collaborators) >= limit {
+			return collaborators[:limit], nil
+		}
+		if !query.Node.Project.Collaborators.PageInfo.HasNextPage {
+			return collaborators, nil
+		}
+		variables["after"] = githubv4.NewString(query.Node.Project.Collaborators.PageInfo.EndCursor)
+	}
+}
+
+type updateProjectCollaboratorsMutation struct {
+	UpdateProjectV2Collaborators struct {
+		ClientMutationId string `graphql:"clientMutationId"`
+	} `graphql:"updateProjectV2Collaborators(input:$input)"`
+}
+
+// UpdateProjectCollaborators grants or revokes project roles for users and teams.
+func (c *Client) UpdateProjectCollaborators(projectID string, collaborators []githubv4.ProjectV2Collaborator) error {
+	var mutation updateProjectCollaboratorsMutation
+	variables := map[string]interface{}{
+		"input": githubv4.UpdateProjectV2CollaboratorsInput{
+			ProjectID:     githubv4.ID(projectID),
+			Collaborators: collaborators,
+		},
+	}
+
+	return c.Mutate("UpdateProjectV2Collaborators", &mutation, variables)
+}
+
 func handleError(err error) error {
 	var gerr api.GraphQLError
 	if errors.As(err, &gerr) {
@@ -1542,17 +1953,24 @@ func projectFieldValueData(v FieldValueNodes) interface{} {
 
 // serialize creates a map from field to field values
 func serializeProjectWithItems(project *Project) []map[string]interface{} {
+	return project.SerializedItems(project.Items.Nodes)
+}
+
+// SerializedItems returns the given items in the shape DetailedItems uses,
+// resolving field value names against the project's fields. It lets callers
+// that page through a project's items serialize one page at a time.
+func (p *Project) SerializedItems(items []ProjectItem) []map[string]interface{} {
 	fields := make(map[string]string)
 
 	// make a map of fields by ID
-	for _, f := range project.Fields.Nodes {
+	for _, f := range p.Fields.Nodes {
 		fields[f.ID()] = camelCase(f.Name())
 	}
 	itemsSlice := make([]map[string]interface{}, 0)
 
 	// for each value, look up the name by ID
 	// and set the value to the field value
-	for _, i := range project.Items.Nodes {
+	for _, i := range items {
 		o := make(map[string]interface{})
 		o["id"] = i.Id
 		if projectItem := i.DetailedItem(); projectItem != nil {
@@ -164,6 +164,107 @@ func TestProjectItems_NoLimit(t *testing.T) {
 	assert.Len(t, project.Items.Nodes, 3)
 }
 
+func TestProjectItemsPaged_NoLimit(t *testing.T) {
+	defer gock.Off()
+	gock.Observe(gock.DumpRequest)
+
+	// list first page of project items
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		JSON(map[string]interface{}{
+			"query": "query UserProjectWithItems.*",
+			"variables": map[string]interface{}{
+				"firstItems":  LimitMax,
+				"afterItems":  nil,
+				"firstFields": LimitMax,
+				"afterFields": nil,
+				"login":       "monalisa",
+				"number":      1,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"items": map[string]interface{}{
+							"nodes": []map[string]interface{}{
+								{
+									"id": "issue ID",
+								},
+								{
+									"id": "pull request ID",
+								},
+							},
+							"pageInfo": map[string]interface{}{
+								"hasNextPage": true,
+								"endCursor":   "cursor 1",
+							},
+						},
+					},
+				},
+			},
+		})
+
+	// list second page of project items
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		JSON(map[string]interface{}{
+			"query": "query UserProjectWithItems.*",
+			"variables": map[string]interface{}{
+				"firstItems":  LimitMax,
+				"afterItems":  "cursor 1",
+				"firstFields": LimitMax,
+				"afterFields": nil,
+				"login":       "monalisa",
+				"number":      1,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"items": map[string]interface{}{
+							"nodes": []map[string]interface{}{
+								{
+									"id": "draft issue ID",
+								},
+							},
+							"pageInfo": map[string]interface{}{
+								"hasNextPage": false,
+								"endCursor":   "cursor 2",
+							},
+						},
+					},
+				},
+			},
+		})
+
+	client := NewTestClient()
+
+	owner := &Owner{
+		Type:  "USER",
+		Login: "monalisa",
+		ID:    "user ID",
+	}
+	var pages [][]string
+	err := client.ProjectItemsPaged(owner, 1, 0, func(project *Project, items []ProjectItem) error {
+		assert.Empty(t, project.Items.Nodes)
+		var ids []string
+		for _, item := range items {
+			ids = append(ids, item.Id)
+		}
+		pages = append(pages, ids)
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, [][]string{
+		{"issue ID", "pull request ID"},
+		{"draft issue ID"},
+	}, pages)
+}
+
 func TestProjectFields_LowerLimit(t *testing.T) {
 
 	defer gock.Off()
@@ -365,6 +466,128 @@ func TestNewOwner_nonTTY(t *testing.T) {
 
 }
 
+func TestNewOwner_DefaultOwner(t *testing.T) {
+	defer gock.Off()
+
+	// get org ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query UserOrgOwner.*",
+			"variables": map[string]interface{}{
+				"login": "github",
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"organization": map[string]interface{}{
+					"id": "an ID",
+				},
+			},
+			"errors": []interface{}{
+				map[string]interface{}{
+					"type": "NOT_FOUND",
+					"path": []string{"user"},
+				},
+			},
+		})
+
+	client := NewTestClient(WithDefaultOwner(func() (string, error) {
+		return "github", nil
+	}))
+
+	owner, err := client.NewOwner(false, "")
+	assert.NoError(t, err)
+	assert.Equal(t, "github", owner.Login)
+	assert.Equal(t, OrgOwner, owner.Type)
+	assert.Equal(t, "an ID", owner.ID)
+}
+
+func TestNewOwner_Cached(t *testing.T) {
+	defer gock.Off()
+
+	// get user ID, only expected to be queried once
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query UserOrgOwner.*",
+			"variables": map[string]interface{}{
+				"login": "monalisa",
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"id": "an ID",
+				},
+			},
+			"errors": []interface{}{
+				map[string]interface{}{
+					"type": "NOT_FOUND",
+					"path": []string{"organization"},
+				},
+			},
+		})
+
+	client := NewTestClient()
+
+	owner, err := client.NewOwner(false, "monalisa")
+	assert.NoError(t, err)
+
+	cached, err := client.NewOwner(false, "monalisa")
+	assert.NoError(t, err)
+	assert.Equal(t, owner, cached)
+}
+
+func TestNewProject_Cached(t *testing.T) {
+	defer gock.Off()
+
+	// get project ID, only expected to be queried once
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query UserProject.*",
+			"variables": map[string]interface{}{
+				"login":       "monalisa",
+				"number":      1,
+				"firstItems":  0,
+				"afterItems":  nil,
+				"firstFields": 0,
+				"afterFields": nil,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"id": "project ID",
+					},
+				},
+			},
+		})
+
+	client := NewTestClient()
+
+	owner := &Owner{
+		Type:  "USER",
+		Login: "monalisa",
+		ID:    "user ID",
+	}
+	project, err := client.NewProject(false, owner, 1, false)
+	assert.NoError(t, err)
+	assert.Equal(t, "project ID", project.ID)
+
+	cached, err := client.NewProject(false, owner, 1, false)
+	assert.NoError(t, err)
+	assert.Equal(t, project, cached)
+}
+
 func TestProjectItems_FieldTitle(t *testing.T) {
 	defer gock.Off()
 	gock.Observe(gock.DumpRequest)
@@ -0,0 +1,162 @@
+package queries
+
+import (
+	"github.com/shurcooL/githubv4"
+)
+
+// ProjectStatusUpdate is a status update posted to a project.
+type ProjectStatusUpdate struct {
+	ID         string
+	Status     string
+	Body       string
+	StartDate  string
+	TargetDate string
+	CreatedAt  string
+	Creator    struct {
+		Login string
+	}
+}
+
+// JSON field names available to the --json flag, matching the keys of the
+// corresponding ExportData methods.
+var (
+	StatusUpdateJSONFields  = []string{"body", "createdAt", "creator", "id", "startDate", "status", "targetDate"}
+	StatusUpdatesJSONFields = []string{"statusUpdates", "totalCount"}
+)
+
+func (s ProjectStatusUpdate) ExportData(_ []string) map[string]interface{} {
+	return map[string]interface{}{
+		"id":         s.ID,
+		"status":     s.Status,
+		"body":       s.Body,
+		"startDate":  s.StartDate,
+		"targetDate": s.TargetDate,
+		"createdAt":  s.CreatedAt,
+		"creator": map[string]interface{}{
+			"login": s.Creator.Login,
+		},
+	}
+}
+
+// ProjectStatusUpdates is a connection of project status updates.
+type ProjectStatusUpdates struct {
+	TotalCount int
+	Nodes      []ProjectStatusUpdate
+	PageInfo   PageInfo
+}
+
+func (p ProjectStatusUpdates) ExportData(_ []string) map[string]interface{} {
+	statusUpdates := make([]map[string]interface{}, len(p.Nodes))
+	for i := range p.Nodes {
+		statusUpdates[i] = p.Nodes[i].ExportData(nil)
+	}
+	return map[string]interface{}{
+		"statusUpdates": statusUpdates,
+		"totalCount":    p.TotalCount,
+	}
+}
+
+// ProjectV2StatusUpdateStatus is the status of a project status update. It is
+// not yet defined in githubv4.
+type ProjectV2StatusUpdateStatus string
+
+// CreateProjectV2StatusUpdateInput is the input to the
+// createProjectV2StatusUpdate mutation. It is not yet defined in githubv4.
+type CreateProjectV2StatusUpdateInput struct {
+	ProjectID  githubv4.ID                  `json:"projectId"`
+	Body       *githubv4.String             `json:"body,omitempty"`
+	StartDate  *githubv4.Date               `json:"startDate,omitempty"`
+	TargetDate *githubv4.Date               `json:"targetDate,omitempty"`
+	Status     *ProjectV2StatusUpdateStatus `json:"status,omitempty"`
+}
+
+// projectWithStatusUpdates queries only the status updates connection of a
+// project.
+type projectWithStatusUpdates struct {
+	StatusUpdates ProjectStatusUpdates `graphql:"statusUpdates(first: $firstStatusUpdates, after: $afterStatusUpdates)"`
+}
+
+type userOwnerWithStatusUpdates struct {
+	Owner struct {
+		Project projectWithStatusUpdates `graphql:"projectV2(number: $number)"`
+	} `graphql:"user(login: $login)"`
+}
+
+type orgOwnerWithStatusUpdates struct {
+	Owner struct {
+		Project projectWithStatusUpdates `graphql:"projectV2(number: $number)"`
+	} `graphql:"organization(login: $login)"`
+}
+
+type viewerOwnerWithStatusUpdates struct {
+	Owner struct {
+		Project projectWithStatusUpdates `graphql:"projectV2(number: $number)"`
+	} `graphql:"viewer"`
+}
+
+// ProjectStatusUpdates returns the status updates of a project. If the
+// OwnerType is VIEWER, no login is required. If limit is 0, the default limit
+// is used.
+func (c *Client) ProjectStatusUpdates(o *Owner, number int32, limit int) (*ProjectStatusUpdates, error) {
+	if limit == 0 {
+		limit = LimitDefault
+	}
+
+	first := LimitMax
+	if limit < first {
+		first = limit
+	}
+	variables := map[string]interface{}{
+		"firstStatusUpdates": githubv4.Int(first),
+		"afterStatusUpdates": (*githubv4.String)(nil),
+		"number":             githubv4.Int(number),
+	}
+
+	var project *projectWithStatusUpdates
+	var queryName string
+	var query interface{}
+	switch o.Type {
+	case UserOwner:
+		variables["login"] = githubv4.String(o.Login)
+		q := &userOwnerWithStatusUpdates{} // must be a pointer to work with graphql queries
+		project = &q.Owner.Project
+		query = q
+		queryName = "UserProjectWithStatusUpdates"
+	case OrgOwner:
+		variables["login"] = githubv4.String(o.Login)
+		q := &orgOwnerWithStatusUpdates{} // must be a pointer to work with graphql queries
+		project = &q.Owner.Project
+		query = q
+		queryName = "OrgProjectWithStatusUpdates"
+	case ViewerOwner:
+		q := &viewerOwnerWithStatusUpdates{} // must be a pointer to work with graphql queries
+		project = &q.Owner.Project
+		query = q
+		queryName = "ViewerProjectWithStatusUpdates"
+	}
+
+	statusUpdates := ProjectStatusUpdates{}
+	for {
+		if err := c.doQuery(queryName, query, variables); err != nil {
+			return &statusUpdates, err
+		}
+
+		statusUpdates.TotalCount = project.StatusUpdates.TotalCount
+		statusUpdates.Nodes = append(statusUpdates.Nodes, project.StatusUpdates.Nodes...)
+
+		if !project.StatusUpdates.PageInfo.HasNextPage || len(statusUpdates.Nodes) >= limit {
+			break
+		}
+
+		if len(statusUpdates.Nodes)+LimitMax > limit {
+			variables["firstStatusUpdates"] = githubv4.Int(limit - len(statusUpdates.Nodes))
+		}
+		cursor := project.StatusUpdates.PageInfo.EndCursor
+		variables["afterStatusUpdates"] = githubv4.NewString(cursor)
+	}
+
+	if len(statusUpdates.Nodes) > limit {
+		statusUpdates.Nodes = statusUpdates.Nodes[:limit]
+	}
+	return &statusUpdates, nil
+}
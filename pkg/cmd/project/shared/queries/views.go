@@ -0,0 +1,199 @@
+package queries
+
+import (
+	"strings"
+
+	"github.com/shurcooL/githubv4"
+)
+
+// ProjectV2View is a view of a project, such as a board, table, or roadmap.
+type ProjectV2View struct {
+	ID     string
+	Number int32
+	Name   string
+	Layout string
+	Fields struct {
+		TotalCount int
+		Nodes      []struct {
+			Field struct {
+				ID   string
+				Name string
+			} `graphql:"... on ProjectV2FieldCommon"`
+		}
+	} `graphql:"fields(first: 100)"`
+	GroupByFields struct {
+		Nodes []struct {
+			Field struct {
+				ID   string
+				Name string
+			} `graphql:"... on ProjectV2FieldCommon"`
+		}
+	} `graphql:"groupByFields(first: 10)"`
+	SortByFields struct {
+		Nodes []struct {
+			Direction string
+			Field     struct {
+				Common struct {
+					ID   string
+					Name string
+				} `graphql:"... on ProjectV2FieldCommon"`
+			}
+		}
+	} `graphql:"sortByFields(first: 10)"`
+}
+
+// LayoutName returns a human-readable name for the view layout.
+func (v ProjectV2View) LayoutName() string {
+	return strings.ToLower(strings.TrimSuffix(v.Layout, "_LAYOUT"))
+}
+
+// VisibleFieldNames returns the names of the fields shown in the view.
+func (v ProjectV2View) VisibleFieldNames() []string {
+	names := make([]string, len(v.Fields.Nodes))
+	for i, n := range v.Fields.Nodes {
+		names[i] = n.Field.Name
+	}
+	return names
+}
+
+// GroupByFieldNames returns the names of the fields the view groups by.
+func (v ProjectV2View) GroupByFieldNames() []string {
+	names := make([]string, len(v.GroupByFields.Nodes))
+	for i, n := range v.GroupByFields.Nodes {
+		names[i] = n.Field.Name
+	}
+	return names
+}
+
+// JSON field names available to the --json flag, matching the keys of the
+// corresponding ExportData methods.
+var (
+	ViewJSONFields  = []string{"groupBy", "id", "layout", "name", "number", "sortBy", "visibleFields"}
+	ViewsJSONFields = []string{"totalCount", "views"}
+)
+
+func (v ProjectV2View) ExportData(_ []string) map[string]interface{} {
+	sortBy := make([]map[string]interface{}, len(v.SortByFields.Nodes))
+	for i, n := range v.SortByFields.Nodes {
+		sortBy[i] = map[string]interface{}{
+			"field":     n.Field.Common.Name,
+			"direction": n.Direction,
+		}
+	}
+	return map[string]interface{}{
+		"id":            v.ID,
+		"number":        v.Number,
+		"name":          v.Name,
+		"layout":        v.LayoutName(),
+		"visibleFields": v.VisibleFieldNames(),
+		"groupBy":       v.GroupByFieldNames(),
+		"sortBy":        sortBy,
+	}
+}
+
+// ProjectViews is a connection of project views.
+type ProjectViews struct {
+	TotalCount int
+	Nodes      []ProjectV2View
+	PageInfo   PageInfo
+}
+
+func (p ProjectViews) ExportData(_ []string) map[string]interface{} {
+	views := make([]map[string]interface{}, len(p.Nodes))
+	for i := range p.Nodes {
+		views[i] = p.Nodes[i].ExportData(nil)
+	}
+	return map[string]interface{}{
+		"views":      views,
+		"totalCount": p.TotalCount,
+	}
+}
+
+// projectWithViews queries only the views connection of a project.
+type projectWithViews struct {
+	Views ProjectViews `graphql:"views(first: $firstViews, after: $afterViews)"`
+}
+
+type userOwnerWithViews struct {
+	Owner struct {
+		Project projectWithViews `graphql:"projectV2(number: $number)"`
+	} `graphql:"user(login: $login)"`
+}
+
+type orgOwnerWithViews struct {
+	Owner struct {
+		Project projectWithViews `graphql:"projectV2(number: $number)"`
+	} `graphql:"organization(login: $login)"`
+}
+
+type viewerOwnerWithViews struct {
+	Owner struct {
+		Project projectWithViews `graphql:"projectV2(number: $number)"`
+	} `graphql:"viewer"`
+}
+
+// ProjectViews returns the views of a project. If the OwnerType is VIEWER, no
+// login is required. If limit is 0, the default limit is used.
+func (c *Client) ProjectViews(o *Owner, number int32, limit int) (*ProjectViews, error) {
+	if limit == 0 {
+		limit = LimitDefault
+	}
+
+	first := LimitMax
+	if limit < first {
+		first = limit
+	}
+	variables := map[string]interface{}{
+		"firstViews": githubv4.Int(first),
+		"afterViews": (*githubv4.String)(nil),
+		"number":     githubv4.Int(number),
+	}
+
+	var project *projectWithViews
+	var queryName string
+	var query interface{}
+	switch o.Type {
+	case UserOwner:
+		variables["login"] = githubv4.String(o.Login)
+		q := &userOwnerWithViews{} // must be a pointer to work with graphql queries
+		project = &q.Owner.Project
+		query = q
+		queryName = "UserProjectWithViews"
+	case OrgOwner:
+		variables["login"] = githubv4.String(o.Login)
+		q := &orgOwnerWithViews{} // must be a pointer to work with graphql queries
+		project = &q.Owner.Project
+		query = q
+		queryName = "OrgProjectWithViews"
+	case ViewerOwner:
+		q := &viewerOwnerWithViews{} // must be a pointer to work with graphql queries
+		project = &q.Owner.Project
+		query = q
+		queryName = "ViewerProjectWithViews"
+	}
+
+	views := ProjectViews{}
+	for {
+		if err := c.doQuery(queryName, query, variables); err != nil {
+			return &views, err
+		}
+
+		views.TotalCount = project.Views.TotalCount
+		views.Nodes = append(views.Nodes, project.Views.Nodes...)
+
+		if !project.Views.PageInfo.HasNextPage || len(views.Nodes) >= limit {
+			break
+		}
+
+		if len(views.Nodes)+LimitMax > limit {
+			variables["firstViews"] = githubv4.Int(limit - len(views.Nodes))
+		}
+		cursor := project.Views.PageInfo.EndCursor
+		variables["afterViews"] = githubv4.NewString(cursor)
+	}
+
+	if len(views.Nodes) > limit {
+		views.Nodes = views.Nodes[:limit]
+	}
+	return &views, nil
+}
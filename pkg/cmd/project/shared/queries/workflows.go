@@ -0,0 +1,165 @@
+package queries
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/shurcooL/githubv4"
+)
+
+// ProjectWorkflow is a built-in automation of a project, such as auto-add or
+// item closed.
+type ProjectWorkflow struct {
+	ID      string
+	Number  int32
+	Name    string
+	Enabled bool
+}
+
+// JSON field names available to the --json flag, matching the keys of the
+// corresponding ExportData methods.
+var (
+	WorkflowJSONFields  = []string{"enabled", "id", "name", "number"}
+	WorkflowsJSONFields = []string{"totalCount", "workflows"}
+)
+
+func (w ProjectWorkflow) ExportData(_ []string) map[string]interface{} {
+	return map[string]interface{}{
+		"id":      w.ID,
+		"number":  w.Number,
+		"name":    w.Name,
+		"enabled": w.Enabled,
+	}
+}
+
+// ProjectWorkflows is a connection of project workflows.
+type ProjectWorkflows struct {
+	TotalCount int
+	Nodes      []ProjectWorkflow
+	PageInfo   PageInfo
+}
+
+func (p ProjectWorkflows) ExportData(_ []string) map[string]interface{} {
+	workflows := make([]map[string]interface{}, len(p.Nodes))
+	for i := range p.Nodes {
+		workflows[i] = p.Nodes[i].ExportData(nil)
+	}
+	return map[string]interface{}{
+		"workflows":  workflows,
+		"totalCount": p.TotalCount,
+	}
+}
+
+// UpdateProjectV2WorkflowInput is the input to the updateProjectV2Workflow
+// mutation. It is not yet defined in githubv4.
+type UpdateProjectV2WorkflowInput struct {
+	WorkflowID githubv4.ID      `json:"workflowId"`
+	Enabled    githubv4.Boolean `json:"enabled"`
+}
+
+// projectWithWorkflows queries only the workflows connection of a project.
+type projectWithWorkflows struct {
+	Workflows ProjectWorkflows `graphql:"workflows(first: $firstWorkflows, after: $afterWorkflows)"`
+}
+
+type userOwnerWithWorkflows struct {
+	Owner struct {
+		Project projectWithWorkflows `graphql:"projectV2(number: $number)"`
+	} `graphql:"user(login: $login)"`
+}
+
+type orgOwnerWithWorkflows struct {
+	Owner struct {
+		Project projectWithWorkflows `graphql:"projectV2(number: $number)"`
+	} `graphql:"organization(login: $login)"`
+}
+
+type viewerOwnerWithWorkflows struct {
+	Owner struct {
+		Project projectWithWorkflows `graphql:"projectV2(number: $number)"`
+	} `graphql:"viewer"`
+}
+
+// ProjectWorkflows returns the built-in workflows of a project. If the
+// OwnerType is VIEWER, no login is required. If limit is 0, the default limit
+// is used.
+func (c *Client) ProjectWorkflows(o *Owner, number int32, limit int) (*ProjectWorkflows, error) {
+	if limit == 0 {
+		limit = LimitDefault
+	}
+
+	first := LimitMax
+	if limit < first {
+		first = limit
+	}
+	variables := map[string]interface{}{
+		"firstWorkflows": githubv4.Int(first),
+		"afterWorkflows": (*githubv4.String)(nil),
+		"number":         githubv4.Int(number),
+	}
+
+	var project *projectWithWorkflows
+	var queryName string
+	var query interface{}
+	switch o.Type {
+	case UserOwner:
+		variables["login"] = githubv4.String(o.Login)
+		q := &userOwnerWithWorkflows{} // must be a pointer to work with graphql queries
+		project = &q.Owner.Project
+		query = q
+		queryName = "UserProjectWithWorkflows"
+	case OrgOwner:
+		variables["login"] = githubv4.String(o.Login)
+		q := &orgOwnerWithWorkflows{} // must be a pointer to work with graphql queries
+		project = &q.Owner.Project
+		query = q
+		queryName = "OrgProjectWithWorkflows"
+	case ViewerOwner:
+		q := &viewerOwnerWithWorkflows{} // must be a pointer to work with graphql queries
+		project = &q.Owner.Project
+		query = q
+		queryName = "ViewerProjectWithWorkflows"
+	}
+
+	workflows := ProjectWorkflows{}
+	for {
+		if err := c.doQuery(queryName, query, variables); err != nil {
+			return &workflows, err
+		}
+
+		workflows.TotalCount = project.Workflows.TotalCount
+		workflows.Nodes = append(workflows.Nodes, project.Workflows.Nodes...)
+
+		if !project.Workflows.PageInfo.HasNextPage || len(workflows.Nodes) >= limit {
+			break
+		}
+
+		if len(workflows.Nodes)+LimitMax > limit {
+			variables["firstWorkflows"] = githubv4.Int(limit - len(workflows.Nodes))
+		}
+		cursor := project.Workflows.PageInfo.EndCursor
+		variables["afterWorkflows"] = githubv4.NewString(cursor)
+	}
+
+	if len(workflows.Nodes) > limit {
+		workflows.Nodes = workflows.Nodes[:limit]
+	}
+	return &workflows, nil
+}
+
+// ProjectWorkflowByName returns the workflow in the project whose name
+// matches, ignoring case.
+func (c *Client) ProjectWorkflowByName(o *Owner, number int32, name string) (*ProjectWorkflow, error) {
+	workflows, err := c.ProjectWorkflows(o, number, LimitMax)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range workflows.Nodes {
+		if strings.EqualFold(workflows.Nodes[i].Name, name) {
+			return &workflows.Nodes[i], nil
+		}
+	}
+
+	return nil, fmt.Errorf("workflow %q not found in project %d", name, number)
+}
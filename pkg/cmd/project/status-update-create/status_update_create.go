@@ -0,0 +1,164 @@
+package statusupdatecreate
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/pkg/cmd/project/shared/client"
+	"github.com/cli/cli/v2/pkg/cmd/project/shared/queries"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/shurcooL/githubv4"
+	"github.com/spf13/cobra"
+)
+
+type createStatusUpdateOpts struct {
+	owner      string
+	number     int32
+	projectID  string
+	body       string
+	startDate  string
+	targetDate string
+	status     string
+	exporter   cmdutil.Exporter
+}
+
+type createStatusUpdateConfig struct {
+	client *queries.Client
+	opts   createStatusUpdateOpts
+	io     *iostreams.IOStreams
+}
+
+type createProjectStatusUpdateMutation struct {
+	CreateProjectV2StatusUpdate struct {
+		StatusUpdate queries.ProjectStatusUpdate `graphql:"statusUpdate"`
+	} `graphql:"createProjectV2StatusUpdate(input:$input)"`
+}
+
+func NewCmdCreateStatusUpdate(f *cmdutil.Factory, runF func(config createStatusUpdateConfig) error) *cobra.Command {
+	opts := createStatusUpdateOpts{}
+	createStatusUpdateCmd := &cobra.Command{
+		Short: "Create a status update in a project",
+		Use:   "status-update-create [<number>]",
+		Example: heredoc.Doc(`
+			# post an on-track status update to the current user's project "1"
+			gh project status-update-create 1 --owner "@me" --status on_track --body "Shipping on schedule"
+
+			# post a status update with start and target dates
+			gh project status-update-create 1 --owner "@me" --status at_risk --start-date 2025-01-01 --target-date 2025-03-31 --body "Blocked on review"
+		`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := client.New(f)
+			if err != nil {
+				return err
+			}
+
+			if len(args) == 1 {
+				num, err := strconv.ParseInt(args[0], 10, 32)
+				if err != nil {
+					return cmdutil.FlagErrorf("invalid number: %v", args[0])
+				}
+				opts.number = int32(num)
+			}
+
+			config := createStatusUpdateConfig{
+				client: client,
+				opts:   opts,
+				io:     f.IOStreams,
+			}
+
+			// allow testing of the command without actually running it
+			if runF != nil {
+				return runF(config)
+			}
+			return runCreateStatusUpdate(config)
+		},
+	}
+
+	createStatusUpdateCmd.Flags().StringVar(&opts.owner, "owner", "", "Login of the owner. Use \"@me\" for the current user. Defaults to the owner of the current directory's repository.")
+	createStatusUpdateCmd.Flags().StringVar(&opts.body, "body", "", "Body for the status update")
+	createStatusUpdateCmd.Flags().StringVar(&opts.startDate, "start-date", "", "Start date for the status update (YYYY-MM-DD)")
+	createStatusUpdateCmd.Flags().StringVar(&opts.targetDate, "target-date", "", "Target date for the status update (YYYY-MM-DD)")
+	cmdutil.StringEnumFlag(createStatusUpdateCmd, &opts.status, "status", "", "", []string{"inactive", "on_track", "at_risk", "off_track", "complete"}, "Status of the project")
+	cmdutil.AddFormatAndJSONFlags(createStatusUpdateCmd, &opts.exporter, queries.StatusUpdateJSONFields)
+
+	_ = createStatusUpdateCmd.MarkFlagRequired("body")
+
+	return createStatusUpdateCmd
+}
+
+func runCreateStatusUpdate(config createStatusUpdateConfig) error {
+	canPrompt := config.io.CanPrompt()
+	owner, err := config.client.NewOwner(canPrompt, config.opts.owner)
+	if err != nil {
+		return err
+	}
+
+	project, err := config.client.NewProject(canPrompt, owner, config.opts.number, false)
+	if err != nil {
+		return err
+	}
+	config.opts.projectID = project.ID
+	config.opts.number = project.Number
+
+	query, variables, err := createStatusUpdateArgs(config)
+	if err != nil {
+		return err
+	}
+
+	err = config.client.Mutate("CreateStatusUpdate", query, variables)
+	if err != nil {
+		return err
+	}
+
+	if config.opts.exporter != nil {
+		return config.opts.exporter.Write(config.io, query.CreateProjectV2StatusUpdate.StatusUpdate)
+	}
+
+	return printResults(config, query.CreateProjectV2StatusUpdate.StatusUpdate)
+}
+
+func createStatusUpdateArgs(config createStatusUpdateConfig) (*createProjectStatusUpdateMutation, map[string]interface{}, error) {
+	input := queries.CreateProjectV2StatusUpdateInput{
+		ProjectID: githubv4.ID(config.opts.projectID),
+		Body:      githubv4.NewString(githubv4.String(config.opts.body)),
+	}
+
+	if config.opts.startDate != "" {
+		date, err := time.Parse("2006-01-02", config.opts.startDate)
+		if err != nil {
+			return nil, nil, cmdutil.FlagErrorf("invalid start date %q: date must be in YYYY-MM-DD format", config.opts.startDate)
+		}
+		input.StartDate = &githubv4.Date{Time: date}
+	}
+
+	if config.opts.targetDate != "" {
+		date, err := time.Parse("2006-01-02", config.opts.targetDate)
+		if err != nil {
+			return nil, nil, cmdutil.FlagErrorf("invalid target date %q: date must be in YYYY-MM-DD format", config.opts.targetDate)
+		}
+		input.TargetDate = &githubv4.Date{Time: date}
+	}
+
+	if config.opts.status != "" {
+		status := queries.ProjectV2StatusUpdateStatus(strings.ToUpper(config.opts.status))
+		input.Status = &status
+	}
+
+	return &createProjectStatusUpdateMutation{}, map[string]interface{}{
+		"input": input,
+	}, nil
+}
+
+func printResults(config createStatusUpdateConfig, statusUpdate queries.ProjectStatusUpdate) error {
+	if !config.io.IsStdoutTTY() {
+		return nil
+	}
+
+	_, err := fmt.Fprintf(config.io.Out, "Created status update in project %d\n", config.opts.number)
+	return err
+}
@@ -0,0 +1,227 @@
+package statusupdatecreate
+
+import (
+	"testing"
+
+	"github.com/cli/cli/v2/pkg/cmd/project/shared/queries"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestNewCmdCreateStatusUpdate(t *testing.T) {
+	tests := []struct {
+		name          string
+		cli           string
+		wants         createStatusUpdateOpts
+		wantsErr      bool
+		wantsErrMsg   string
+		wantsExporter bool
+	}{
+		{
+			name:        "missing-body",
+			cli:         "",
+			wantsErr:    true,
+			wantsErrMsg: "required flag(s) \"body\" not set",
+		},
+		{
+			name:        "not-a-number",
+			cli:         "x --body b",
+			wantsErr:    true,
+			wantsErrMsg: "invalid number: x",
+		},
+		{
+			name:        "invalid-status",
+			cli:         "--body b --status bad",
+			wantsErr:    true,
+			wantsErrMsg: "invalid argument \"bad\" for \"--status\" flag: valid values are {inactive|on_track|at_risk|off_track|complete}",
+		},
+		{
+			name: "body",
+			cli:  "--body b",
+			wants: createStatusUpdateOpts{
+				body: "b",
+			},
+		},
+		{
+			name: "number",
+			cli:  "123 --body b",
+			wants: createStatusUpdateOpts{
+				number: 123,
+				body:   "b",
+			},
+		},
+		{
+			name: "all-flags",
+			cli:  "--owner monalisa --body b --status on_track --start-date 2025-01-01 --target-date 2025-03-31",
+			wants: createStatusUpdateOpts{
+				owner:      "monalisa",
+				body:       "b",
+				status:     "on_track",
+				startDate:  "2025-01-01",
+				targetDate: "2025-03-31",
+			},
+		},
+		{
+			name: "json",
+			cli:  "--body b --format json",
+			wants: createStatusUpdateOpts{
+				body: "b",
+			},
+			wantsExporter: true,
+		},
+	}
+
+	t.Setenv("GH_TOKEN", "auth-token")
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ios, _, _, _ := iostreams.Test()
+			f := &cmdutil.Factory{
+				IOStreams: ios,
+			}
+
+			argv, err := shlex.Split(tt.cli)
+			assert.NoError(t, err)
+
+			var gotOpts createStatusUpdateOpts
+			cmd := NewCmdCreateStatusUpdate(f, func(config createStatusUpdateConfig) error {
+				gotOpts = config.opts
+				return nil
+			})
+
+			cmd.SetArgs(argv)
+			_, err = cmd.ExecuteC()
+			if tt.wantsErr {
+				assert.Equal(t, tt.wantsErrMsg, err.Error())
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+
+			assert.Equal(t, tt.wants.number, gotOpts.number)
+			assert.Equal(t, tt.wants.owner, gotOpts.owner)
+			assert.Equal(t, tt.wants.body, gotOpts.body)
+			assert.Equal(t, tt.wants.status, gotOpts.status)
+			assert.Equal(t, tt.wants.startDate, gotOpts.startDate)
+			assert.Equal(t, tt.wants.targetDate, gotOpts.targetDate)
+			assert.Equal(t, tt.wantsExporter, gotOpts.exporter != nil)
+		})
+	}
+}
+
+func TestRunCreateStatusUpdate_User(t *testing.T) {
+	defer gock.Off()
+	// gock.Observe(gock.DumpRequest)
+
+	// get user ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query UserOrgOwner.*",
+			"variables": map[string]interface{}{
+				"login": "monalisa",
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"id": "an ID",
+				},
+			},
+			"errors": []interface{}{
+				map[string]interface{}{
+					"type": "NOT_FOUND",
+					"path": []string{"organization"},
+				},
+			},
+		})
+
+	// get project ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query UserProject.*",
+			"variables": map[string]interface{}{
+				"login":       "monalisa",
+				"number":      1,
+				"firstItems":  0,
+				"afterItems":  nil,
+				"firstFields": 0,
+				"afterFields": nil,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"number": 1,
+						"id":     "an ID",
+					},
+				},
+			},
+		})
+
+	// create status update
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`{"query":"mutation CreateStatusUpdate.*","variables":{"input":{"projectId":"an ID","body":"Everything is on schedule","startDate":"2025-01-01T00:00:00Z","targetDate":"2025-03-31T00:00:00Z","status":"ON_TRACK"}}}`).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"createProjectV2StatusUpdate": map[string]interface{}{
+					"statusUpdate": map[string]interface{}{
+						"id":     "status update ID",
+						"status": "ON_TRACK",
+					},
+				},
+			},
+		})
+
+	client := queries.NewTestClient()
+
+	ios, _, stdout, _ := iostreams.Test()
+	ios.SetStdoutTTY(true)
+	config := createStatusUpdateConfig{
+		opts: createStatusUpdateOpts{
+			owner:      "monalisa",
+			number:     1,
+			body:       "Everything is on schedule",
+			status:     "on_track",
+			startDate:  "2025-01-01",
+			targetDate: "2025-03-31",
+		},
+		client: client,
+		io:     ios,
+	}
+
+	err := runCreateStatusUpdate(config)
+	assert.NoError(t, err)
+	assert.Equal(
+		t,
+		"Created status update in project 1\n",
+		stdout.String())
+}
+
+func TestRunCreateStatusUpdate_InvalidDate(t *testing.T) {
+	ios, _, _, _ := iostreams.Test()
+	config := createStatusUpdateConfig{
+		opts: createStatusUpdateOpts{
+			owner:     "monalisa",
+			number:    1,
+			body:      "a body",
+			startDate: "01/02/2025",
+			projectID: "an ID",
+		},
+		io: ios,
+	}
+
+	_, _, err := createStatusUpdateArgs(config)
+	assert.EqualError(t, err, `invalid start date "01/02/2025": date must be in YYYY-MM-DD format`)
+}
@@ -0,0 +1,121 @@
+package statusupdatelist
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/tableprinter"
+	"github.com/cli/cli/v2/pkg/cmd/project/shared/client"
+	"github.com/cli/cli/v2/pkg/cmd/project/shared/queries"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type listOpts struct {
+	limit    int
+	owner    string
+	number   int32
+	exporter cmdutil.Exporter
+}
+
+type listConfig struct {
+	io     *iostreams.IOStreams
+	client *queries.Client
+	opts   listOpts
+}
+
+func NewCmdList(f *cmdutil.Factory, runF func(config listConfig) error) *cobra.Command {
+	opts := listOpts{}
+	listCmd := &cobra.Command{
+		Short: "List the status updates in a project",
+		Use:   "status-update-list [<number>]",
+		Example: heredoc.Doc(`
+			# list status updates in the current user's project "1"
+			gh project status-update-list 1 --owner "@me"
+		`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := client.New(f)
+			if err != nil {
+				return err
+			}
+
+			if len(args) == 1 {
+				num, err := strconv.ParseInt(args[0], 10, 32)
+				if err != nil {
+					return cmdutil.FlagErrorf("invalid number: %v", args[0])
+				}
+				opts.number = int32(num)
+			}
+
+			config := listConfig{
+				io:     f.IOStreams,
+				client: client,
+				opts:   opts,
+			}
+
+			// allow testing of the command without actually running it
+			if runF != nil {
+				return runF(config)
+			}
+			return runList(config)
+		},
+	}
+
+	listCmd.Flags().StringVar(&opts.owner, "owner", "", "Login of the owner. Use \"@me\" for the current user. Defaults to the owner of the current directory's repository.")
+	cmdutil.AddFormatAndJSONFlags(listCmd, &opts.exporter, queries.StatusUpdatesJSONFields)
+	listCmd.Flags().IntVarP(&opts.limit, "limit", "L", queries.LimitDefault, "Maximum number of status updates to fetch")
+
+	return listCmd
+}
+
+func runList(config listConfig) error {
+	canPrompt := config.io.CanPrompt()
+	owner, err := config.client.NewOwner(canPrompt, config.opts.owner)
+	if err != nil {
+		return err
+	}
+
+	// no need to fetch the project if we already have the number
+	if config.opts.number == 0 {
+		project, err := config.client.NewProject(canPrompt, owner, config.opts.number, false)
+		if err != nil {
+			return err
+		}
+		config.opts.number = project.Number
+	}
+
+	statusUpdates, err := config.client.ProjectStatusUpdates(owner, config.opts.number, config.opts.limit)
+	if err != nil {
+		return err
+	}
+
+	if config.opts.exporter != nil {
+		return config.opts.exporter.Write(config.io, statusUpdates)
+	}
+
+	return printResults(config, statusUpdates.Nodes, owner.Login)
+}
+
+func printResults(config listConfig, statusUpdates []queries.ProjectStatusUpdate, login string) error {
+	if len(statusUpdates) == 0 {
+		return cmdutil.NewNoResultsError(fmt.Sprintf("Project %d for owner %s has no status updates", config.opts.number, login))
+	}
+
+	tp := tableprinter.New(config.io, tableprinter.WithHeader("Status", "Start date", "Target date", "Creator", "Body", "ID"))
+
+	for _, s := range statusUpdates {
+		tp.AddField(strings.ToLower(s.Status))
+		tp.AddField(s.StartDate)
+		tp.AddField(s.TargetDate)
+		tp.AddField(s.Creator.Login)
+		tp.AddField(s.Body)
+		tp.AddField(s.ID, tableprinter.WithTruncate(nil))
+		tp.EndRow()
+	}
+
+	return tp.Render()
+}
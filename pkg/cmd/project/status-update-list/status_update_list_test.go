@@ -0,0 +1,189 @@
+package statusupdatelist
+
+import (
+	"testing"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/pkg/cmd/project/shared/queries"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestNewCmdList(t *testing.T) {
+	tests := []struct {
+		name          string
+		cli           string
+		wants         listOpts
+		wantsErr      bool
+		wantsErrMsg   string
+		wantsExporter bool
+	}{
+		{
+			name:        "not-a-number",
+			cli:         "x",
+			wantsErr:    true,
+			wantsErrMsg: "invalid number: x",
+		},
+		{
+			name: "number",
+			cli:  "123",
+			wants: listOpts{
+				number: 123,
+				limit:  30,
+			},
+		},
+		{
+			name: "owner",
+			cli:  "--owner monalisa",
+			wants: listOpts{
+				owner: "monalisa",
+				limit: 30,
+			},
+		},
+		{
+			name: "json",
+			cli:  "--format json",
+			wants: listOpts{
+				limit: 30,
+			},
+			wantsExporter: true,
+		},
+	}
+
+	t.Setenv("GH_TOKEN", "auth-token")
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ios, _, _, _ := iostreams.Test()
+			f := &cmdutil.Factory{
+				IOStreams: ios,
+			}
+
+			argv, err := shlex.Split(tt.cli)
+			assert.NoError(t, err)
+
+			var gotOpts listOpts
+			cmd := NewCmdList(f, func(config listConfig) error {
+				gotOpts = config.opts
+				return nil
+			})
+
+			cmd.SetArgs(argv)
+			_, err = cmd.ExecuteC()
+			if tt.wantsErr {
+				assert.Equal(t, tt.wantsErrMsg, err.Error())
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+
+			assert.Equal(t, tt.wants.number, gotOpts.number)
+			assert.Equal(t, tt.wants.owner, gotOpts.owner)
+			assert.Equal(t, tt.wants.limit, gotOpts.limit)
+			assert.Equal(t, tt.wantsExporter, gotOpts.exporter != nil)
+		})
+	}
+}
+
+func TestRunList_User_tty(t *testing.T) {
+	defer gock.Off()
+	// gock.Observe(gock.DumpRequest)
+
+	// get user ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query UserOrgOwner.*",
+			"variables": map[string]interface{}{
+				"login": "monalisa",
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"id": "an ID",
+				},
+			},
+			"errors": []interface{}{
+				map[string]interface{}{
+					"type": "NOT_FOUND",
+					"path": []string{"organization"},
+				},
+			},
+		})
+
+	// list project status updates
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		JSON(map[string]interface{}{
+			"query": "query UserProjectWithStatusUpdates.*",
+			"variables": map[string]interface{}{
+				"login":              "monalisa",
+				"number":             1,
+				"firstStatusUpdates": queries.LimitDefault,
+				"afterStatusUpdates": nil,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"statusUpdates": map[string]interface{}{
+							"totalCount": 2,
+							"nodes": []map[string]interface{}{
+								{
+									"id":         "status update ID 1",
+									"status":     "ON_TRACK",
+									"body":       "Everything is on schedule",
+									"startDate":  "2025-01-01",
+									"targetDate": "2025-03-31",
+									"createdAt":  "2025-01-06T00:00:00Z",
+									"creator": map[string]interface{}{
+										"login": "monalisa",
+									},
+								},
+								{
+									"id":         "status update ID 2",
+									"status":     "AT_RISK",
+									"body":       "Blocked on review",
+									"startDate":  "2025-01-01",
+									"targetDate": "2025-03-31",
+									"createdAt":  "2025-01-13T00:00:00Z",
+									"creator": map[string]interface{}{
+										"login": "monalisa",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		})
+
+	client := queries.NewTestClient()
+
+	ios, _, stdout, _ := iostreams.Test()
+	ios.SetStdoutTTY(true)
+	config := listConfig{
+		opts: listOpts{
+			number: 1,
+			owner:  "monalisa",
+		},
+		client: client,
+		io:     ios,
+	}
+
+	err := runList(config)
+	assert.NoError(t, err)
+	assert.Equal(t, heredoc.Doc(`
+		STATUS    START DATE  TARGET DATE  CREATOR   BODY             ID
+		on_track  2025-01-01  2025-03-31   monalisa  Everything i...  status update ID 1
+		at_risk   2025-01-01  2025-03-31   monalisa  Blocked on r...  status update ID 2
+  `), stdout.String())
+}
@@ -42,6 +42,14 @@ func NewCmdUnlink(f *cmdutil.Factory, runF func(config unlinkConfig) error) *cob
 	linkCmd := &cobra.Command{
 		Short: "Unlink a project from a repository or a team",
 		Use:   "unlink [<number>] [flag]",
+		Long: heredoc.Docf(`
+			Unlink a project from a repository or a team.
+
+			The repository may be specified in the %[1]s[HOST/]OWNER/REPO%[1]s format and the
+			team in the %[1]s[HOST/]ORG/TEAM%[1]s format, in which case the owner must match
+			%[1]s--owner%[1]s if both are given. When neither %[1]s--repo%[1]s nor %[1]s--team%[1]s is specified,
+			the project is unlinked from the repository of the current directory.
+		`, "`"),
 		Example: heredoc.Doc(`
 			# unlink monalisa's project 1 from her repository "my_repo"
 			gh project unlink 1 --owner monalisa --repo my_repo
@@ -102,7 +110,7 @@ func NewCmdUnlink(f *cmdutil.Factory, runF func(config unlinkConfig) error) *cob
 	}
 
 	cmdutil.EnableRepoOverride(linkCmd, f)
-	linkCmd.Flags().StringVar(&opts.owner, "owner", "", "Login of the owner. Use \"@me\" for the current user.")
+	linkCmd.Flags().StringVar(&opts.owner, "owner", "", "Login of the owner. Use \"@me\" for the current user. Defaults to the owner of the current directory's repository.")
 	linkCmd.Flags().StringVarP(&opts.repo, "repo", "R", "", "The repository to be unlinked from this project")
 	linkCmd.Flags().StringVarP(&opts.team, "team", "T", "", "The team to be unlinked from this project")
 
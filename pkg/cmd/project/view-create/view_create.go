@@ -0,0 +1,138 @@
+package viewcreate
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/pkg/cmd/project/shared/client"
+	"github.com/cli/cli/v2/pkg/cmd/project/shared/queries"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/shurcooL/githubv4"
+	"github.com/spf13/cobra"
+)
+
+type createViewOpts struct {
+	name      string
+	layout    string
+	owner     string
+	number    int32
+	projectID string
+	exporter  cmdutil.Exporter
+}
+
+type createViewConfig struct {
+	client *queries.Client
+	opts   createViewOpts
+	io     *iostreams.IOStreams
+}
+
+// CreateProjectV2ViewInput mirrors the GraphQL input of the same name, which
+// is not available in the githubv4 bindings.
+type CreateProjectV2ViewInput struct {
+	ProjectID githubv4.ID                  `json:"projectId"`
+	Name      githubv4.String              `json:"name"`
+	Layout    githubv4.ProjectV2ViewLayout `json:"layout"`
+}
+
+type createProjectV2ViewMutation struct {
+	CreateProjectV2View struct {
+		View queries.ProjectV2View `graphql:"projectV2View"`
+	} `graphql:"createProjectV2View(input:$input)"`
+}
+
+func NewCmdCreateView(f *cmdutil.Factory, runF func(config createViewConfig) error) *cobra.Command {
+	opts := createViewOpts{}
+	createViewCmd := &cobra.Command{
+		Short: "Create a view in a project",
+		Use:   "view-create [<number>]",
+		Example: heredoc.Doc(`
+			# create a board view in the current user's project "1"
+			gh project view-create 1 --owner "@me" --name "Sprint board" --layout board
+		`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := client.New(f)
+			if err != nil {
+				return err
+			}
+
+			if len(args) == 1 {
+				num, err := strconv.ParseInt(args[0], 10, 32)
+				if err != nil {
+					return cmdutil.FlagErrorf("invalid number: %v", args[0])
+				}
+				opts.number = int32(num)
+			}
+
+			config := createViewConfig{
+				client: client,
+				opts:   opts,
+				io:     f.IOStreams,
+			}
+
+			// allow testing of the command without actually running it
+			if runF != nil {
+				return runF(config)
+			}
+			return runCreateView(config)
+		},
+	}
+
+	createViewCmd.Flags().StringVar(&opts.owner, "owner", "", "Login of the owner. Use \"@me\" for the current user. Defaults to the owner of the current directory's repository.")
+	createViewCmd.Flags().StringVar(&opts.name, "name", "", "Name of the new view")
+	cmdutil.StringEnumFlag(createViewCmd, &opts.layout, "layout", "", "table", []string{"board", "table", "roadmap"}, "Layout of the new view")
+	cmdutil.AddFormatAndJSONFlags(createViewCmd, &opts.exporter, queries.ViewJSONFields)
+
+	_ = createViewCmd.MarkFlagRequired("name")
+
+	return createViewCmd
+}
+
+func runCreateView(config createViewConfig) error {
+	canPrompt := config.io.CanPrompt()
+	owner, err := config.client.NewOwner(canPrompt, config.opts.owner)
+	if err != nil {
+		return err
+	}
+
+	project, err := config.client.NewProject(canPrompt, owner, config.opts.number, false)
+	if err != nil {
+		return err
+	}
+	config.opts.projectID = project.ID
+
+	query, variables := createViewArgs(config)
+
+	err = config.client.Mutate("CreateView", query, variables)
+	if err != nil {
+		return err
+	}
+
+	if config.opts.exporter != nil {
+		return config.opts.exporter.Write(config.io, query.CreateProjectV2View.View)
+	}
+
+	return printResults(config, query.CreateProjectV2View.View)
+}
+
+func createViewArgs(config createViewConfig) (*createProjectV2ViewMutation, map[string]interface{}) {
+	return &createProjectV2ViewMutation{}, map[string]interface{}{
+		"input": CreateProjectV2ViewInput{
+			ProjectID: githubv4.ID(config.opts.projectID),
+			Name:      githubv4.String(config.opts.name),
+			Layout:    githubv4.ProjectV2ViewLayout(strings.ToUpper(config.opts.layout) + "_LAYOUT"),
+		},
+	}
+}
+
+func printResults(config createViewConfig, view queries.ProjectV2View) error {
+	if !config.io.IsStdoutTTY() {
+		return nil
+	}
+
+	_, err := fmt.Fprintf(config.io.Out, "Created view %q\n", view.Name)
+	return err
+}
@@ -0,0 +1,169 @@
+package viewcreate
+
+import (
+	"testing"
+
+	"github.com/cli/cli/v2/pkg/cmd/project/shared/queries"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestNewCmdCreateView(t *testing.T) {
+	tests := []struct {
+		name        string
+		cli         string
+		wants       createViewOpts
+		wantsErr    bool
+		wantsErrMsg string
+	}{
+		{
+			name:        "missing-name",
+			cli:         "",
+			wantsErr:    true,
+			wantsErrMsg: "required flag(s) \"name\" not set",
+		},
+		{
+			name:        "invalid-layout",
+			cli:         "--name v --layout circle",
+			wantsErr:    true,
+			wantsErrMsg: "invalid argument \"circle\" for \"--layout\" flag: valid values are {board|table|roadmap}",
+		},
+		{
+			name: "name-and-layout",
+			cli:  "123 --name v --layout roadmap",
+			wants: createViewOpts{
+				number: 123,
+				name:   "v",
+				layout: "roadmap",
+			},
+		},
+	}
+
+	t.Setenv("GH_TOKEN", "auth-token")
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ios, _, _, _ := iostreams.Test()
+			f := &cmdutil.Factory{
+				IOStreams: ios,
+			}
+
+			argv, err := shlex.Split(tt.cli)
+			assert.NoError(t, err)
+
+			var gotOpts createViewOpts
+			cmd := NewCmdCreateView(f, func(config createViewConfig) error {
+				gotOpts = config.opts
+				return nil
+			})
+
+			cmd.SetArgs(argv)
+			_, err = cmd.ExecuteC()
+			if tt.wantsErr {
+				assert.Equal(t, tt.wantsErrMsg, err.Error())
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+
+			assert.Equal(t, tt.wants.number, gotOpts.number)
+			assert.Equal(t, tt.wants.name, gotOpts.name)
+			assert.Equal(t, tt.wants.layout, gotOpts.layout)
+		})
+	}
+}
+
+func TestRunCreateView_User(t *testing.T) {
+	defer gock.Off()
+	// gock.Observe(gock.DumpRequest)
+
+	// get user ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query UserOrgOwner.*",
+			"variables": map[string]interface{}{
+				"login": "monalisa",
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"id": "an ID",
+				},
+			},
+			"errors": []interface{}{
+				map[string]interface{}{
+					"type": "NOT_FOUND",
+					"path": []string{"organization"},
+				},
+			},
+		})
+
+	// get project ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query UserProject.*",
+			"variables": map[string]interface{}{
+				"login":       "monalisa",
+				"number":      1,
+				"firstItems":  0,
+				"afterItems":  nil,
+				"firstFields": 0,
+				"afterFields": nil,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"id": "project ID",
+					},
+				},
+			},
+		})
+
+	// create view
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`{"query":"mutation CreateView.*","variables":{"input":{"projectId":"project ID","name":"Sprint board","layout":"BOARD_LAYOUT"}}}`).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"createProjectV2View": map[string]interface{}{
+					"projectV2View": map[string]interface{}{
+						"id":     "view ID",
+						"name":   "Sprint board",
+						"layout": "BOARD_LAYOUT",
+					},
+				},
+			},
+		})
+
+	client := queries.NewTestClient()
+
+	ios, _, stdout, _ := iostreams.Test()
+	ios.SetStdoutTTY(true)
+	config := createViewConfig{
+		opts: createViewOpts{
+			number: 1,
+			owner:  "monalisa",
+			name:   "Sprint board",
+			layout: "board",
+		},
+		client: client,
+		io:     ios,
+	}
+
+	err := runCreateView(config)
+	assert.NoError(t, err)
+	assert.Equal(t, "Created view \"Sprint board\"\n", stdout.String())
+}
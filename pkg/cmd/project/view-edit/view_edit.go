@@ -0,0 +1,186 @@
+package viewedit
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/pkg/cmd/project/shared/client"
+	"github.com/cli/cli/v2/pkg/cmd/project/shared/queries"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/shurcooL/githubv4"
+	"github.com/spf13/cobra"
+)
+
+type editViewOpts struct {
+	viewID          string
+	name            string
+	layout          string
+	visibleFieldIDs []string
+	groupByFieldIDs []string
+	sortBy          string
+	// format
+	exporter cmdutil.Exporter
+}
+
+type editViewConfig struct {
+	io     *iostreams.IOStreams
+	client *queries.Client
+	opts   editViewOpts
+}
+
+// UpdateProjectV2ViewInput mirrors the GraphQL input of the same name, which
+// is not available in the githubv4 bindings.
+type UpdateProjectV2ViewInput struct {
+	ViewID          githubv4.ID                   `json:"viewId"`
+	Name            *githubv4.String              `json:"name,omitempty"`
+	Layout          *githubv4.ProjectV2ViewLayout `json:"layout,omitempty"`
+	VisibleFieldIDs *[]githubv4.ID                `json:"visibleFieldIds,omitempty"`
+	GroupByFieldIDs *[]githubv4.ID                `json:"groupByFieldIds,omitempty"`
+	SortByFields    *[]SortByFieldInput           `json:"sortByFields,omitempty"`
+}
+
+type SortByFieldInput struct {
+	FieldID   githubv4.ID     `json:"fieldId"`
+	Direction githubv4.String `json:"direction"`
+}
+
+type updateProjectV2ViewMutation struct {
+	UpdateProjectV2View struct {
+		View queries.ProjectV2View `graphql:"projectV2View"`
+	} `graphql:"updateProjectV2View(input:$input)"`
+}
+
+func NewCmdEditView(f *cmdutil.Factory, runF func(config editViewConfig) error) *cobra.Command {
+	opts := editViewOpts{}
+	editViewCmd := &cobra.Command{
+		Short: "Edit a view in a project",
+		Use:   "view-edit",
+		Long: heredoc.Docf(`
+			Edit the name, layout, visible fields, grouping, or sort of a project view.
+
+			Field IDs for %[1]s--visible-fields%[1]s, %[1]s--group-by%[1]s, and %[1]s--sort-by%[1]s can be found
+			with %[1]sgh project field-list%[1]s; view IDs with %[1]sgh project view-list%[1]s.
+		`, "`"),
+		Example: heredoc.Doc(`
+			# switch a view to the roadmap layout
+			gh project view-edit --id <view-ID> --layout roadmap
+
+			# show only certain fields, grouped by status
+			gh project view-edit --id <view-ID> --visible-fields <field-ID>,<field-ID> --group-by <field-ID>
+
+			# sort a view by a field, descending
+			gh project view-edit --id <view-ID> --sort-by <field-ID>:desc
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.name == "" && opts.layout == "" && len(opts.visibleFieldIDs) == 0 &&
+				len(opts.groupByFieldIDs) == 0 && opts.sortBy == "" {
+				return cmdutil.FlagErrorf("specify at least one of `--name`, `--layout`, `--visible-fields`, `--group-by` or `--sort-by`")
+			}
+
+			client, err := client.New(f)
+			if err != nil {
+				return err
+			}
+
+			config := editViewConfig{
+				io:     f.IOStreams,
+				client: client,
+				opts:   opts,
+			}
+
+			// allow testing of the command without actually running it
+			if runF != nil {
+				return runF(config)
+			}
+			return runEditView(config)
+		},
+	}
+
+	editViewCmd.Flags().StringVar(&opts.viewID, "id", "", "ID of the view to edit")
+	editViewCmd.Flags().StringVar(&opts.name, "name", "", "New name for the view")
+	cmdutil.StringEnumFlag(editViewCmd, &opts.layout, "layout", "", "", []string{"board", "table", "roadmap"}, "New layout for the view")
+	editViewCmd.Flags().StringSliceVar(&opts.visibleFieldIDs, "visible-fields", nil, "IDs of the fields to show in the view")
+	editViewCmd.Flags().StringSliceVar(&opts.groupByFieldIDs, "group-by", nil, "IDs of the fields to group items by")
+	editViewCmd.Flags().StringVar(&opts.sortBy, "sort-by", "", "Field ID and direction to sort items by, formatted as `field-ID:{asc|desc}`")
+	cmdutil.AddFormatAndJSONFlags(editViewCmd, &opts.exporter, queries.ViewJSONFields)
+
+	_ = editViewCmd.MarkFlagRequired("id")
+
+	return editViewCmd
+}
+
+func runEditView(config editViewConfig) error {
+	query, variables, err := editViewArgs(config)
+	if err != nil {
+		return err
+	}
+
+	err = config.client.Mutate("UpdateView", query, variables)
+	if err != nil {
+		return err
+	}
+
+	if config.opts.exporter != nil {
+		return config.opts.exporter.Write(config.io, query.UpdateProjectV2View.View)
+	}
+
+	return printResults(config, query.UpdateProjectV2View.View)
+}
+
+func editViewArgs(config editViewConfig) (*updateProjectV2ViewMutation, map[string]interface{}, error) {
+	input := UpdateProjectV2ViewInput{
+		ViewID: githubv4.ID(config.opts.viewID),
+	}
+
+	if config.opts.name != "" {
+		input.Name = githubv4.NewString(githubv4.String(config.opts.name))
+	}
+	if config.opts.layout != "" {
+		layout := githubv4.ProjectV2ViewLayout(strings.ToUpper(config.opts.layout) + "_LAYOUT")
+		input.Layout = &layout
+	}
+	if len(config.opts.visibleFieldIDs) > 0 {
+		ids := fieldIDs(config.opts.visibleFieldIDs)
+		input.VisibleFieldIDs = &ids
+	}
+	if len(config.opts.groupByFieldIDs) > 0 {
+		ids := fieldIDs(config.opts.groupByFieldIDs)
+		input.GroupByFieldIDs = &ids
+	}
+	if config.opts.sortBy != "" {
+		fieldID, direction, _ := strings.Cut(config.opts.sortBy, ":")
+		if direction == "" {
+			direction = "asc"
+		}
+		if fieldID == "" || (direction != "asc" && direction != "desc") {
+			return nil, nil, cmdutil.FlagErrorf("invalid sort %q: expected the field-ID:{asc|desc} format", config.opts.sortBy)
+		}
+		input.SortByFields = &[]SortByFieldInput{{
+			FieldID:   githubv4.ID(fieldID),
+			Direction: githubv4.String(strings.ToUpper(direction)),
+		}}
+	}
+
+	return &updateProjectV2ViewMutation{}, map[string]interface{}{
+		"input": input,
+	}, nil
+}
+
+func fieldIDs(ids []string) []githubv4.ID {
+	out := make([]githubv4.ID, len(ids))
+	for i, id := range ids {
+		out[i] = githubv4.ID(id)
+	}
+	return out
+}
+
+func printResults(config editViewConfig, view queries.ProjectV2View) error {
+	if !config.io.IsStdoutTTY() {
+		return nil
+	}
+
+	_, err := fmt.Fprintf(config.io.Out, "Edited view %q\n", view.Name)
+	return err
+}
@@ -0,0 +1,138 @@
+package viewedit
+
+import (
+	"testing"
+
+	"github.com/cli/cli/v2/pkg/cmd/project/shared/queries"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestNewCmdEditView(t *testing.T) {
+	tests := []struct {
+		name        string
+		cli         string
+		wants       editViewOpts
+		wantsErr    bool
+		wantsErrMsg string
+	}{
+		{
+			name:        "no-changes",
+			cli:         "--id 123",
+			wantsErr:    true,
+			wantsErrMsg: "specify at least one of `--name`, `--layout`, `--visible-fields`, `--group-by` or `--sort-by`",
+		},
+		{
+			name:        "missing-id",
+			cli:         "--name v",
+			wantsErr:    true,
+			wantsErrMsg: "required flag(s) \"id\" not set",
+		},
+		{
+			name: "all-flags",
+			cli:  "--id 123 --name v --layout board --visible-fields f1,f2 --group-by f3 --sort-by f4:desc",
+			wants: editViewOpts{
+				viewID:          "123",
+				name:            "v",
+				layout:          "board",
+				visibleFieldIDs: []string{"f1", "f2"},
+				groupByFieldIDs: []string{"f3"},
+				sortBy:          "f4:desc",
+			},
+		},
+	}
+
+	t.Setenv("GH_TOKEN", "auth-token")
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ios, _, _, _ := iostreams.Test()
+			f := &cmdutil.Factory{
+				IOStreams: ios,
+			}
+
+			argv, err := shlex.Split(tt.cli)
+			assert.NoError(t, err)
+
+			var gotOpts editViewOpts
+			cmd := NewCmdEditView(f, func(config editViewConfig) error {
+				gotOpts = config.opts
+				return nil
+			})
+
+			cmd.SetArgs(argv)
+			_, err = cmd.ExecuteC()
+			if tt.wantsErr {
+				assert.Equal(t, tt.wantsErrMsg, err.Error())
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+
+			assert.Equal(t, tt.wants.viewID, gotOpts.viewID)
+			assert.Equal(t, tt.wants.name, gotOpts.name)
+			assert.Equal(t, tt.wants.layout, gotOpts.layout)
+			assert.Equal(t, tt.wants.visibleFieldIDs, gotOpts.visibleFieldIDs)
+			assert.Equal(t, tt.wants.groupByFieldIDs, gotOpts.groupByFieldIDs)
+			assert.Equal(t, tt.wants.sortBy, gotOpts.sortBy)
+		})
+	}
+}
+
+func TestRunEditView(t *testing.T) {
+	defer gock.Off()
+	// gock.Observe(gock.DumpRequest)
+
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`{"query":"mutation UpdateView.*","variables":{"input":{"viewId":"view ID","layout":"ROADMAP_LAYOUT","visibleFieldIds":\["f1","f2"\],"sortByFields":\[{"fieldId":"f3","direction":"ASC"}\]}}}`).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"updateProjectV2View": map[string]interface{}{
+					"projectV2View": map[string]interface{}{
+						"id":     "view ID",
+						"name":   "Roadmap",
+						"layout": "ROADMAP_LAYOUT",
+					},
+				},
+			},
+		})
+
+	client := queries.NewTestClient()
+
+	ios, _, stdout, _ := iostreams.Test()
+	ios.SetStdoutTTY(true)
+	config := editViewConfig{
+		opts: editViewOpts{
+			viewID:          "view ID",
+			layout:          "roadmap",
+			visibleFieldIDs: []string{"f1", "f2"},
+			sortBy:          "f3",
+		},
+		client: client,
+		io:     ios,
+	}
+
+	err := runEditView(config)
+	assert.NoError(t, err)
+	assert.Equal(t, "Edited view \"Roadmap\"\n", stdout.String())
+}
+
+func TestRunEditView_InvalidSort(t *testing.T) {
+	ios, _, _, _ := iostreams.Test()
+	config := editViewConfig{
+		opts: editViewOpts{
+			viewID: "view ID",
+			sortBy: "f1:sideways",
+		},
+		client: queries.NewTestClient(),
+		io:     ios,
+	}
+
+	err := runEditView(config)
+	assert.EqualError(t, err, "invalid sort \"f1:sideways\": expected the field-ID:{asc|desc} format")
+}
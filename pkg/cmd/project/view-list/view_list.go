@@ -0,0 +1,126 @@
+package viewlist
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/tableprinter"
+	"github.com/cli/cli/v2/pkg/cmd/project/shared/client"
+	"github.com/cli/cli/v2/pkg/cmd/project/shared/queries"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type listOpts struct {
+	limit    int
+	owner    string
+	number   int32
+	exporter cmdutil.Exporter
+}
+
+type listConfig struct {
+	io     *iostreams.IOStreams
+	client *queries.Client
+	opts   listOpts
+}
+
+func NewCmdList(f *cmdutil.Factory, runF func(config listConfig) error) *cobra.Command {
+	opts := listOpts{}
+	listCmd := &cobra.Command{
+		Short: "List the views in a project",
+		Use:   "view-list [<number>]",
+		Example: heredoc.Doc(`
+			# list views in the current user's project "1"
+			gh project view-list 1 --owner "@me"
+		`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := client.New(f)
+			if err != nil {
+				return err
+			}
+
+			if len(args) == 1 {
+				num, err := strconv.ParseInt(args[0], 10, 32)
+				if err != nil {
+					return cmdutil.FlagErrorf("invalid number: %v", args[0])
+				}
+				opts.number = int32(num)
+			}
+
+			config := listConfig{
+				io:     f.IOStreams,
+				client: client,
+				opts:   opts,
+			}
+
+			// allow testing of the command without actually running it
+			if runF != nil {
+				return runF(config)
+			}
+			return runList(config)
+		},
+	}
+
+	listCmd.Flags().StringVar(&opts.owner, "owner", "", "Login of the owner. Use \"@me\" for the current user. Defaults to the owner of the current directory's repository.")
+	cmdutil.AddFormatAndJSONFlags(listCmd, &opts.exporter, queries.ViewsJSONFields)
+	listCmd.Flags().IntVarP(&opts.limit, "limit", "L", queries.LimitDefault, "Maximum number of views to fetch")
+
+	return listCmd
+}
+
+func runList(config listConfig) error {
+	canPrompt := config.io.CanPrompt()
+	owner, err := config.client.NewOwner(canPrompt, config.opts.owner)
+	if err != nil {
+		return err
+	}
+
+	// no need to fetch the project if we already have the number
+	if config.opts.number == 0 {
+		project, err := config.client.NewProject(canPrompt, owner, config.opts.number, false)
+		if err != nil {
+			return err
+		}
+		config.opts.number = project.Number
+	}
+
+	views, err := config.client.ProjectViews(owner, config.opts.number, config.opts.limit)
+	if err != nil {
+		return err
+	}
+
+	if config.opts.exporter != nil {
+		return config.opts.exporter.Write(config.io, views)
+	}
+
+	return printResults(config, views.Nodes, owner.Login)
+}
+
+func printResults(config listConfig, views []queries.ProjectV2View, login string) error {
+	if len(views) == 0 {
+		return cmdutil.NewNoResultsError(fmt.Sprintf("Project %d for owner %s has no views", config.opts.number, login))
+	}
+
+	tp := tableprinter.New(config.io, tableprinter.WithHeader("Number", "Name", "Layout", "Group by", "Sort by", "ID"))
+
+	for _, v := range views {
+		sortBy := make([]string, len(v.SortByFields.Nodes))
+		for i, n := range v.SortByFields.Nodes {
+			sortBy[i] = fmt.Sprintf("%s (%s)", n.Field.Common.Name, strings.ToLower(n.Direction))
+		}
+
+		tp.AddField(strconv.Itoa(int(v.Number)))
+		tp.AddField(v.Name)
+		tp.AddField(v.LayoutName())
+		tp.AddField(strings.Join(v.GroupByFieldNames(), ", "))
+		tp.AddField(strings.Join(sortBy, ", "))
+		tp.AddField(v.ID, tableprinter.WithTruncate(nil))
+		tp.EndRow()
+	}
+
+	return tp.Render()
+}
@@ -0,0 +1,192 @@
+package viewlist
+
+import (
+	"testing"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/pkg/cmd/project/shared/queries"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestNewCmdList(t *testing.T) {
+	tests := []struct {
+		name          string
+		cli           string
+		wants         listOpts
+		wantsErr      bool
+		wantsErrMsg   string
+		wantsExporter bool
+	}{
+		{
+			name:        "not-a-number",
+			cli:         "x",
+			wantsErr:    true,
+			wantsErrMsg: "invalid number: x",
+		},
+		{
+			name: "number",
+			cli:  "123",
+			wants: listOpts{
+				number: 123,
+				limit:  30,
+			},
+		},
+		{
+			name: "owner",
+			cli:  "--owner monalisa",
+			wants: listOpts{
+				owner: "monalisa",
+				limit: 30,
+			},
+		},
+		{
+			name: "json",
+			cli:  "--format json",
+			wants: listOpts{
+				limit: 30,
+			},
+			wantsExporter: true,
+		},
+	}
+
+	t.Setenv("GH_TOKEN", "auth-token")
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ios, _, _, _ := iostreams.Test()
+			f := &cmdutil.Factory{
+				IOStreams: ios,
+			}
+
+			argv, err := shlex.Split(tt.cli)
+			assert.NoError(t, err)
+
+			var gotOpts listOpts
+			cmd := NewCmdList(f, func(config listConfig) error {
+				gotOpts = config.opts
+				return nil
+			})
+
+			cmd.SetArgs(argv)
+			_, err = cmd.ExecuteC()
+			if tt.wantsErr {
+				assert.Equal(t, tt.wantsErrMsg, err.Error())
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+
+			assert.Equal(t, tt.wants.number, gotOpts.number)
+			assert.Equal(t, tt.wants.owner, gotOpts.owner)
+			assert.Equal(t, tt.wants.limit, gotOpts.limit)
+			assert.Equal(t, tt.wantsExporter, gotOpts.exporter != nil)
+		})
+	}
+}
+
+func TestRunList_User_tty(t *testing.T) {
+	defer gock.Off()
+	// gock.Observe(gock.DumpRequest)
+
+	// get user ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query UserOrgOwner.*",
+			"variables": map[string]interface{}{
+				"login": "monalisa",
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"id": "an ID",
+				},
+			},
+			"errors": []interface{}{
+				map[string]interface{}{
+					"type": "NOT_FOUND",
+					"path": []string{"organization"},
+				},
+			},
+		})
+
+	// list project views
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		JSON(map[string]interface{}{
+			"query": "query UserProjectWithViews.*",
+			"variables": map[string]interface{}{
+				"login":      "monalisa",
+				"number":     1,
+				"firstViews": queries.LimitDefault,
+				"afterViews": nil,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"views": map[string]interface{}{
+							"totalCount": 2,
+							"nodes": []map[string]interface{}{
+								{
+									"id":     "view ID 1",
+									"number": 1,
+									"name":   "Backlog",
+									"layout": "TABLE_LAYOUT",
+								},
+								{
+									"id":     "view ID 2",
+									"number": 2,
+									"name":   "Sprint board",
+									"layout": "BOARD_LAYOUT",
+									"groupByFields": map[string]interface{}{
+										"nodes": []map[string]interface{}{
+											{"name": "Status", "id": "status ID"},
+										},
+									},
+									"sortByFields": map[string]interface{}{
+										"nodes": []map[string]interface{}{
+											{
+												"direction": "DESC",
+												"field":     map[string]interface{}{"name": "Priority", "id": "priority ID"},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		})
+
+	client := queries.NewTestClient()
+
+	ios, _, stdout, _ := iostreams.Test()
+	ios.SetStdoutTTY(true)
+	config := listConfig{
+		opts: listOpts{
+			number: 1,
+			owner:  "monalisa",
+		},
+		client: client,
+		io:     ios,
+	}
+
+	err := runList(config)
+	assert.NoError(t, err)
+	assert.Equal(t, heredoc.Doc(`
+		NUMBER  NAME          LAYOUT  GROUP BY  SORT BY          ID
+		1       Backlog       table                              view ID 1
+		2       Sprint board  board   Status    Priority (desc)  view ID 2
+  `), stdout.String())
+}
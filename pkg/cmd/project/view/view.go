@@ -74,9 +74,9 @@ func NewCmdView(f *cmdutil.Factory, runF func(config viewConfig) error) *cobra.C
 		},
 	}
 
-	viewCmd.Flags().StringVar(&opts.owner, "owner", "", "Login of the owner. Use \"@me\" for the current user.")
+	viewCmd.Flags().StringVar(&opts.owner, "owner", "", "Login of the owner. Use \"@me\" for the current user. Defaults to the owner of the current directory's repository.")
 	viewCmd.Flags().BoolVarP(&opts.web, "web", "w", false, "Open a project in the browser")
-	cmdutil.AddFormatFlags(viewCmd, &opts.exporter)
+	cmdutil.AddFormatAndJSONFlags(viewCmd, &opts.exporter, queries.ProjectJSONFields)
 
 	return viewCmd
 }
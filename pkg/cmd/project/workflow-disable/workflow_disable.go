@@ -0,0 +1,131 @@
+package workflowdisable
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/pkg/cmd/project/shared/client"
+	"github.com/cli/cli/v2/pkg/cmd/project/shared/queries"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/shurcooL/githubv4"
+	"github.com/spf13/cobra"
+)
+
+type disableWorkflowOpts struct {
+	number   int32
+	owner    string
+	name     string
+	exporter cmdutil.Exporter
+}
+
+type disableWorkflowConfig struct {
+	io     *iostreams.IOStreams
+	client *queries.Client
+	opts   disableWorkflowOpts
+}
+
+// the workflow-disable command relies on the updateProjectV2Workflow mutation
+type updateProjectWorkflowMutation struct {
+	UpdateProjectV2Workflow struct {
+		Workflow queries.ProjectWorkflow `graphql:"projectV2Workflow"`
+	} `graphql:"updateProjectV2Workflow(input:$input)"`
+}
+
+func NewCmdDisableWorkflow(f *cmdutil.Factory, runF func(config disableWorkflowConfig) error) *cobra.Command {
+	opts := disableWorkflowOpts{}
+	disableWorkflowCmd := &cobra.Command{
+		Short: "Disable a built-in workflow in a project",
+		Use:   "workflow-disable [<number>]",
+		Example: heredoc.Doc(`
+			# disable the "Item closed" workflow in the current user's project "1"
+			gh project workflow-disable 1 --owner "@me" --name "Item closed"
+		`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := client.New(f)
+			if err != nil {
+				return err
+			}
+
+			if len(args) == 1 {
+				num, err := strconv.ParseInt(args[0], 10, 32)
+				if err != nil {
+					return cmdutil.FlagErrorf("invalid number: %v", args[0])
+				}
+				opts.number = int32(num)
+			}
+
+			config := disableWorkflowConfig{
+				io:     f.IOStreams,
+				client: client,
+				opts:   opts,
+			}
+
+			// allow testing of the command without actually running it
+			if runF != nil {
+				return runF(config)
+			}
+			return runDisableWorkflow(config)
+		},
+	}
+
+	disableWorkflowCmd.Flags().StringVar(&opts.owner, "owner", "", "Login of the owner. Use \"@me\" for the current user. Defaults to the owner of the current directory's repository.")
+	disableWorkflowCmd.Flags().StringVar(&opts.name, "name", "", "Name of the workflow")
+	cmdutil.AddFormatAndJSONFlags(disableWorkflowCmd, &opts.exporter, queries.WorkflowJSONFields)
+
+	_ = disableWorkflowCmd.MarkFlagRequired("name")
+
+	return disableWorkflowCmd
+}
+
+func runDisableWorkflow(config disableWorkflowConfig) error {
+	canPrompt := config.io.CanPrompt()
+	owner, err := config.client.NewOwner(canPrompt, config.opts.owner)
+	if err != nil {
+		return err
+	}
+
+	// no need to fetch the project if we already have the number
+	if config.opts.number == 0 {
+		project, err := config.client.NewProject(canPrompt, owner, config.opts.number, false)
+		if err != nil {
+			return err
+		}
+		config.opts.number = project.Number
+	}
+
+	workflow, err := config.client.ProjectWorkflowByName(owner, config.opts.number, config.opts.name)
+	if err != nil {
+		return err
+	}
+
+	query := &updateProjectWorkflowMutation{}
+	variables := map[string]interface{}{
+		"input": queries.UpdateProjectV2WorkflowInput{
+			WorkflowID: githubv4.ID(workflow.ID),
+			Enabled:    githubv4.Boolean(false),
+		},
+	}
+
+	err = config.client.Mutate("DisableProjectWorkflow", query, variables)
+	if err != nil {
+		return err
+	}
+
+	if config.opts.exporter != nil {
+		return config.opts.exporter.Write(config.io, query.UpdateProjectV2Workflow.Workflow)
+	}
+
+	return printResults(config, query.UpdateProjectV2Workflow.Workflow)
+}
+
+func printResults(config disableWorkflowConfig, workflow queries.ProjectWorkflow) error {
+	if !config.io.IsStdoutTTY() {
+		return nil
+	}
+
+	_, err := fmt.Fprintf(config.io.Out, "Disabled workflow %q in project %d\n", workflow.Name, config.opts.number)
+	return err
+}
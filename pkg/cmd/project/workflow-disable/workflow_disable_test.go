@@ -0,0 +1,177 @@
+package workflowdisable
+
+import (
+	"testing"
+
+	"github.com/cli/cli/v2/pkg/cmd/project/shared/queries"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestNewCmdDisableWorkflow(t *testing.T) {
+	tests := []struct {
+		name        string
+		cli         string
+		wants       disableWorkflowOpts
+		wantsErr    bool
+		wantsErrMsg string
+	}{
+		{
+			name:        "missing-name",
+			cli:         "123",
+			wantsErr:    true,
+			wantsErrMsg: `required flag(s) "name" not set`,
+		},
+		{
+			name: "number",
+			cli:  "123 --name 'Item closed'",
+			wants: disableWorkflowOpts{
+				number: 123,
+				name:   "Item closed",
+			},
+		},
+		{
+			name: "owner",
+			cli:  "--owner monalisa --name 'Item closed'",
+			wants: disableWorkflowOpts{
+				owner: "monalisa",
+				name:  "Item closed",
+			},
+		},
+	}
+
+	t.Setenv("GH_TOKEN", "auth-token")
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ios, _, _, _ := iostreams.Test()
+			f := &cmdutil.Factory{
+				IOStreams: ios,
+			}
+
+			argv, err := shlex.Split(tt.cli)
+			assert.NoError(t, err)
+
+			var gotOpts disableWorkflowOpts
+			cmd := NewCmdDisableWorkflow(f, func(config disableWorkflowConfig) error {
+				gotOpts = config.opts
+				return nil
+			})
+
+			cmd.SetArgs(argv)
+			_, err = cmd.ExecuteC()
+			if tt.wantsErr {
+				assert.Error(t, err)
+				assert.Equal(t, tt.wantsErrMsg, err.Error())
+				return
+			}
+			assert.NoError(t, err)
+
+			assert.Equal(t, tt.wants.number, gotOpts.number)
+			assert.Equal(t, tt.wants.owner, gotOpts.owner)
+			assert.Equal(t, tt.wants.name, gotOpts.name)
+		})
+	}
+}
+
+func TestRunDisableWorkflow_User(t *testing.T) {
+	defer gock.Off()
+	// gock.Observe(gock.DumpRequest)
+
+	// get user ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query UserOrgOwner.*",
+			"variables": map[string]interface{}{
+				"login": "monalisa",
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"id": "an ID",
+				},
+			},
+			"errors": []interface{}{
+				map[string]interface{}{
+					"type": "NOT_FOUND",
+					"path": []string{"organization"},
+				},
+			},
+		})
+
+	// list project workflows
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		JSON(map[string]interface{}{
+			"query": "query UserProjectWithWorkflows.*",
+			"variables": map[string]interface{}{
+				"login":          "monalisa",
+				"number":         1,
+				"firstWorkflows": queries.LimitMax,
+				"afterWorkflows": nil,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"workflows": map[string]interface{}{
+							"totalCount": 1,
+							"nodes": []map[string]interface{}{
+								{
+									"id":      "workflow ID",
+									"number":  2,
+									"name":    "Item closed",
+									"enabled": true,
+								},
+							},
+						},
+					},
+				},
+			},
+		})
+
+	// disable the workflow
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`.*"input":{"workflowId":"workflow ID","enabled":false}.*`).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"updateProjectV2Workflow": map[string]interface{}{
+					"projectV2Workflow": map[string]interface{}{
+						"id":      "workflow ID",
+						"number":  2,
+						"name":    "Item closed",
+						"enabled": false,
+					},
+				},
+			},
+		})
+
+	client := queries.NewTestClient()
+
+	ios, _, stdout, _ := iostreams.Test()
+	ios.SetStdoutTTY(true)
+	config := disableWorkflowConfig{
+		opts: disableWorkflowOpts{
+			number: 1,
+			owner:  "monalisa",
+			name:   "Item closed",
+		},
+		client: client,
+		io:     ios,
+	}
+
+	err := runDisableWorkflow(config)
+	assert.NoError(t, err)
+	assert.Equal(t, "Disabled workflow \"Item closed\" in project 1\n", stdout.String())
+}
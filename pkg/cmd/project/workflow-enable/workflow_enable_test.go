@@ -0,0 +1,211 @@
+package workflowenable
+
+import (
+	"testing"
+
+	"github.com/cli/cli/v2/pkg/cmd/project/shared/queries"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func TestNewCmdEnableWorkflow(t *testing.T) {
+	tests := []struct {
+		name        string
+		cli         string
+		wants       enableWorkflowOpts
+		wantsErr    bool
+		wantsErrMsg string
+	}{
+		{
+			name:        "missing-name",
+			cli:         "123",
+			wantsErr:    true,
+			wantsErrMsg: `required flag(s) "name" not set`,
+		},
+		{
+			name:        "not-a-number",
+			cli:         "x --name 'Item closed'",
+			wantsErr:    true,
+			wantsErrMsg: "invalid number: x",
+		},
+		{
+			name: "number",
+			cli:  "123 --name 'Item closed'",
+			wants: enableWorkflowOpts{
+				number: 123,
+				name:   "Item closed",
+			},
+		},
+		{
+			name: "owner",
+			cli:  "--owner monalisa --name 'Item closed'",
+			wants: enableWorkflowOpts{
+				owner: "monalisa",
+				name:  "Item closed",
+			},
+		},
+	}
+
+	t.Setenv("GH_TOKEN", "auth-token")
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ios, _, _, _ := iostreams.Test()
+			f := &cmdutil.Factory{
+				IOStreams: ios,
+			}
+
+			argv, err := shlex.Split(tt.cli)
+			assert.NoError(t, err)
+
+			var gotOpts enableWorkflowOpts
+			cmd := NewCmdEnableWorkflow(f, func(config enableWorkflowConfig) error {
+				gotOpts = config.opts
+				return nil
+			})
+
+			cmd.SetArgs(argv)
+			_, err = cmd.ExecuteC()
+			if tt.wantsErr {
+				assert.Error(t, err)
+				assert.Equal(t, tt.wantsErrMsg, err.Error())
+				return
+			}
+			assert.NoError(t, err)
+
+			assert.Equal(t, tt.wants.number, gotOpts.number)
+			assert.Equal(t, tt.wants.owner, gotOpts.owner)
+			assert.Equal(t, tt.wants.name, gotOpts.name)
+		})
+	}
+}
+
+func registerOwnerAndWorkflows(t *testing.T) {
+	t.Helper()
+
+	// get user ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query UserOrgOwner.*",
+			"variables": map[string]interface{}{
+				"login": "monalisa",
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"id": "an ID",
+				},
+			},
+			"errors": []interface{}{
+				map[string]interface{}{
+					"type": "NOT_FOUND",
+					"path": []string{"organization"},
+				},
+			},
+		})
+
+	// list project workflows
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		JSON(map[string]interface{}{
+			"query": "query UserProjectWithWorkflows.*",
+			"variables": map[string]interface{}{
+				"login":          "monalisa",
+				"number":         1,
+				"firstWorkflows": queries.LimitMax,
+				"afterWorkflows": nil,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"workflows": map[string]interface{}{
+							"totalCount": 1,
+							"nodes": []map[string]interface{}{
+								{
+									"id":      "workflow ID",
+									"number":  2,
+									"name":    "Item closed",
+									"enabled": false,
+								},
+							},
+						},
+					},
+				},
+			},
+		})
+}
+
+func TestRunEnableWorkflow_User(t *testing.T) {
+	defer gock.Off()
+	// gock.Observe(gock.DumpRequest)
+
+	registerOwnerAndWorkflows(t)
+
+	// enable the workflow
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`.*"input":{"workflowId":"workflow ID","enabled":true}.*`).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"updateProjectV2Workflow": map[string]interface{}{
+					"projectV2Workflow": map[string]interface{}{
+						"id":      "workflow ID",
+						"number":  2,
+						"name":    "Item closed",
+						"enabled": true,
+					},
+				},
+			},
+		})
+
+	client := queries.NewTestClient()
+
+	ios, _, stdout, _ := iostreams.Test()
+	ios.SetStdoutTTY(true)
+	config := enableWorkflowConfig{
+		opts: enableWorkflowOpts{
+			number: 1,
+			owner:  "monalisa",
+			name:   "item closed",
+		},
+		client: client,
+		io:     ios,
+	}
+
+	err := runEnableWorkflow(config)
+	assert.NoError(t, err)
+	assert.Equal(t, "Enabled workflow \"Item closed\" in project 1\n", stdout.String())
+}
+
+func TestRunEnableWorkflow_NotFound(t *testing.T) {
+	defer gock.Off()
+
+	registerOwnerAndWorkflows(t)
+
+	client := queries.NewTestClient()
+
+	ios, _, _, _ := iostreams.Test()
+	config := enableWorkflowConfig{
+		opts: enableWorkflowOpts{
+			number: 1,
+			owner:  "monalisa",
+			name:   "Auto-close issue",
+		},
+		client: client,
+		io:     ios,
+	}
+
+	err := runEnableWorkflow(config)
+	assert.EqualError(t, err, `workflow "Auto-close issue" not found in project 1`)
+}
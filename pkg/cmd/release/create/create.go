@@ -60,6 +60,7 @@ type CreateOptions struct {
 	NotesStartTag      string
 	VerifyTag          bool
 	NotesFromTag       bool
+	Attest             bool
 }
 
 func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Command {
@@ -163,6 +164,10 @@ func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Co
 				return cmdutil.FlagErrorf("using `--notes-from-tag` with `--repo` is not supported")
 			}
 
+			if opts.Attest && len(opts.Assets) == 0 {
+				return cmdutil.FlagErrorf("`--attest` requires at least one asset file")
+			}
+
 			opts.Concurrency = 5
 
 			opts.BodyProvided = cmd.Flags().Changed("notes") || opts.GenerateNotes || opts.NotesFromTag
@@ -194,6 +199,7 @@ func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Co
 	cmdutil.NilBoolFlag(cmd, &opts.IsLatest, "latest", "", "Mark this release as \"Latest\" (default [automatic based on date and version]). --latest=false to explicitly NOT set as latest")
 	cmd.Flags().BoolVarP(&opts.VerifyTag, "verify-tag", "", false, "Abort in case the git tag doesn't already exist in the remote repository")
 	cmd.Flags().BoolVarP(&opts.NotesFromTag, "notes-from-tag", "", false, "Automatically generate notes from annotated tag")
+	cmd.Flags().BoolVar(&opts.Attest, "attest", false, "Generate provenance attestations for the uploaded assets")
 
 	_ = cmdutil.RegisterBranchCompletionFlags(f.GitClient, cmd, "target")
 
@@ -201,6 +207,10 @@ func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Co
 }
 
 func createRun(opts *CreateOptions) error {
+	if opts.Attest && !shared.InActionsOIDCContext() {
+		return fmt.Errorf("`--attest` requires GitHub Actions OIDC credentials; run this command from a workflow with the `id-token: write` permission")
+	}
+
 	httpClient, err := opts.HttpClient()
 	if err != nil {
 		return err
@@ -519,6 +529,15 @@ func createRun(opts *CreateOptions) error {
 			return cleanupDraftRelease(err)
 		}
 
+		if opts.Attest {
+			opts.IO.StartProgressIndicator()
+			err = shared.AttestAssets(httpClient, baseRepo, opts.Assets)
+			opts.IO.StopProgressIndicator()
+			if err != nil {
+				return cleanupDraftRelease(err)
+			}
+		}
+
 		if draftWhileUploading {
 			rel, err := publishRelease(httpClient, newRelease.APIURL, opts.DiscussionCategory, opts.IsLatest)
 			if err != nil {
@@ -347,6 +347,12 @@ func Test_NewCmdCreate(t *testing.T) {
 			isTTY:   false,
 			wantErr: "using `--notes-from-tag` with `--generate-notes` or `--notes-start-tag` is not supported",
 		},
+		{
+			name:    "with --attest and no assets",
+			args:    "v1.2.3 --attest",
+			isTTY:   false,
+			wantErr: "`--attest` requires at least one asset file",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -1,6 +1,7 @@
 package list
 
 import (
+	"fmt"
 	"net/http"
 	"strings"
 	"time"
@@ -8,7 +9,7 @@ import (
 	"github.com/cli/cli/v2/api"
 	"github.com/cli/cli/v2/internal/ghrepo"
 	"github.com/cli/cli/v2/pkg/cmdutil"
-	"github.com/shurcooL/githubv4"
+	"github.com/cli/cli/v2/pkg/set"
 )
 
 var releaseFields = []string{
@@ -35,7 +36,7 @@ func (r *Release) ExportData(fields []string) map[string]interface{} {
 	return cmdutil.StructExportData(r, fields)
 }
 
-func fetchReleases(httpClient *http.Client, repo ghrepo.Interface, limit int, excludeDrafts bool, excludePreReleases bool, order string) ([]Release, error) {
+func fetchReleases(httpClient *http.Client, repo ghrepo.Interface, fields []string, limit int, excludeDrafts bool, excludePreReleases bool, order string) ([]Release, error) {
 	type responseData struct {
 		Repository struct {
 			Releases struct {
@@ -44,21 +45,44 @@ func fetchReleases(httpClient *http.Client, repo ghrepo.Interface, limit int, ex
 					HasNextPage bool
 					EndCursor   string
 				}
-			} `graphql:"releases(first: $perPage, orderBy: {field: CREATED_AT, direction: $direction}, after: $endCursor)"`
-		} `graphql:"repository(owner: $owner, name: $name)"`
+			}
+		}
+	}
+
+	// the exclusion filters are applied client-side, so their fields must be
+	// fetched even when they were not selected for output
+	selected := set.NewStringSet()
+	selected.AddValues(fields)
+	if excludeDrafts {
+		selected.Add("isDraft")
+	}
+	if excludePreReleases {
+		selected.Add("isPrerelease")
 	}
 
+	query := fmt.Sprintf(`query RepositoryReleaseList($owner: String!, $name: String!, $perPage: Int!, $endCursor: String, $direction: OrderDirection!) {
+		repository(owner: $owner, name: $name) {
+			releases(first: $perPage, orderBy: {field: CREATED_AT, direction: $direction}, after: $endCursor) {
+				nodes {%s}
+				pageInfo {
+					hasNextPage
+					endCursor
+				}
+			}
+		}
+	}`, strings.Join(selected.ToSlice(), ","))
+
 	perPage := limit
 	if limit > 100 {
 		perPage = 100
 	}
 
 	variables := map[string]interface{}{
-		"owner":     githubv4.String(repo.RepoOwner()),
-		"name":      githubv4.String(repo.RepoName()),
-		"perPage":   githubv4.Int(perPage),
-		"endCursor": (*githubv4.String)(nil),
-		"direction": githubv4.OrderDirection(strings.ToUpper(order)),
+		"owner":     repo.RepoOwner(),
+		"name":      repo.RepoName(),
+		"perPage":   perPage,
+		"endCursor": nil,
+		"direction": strings.ToUpper(order),
 	}
 
 	gql := api.NewClientFromHTTP(httpClient)
@@ -66,13 +90,13 @@ func fetchReleases(httpClient *http.Client, repo ghrepo.Interface, limit int, ex
 	var releases []Release
 loop:
 	for {
-		var query responseData
-		err := gql.Query(repo.RepoHost(), "RepositoryReleaseList", &query, variables)
+		var data responseData
+		err := gql.GraphQL(repo.RepoHost(), query, variables, &data)
 		if err != nil {
 			return nil, err
 		}
 
-		for _, r := range query.Repository.Releases.Nodes {
+		for _, r := range data.Repository.Releases.Nodes {
 			if excludeDrafts && r.IsDraft {
 				continue
 			}
@@ -85,10 +109,10 @@ loop:
 			}
 		}
 
-		if !query.Repository.Releases.PageInfo.HasNextPage {
+		if !data.Repository.Releases.PageInfo.HasNextPage {
 			break
 		}
-		variables["endCursor"] = githubv4.String(query.Repository.Releases.PageInfo.EndCursor)
+		variables["endCursor"] = data.Repository.Releases.PageInfo.EndCursor
 	}
 
 	return releases, nil
@@ -68,7 +68,12 @@ func listRun(opts *ListOptions) error {
 		return err
 	}
 
-	releases, err := fetchReleases(httpClient, baseRepo, opts.LimitResults, opts.ExcludeDrafts, opts.ExcludePreReleases, opts.Order)
+	fields := releaseFields
+	if opts.Exporter != nil {
+		fields = opts.Exporter.Fields()
+	}
+
+	releases, err := fetchReleases(httpClient, baseRepo, fields, opts.LimitResults, opts.ExcludeDrafts, opts.ExcludePreReleases, opts.Order)
 	if err != nil {
 		return err
 	}
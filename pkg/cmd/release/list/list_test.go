@@ -249,3 +249,23 @@ func TestExportReleases(t *testing.T) {
 		`[{"createdAt":"2024-01-01T00:00:00Z","isDraft":true,"isLatest":false,"isPrerelease":true,"name":"v1","publishedAt":"2024-02-01T00:00:00Z","tagName":"tag"}]`,
 		stdout.String())
 }
+
+func Test_fetchReleases_fieldSelection(t *testing.T) {
+	fakeHTTP := &httpmock.Registry{}
+	var gotQuery string
+	fakeHTTP.Register(
+		httpmock.GraphQL(`\bRepositoryReleaseList\(`),
+		httpmock.GraphQLQuery(`{ "data": { "repository": { "releases": { "nodes": [] } } } }`,
+			func(query string, _ map[string]interface{}) {
+				gotQuery = query
+			}))
+
+	repo, err := ghrepo.FromFullName("OWNER/REPO")
+	require.NoError(t, err)
+
+	_, err = fetchReleases(&http.Client{Transport: fakeHTTP}, repo, []string{"tagName"}, 30, true, false, "desc")
+	require.NoError(t, err)
+
+	assert.Contains(t, gotQuery, "nodes {tagName,isDraft}")
+	assert.NotContains(t, gotQuery, "publishedAt")
+}
@@ -0,0 +1,71 @@
+package shared
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+)
+
+// provenancePredicateType identifies the SLSA provenance predicate attached to
+// asset attestations, matching what `gh attestation verify` expects by default.
+const provenancePredicateType = "https://slsa.dev/provenance/v1"
+
+// InActionsOIDCContext reports whether the current process can request GitHub
+// Actions OIDC tokens, which the attestations API requires in order to bind
+// provenance to the generating workflow.
+func InActionsOIDCContext() bool {
+	return os.Getenv("ACTIONS_ID_TOKEN_REQUEST_URL") != "" && os.Getenv("ACTIONS_ID_TOKEN_REQUEST_TOKEN") != ""
+}
+
+// AttestAssets generates a provenance attestation for each asset via the
+// attestations API. Assets are identified by the SHA-256 digest of their
+// contents so that consumers can later locate the attestations with
+// `gh attestation verify`.
+func AttestAssets(httpClient *http.Client, repo ghrepo.Interface, assets []*AssetForUpload) error {
+	client := api.NewClientFromHTTP(httpClient)
+	path := fmt.Sprintf("repos/%s/attestations", ghrepo.FullName(repo))
+
+	for _, a := range assets {
+		digest, err := assetDigest(a)
+		if err != nil {
+			return fmt.Errorf("failed to digest %s: %w", a.Name, err)
+		}
+
+		body, err := json.Marshal(map[string]interface{}{
+			"subject_name":   a.Name,
+			"subject_digest": digest,
+			"predicate_type": provenancePredicateType,
+		})
+		if err != nil {
+			return err
+		}
+
+		if err := client.REST(repo.RepoHost(), "POST", path, bytes.NewReader(body), nil); err != nil {
+			return fmt.Errorf("failed to attest %s: %w", a.Name, err)
+		}
+	}
+
+	return nil
+}
+
+func assetDigest(a *AssetForUpload) (string, error) {
+	f, err := a.Open()
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return "sha256:" + hex.EncodeToString(h.Sum(nil)), nil
+}
@@ -0,0 +1,49 @@
+package shared
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_InActionsOIDCContext(t *testing.T) {
+	t.Setenv("ACTIONS_ID_TOKEN_REQUEST_URL", "")
+	t.Setenv("ACTIONS_ID_TOKEN_REQUEST_TOKEN", "")
+	assert.False(t, InActionsOIDCContext())
+
+	t.Setenv("ACTIONS_ID_TOKEN_REQUEST_URL", "https://actions.example.com/token")
+	assert.False(t, InActionsOIDCContext())
+
+	t.Setenv("ACTIONS_ID_TOKEN_REQUEST_TOKEN", "secret")
+	assert.True(t, InActionsOIDCContext())
+}
+
+func Test_AttestAssets(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("POST", "repos/OWNER/REPO/attestations"),
+		httpmock.RESTPayload(201, `{}`, func(payload map[string]interface{}) {
+			assert.Equal(t, map[string]interface{}{
+				"subject_name":   "asset.zip",
+				"subject_digest": "sha256:729d7d638cef1b476c9ad1d5d275dc91fc253a026e0924d45b8995bcf828b01b",
+				"predicate_type": "https://slsa.dev/provenance/v1",
+			}, payload)
+		}))
+
+	err := AttestAssets(&http.Client{Transport: reg}, ghrepo.New("OWNER", "REPO"), []*AssetForUpload{
+		{
+			Name: "asset.zip",
+			Open: func() (io.ReadCloser, error) {
+				return io.NopCloser(bytes.NewBufferString("somebody")), nil
+			},
+		},
+	})
+	require.NoError(t, err)
+}
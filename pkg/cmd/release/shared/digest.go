@@ -0,0 +1,104 @@
+package shared
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/cli/cli/v2/api"
+)
+
+// checksumAssetRE matches release assets that hold checksum manifests for the
+// other assets, e.g. checksums.txt, SHA256SUMS, or example.bin.sha256.
+var checksumAssetRE = regexp.MustCompile(`(?i)^(sha256sums?|sha512sums?|checksums?)(\.txt)?$|\.(sha256|sha512)$`)
+
+var hexRE = regexp.MustCompile(`^[0-9a-f]+$`)
+
+// IsChecksumAsset reports whether the asset name looks like a checksum
+// manifest.
+func IsChecksumAsset(name string) bool {
+	return checksumAssetRE.MatchString(name)
+}
+
+// PopulateAssetDigests fills in the Digest field of the release's assets from
+// checksum manifests uploaded alongside them. Releases without a checksum
+// asset are left untouched.
+func PopulateAssetDigests(ctx context.Context, httpClient *http.Client, release *Release) error {
+	digests := map[string]string{}
+	for _, a := range release.Assets {
+		if !IsChecksumAsset(a.Name) {
+			continue
+		}
+		contents, err := downloadChecksumAsset(ctx, httpClient, a)
+		if err != nil {
+			return fmt.Errorf("failed to download %s: %w", a.Name, err)
+		}
+		parseChecksums(contents, digests)
+	}
+
+	for i, a := range release.Assets {
+		if digest, ok := digests[a.Name]; ok {
+			release.Assets[i].Digest = digest
+		}
+	}
+	return nil
+}
+
+func downloadChecksumAsset(ctx context.Context, httpClient *http.Client, a ReleaseAsset) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", a.APIURL, nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Accept", "application/octet-stream")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode > 299 {
+		return "", api.HandleHTTPError(resp)
+	}
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// parseChecksums reads `<hex-digest> <file-name>` lines, the format produced
+// by sha256sum and friends, and records a digest per file name.
+func parseChecksums(contents string, digests map[string]string) {
+	scanner := bufio.NewScanner(strings.NewReader(contents))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) != 2 {
+			continue
+		}
+
+		digest := strings.ToLower(fields[0])
+		var alg string
+		switch len(digest) {
+		case 64:
+			alg = "sha256"
+		case 128:
+			alg = "sha512"
+		default:
+			continue
+		}
+		if !hexRE.MatchString(digest) {
+			continue
+		}
+
+		// sha256sum marks binary mode with a leading asterisk
+		name := strings.TrimPrefix(fields[1], "*")
+		name = strings.TrimPrefix(name, "./")
+		digests[name] = fmt.Sprintf("%s:%s", alg, digest)
+	}
+}
@@ -0,0 +1,108 @@
+package shared
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_IsChecksumAsset(t *testing.T) {
+	tests := []struct {
+		name string
+		want bool
+	}{
+		{name: "checksums.txt", want: true},
+		{name: "CHECKSUMS", want: true},
+		{name: "SHA256SUMS", want: true},
+		{name: "sha512sums.txt", want: true},
+		{name: "example.bin.sha256", want: true},
+		{name: "example.bin", want: false},
+		{name: "windows.zip", want: false},
+		{name: "checksums.sig", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, IsChecksumAsset(tt.name))
+		})
+	}
+}
+
+func Test_parseChecksums(t *testing.T) {
+	sha256Digest := strings.Repeat("ab", 32)
+	sha512Digest := strings.Repeat("cd", 64)
+	contents := strings.Join([]string{
+		sha256Digest + "  windows.zip",
+		sha512Digest + "  linux.tgz",
+		sha256Digest + "  *binary.bin",
+		sha256Digest + "  ./dist/nested.bin",
+		"not-a-digest  bogus.bin",
+		"onlyonefield",
+	}, "\n")
+
+	digests := map[string]string{}
+	parseChecksums(contents, digests)
+
+	assert.Equal(t, map[string]string{
+		"windows.zip":     "sha256:" + sha256Digest,
+		"linux.tgz":       "sha512:" + sha512Digest,
+		"binary.bin":      "sha256:" + sha256Digest,
+		"dist/nested.bin": "sha256:" + sha256Digest,
+	}, digests)
+}
+
+func Test_PopulateAssetDigests(t *testing.T) {
+	sha256Digest := strings.Repeat("ab", 32)
+
+	t.Run("populates digests from a checksum asset", func(t *testing.T) {
+		fakeHTTP := &httpmock.Registry{}
+		defer fakeHTTP.Verify(t)
+		fakeHTTP.Register(httpmock.REST("GET", "repos/OWNER/REPO/releases/assets/1"),
+			httpmock.StringResponse(sha256Digest+"  windows.zip\n"))
+
+		release := &Release{
+			Assets: []ReleaseAsset{
+				{Name: "windows.zip"},
+				{Name: "checksums.txt", APIURL: "https://api.github.com/repos/OWNER/REPO/releases/assets/1"},
+			},
+		}
+
+		err := PopulateAssetDigests(context.Background(), &http.Client{Transport: fakeHTTP}, release)
+		require.NoError(t, err)
+		assert.Equal(t, "sha256:"+sha256Digest, release.Assets[0].Digest)
+		assert.Equal(t, "", release.Assets[1].Digest)
+	})
+
+	t.Run("leaves releases without a checksum asset untouched", func(t *testing.T) {
+		fakeHTTP := &httpmock.Registry{}
+		defer fakeHTTP.Verify(t)
+
+		release := &Release{
+			Assets: []ReleaseAsset{{Name: "windows.zip"}},
+		}
+
+		err := PopulateAssetDigests(context.Background(), &http.Client{Transport: fakeHTTP}, release)
+		require.NoError(t, err)
+		assert.Equal(t, "", release.Assets[0].Digest)
+	})
+
+	t.Run("returns an error when the checksum asset cannot be downloaded", func(t *testing.T) {
+		fakeHTTP := &httpmock.Registry{}
+		defer fakeHTTP.Verify(t)
+		fakeHTTP.Register(httpmock.REST("GET", "repos/OWNER/REPO/releases/assets/1"),
+			httpmock.StatusStringResponse(500, "oops"))
+
+		release := &Release{
+			Assets: []ReleaseAsset{
+				{Name: "checksums.txt", APIURL: "https://api.github.com/repos/OWNER/REPO/releases/assets/1"},
+			},
+		}
+
+		err := PopulateAssetDigests(context.Background(), &http.Client{Transport: fakeHTTP}, release)
+		require.ErrorContains(t, err, "failed to download checksums.txt")
+	})
+}
@@ -79,6 +79,10 @@ type ReleaseAsset struct {
 	DownloadCount      int       `json:"download_count"`
 	ContentType        string    `json:"content_type"`
 	BrowserDownloadURL string    `json:"browser_download_url"`
+
+	// Digest is not returned by the API; it is populated from checksum
+	// manifests uploaded alongside the assets (c.f. PopulateAssetDigests)
+	Digest string `json:"-"`
 }
 
 func (rel *Release) ExportData(fields []string) map[string]interface{} {
@@ -112,6 +116,7 @@ func (rel *Release) ExportData(fields []string) map[string]interface{} {
 					"updatedAt":     a.UpdatedAt,
 					"downloadCount": a.DownloadCount,
 					"contentType":   a.ContentType,
+					"digest":        a.Digest,
 				})
 			}
 			data[f] = assets
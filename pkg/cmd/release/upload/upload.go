@@ -27,6 +27,7 @@ type UploadOptions struct {
 	// maximum number of simultaneous uploads
 	Concurrency       int
 	OverwriteExisting bool
+	Attest            bool
 }
 
 func NewCmdUpload(f *cmdutil.Factory, runF func(*UploadOptions) error) *cobra.Command {
@@ -43,6 +44,10 @@ func NewCmdUpload(f *cmdutil.Factory, runF func(*UploadOptions) error) *cobra.Co
 
 			To define a display label for an asset, append text starting with %[1]s#%[1]s after the
 			file name.
+
+			With %[1]s--attest%[1]s, a provenance attestation is generated for each uploaded
+			asset so that consumers can verify it with %[1]sgh attestation verify%[1]s. This
+			requires running within GitHub Actions with the %[1]sid-token: write%[1]s permission.
 		`, "`"),
 		Args: cobra.MinimumNArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -67,11 +72,16 @@ func NewCmdUpload(f *cmdutil.Factory, runF func(*UploadOptions) error) *cobra.Co
 	}
 
 	cmd.Flags().BoolVar(&opts.OverwriteExisting, "clobber", false, "Overwrite existing assets of the same name")
+	cmd.Flags().BoolVar(&opts.Attest, "attest", false, "Generate provenance attestations for the uploaded assets")
 
 	return cmd
 }
 
 func uploadRun(opts *UploadOptions) error {
+	if opts.Attest && !shared.InActionsOIDCContext() {
+		return fmt.Errorf("`--attest` requires GitHub Actions OIDC credentials; run this command from a workflow with the `id-token: write` permission")
+	}
+
 	httpClient, err := opts.HttpClient()
 	if err != nil {
 		return err
@@ -115,11 +125,24 @@ func uploadRun(opts *UploadOptions) error {
 		return err
 	}
 
+	if opts.Attest {
+		opts.IO.StartProgressIndicator()
+		err = shared.AttestAssets(httpClient, baseRepo, opts.Assets)
+		opts.IO.StopProgressIndicator()
+		if err != nil {
+			return err
+		}
+	}
+
 	if opts.IO.IsStdoutTTY() {
 		iofmt := opts.IO.ColorScheme()
 		fmt.Fprintf(opts.IO.Out, "Successfully uploaded %s to %s\n",
 			text.Pluralize(len(opts.Assets), "asset"),
 			iofmt.Bold(release.TagName))
+		if opts.Attest {
+			fmt.Fprintf(opts.IO.Out, "Generated provenance %s\n",
+				text.Pluralize(len(opts.Assets), "attestation"))
+		}
 	}
 
 	return nil
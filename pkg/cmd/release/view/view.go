@@ -105,6 +105,13 @@ func viewRun(opts *ViewOptions) error {
 		return opts.Browser.Browse(release.URL)
 	}
 
+	if len(release.Assets) > 0 {
+		// asset digests come from checksum manifests uploaded alongside the assets
+		if err := shared.PopulateAssetDigests(ctx, httpClient, release); err != nil {
+			fmt.Fprintf(opts.IO.ErrOut, "warning: could not load asset digests: %v\n", err)
+		}
+	}
+
 	opts.IO.DetectTerminalTheme()
 	if err := opts.IO.StartPager(); err != nil {
 		fmt.Fprintf(opts.IO.ErrOut, "error starting pager: %v\n", err)
@@ -153,12 +160,25 @@ func renderReleaseTTY(io *iostreams.IOStreams, release *shared.Release) error {
 	fmt.Fprintln(w, renderedDescription)
 
 	if len(release.Assets) > 0 {
+		// only show the digest column when at least one digest is known
+		var hasDigests bool
+		for _, a := range release.Assets {
+			if a.Digest != "" {
+				hasDigests = true
+				break
+			}
+		}
+
 		fmt.Fprintf(w, "%s\n", iofmt.Bold("Assets"))
 		//nolint:staticcheck // SA1019: Showing NAME|SIZE headers adds nothing to table.
 		table := tableprinter.New(io, tableprinter.NoHeader)
 		for _, a := range release.Assets {
 			table.AddField(a.Name)
 			table.AddField(humanFileSize(a.Size))
+			table.AddField(text.Pluralize(a.DownloadCount, "download"))
+			if hasDigests {
+				table.AddField(a.Digest)
+			}
 			table.EndRow()
 		}
 		err := table.Render()
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strings"
 	"testing"
 	"time"
 
@@ -150,8 +151,8 @@ func Test_viewRun(t *testing.T) {
 				
 				
 				Assets
-				windows.zip  12 B
-				linux.tgz    34 B
+				windows.zip  12 B  2 downloads
+				linux.tgz    34 B  1 download
 				
 				View on GitHub: https://github.com/OWNER/REPO/releases/tags/v1.2.3
 			`),
@@ -174,8 +175,8 @@ func Test_viewRun(t *testing.T) {
 				
 				
 				Assets
-				windows.zip  12 B
-				linux.tgz    34 B
+				windows.zip  12 B  2 downloads
+				linux.tgz    34 B  1 download
 				
 				View on GitHub: https://github.com/OWNER/REPO/releases/tags/v1.2.3
 			`),
@@ -248,8 +249,8 @@ func Test_viewRun(t *testing.T) {
 				"published_at": "%[1]s",
 				"html_url": "https://github.com/OWNER/REPO/releases/tags/v1.2.3",
 				"assets": [
-					{ "name": "windows.zip", "size": 12 },
-					{ "name": "linux.tgz", "size": 34 }
+					{ "name": "windows.zip", "size": 12, "download_count": 2 },
+					{ "name": "linux.tgz", "size": 34, "download_count": 1 }
 				]
 			}`, tt.releasedAt.Format(time.RFC3339), tt.releaseBody))
 
@@ -275,6 +276,47 @@ func Test_viewRun(t *testing.T) {
 	}
 }
 
+func Test_viewRun_withChecksumAsset(t *testing.T) {
+	ios, _, stdout, stderr := iostreams.Test()
+	ios.SetStdoutTTY(true)
+	ios.SetStdinTTY(true)
+	ios.SetStderrTTY(true)
+
+	fakeHTTP := &httpmock.Registry{}
+	defer fakeHTTP.Verify(t)
+	shared.StubFetchRelease(t, fakeHTTP, "OWNER", "REPO", "v1.2.3", `{
+		"tag_name": "v1.2.3",
+		"draft": false,
+		"author": { "login": "MonaLisa" },
+		"body": "* Fixed bugs",
+		"created_at": "2020-08-31T15:44:24+02:00",
+		"published_at": "2020-08-31T15:44:24+02:00",
+		"html_url": "https://github.com/OWNER/REPO/releases/tags/v1.2.3",
+		"assets": [
+			{ "name": "windows.zip", "size": 12, "download_count": 2 },
+			{ "name": "checksums.txt", "size": 78, "download_count": 1, "url": "https://api.github.com/repos/OWNER/REPO/releases/assets/1" }
+		]
+	}`)
+	digest := strings.Repeat("ab", 32)
+	fakeHTTP.Register(httpmock.REST("GET", "repos/OWNER/REPO/releases/assets/1"),
+		httpmock.StringResponse(fmt.Sprintf("%s  windows.zip\n", digest)))
+
+	opts := ViewOptions{
+		TagName: "v1.2.3",
+		IO:      ios,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: fakeHTTP}, nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.FromFullName("OWNER/REPO")
+		},
+	}
+
+	require.NoError(t, viewRun(&opts))
+	assert.Contains(t, stdout.String(), "sha256:")
+	assert.Equal(t, "", stderr.String())
+}
+
 func Test_humanFileSize(t *testing.T) {
 	tests := []struct {
 		name string
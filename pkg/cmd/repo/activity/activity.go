@@ -0,0 +1,147 @@
+package activity
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/internal/tableprinter"
+	"github.com/cli/cli/v2/pkg/cmd/repo/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type ActivityOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+
+	Weeks    int
+	Exporter cmdutil.Exporter
+}
+
+type Week struct {
+	WeekStart string `json:"weekStart"`
+	Commits   int    `json:"commits"`
+	Additions int    `json:"additions"`
+	Deletions int    `json:"deletions"`
+}
+
+var weekFields = []string{"weekStart", "commits", "additions", "deletions"}
+
+func (w *Week) ExportData(fields []string) map[string]interface{} {
+	return cmdutil.StructExportData(w, fields)
+}
+
+func NewCmdActivity(f *cmdutil.Factory, runF func(*ActivityOptions) error) *cobra.Command {
+	opts := &ActivityOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "activity",
+		Short: "Show weekly commit and code frequency for a repository",
+		Long: heredoc.Doc(`
+			Show the weekly commit count and lines added and deleted for a repository,
+			based on GitHub's commit activity and code frequency statistics.
+
+			GitHub computes these statistics in the background; the command retries while
+			the computation is pending.
+		`),
+		Example: heredoc.Doc(`
+			$ gh repo activity
+			$ gh repo activity --weeks 12 --json weekStart,commits
+		`),
+		Args: cmdutil.NoArgsQuoteReminder,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// support `-R, --repo` override
+			opts.BaseRepo = f.BaseRepo
+
+			if opts.Weeks < 1 {
+				return cmdutil.FlagErrorf("invalid number of weeks: %v", opts.Weeks)
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return activityRun(opts)
+		},
+	}
+
+	cmd.Flags().IntVar(&opts.Weeks, "weeks", 52, "Maximum number of most recent weeks to show")
+	cmdutil.AddJSONFlags(cmd, &opts.Exporter, weekFields)
+
+	return cmd
+}
+
+func activityRun(opts *ActivityOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	var commitActivity []struct {
+		WeekStart int64 `json:"week"`
+		Total     int   `json:"total"`
+	}
+
+	// Each code frequency entry is a [weekStart, additions, deletions] tuple.
+	var codeFrequency [][3]int64
+
+	opts.IO.StartProgressIndicator()
+	err = shared.FetchStats(httpClient, repo, "stats/commit_activity", &commitActivity)
+	if err == nil {
+		err = shared.FetchStats(httpClient, repo, "stats/code_frequency", &codeFrequency)
+	}
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		return err
+	}
+
+	changesByWeek := make(map[int64][2]int, len(codeFrequency))
+	for _, entry := range codeFrequency {
+		changesByWeek[entry[0]] = [2]int{int(entry[1]), int(-entry[2])}
+	}
+
+	var weeks []*Week
+	for _, activity := range commitActivity {
+		changes := changesByWeek[activity.WeekStart]
+		weeks = append(weeks, &Week{
+			WeekStart: time.Unix(activity.WeekStart, 0).UTC().Format("2006-01-02"),
+			Commits:   activity.Total,
+			Additions: changes[0],
+			Deletions: changes[1],
+		})
+	}
+
+	if len(weeks) > opts.Weeks {
+		weeks = weeks[len(weeks)-opts.Weeks:]
+	}
+
+	if opts.Exporter != nil {
+		return opts.Exporter.Write(opts.IO, weeks)
+	}
+
+	if len(weeks) == 0 {
+		return cmdutil.NewNoResultsError(fmt.Sprintf("no commit activity found in %s", ghrepo.FullName(repo)))
+	}
+
+	tp := tableprinter.New(opts.IO, tableprinter.WithHeader("WEEK", "COMMITS", "ADDITIONS", "DELETIONS"))
+	for _, week := range weeks {
+		tp.AddField(week.WeekStart)
+		tp.AddField(fmt.Sprintf("%d", week.Commits))
+		tp.AddField(fmt.Sprintf("%d", week.Additions))
+		tp.AddField(fmt.Sprintf("%d", week.Deletions))
+		tp.EndRow()
+	}
+	return tp.Render()
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/cli/cli/v2/git"
 	"github.com/cli/cli/v2/internal/gh"
 	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/repo/shared"
 	"github.com/cli/cli/v2/pkg/cmdutil"
 	"github.com/cli/cli/v2/pkg/iostreams"
 	"github.com/spf13/cobra"
@@ -60,6 +61,10 @@ func NewCmdClone(f *cmdutil.Factory, runF func(*CloneOptions) error) *cobra.Comm
 			the remote after the owner of the parent repository.
 
 			If the repository is a fork, its parent repository will be set as the default remote repository.
+
+			If the %[1]sgit_optimizations%[1]s configuration value is set to %[1]strue%[1]s, the cloned repository
+			is additionally configured for performance on large repositories: background maintenance,
+			the filesystem monitor, and commit-graph writing on fetch.
 		`, "`"),
 		Example: heredoc.Doc(`
 			# Clone a repository from a specific org
@@ -220,6 +225,13 @@ func cloneRun(opts *CloneOptions) error {
 			fmt.Fprintf(opts.IO.ErrOut, "%s Repository %s set as the default repository. To learn more about the default repository, run: gh repo set-default --help\n", cs.WarningIcon(), cs.Bold(ghrepo.FullName(canonicalRepo.Parent)))
 		}
 	}
+
+	if cfg.GitOptimizations(repo.RepoHost()).Value == "true" {
+		if err := shared.ApplyGitOptimizations(ctx, gitClient, cloneDir); err != nil {
+			cs := opts.IO.ColorScheme()
+			fmt.Fprintf(opts.IO.ErrOut, "%s Failed to apply git optimizations: %v\n", cs.WarningIcon(), err)
+		}
+	}
 	return nil
 }
 
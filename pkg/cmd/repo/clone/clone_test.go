@@ -309,6 +309,58 @@ func Test_RepoClone_hasParent_upstreamRemoteName(t *testing.T) {
 	}
 }
 
+func Test_RepoClone_gitOptimizations(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.GraphQL(`query RepositoryInfo\b`),
+		httpmock.StringResponse(`
+				{ "data": { "repository": {
+					"name": "REPO",
+					"owner": {
+						"login": "OWNER"
+					}
+				} } }
+				`))
+
+	httpClient := &http.Client{Transport: reg}
+
+	cs, cmdTeardown := run.Stub()
+	defer cmdTeardown(t)
+
+	cs.Register(`git clone https://github.com/OWNER/REPO.git`, 0, "")
+	cs.Register(`git -C REPO config core.fsmonitor true`, 0, "")
+	cs.Register(`git -C REPO config core.untrackedCache true`, 0, "")
+	cs.Register(`git -C REPO config fetch.writeCommitGraph true`, 0, "")
+	cs.Register(`git -C REPO maintenance start`, 0, "")
+
+	ios, stdin, stdout, stderr := iostreams.Test()
+	fac := &cmdutil.Factory{
+		IOStreams: ios,
+		HttpClient: func() (*http.Client, error) {
+			return httpClient, nil
+		},
+		Config: func() (gh.Config, error) {
+			return config.NewFromString("git_optimizations: true"), nil
+		},
+		GitClient: &git.Client{
+			GhPath:  "some/path/gh",
+			GitPath: "some/path/git",
+		},
+	}
+
+	cmd := NewCmdClone(fac, nil)
+	cmd.SetArgs([]string{"OWNER/REPO"})
+	cmd.SetIn(stdin)
+	cmd.SetOut(stderr)
+	cmd.SetErr(stderr)
+
+	_, err := cmd.ExecuteC()
+	require.NoError(t, err)
+	assert.Equal(t, "", stdout.String())
+	assert.Equal(t, "", stderr.String())
+}
+
 func Test_RepoClone_withoutUsername(t *testing.T) {
 	reg := &httpmock.Registry{}
 	defer reg.Verify(t)
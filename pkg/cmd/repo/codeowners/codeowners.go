@@ -0,0 +1,20 @@
+package codeowners
+
+import (
+	cmdErrors "github.com/cli/cli/v2/pkg/cmd/repo/codeowners/errors"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+func NewCmdCodeowners(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "codeowners <command>",
+		Short: "Inspect the CODEOWNERS file of a repository",
+	}
+
+	cmdutil.EnableRepoOverride(cmd, f)
+
+	cmd.AddCommand(cmdErrors.NewCmdErrors(f, nil))
+
+	return cmd
+}
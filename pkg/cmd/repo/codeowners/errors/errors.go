@@ -0,0 +1,146 @@
+package errors
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/internal/tableprinter"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type ErrorsOptions struct {
+	IO         *iostreams.IOStreams
+	HTTPClient func() (*http.Client, error)
+	BaseRepo   func() (ghrepo.Interface, error)
+	Exporter   cmdutil.Exporter
+
+	Ref string
+}
+
+type codeownersError struct {
+	Line       int    `json:"line"`
+	Column     int    `json:"column"`
+	Kind       string `json:"kind"`
+	Message    string `json:"message"`
+	Path       string `json:"path"`
+	Source     string `json:"source"`
+	Suggestion string `json:"suggestion"`
+}
+
+func (e codeownersError) ExportData(fields []string) map[string]interface{} {
+	return cmdutil.StructExportData(e, fields)
+}
+
+var codeownersErrorFields = []string{
+	"line",
+	"column",
+	"kind",
+	"message",
+	"path",
+	"source",
+	"suggestion",
+}
+
+func NewCmdErrors(f *cmdutil.Factory, runF func(*ErrorsOptions) error) *cobra.Command {
+	opts := &ErrorsOptions{
+		IO:         f.IOStreams,
+		HTTPClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "errors",
+		Short: "List errors in the CODEOWNERS file of a repository",
+		Long: heredoc.Doc(`
+			List syntax problems and unknown owners found in the CODEOWNERS file of a
+			repository, as detected by GitHub.
+
+			By default the CODEOWNERS file on the default branch is checked; use --ref
+			to check another branch, tag, or commit.
+		`),
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.BaseRepo = f.BaseRepo
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return errorsRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.Ref, "ref", "", "The branch, tag, or commit to check, defaults to the default branch")
+	cmdutil.AddJSONFlags(cmd, &opts.Exporter, codeownersErrorFields)
+
+	return cmd
+}
+
+func errorsRun(opts *ErrorsOptions) error {
+	httpClient, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	codeownersErrors, err := listCodeownersErrors(httpClient, repo, opts.Ref)
+	if err != nil {
+		return err
+	}
+
+	if opts.Exporter != nil {
+		return opts.Exporter.Write(opts.IO, codeownersErrors)
+	}
+
+	if len(codeownersErrors) == 0 {
+		if opts.IO.IsStdoutTTY() {
+			cs := opts.IO.ColorScheme()
+			fmt.Fprintf(opts.IO.Out, "%s No CODEOWNERS errors found in %s\n", cs.SuccessIcon(), ghrepo.FullName(repo))
+		}
+		return nil
+	}
+
+	t := tableprinter.New(opts.IO, tableprinter.WithHeader("LOCATION", "KIND", "MESSAGE"))
+	cs := opts.IO.ColorScheme()
+	for _, e := range codeownersErrors {
+		t.AddField(fmt.Sprintf("%s:%d:%d", e.Path, e.Line, e.Column))
+		t.AddField(e.Kind, tableprinter.WithColor(cs.Red))
+		message := e.Message
+		if e.Suggestion != "" {
+			message = fmt.Sprintf("%s (suggestion: %s)", message, e.Suggestion)
+		}
+		t.AddField(message)
+		t.EndRow()
+	}
+
+	if err := t.Render(); err != nil {
+		return err
+	}
+
+	return cmdutil.SilentError
+}
+
+func listCodeownersErrors(httpClient *http.Client, repo ghrepo.Interface, ref string) ([]codeownersError, error) {
+	path := fmt.Sprintf("repos/%s/%s/codeowners/errors", repo.RepoOwner(), repo.RepoName())
+	if ref != "" {
+		path += "?ref=" + url.QueryEscape(ref)
+	}
+
+	var response struct {
+		Errors []codeownersError `json:"errors"`
+	}
+	apiClient := api.NewClientFromHTTP(httpClient)
+	if err := apiClient.REST(repo.RepoHost(), "GET", path, nil, &response); err != nil {
+		return nil, err
+	}
+
+	return response.Errors, nil
+}
@@ -0,0 +1,97 @@
+package errors
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestErrorsRun(t *testing.T) {
+	tests := []struct {
+		name       string
+		opts       ErrorsOptions
+		isTTY      bool
+		httpStubs  func(*httpmock.Registry)
+		wantStdout string
+		wantErr    error
+	}{
+		{
+			name:  "no errors",
+			isTTY: true,
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/codeowners/errors"),
+					httpmock.StringResponse(`{"errors": []}`),
+				)
+			},
+			wantStdout: "✓ No CODEOWNERS errors found in OWNER/REPO\n",
+		},
+		{
+			name:  "errors found",
+			isTTY: true,
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/codeowners/errors"),
+					httpmock.StringResponse(`{"errors": [
+						{
+							"line": 3,
+							"column": 7,
+							"kind": "Unknown owner",
+							"message": "make sure @octocat exists",
+							"path": ".github/CODEOWNERS",
+							"source": "* @octocat",
+							"suggestion": "did you mean @octocats"
+						}
+					]}`),
+				)
+			},
+			wantStdout: "LOCATION                KIND           MESSAGE\n.github/CODEOWNERS:3:7  Unknown owner  make sure @octocat exists (suggestion:...\n",
+			wantErr:    cmdutil.SilentError,
+		},
+		{
+			name: "ref flag",
+			opts: ErrorsOptions{Ref: "my-feature"},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.QueryMatcher("GET", "repos/OWNER/REPO/codeowners/errors", map[string][]string{"ref": {"my-feature"}}),
+					httpmock.StringResponse(`{"errors": []}`),
+				)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reg := &httpmock.Registry{}
+			defer reg.Verify(t)
+			if tt.httpStubs != nil {
+				tt.httpStubs(reg)
+			}
+
+			ios, _, stdout, _ := iostreams.Test()
+			ios.SetStdoutTTY(tt.isTTY)
+
+			opts := tt.opts
+			opts.IO = ios
+			opts.HTTPClient = func() (*http.Client, error) {
+				return &http.Client{Transport: reg}, nil
+			}
+			opts.BaseRepo = func() (ghrepo.Interface, error) {
+				return ghrepo.New("OWNER", "REPO"), nil
+			}
+
+			err := errorsRun(&opts)
+			if tt.wantErr != nil {
+				assert.Equal(t, tt.wantErr, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantStdout, stdout.String())
+		})
+	}
+}
@@ -0,0 +1,156 @@
+package contributors
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/internal/tableprinter"
+	"github.com/cli/cli/v2/pkg/cmd/repo/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type ContributorsOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+
+	Since    string
+	Exporter cmdutil.Exporter
+}
+
+type Contributor struct {
+	Login     string `json:"login"`
+	Commits   int    `json:"commits"`
+	Additions int    `json:"additions"`
+	Deletions int    `json:"deletions"`
+}
+
+var contributorFields = []string{"login", "commits", "additions", "deletions"}
+
+func (c *Contributor) ExportData(fields []string) map[string]interface{} {
+	return cmdutil.StructExportData(c, fields)
+}
+
+func NewCmdContributors(f *cmdutil.Factory, runF func(*ContributorsOptions) error) *cobra.Command {
+	opts := &ContributorsOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "contributors",
+		Short: "List contributors with their commit counts",
+		Long: heredoc.Doc(`
+			List the contributors of a repository together with their commit, addition,
+			and deletion counts, based on GitHub's repository statistics.
+
+			GitHub computes these statistics in the background; the command retries while
+			the computation is pending.
+		`),
+		Example: heredoc.Doc(`
+			$ gh repo contributors
+			$ gh repo contributors --since 2024-01-01 --json login,commits
+		`),
+		Args: cmdutil.NoArgsQuoteReminder,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// support `-R, --repo` override
+			opts.BaseRepo = f.BaseRepo
+
+			if opts.Since != "" {
+				if _, err := time.Parse("2006-01-02", opts.Since); err != nil {
+					return cmdutil.FlagErrorf("invalid date %q: expected the YYYY-MM-DD format", opts.Since)
+				}
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return contributorsRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.Since, "since", "", "Only count contributions after the given `date`, in YYYY-MM-DD format")
+	cmdutil.AddJSONFlags(cmd, &opts.Exporter, contributorFields)
+
+	return cmd
+}
+
+func contributorsRun(opts *ContributorsOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	var since time.Time
+	if opts.Since != "" {
+		since, _ = time.Parse("2006-01-02", opts.Since)
+	}
+
+	var stats []struct {
+		Author struct {
+			Login string `json:"login"`
+		} `json:"author"`
+		Weeks []struct {
+			WeekStart int64 `json:"w"`
+			Additions int   `json:"a"`
+			Deletions int   `json:"d"`
+			Commits   int   `json:"c"`
+		} `json:"weeks"`
+	}
+
+	opts.IO.StartProgressIndicator()
+	err = shared.FetchStats(httpClient, repo, "stats/contributors", &stats)
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		return err
+	}
+
+	var contributors []*Contributor
+	for _, stat := range stats {
+		contributor := &Contributor{Login: stat.Author.Login}
+		for _, week := range stat.Weeks {
+			if !since.IsZero() && time.Unix(week.WeekStart, 0).Before(since) {
+				continue
+			}
+			contributor.Commits += week.Commits
+			contributor.Additions += week.Additions
+			contributor.Deletions += week.Deletions
+		}
+		if contributor.Commits > 0 {
+			contributors = append(contributors, contributor)
+		}
+	}
+
+	sort.Slice(contributors, func(i, j int) bool {
+		return contributors[i].Commits > contributors[j].Commits
+	})
+
+	if opts.Exporter != nil {
+		return opts.Exporter.Write(opts.IO, contributors)
+	}
+
+	if len(contributors) == 0 {
+		return cmdutil.NewNoResultsError(fmt.Sprintf("no contributions found in %s", ghrepo.FullName(repo)))
+	}
+
+	tp := tableprinter.New(opts.IO, tableprinter.WithHeader("LOGIN", "COMMITS", "ADDITIONS", "DELETIONS"))
+	for _, contributor := range contributors {
+		tp.AddField(contributor.Login)
+		tp.AddField(fmt.Sprintf("%d", contributor.Commits))
+		tp.AddField(fmt.Sprintf("%d", contributor.Additions))
+		tp.AddField(fmt.Sprintf("%d", contributor.Deletions))
+		tp.EndRow()
+	}
+	return tp.Render()
+}
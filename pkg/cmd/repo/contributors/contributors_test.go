@@ -0,0 +1,139 @@
+package contributors
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCmdContributors(t *testing.T) {
+	tests := []struct {
+		name     string
+		args     string
+		wants    ContributorsOptions
+		wantsErr string
+	}{
+		{
+			name: "no arguments",
+			args: "",
+			wants: ContributorsOptions{
+				Since: "",
+			},
+		},
+		{
+			name: "since flag",
+			args: "--since 2024-01-01",
+			wants: ContributorsOptions{
+				Since: "2024-01-01",
+			},
+		},
+		{
+			name:     "invalid since flag",
+			args:     "--since January",
+			wantsErr: `invalid date "January": expected the YYYY-MM-DD format`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ios, _, _, _ := iostreams.Test()
+			f := &cmdutil.Factory{
+				IOStreams: ios,
+			}
+
+			argv, err := shlex.Split(tt.args)
+			require.NoError(t, err)
+
+			var gotOpts *ContributorsOptions
+			cmd := NewCmdContributors(f, func(opts *ContributorsOptions) error {
+				gotOpts = opts
+				return nil
+			})
+			cmd.SetArgs(argv)
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+
+			_, err = cmd.ExecuteC()
+			if tt.wantsErr != "" {
+				assert.EqualError(t, err, tt.wantsErr)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.wants.Since, gotOpts.Since)
+		})
+	}
+}
+
+func TestContributorsRun(t *testing.T) {
+	stats := `[
+		{
+			"author": {"login": "monalisa"},
+			"weeks": [
+				{"w": 1704067200, "a": 10, "d": 2, "c": 3},
+				{"w": 1704672000, "a": 5, "d": 1, "c": 1}
+			]
+		},
+		{
+			"author": {"login": "hubot"},
+			"weeks": [
+				{"w": 1704067200, "a": 100, "d": 50, "c": 7}
+			]
+		},
+		{
+			"author": {"login": "octocat"},
+			"weeks": [
+				{"w": 1704067200, "a": 0, "d": 0, "c": 0}
+			]
+		}
+	]`
+
+	tests := []struct {
+		name    string
+		opts    ContributorsOptions
+		wantOut string
+	}{
+		{
+			name:    "lists contributors sorted by commits",
+			opts:    ContributorsOptions{},
+			wantOut: "hubot\t7\t100\t50\nmonalisa\t4\t15\t3\n",
+		},
+		{
+			name:    "since filters out earlier weeks",
+			opts:    ContributorsOptions{Since: "2024-01-05"},
+			wantOut: "monalisa\t1\t5\t1\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reg := &httpmock.Registry{}
+			defer reg.Verify(t)
+			reg.Register(
+				httpmock.REST("GET", "repos/OWNER/REPO/stats/contributors"),
+				httpmock.StringResponse(stats))
+
+			ios, _, stdout, _ := iostreams.Test()
+			tt.opts.IO = ios
+			tt.opts.HttpClient = func() (*http.Client, error) {
+				return &http.Client{Transport: reg}, nil
+			}
+			tt.opts.BaseRepo = func() (ghrepo.Interface, error) {
+				return ghrepo.New("OWNER", "REPO"), nil
+			}
+
+			err := contributorsRun(&tt.opts)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantOut, stdout.String())
+		})
+	}
+}
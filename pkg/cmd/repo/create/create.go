@@ -415,8 +415,17 @@ func createFromScratch(opts *CreateOptions) error {
 			if err := localInit(opts.GitClient, remoteURL, repo.RepoName()); err != nil {
 				return err
 			}
-		} else if err := cloneWithRetry(opts, remoteURL, templateRepoMainBranch); err != nil {
-			return err
+		} else {
+			cloneDir, err := cloneWithRetry(opts, remoteURL, templateRepoMainBranch)
+			if err != nil {
+				return err
+			}
+			if cfg.GitOptimizations(repo.RepoHost()).Value == "true" {
+				if err := shared.ApplyGitOptimizations(context.Background(), opts.GitClient, cloneDir); err != nil {
+					cs := opts.IO.ColorScheme()
+					fmt.Fprintf(opts.IO.ErrOut, "%s Failed to apply git optimizations: %v\n", cs.WarningIcon(), err)
+				}
+			}
 		}
 
 	}
@@ -508,9 +517,15 @@ func createFromTemplate(opts *CreateOptions) error {
 		protocol := cfg.GitProtocol(repo.RepoHost()).Value
 		remoteURL := ghrepo.FormatRemoteURL(repo, protocol)
 
-		if err := cloneWithRetry(opts, remoteURL, templateRepoMainBranch); err != nil {
+		cloneDir, err := cloneWithRetry(opts, remoteURL, templateRepoMainBranch)
+		if err != nil {
 			return err
 		}
+		if cfg.GitOptimizations(repo.RepoHost()).Value == "true" {
+			if err := shared.ApplyGitOptimizations(context.Background(), opts.GitClient, cloneDir); err != nil {
+				fmt.Fprintf(opts.IO.ErrOut, "%s Failed to apply git optimizations: %v\n", cs.WarningIcon(), err)
+			}
+		}
 	}
 
 	return nil
@@ -692,7 +707,7 @@ func createFromLocal(opts *CreateOptions) error {
 	return nil
 }
 
-func cloneWithRetry(opts *CreateOptions, remoteURL, branch string) error {
+func cloneWithRetry(opts *CreateOptions, remoteURL, branch string) (string, error) {
 	// Allow injecting alternative BackOff in tests.
 	if opts.BackOff == nil {
 		opts.BackOff = backoff.NewConstantBackOff(3 * time.Second)
@@ -703,10 +718,12 @@ func cloneWithRetry(opts *CreateOptions, remoteURL, branch string) error {
 		args = append(args, "--branch", branch)
 	}
 
+	var cloneDir string
 	ctx := context.Background()
-	return backoff.Retry(func() error {
+	err := backoff.Retry(func() error {
 		stderr := &bytes.Buffer{}
-		_, err := opts.GitClient.Clone(ctx, remoteURL, args, git.WithStderr(stderr))
+		dir, err := opts.GitClient.Clone(ctx, remoteURL, args, git.WithStderr(stderr))
+		cloneDir = dir
 
 		var execError errWithExitCode
 		if errors.As(err, &execError) && execError.ExitCode() == 128 {
@@ -717,6 +734,7 @@ func cloneWithRetry(opts *CreateOptions, remoteURL, branch string) error {
 
 		return backoff.Permanent(err)
 	}, backoff.WithContext(backoff.WithMaxRetries(opts.BackOff, 3), ctx))
+	return cloneDir, err
 }
 
 func sourceInit(gitClient *git.Client, io *iostreams.IOStreams, remoteURL, baseRemote string) error {
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/MakeNowJust/heredoc"
 	"github.com/cli/cli/v2/api"
@@ -26,6 +27,12 @@ type DeleteOptions struct {
 	IO         *iostreams.IOStreams
 	RepoArg    string
 	Confirmed  bool
+
+	Schedule      time.Duration
+	Cancel        bool
+	TrackingIssue bool
+	// DeletionsFile overrides where pending deletions are recorded; used in tests
+	DeletionsFile string
 }
 
 func NewCmdDelete(f *cmdutil.Factory, runF func(*DeleteOptions) error) *cobra.Command {
@@ -44,8 +51,16 @@ func NewCmdDelete(f *cmdutil.Factory, runF func(*DeleteOptions) error) *cobra.Co
 			
 			With no argument, deletes the current repository. Otherwise, deletes the specified repository.
 
-			Deletion requires authorization with the %[1]sdelete_repo%[1]s scope. 
+			Deletion requires authorization with the %[1]sdelete_repo%[1]s scope.
 			To authorize, run %[1]sgh auth refresh -s delete_repo%[1]s
+
+			Instead of deleting immediately, the %[1]s--schedule%[1]s flag records a pending
+			deletion with a safety window (e.g. %[1]s--schedule 24h%[1]s), optionally opening a
+			tracking issue in the repository. The repository cannot be deleted until the
+			window has passed, and the pending deletion can be abandoned at any time with
+			%[1]s--cancel%[1]s. Note that the schedule is recorded on this machine only; the
+			deletion still has to be performed by re-running %[1]sgh repo delete%[1]s after
+			the window has passed.
 		`, "`"),
 		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -53,7 +68,16 @@ func NewCmdDelete(f *cmdutil.Factory, runF func(*DeleteOptions) error) *cobra.Co
 				opts.RepoArg = args[0]
 			}
 
-			if !opts.IO.CanPrompt() && !opts.Confirmed {
+			if opts.Schedule < 0 {
+				return cmdutil.FlagErrorf("--schedule must be a positive duration")
+			}
+
+			if opts.TrackingIssue && opts.Schedule == 0 {
+				return cmdutil.FlagErrorf("--tracking-issue requires --schedule")
+			}
+
+			// scheduling and canceling do not delete anything, so they need no confirmation
+			if !opts.IO.CanPrompt() && !opts.Confirmed && opts.Schedule == 0 && !opts.Cancel {
 				return cmdutil.FlagErrorf("--yes required when not running interactively")
 			}
 
@@ -68,6 +92,10 @@ func NewCmdDelete(f *cmdutil.Factory, runF func(*DeleteOptions) error) *cobra.Co
 	cmd.Flags().BoolVar(&opts.Confirmed, "confirm", false, "confirm deletion without prompting")
 	_ = cmd.Flags().MarkDeprecated("confirm", "use `--yes` instead")
 	cmd.Flags().BoolVar(&opts.Confirmed, "yes", false, "confirm deletion without prompting")
+	cmd.Flags().DurationVar(&opts.Schedule, "schedule", 0, "Schedule the deletion after a safety window (e.g. \"24h\") instead of deleting immediately")
+	cmd.Flags().BoolVar(&opts.Cancel, "cancel", false, "Cancel a scheduled deletion")
+	cmd.Flags().BoolVar(&opts.TrackingIssue, "tracking-issue", false, "Open a tracking issue in the repository when scheduling its deletion")
+	cmd.MarkFlagsMutuallyExclusive("schedule", "cancel")
 	return cmd
 }
 
@@ -102,6 +130,29 @@ func deleteRun(opts *DeleteOptions) error {
 	}
 	fullName := ghrepo.FullName(toDelete)
 
+	deletionsFile := opts.DeletionsFile
+	if deletionsFile == "" {
+		deletionsFile = defaultDeletionsFile()
+	}
+
+	if opts.Cancel {
+		return cancelRun(opts, httpClient, toDelete, deletionsFile)
+	}
+	if opts.Schedule > 0 {
+		return scheduleRun(opts, httpClient, toDelete, deletionsFile)
+	}
+
+	// a pending deletion blocks immediate deletion until its safety window has passed
+	pending, err := readPendingDeletions(deletionsFile)
+	if err != nil {
+		return err
+	}
+	pendingIdx := findPendingDeletion(pending, toDelete.RepoHost(), fullName)
+	if pendingIdx >= 0 && time.Now().Before(pending[pendingIdx].DueAt) {
+		return fmt.Errorf("deletion of %s is scheduled for %s; wait for the safety window to pass or run `gh repo delete %s --cancel`",
+			fullName, pending[pendingIdx].DueAt.Format(time.RFC1123), fullName)
+	}
+
 	if !opts.Confirmed {
 		if err := opts.Prompter.ConfirmDeletion(fullName); err != nil {
 			return err
@@ -124,6 +175,14 @@ func deleteRun(opts *DeleteOptions) error {
 		return err
 	}
 
+	// the repository is gone, so its pending deletion record is no longer needed
+	if pendingIdx >= 0 {
+		pending = append(pending[:pendingIdx], pending[pendingIdx+1:]...)
+		if err := writePendingDeletions(deletionsFile, pending); err != nil {
+			return err
+		}
+	}
+
 	if opts.IO.IsStdoutTTY() {
 		cs := opts.IO.ColorScheme()
 		fmt.Fprintf(opts.IO.Out,
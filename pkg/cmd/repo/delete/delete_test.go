@@ -3,6 +3,7 @@ package delete
 import (
 	"bytes"
 	"net/http"
+	"path/filepath"
 	"testing"
 
 	"github.com/cli/cli/v2/internal/ghrepo"
@@ -48,6 +49,30 @@ func TestNewCmdDelete(t *testing.T) {
 			tty:    true,
 			output: DeleteOptions{},
 		},
+		{
+			name:   "schedule notty",
+			input:  "OWNER/REPO --schedule 24h",
+			output: DeleteOptions{RepoArg: "OWNER/REPO"},
+		},
+		{
+			name:   "cancel notty",
+			input:  "OWNER/REPO --cancel",
+			output: DeleteOptions{RepoArg: "OWNER/REPO"},
+		},
+		{
+			name:    "tracking issue without schedule",
+			input:   "OWNER/REPO --tracking-issue",
+			tty:     true,
+			wantErr: true,
+			errMsg:  "--tracking-issue requires --schedule",
+		},
+		{
+			name:    "schedule and cancel together",
+			input:   "OWNER/REPO --schedule 24h --cancel",
+			tty:     true,
+			wantErr: true,
+			errMsg:  "if any flags in the group [schedule cancel] are set none of the others can be; [cancel schedule] were all set",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -188,6 +213,10 @@ func Test_deleteRun(t *testing.T) {
 			return &http.Client{Transport: reg}, nil
 		}
 
+		if tt.opts.DeletionsFile == "" {
+			tt.opts.DeletionsFile = filepath.Join(t.TempDir(), "pending_repo_deletions.json")
+		}
+
 		ios, _, stdout, stderr := iostreams.Test()
 		ios.SetStdinTTY(tt.tty)
 		ios.SetStdoutTTY(tt.tty)
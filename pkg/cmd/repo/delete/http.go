@@ -1,8 +1,11 @@
 package delete
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/cli/cli/v2/api"
 	"github.com/cli/cli/v2/internal/ghinstance"
@@ -37,3 +40,40 @@ func deleteRepo(client *http.Client, repo ghrepo.Interface) error {
 
 	return nil
 }
+
+type trackingIssue struct {
+	Number int    `json:"number"`
+	URL    string `json:"html_url"`
+}
+
+func createTrackingIssue(client *http.Client, repo ghrepo.Interface, dueAt time.Time) (*trackingIssue, error) {
+	fullName := ghrepo.FullName(repo)
+	body, err := json.Marshal(map[string]string{
+		"title": fmt.Sprintf("Scheduled deletion of %s", fullName),
+		"body": fmt.Sprintf(
+			"This repository is scheduled for deletion on %s.\n\nRun `gh repo delete %s --cancel` to cancel the deletion.",
+			dueAt.Format(time.RFC1123), fullName),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var issue trackingIssue
+	apiClient := api.NewClientFromHTTP(client)
+	path := fmt.Sprintf("repos/%s/issues", fullName)
+	if err := apiClient.REST(repo.RepoHost(), "POST", path, bytes.NewReader(body), &issue); err != nil {
+		return nil, err
+	}
+	return &issue, nil
+}
+
+func closeTrackingIssue(client *http.Client, repo ghrepo.Interface, number int) error {
+	body, err := json.Marshal(map[string]string{"state": "closed"})
+	if err != nil {
+		return err
+	}
+
+	apiClient := api.NewClientFromHTTP(client)
+	path := fmt.Sprintf("repos/%s/issues/%d", ghrepo.FullName(repo), number)
+	return apiClient.REST(repo.RepoHost(), "PATCH", path, bytes.NewReader(body), nil)
+}
@@ -0,0 +1,150 @@
+package delete
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/internal/ghrepo"
+)
+
+// pendingDeletion records a repository deletion that was scheduled with the
+// schedule flag and may still be canceled before its safety window elapses
+type pendingDeletion struct {
+	Repository  string    `json:"repository"`
+	Host        string    `json:"host"`
+	ScheduledAt time.Time `json:"scheduled_at"`
+	DueAt       time.Time `json:"due_at"`
+	IssueNumber int       `json:"issue_number,omitempty"`
+	IssueURL    string    `json:"issue_url,omitempty"`
+}
+
+// defaultDeletionsFile returns where pending deletions are recorded
+func defaultDeletionsFile() string {
+	return filepath.Join(config.StateDir(), "pending_repo_deletions.json")
+}
+
+func readPendingDeletions(path string) ([]pendingDeletion, error) {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var pending []pendingDeletion
+	if err := json.Unmarshal(contents, &pending); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+	return pending, nil
+}
+
+func writePendingDeletions(path string, pending []pendingDeletion) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	contents, err := json.MarshalIndent(pending, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, contents, 0600)
+}
+
+func findPendingDeletion(pending []pendingDeletion, host, repository string) int {
+	for i, p := range pending {
+		if p.Host == host && p.Repository == repository {
+			return i
+		}
+	}
+	return -1
+}
+
+func scheduleRun(opts *DeleteOptions, httpClient *http.Client, toDelete ghrepo.Interface, deletionsFile string) error {
+	fullName := ghrepo.FullName(toDelete)
+
+	pending, err := readPendingDeletions(deletionsFile)
+	if err != nil {
+		return err
+	}
+	if i := findPendingDeletion(pending, toDelete.RepoHost(), fullName); i >= 0 {
+		return fmt.Errorf("deletion of %s is already scheduled for %s", fullName, pending[i].DueAt.Format(time.RFC1123))
+	}
+
+	now := time.Now().UTC()
+	p := pendingDeletion{
+		Repository:  fullName,
+		Host:        toDelete.RepoHost(),
+		ScheduledAt: now,
+		DueAt:       now.Add(opts.Schedule),
+	}
+
+	if opts.TrackingIssue {
+		issue, err := createTrackingIssue(httpClient, toDelete, p.DueAt)
+		if err != nil {
+			return fmt.Errorf("failed to create tracking issue: %w", err)
+		}
+		p.IssueNumber = issue.Number
+		p.IssueURL = issue.URL
+	}
+
+	pending = append(pending, p)
+	if err := writePendingDeletions(deletionsFile, pending); err != nil {
+		return err
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		cs := opts.IO.ColorScheme()
+		fmt.Fprintf(opts.IO.Out,
+			"%s Scheduled deletion of %s for %s\n",
+			cs.SuccessIcon(),
+			fullName,
+			p.DueAt.Format(time.RFC1123))
+		if p.IssueURL != "" {
+			fmt.Fprintf(opts.IO.Out, "Tracking issue: %s\n", p.IssueURL)
+		}
+		fmt.Fprintf(opts.IO.Out, "Run `gh repo delete %s --cancel` to cancel the deletion\n", fullName)
+	}
+
+	return nil
+}
+
+func cancelRun(opts *DeleteOptions, httpClient *http.Client, toDelete ghrepo.Interface, deletionsFile string) error {
+	fullName := ghrepo.FullName(toDelete)
+
+	pending, err := readPendingDeletions(deletionsFile)
+	if err != nil {
+		return err
+	}
+	i := findPendingDeletion(pending, toDelete.RepoHost(), fullName)
+	if i < 0 {
+		return fmt.Errorf("no scheduled deletion found for %s", fullName)
+	}
+
+	p := pending[i]
+	pending = append(pending[:i], pending[i+1:]...)
+	if err := writePendingDeletions(deletionsFile, pending); err != nil {
+		return err
+	}
+
+	if p.IssueNumber != 0 {
+		if err := closeTrackingIssue(httpClient, toDelete, p.IssueNumber); err != nil {
+			fmt.Fprintf(opts.IO.ErrOut, "warning: failed to close tracking issue #%d: %v\n", p.IssueNumber, err)
+		}
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		cs := opts.IO.ColorScheme()
+		fmt.Fprintf(opts.IO.Out,
+			"%s Canceled scheduled deletion of %s\n",
+			cs.SuccessIcon(),
+			fullName)
+	}
+
+	return nil
+}
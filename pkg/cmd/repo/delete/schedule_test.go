@@ -0,0 +1,163 @@
+package delete
+
+import (
+	"bytes"
+	"net/http"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newScheduleOpts(t *testing.T, reg *httpmock.Registry, tty bool) (*DeleteOptions, *bytes.Buffer) {
+	ios, _, stdout, _ := iostreams.Test()
+	ios.SetStdinTTY(tty)
+	ios.SetStdoutTTY(tty)
+	return &DeleteOptions{
+		IO:      ios,
+		RepoArg: "OWNER/REPO",
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.New("OWNER", "REPO"), nil
+		},
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		DeletionsFile: filepath.Join(t.TempDir(), "pending_repo_deletions.json"),
+	}, stdout
+}
+
+func Test_deleteRun_schedule(t *testing.T) {
+	t.Run("schedules a deletion", func(t *testing.T) {
+		reg := &httpmock.Registry{}
+		defer reg.Verify(t)
+		opts, stdout := newScheduleOpts(t, reg, true)
+		opts.Schedule = 24 * time.Hour
+
+		err := deleteRun(opts)
+		require.NoError(t, err)
+
+		assert.Contains(t, stdout.String(), "✓ Scheduled deletion of OWNER/REPO for ")
+		assert.Contains(t, stdout.String(), "Run `gh repo delete OWNER/REPO --cancel` to cancel the deletion")
+
+		pending, err := readPendingDeletions(opts.DeletionsFile)
+		require.NoError(t, err)
+		require.Len(t, pending, 1)
+		assert.Equal(t, "OWNER/REPO", pending[0].Repository)
+		assert.Equal(t, "github.com", pending[0].Host)
+		assert.WithinDuration(t, time.Now().Add(24*time.Hour), pending[0].DueAt, time.Minute)
+	})
+
+	t.Run("schedules a deletion with a tracking issue", func(t *testing.T) {
+		reg := &httpmock.Registry{}
+		defer reg.Verify(t)
+		reg.Register(
+			httpmock.REST("POST", "repos/OWNER/REPO/issues"),
+			httpmock.StringResponse(`{"number":123,"html_url":"https://github.com/OWNER/REPO/issues/123"}`))
+		opts, stdout := newScheduleOpts(t, reg, true)
+		opts.Schedule = 24 * time.Hour
+		opts.TrackingIssue = true
+
+		err := deleteRun(opts)
+		require.NoError(t, err)
+
+		assert.Contains(t, stdout.String(), "Tracking issue: https://github.com/OWNER/REPO/issues/123")
+
+		pending, err := readPendingDeletions(opts.DeletionsFile)
+		require.NoError(t, err)
+		require.Len(t, pending, 1)
+		assert.Equal(t, 123, pending[0].IssueNumber)
+		assert.Equal(t, "https://github.com/OWNER/REPO/issues/123", pending[0].IssueURL)
+	})
+
+	t.Run("errors when a deletion is already scheduled", func(t *testing.T) {
+		reg := &httpmock.Registry{}
+		opts, _ := newScheduleOpts(t, reg, true)
+		opts.Schedule = 24 * time.Hour
+		require.NoError(t, writePendingDeletions(opts.DeletionsFile, []pendingDeletion{{
+			Repository: "OWNER/REPO",
+			Host:       "github.com",
+			DueAt:      time.Now().Add(time.Hour),
+		}}))
+
+		err := deleteRun(opts)
+		assert.ErrorContains(t, err, "deletion of OWNER/REPO is already scheduled for ")
+	})
+
+	t.Run("blocks deletion before the safety window has passed", func(t *testing.T) {
+		reg := &httpmock.Registry{}
+		opts, _ := newScheduleOpts(t, reg, false)
+		opts.Confirmed = true
+		require.NoError(t, writePendingDeletions(opts.DeletionsFile, []pendingDeletion{{
+			Repository: "OWNER/REPO",
+			Host:       "github.com",
+			DueAt:      time.Now().Add(time.Hour),
+		}}))
+
+		err := deleteRun(opts)
+		assert.ErrorContains(t, err, "deletion of OWNER/REPO is scheduled for ")
+		assert.ErrorContains(t, err, "run `gh repo delete OWNER/REPO --cancel`")
+	})
+
+	t.Run("deletes and clears the record after the safety window has passed", func(t *testing.T) {
+		reg := &httpmock.Registry{}
+		defer reg.Verify(t)
+		reg.Register(
+			httpmock.REST("DELETE", "repos/OWNER/REPO"),
+			httpmock.StatusStringResponse(204, "{}"))
+		opts, _ := newScheduleOpts(t, reg, false)
+		opts.Confirmed = true
+		require.NoError(t, writePendingDeletions(opts.DeletionsFile, []pendingDeletion{{
+			Repository: "OWNER/REPO",
+			Host:       "github.com",
+			DueAt:      time.Now().Add(-time.Hour),
+		}}))
+
+		err := deleteRun(opts)
+		require.NoError(t, err)
+
+		pending, err := readPendingDeletions(opts.DeletionsFile)
+		require.NoError(t, err)
+		assert.Len(t, pending, 0)
+	})
+}
+
+func Test_deleteRun_cancel(t *testing.T) {
+	t.Run("cancels a scheduled deletion and closes its tracking issue", func(t *testing.T) {
+		reg := &httpmock.Registry{}
+		defer reg.Verify(t)
+		reg.Register(
+			httpmock.REST("PATCH", "repos/OWNER/REPO/issues/123"),
+			httpmock.StringResponse(`{}`))
+		opts, stdout := newScheduleOpts(t, reg, true)
+		opts.Cancel = true
+		require.NoError(t, writePendingDeletions(opts.DeletionsFile, []pendingDeletion{{
+			Repository:  "OWNER/REPO",
+			Host:        "github.com",
+			DueAt:       time.Now().Add(time.Hour),
+			IssueNumber: 123,
+		}}))
+
+		err := deleteRun(opts)
+		require.NoError(t, err)
+
+		assert.Contains(t, stdout.String(), "✓ Canceled scheduled deletion of OWNER/REPO")
+
+		pending, err := readPendingDeletions(opts.DeletionsFile)
+		require.NoError(t, err)
+		assert.Len(t, pending, 0)
+	})
+
+	t.Run("errors when no deletion is scheduled", func(t *testing.T) {
+		reg := &httpmock.Registry{}
+		opts, _ := newScheduleOpts(t, reg, true)
+		opts.Cancel = true
+
+		err := deleteRun(opts)
+		assert.ErrorContains(t, err, "no scheduled deletion found for OWNER/REPO")
+	})
+}
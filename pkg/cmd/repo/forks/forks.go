@@ -0,0 +1,384 @@
+package forks
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/internal/tableprinter"
+	"github.com/cli/cli/v2/internal/text"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+// compareBatchSize is the number of aliased ref comparisons submitted per
+// GraphQL request when computing fork divergence.
+const compareBatchSize = 25
+
+type ForksOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+	Now        func() time.Time
+
+	RepoArg     string
+	Limit       int
+	Sort        string
+	ActiveSince string
+	Exporter    cmdutil.Exporter
+}
+
+// Fork is one fork of the upstream repository, with its divergence from the
+// upstream default branch.
+type Fork struct {
+	NameWithOwner string    `json:"nameWithOwner"`
+	Stars         int       `json:"stars"`
+	AheadBy       int       `json:"aheadBy"`
+	BehindBy      int       `json:"behindBy"`
+	PushedAt      time.Time `json:"pushedAt"`
+}
+
+var forkFields = []string{"nameWithOwner", "stars", "aheadBy", "behindBy", "pushedAt"}
+
+func (f *Fork) ExportData(fields []string) map[string]interface{} {
+	return cmdutil.StructExportData(f, fields)
+}
+
+func NewCmdForks(f *cmdutil.Factory, runF func(*ForksOptions) error) *cobra.Command {
+	opts := &ForksOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+		Now:        time.Now,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "forks [<repository>]",
+		Short: "List forks of a repository",
+		Long: heredoc.Docf(`
+			List forks of a repository along with how far each fork's default branch is
+			ahead of and behind the upstream default branch, which helps find forks with
+			meaningful divergent work.
+
+			With no argument, the forks of the repository in the current directory are
+			listed. Use %[1]s--active-since%[1]s to only show forks that have been pushed to
+			within a period such as %[1]s90d%[1]s.
+		`, "`"),
+		Example: heredoc.Doc(`
+			# list the most starred forks of cli/cli
+			$ gh repo forks cli/cli
+
+			# list forks of the current repository pushed to in the last 90 days
+			$ gh repo forks --active-since 90d --sort pushed
+		`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// support `-R, --repo` override
+			opts.BaseRepo = f.BaseRepo
+
+			if len(args) > 0 {
+				opts.RepoArg = args[0]
+			}
+
+			if opts.Limit < 1 {
+				return cmdutil.FlagErrorf("invalid limit: %v", opts.Limit)
+			}
+
+			if opts.ActiveSince != "" {
+				if _, err := parsePeriod(opts.ActiveSince); err != nil {
+					return cmdutil.FlagErrorWrap(err)
+				}
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return forksRun(opts)
+		},
+	}
+
+	cmd.Flags().IntVarP(&opts.Limit, "limit", "L", 30, "Maximum number of forks to list")
+	cmdutil.StringEnumFlag(cmd, &opts.Sort, "sort", "", "stars", []string{"stars", "pushed", "created", "name"}, "Sort forks by")
+	cmd.Flags().StringVar(&opts.ActiveSince, "active-since", "", "Only show forks pushed to within the given `period` (e.g. \"90d\")")
+	cmdutil.AddJSONFlags(cmd, &opts.Exporter, forkFields)
+
+	return cmd
+}
+
+// parsePeriod parses a duration like "90d" or "12w"; units smaller than a day
+// are accepted in the Go duration syntax.
+func parsePeriod(s string) (time.Duration, error) {
+	if n, err := strconv.Atoi(strings.TrimSuffix(s, "d")); err == nil && strings.HasSuffix(s, "d") {
+		return time.Duration(n) * 24 * time.Hour, nil
+	}
+	if n, err := strconv.Atoi(strings.TrimSuffix(s, "w")); err == nil && strings.HasSuffix(s, "w") {
+		return time.Duration(n) * 7 * 24 * time.Hour, nil
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid period %q: expected a number followed by \"d\" or \"w\", or a duration like \"12h\"", s)
+	}
+	return d, nil
+}
+
+func sortOrderField(sort string) string {
+	switch sort {
+	case "pushed":
+		return "PUSHED_AT"
+	case "created":
+		return "CREATED_AT"
+	case "name":
+		return "NAME"
+	default:
+		return "STARGAZERS"
+	}
+}
+
+func forksRun(opts *ForksOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	var repo ghrepo.Interface
+	if opts.RepoArg == "" {
+		repo, err = opts.BaseRepo()
+	} else {
+		repo, err = ghrepo.FromFullName(opts.RepoArg)
+	}
+	if err != nil {
+		return err
+	}
+
+	client := api.NewClientFromHTTP(httpClient)
+
+	opts.IO.StartProgressIndicator()
+	defaultBranch, forks, err := listForks(client, repo, opts.Limit, opts.Sort)
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		return err
+	}
+
+	if opts.ActiveSince != "" {
+		period, err := parsePeriod(opts.ActiveSince)
+		if err != nil {
+			return err
+		}
+		cutoff := opts.Now().Add(-period)
+		active := forks[:0]
+		for _, fork := range forks {
+			if fork.pushedAt.After(cutoff) {
+				active = append(active, fork)
+			}
+		}
+		forks = active
+	}
+
+	if len(forks) == 0 {
+		return cmdutil.NewNoResultsError(fmt.Sprintf("no forks found in %s", ghrepo.FullName(repo)))
+	}
+
+	opts.IO.StartProgressIndicator()
+	divergence, err := forkDivergence(client, repo, defaultBranch, forks)
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		return err
+	}
+
+	results := make([]*Fork, 0, len(forks))
+	for _, fork := range forks {
+		d := divergence[fork.nameWithOwner]
+		results = append(results, &Fork{
+			NameWithOwner: fork.nameWithOwner,
+			Stars:         fork.stargazerCount,
+			AheadBy:       d.AheadBy,
+			BehindBy:      d.BehindBy,
+			PushedAt:      fork.pushedAt,
+		})
+	}
+
+	if opts.Exporter != nil {
+		return opts.Exporter.Write(opts.IO, results)
+	}
+
+	cs := opts.IO.ColorScheme()
+	tp := tableprinter.New(opts.IO, tableprinter.WithHeader("FORK", "STARS", "AHEAD", "BEHIND", "PUSHED"))
+	for _, fork := range results {
+		tp.AddField(fork.NameWithOwner, tableprinter.WithColor(cs.Bold))
+		tp.AddField(strconv.Itoa(fork.Stars))
+		tp.AddField(strconv.Itoa(fork.AheadBy))
+		tp.AddField(strconv.Itoa(fork.BehindBy))
+		tp.AddTimeField(opts.Now(), fork.PushedAt, cs.Gray)
+		tp.EndRow()
+	}
+	if opts.IO.IsStdoutTTY() {
+		fmt.Fprintf(opts.IO.Out, "\nShowing %s of %s\n\n", text.Pluralize(len(results), "fork"), ghrepo.FullName(repo))
+	}
+	return tp.Render()
+}
+
+type forkNode struct {
+	nameWithOwner  string
+	owner          string
+	stargazerCount int
+	pushedAt       time.Time
+	defaultBranch  string
+}
+
+// listForks fetches the forks of the repository, sorted by the given order,
+// along with the name of the upstream default branch.
+func listForks(client *api.Client, repo ghrepo.Interface, limit int, sort string) (string, []forkNode, error) {
+	query := `
+	query RepositoryForkList($owner: String!, $repo: String!, $limit: Int!, $endCursor: String, $orderBy: RepositoryOrder!) {
+		repository(owner: $owner, name: $repo) {
+			defaultBranchRef { name }
+			forks(first: $limit, after: $endCursor, orderBy: $orderBy) {
+				nodes {
+					nameWithOwner
+					owner { login }
+					stargazerCount
+					pushedAt
+					defaultBranchRef { name }
+				}
+				pageInfo { hasNextPage, endCursor }
+			}
+		}
+	}`
+
+	direction := "DESC"
+	if sort == "name" {
+		direction = "ASC"
+	}
+
+	variables := map[string]interface{}{
+		"owner": repo.RepoOwner(),
+		"repo":  repo.RepoName(),
+		"orderBy": map[string]interface{}{
+			"field":     sortOrderField(sort),
+			"direction": direction,
+		},
+	}
+
+	var defaultBranch string
+	var forks []forkNode
+	for {
+		variables["limit"] = min(limit-len(forks), 100)
+
+		var response struct {
+			Repository struct {
+				DefaultBranchRef struct {
+					Name string
+				}
+				Forks struct {
+					Nodes []struct {
+						NameWithOwner string
+						Owner         struct {
+							Login string
+						}
+						StargazerCount   int
+						PushedAt         time.Time
+						DefaultBranchRef struct {
+							Name string
+						}
+					}
+					PageInfo struct {
+						HasNextPage bool
+						EndCursor   string
+					}
+				}
+			}
+		}
+
+		if err := client.GraphQL(repo.RepoHost(), query, variables, &response); err != nil {
+			return "", nil, err
+		}
+
+		defaultBranch = response.Repository.DefaultBranchRef.Name
+		for _, node := range response.Repository.Forks.Nodes {
+			forks = append(forks, forkNode{
+				nameWithOwner:  node.NameWithOwner,
+				owner:          node.Owner.Login,
+				stargazerCount: node.StargazerCount,
+				pushedAt:       node.PushedAt,
+				defaultBranch:  node.DefaultBranchRef.Name,
+			})
+		}
+
+		if !response.Repository.Forks.PageInfo.HasNextPage || len(forks) >= limit {
+			break
+		}
+		variables["endCursor"] = response.Repository.Forks.PageInfo.EndCursor
+	}
+
+	return defaultBranch, forks, nil
+}
+
+// Divergence is the commit distance between a fork's default branch and the
+// upstream default branch.
+type Divergence struct {
+	AheadBy  int `json:"aheadBy"`
+	BehindBy int `json:"behindBy"`
+}
+
+// forkDivergence compares the default branch of every fork against the
+// upstream default branch, batching the comparisons into aliased GraphQL
+// fields so that large fork networks do not need one request per fork.
+func forkDivergence(client *api.Client, repo ghrepo.Interface, defaultBranch string, forks []forkNode) (map[string]Divergence, error) {
+	divergence := make(map[string]Divergence, len(forks))
+	if defaultBranch == "" {
+		return divergence, nil
+	}
+
+	comparable := make([]forkNode, 0, len(forks))
+	for _, fork := range forks {
+		if fork.defaultBranch != "" {
+			comparable = append(comparable, fork)
+		}
+	}
+
+	for start := 0; start < len(comparable); start += compareBatchSize {
+		end := start + compareBatchSize
+		if end > len(comparable) {
+			end = len(comparable)
+		}
+
+		var fields strings.Builder
+		for i, fork := range comparable[start:end] {
+			headRef := fmt.Sprintf("%s:%s", fork.owner, fork.defaultBranch)
+			fmt.Fprintf(&fields, " fork%d: compare(headRef: %q) { aheadBy, behindBy }", start+i, headRef)
+		}
+
+		query := fmt.Sprintf(`
+		query ForkDivergence($owner: String!, $repo: String!) {
+			repository(owner: $owner, name: $repo) {
+				defaultBranchRef {%s }
+			}
+		}`, fields.String())
+
+		variables := map[string]interface{}{
+			"owner": repo.RepoOwner(),
+			"repo":  repo.RepoName(),
+		}
+
+		var response struct {
+			Repository struct {
+				DefaultBranchRef map[string]Divergence
+			}
+		}
+
+		if err := client.GraphQL(repo.RepoHost(), query, variables, &response); err != nil {
+			return nil, err
+		}
+
+		for i, fork := range comparable[start:end] {
+			divergence[fork.nameWithOwner] = response.Repository.DefaultBranchRef[fmt.Sprintf("fork%d", start+i)]
+		}
+	}
+
+	return divergence, nil
+}
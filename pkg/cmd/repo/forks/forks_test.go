@@ -0,0 +1,192 @@
+package forks
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCmdForks(t *testing.T) {
+	tests := []struct {
+		name     string
+		args     string
+		wants    ForksOptions
+		wantsErr string
+	}{
+		{
+			name: "no arguments",
+			args: "",
+			wants: ForksOptions{
+				Limit: 30,
+				Sort:  "stars",
+			},
+		},
+		{
+			name: "repository argument",
+			args: "cli/cli",
+			wants: ForksOptions{
+				RepoArg: "cli/cli",
+				Limit:   30,
+				Sort:    "stars",
+			},
+		},
+		{
+			name: "sort and active-since",
+			args: "--sort pushed --active-since 90d",
+			wants: ForksOptions{
+				Limit:       30,
+				Sort:        "pushed",
+				ActiveSince: "90d",
+			},
+		},
+		{
+			name:     "invalid sort",
+			args:     "--sort size",
+			wantsErr: "invalid argument \"size\" for \"--sort\" flag: valid values are {stars|pushed|created|name}",
+		},
+		{
+			name:     "invalid active-since",
+			args:     "--active-since yesterday",
+			wantsErr: "invalid period \"yesterday\": expected a number followed by \"d\" or \"w\", or a duration like \"12h\"",
+		},
+		{
+			name:     "invalid limit",
+			args:     "--limit 0",
+			wantsErr: "invalid limit: 0",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ios, _, _, _ := iostreams.Test()
+			f := &cmdutil.Factory{
+				IOStreams: ios,
+			}
+
+			argv, err := shlex.Split(tt.args)
+			require.NoError(t, err)
+
+			var gotOpts *ForksOptions
+			cmd := NewCmdForks(f, func(opts *ForksOptions) error {
+				gotOpts = opts
+				return nil
+			})
+			cmd.SetArgs(argv)
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+
+			_, err = cmd.ExecuteC()
+			if tt.wantsErr != "" {
+				assert.EqualError(t, err, tt.wantsErr)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.wants.RepoArg, gotOpts.RepoArg)
+			assert.Equal(t, tt.wants.Limit, gotOpts.Limit)
+			assert.Equal(t, tt.wants.Sort, gotOpts.Sort)
+			assert.Equal(t, tt.wants.ActiveSince, gotOpts.ActiveSince)
+		})
+	}
+}
+
+func TestForksRun(t *testing.T) {
+	forkList := `{"data": {"repository": {
+		"defaultBranchRef": {"name": "trunk"},
+		"forks": {
+			"nodes": [
+				{
+					"nameWithOwner": "monalisa/REPO",
+					"owner": {"login": "monalisa"},
+					"stargazerCount": 10,
+					"pushedAt": "2024-02-28T12:00:00Z",
+					"defaultBranchRef": {"name": "trunk"}
+				},
+				{
+					"nameWithOwner": "hubot/REPO",
+					"owner": {"login": "hubot"},
+					"stargazerCount": 2,
+					"pushedAt": "2023-01-01T12:00:00Z",
+					"defaultBranchRef": {"name": "main"}
+				}
+			],
+			"pageInfo": {"hasNextPage": false, "endCursor": "cursor"}
+		}
+	}}}`
+
+	now, _ := time.Parse(time.RFC3339, "2024-03-01T12:00:00Z")
+
+	tests := []struct {
+		name       string
+		opts       ForksOptions
+		divergence string
+		wantOut    string
+		wantErrMsg string
+	}{
+		{
+			name: "lists forks with divergence",
+			opts: ForksOptions{Sort: "stars", Limit: 30},
+			divergence: `{"data": {"repository": {"defaultBranchRef": {
+				"fork0": {"aheadBy": 3, "behindBy": 1},
+				"fork1": {"aheadBy": 0, "behindBy": 7}
+			}}}}`,
+			wantOut: "monalisa/REPO\t10\t3\t1\t2024-02-28T12:00:00Z\nhubot/REPO\t2\t0\t7\t2023-01-01T12:00:00Z\n",
+		},
+		{
+			name: "active-since filters out stale forks",
+			opts: ForksOptions{Sort: "stars", Limit: 30, ActiveSince: "90d"},
+			divergence: `{"data": {"repository": {"defaultBranchRef": {
+				"fork0": {"aheadBy": 3, "behindBy": 1}
+			}}}}`,
+			wantOut: "monalisa/REPO\t10\t3\t1\t2024-02-28T12:00:00Z\n",
+		},
+		{
+			name:       "no active forks",
+			opts:       ForksOptions{Sort: "stars", Limit: 30, ActiveSince: "1d"},
+			wantErrMsg: "no forks found in OWNER/REPO",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reg := &httpmock.Registry{}
+			defer reg.Verify(t)
+			reg.Register(
+				httpmock.GraphQL(`query RepositoryForkList\b`),
+				httpmock.StringResponse(forkList))
+			if tt.divergence != "" {
+				reg.Register(
+					httpmock.GraphQL(`query ForkDivergence\b`),
+					httpmock.StringResponse(tt.divergence))
+			}
+
+			ios, _, stdout, _ := iostreams.Test()
+			tt.opts.IO = ios
+			tt.opts.HttpClient = func() (*http.Client, error) {
+				return &http.Client{Transport: reg}, nil
+			}
+			tt.opts.BaseRepo = func() (ghrepo.Interface, error) {
+				return ghrepo.New("OWNER", "REPO"), nil
+			}
+			tt.opts.Now = func() time.Time { return now }
+
+			err := forksRun(&tt.opts)
+			if tt.wantErrMsg != "" {
+				assert.EqualError(t, err, tt.wantErrMsg)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantOut, stdout.String())
+		})
+	}
+}
@@ -30,6 +30,7 @@ func TestJSONFields(t *testing.T) {
 		"codeOfConduct",
 		"contactLinks",
 		"createdAt",
+		"defaultBranchProtection",
 		"defaultBranchRef",
 		"deleteBranchOnMerge",
 		"description",
@@ -2,19 +2,24 @@ package repo
 
 import (
 	"github.com/MakeNowJust/heredoc"
+	repoActivityCmd "github.com/cli/cli/v2/pkg/cmd/repo/activity"
 	repoArchiveCmd "github.com/cli/cli/v2/pkg/cmd/repo/archive"
 	repoCloneCmd "github.com/cli/cli/v2/pkg/cmd/repo/clone"
+	codeownersCmd "github.com/cli/cli/v2/pkg/cmd/repo/codeowners"
+	contributorsCmd "github.com/cli/cli/v2/pkg/cmd/repo/contributors"
 	repoCreateCmd "github.com/cli/cli/v2/pkg/cmd/repo/create"
 	creditsCmd "github.com/cli/cli/v2/pkg/cmd/repo/credits"
 	repoDeleteCmd "github.com/cli/cli/v2/pkg/cmd/repo/delete"
 	deployKeyCmd "github.com/cli/cli/v2/pkg/cmd/repo/deploy-key"
 	repoEditCmd "github.com/cli/cli/v2/pkg/cmd/repo/edit"
 	repoForkCmd "github.com/cli/cli/v2/pkg/cmd/repo/fork"
+	repoForksCmd "github.com/cli/cli/v2/pkg/cmd/repo/forks"
 	gardenCmd "github.com/cli/cli/v2/pkg/cmd/repo/garden"
 	gitIgnoreCmd "github.com/cli/cli/v2/pkg/cmd/repo/gitignore"
 	licenseCmd "github.com/cli/cli/v2/pkg/cmd/repo/license"
 	repoListCmd "github.com/cli/cli/v2/pkg/cmd/repo/list"
 	repoRenameCmd "github.com/cli/cli/v2/pkg/cmd/repo/rename"
+	securityCmd "github.com/cli/cli/v2/pkg/cmd/repo/security"
 	repoDefaultCmd "github.com/cli/cli/v2/pkg/cmd/repo/setdefault"
 	repoSyncCmd "github.com/cli/cli/v2/pkg/cmd/repo/sync"
 	repoUnarchiveCmd "github.com/cli/cli/v2/pkg/cmd/repo/unarchive"
@@ -52,10 +57,13 @@ func NewCmdRepo(f *cmdutil.Factory) *cobra.Command {
 		repoViewCmd.NewCmdView(f, nil),
 		repoCloneCmd.NewCmdClone(f, nil),
 		repoForkCmd.NewCmdFork(f, nil),
+		repoForksCmd.NewCmdForks(f, nil),
 		repoDefaultCmd.NewCmdSetDefault(f, nil),
 		repoSyncCmd.NewCmdSync(f, nil),
 		repoEditCmd.NewCmdEdit(f, nil),
 		deployKeyCmd.NewCmdDeployKey(f),
+		securityCmd.NewCmdSecurity(f),
+		codeownersCmd.NewCmdCodeowners(f),
 		licenseCmd.NewCmdLicense(f),
 		gitIgnoreCmd.NewCmdGitIgnore(f),
 		repoRenameCmd.NewCmdRename(f, nil),
@@ -64,6 +72,8 @@ func NewCmdRepo(f *cmdutil.Factory) *cobra.Command {
 		repoDeleteCmd.NewCmdDelete(f, nil),
 		creditsCmd.NewCmdRepoCredits(f, nil),
 		gardenCmd.NewCmdGarden(f, nil),
+		contributorsCmd.NewCmdContributors(f, nil),
+		repoActivityCmd.NewCmdActivity(f, nil),
 	)
 
 	return cmd
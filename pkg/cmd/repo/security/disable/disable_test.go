@@ -0,0 +1,107 @@
+package disable
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCmdDisable(t *testing.T) {
+	tests := []struct {
+		name        string
+		cli         string
+		wants       DisableOptions
+		wantsErr    bool
+		wantsErrMsg string
+	}{
+		{
+			name: "feature",
+			cli:  "automated-fixes",
+			wants: DisableOptions{
+				Feature: "automated-fixes",
+			},
+		},
+		{
+			name: "repos",
+			cli:  "dependabot-alerts --repos cli/cli",
+			wants: DisableOptions{
+				Feature: "dependabot-alerts",
+				Repos:   []string{"cli/cli"},
+			},
+		},
+		{
+			name:        "invalid feature",
+			cli:         "everything",
+			wantsErr:    true,
+			wantsErrMsg: "invalid feature \"everything\": valid values are {dependabot-alerts|automated-fixes|secret-scanning}",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ios, _, _, _ := iostreams.Test()
+			f := &cmdutil.Factory{
+				IOStreams: ios,
+			}
+
+			argv, err := shlex.Split(tt.cli)
+			assert.NoError(t, err)
+
+			var gotOpts *DisableOptions
+			cmd := NewCmdDisable(f, func(opts *DisableOptions) error {
+				gotOpts = opts
+				return nil
+			})
+
+			cmd.SetArgs(argv)
+			_, err = cmd.ExecuteC()
+			if tt.wantsErr {
+				assert.Error(t, err)
+				if tt.wantsErrMsg != "" {
+					assert.Equal(t, tt.wantsErrMsg, err.Error())
+				}
+				return
+			}
+			assert.NoError(t, err)
+
+			assert.Equal(t, tt.wants.Feature, gotOpts.Feature)
+			assert.Equal(t, tt.wants.Repos, gotOpts.Repos)
+		})
+	}
+}
+
+func TestDisableRun_SecretScanning(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+
+	reg.Register(
+		httpmock.REST("PATCH", "repos/cli/cli"),
+		httpmock.RESTPayload(200, "{}", func(payload map[string]interface{}) {
+			assert.Equal(t, map[string]interface{}{
+				"security_and_analysis": map[string]interface{}{
+					"secret_scanning": map[string]interface{}{
+						"status": "disabled",
+					},
+				},
+			}, payload)
+		}))
+
+	ios, _, stdout, _ := iostreams.Test()
+	opts := &DisableOptions{
+		IO: ios,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		Feature: "secret-scanning",
+		Repos:   []string{"cli/cli"},
+	}
+
+	err := disableRun(opts)
+	assert.NoError(t, err)
+	assert.Equal(t, "✓ Disabled secret-scanning for cli/cli\n", stdout.String())
+}
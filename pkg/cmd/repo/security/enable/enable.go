@@ -0,0 +1,69 @@
+package enable
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/repo/security/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type EnableOptions struct {
+	HttpClient func() (*http.Client, error)
+	BaseRepo   func() (ghrepo.Interface, error)
+	IO         *iostreams.IOStreams
+	Feature    string
+	Repos      []string
+}
+
+func NewCmdEnable(f *cmdutil.Factory, runF func(*EnableOptions) error) *cobra.Command {
+	opts := &EnableOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+		BaseRepo:   f.BaseRepo,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "enable <feature>",
+		Short: "Enable a security feature for one or more repositories",
+		Long: heredoc.Docf(`
+			Enable a security feature for one or more repositories.
+
+			The feature is one of %[1]sdependabot-alerts%[1]s, %[1]sautomated-fixes%[1]s, or
+			%[1]ssecret-scanning%[1]s. With %[1]s--repos%[1]s, the feature is toggled on every listed
+			repository and a result is reported per repository; remaining repositories
+			are still updated when one of them fails.
+		`, "`"),
+		Example: heredoc.Doc(`
+			# enable Dependabot alerts for the current repository
+			gh repo security enable dependabot-alerts
+
+			# roll out secret scanning across several repositories
+			gh repo security enable secret-scanning --repos cli/cli,cli/go-gh
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Feature = args[0]
+			if !shared.IsFeature(opts.Feature) {
+				return cmdutil.FlagErrorf("invalid feature %q: valid values are {%s}", opts.Feature, strings.Join(shared.Features, "|"))
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return enableRun(opts)
+		},
+	}
+
+	cmd.Flags().StringSliceVarP(&opts.Repos, "repos", "R", nil, "Repositories to update in `OWNER/REPO` format (defaults to the current repository)")
+
+	return cmd
+}
+
+func enableRun(opts *EnableOptions) error {
+	return shared.ToggleRun(opts.IO, opts.HttpClient, opts.BaseRepo, opts.Feature, opts.Repos, true)
+}
@@ -0,0 +1,134 @@
+package enable
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCmdEnable(t *testing.T) {
+	tests := []struct {
+		name        string
+		cli         string
+		wants       EnableOptions
+		wantsErr    bool
+		wantsErrMsg string
+	}{
+		{
+			name: "feature",
+			cli:  "dependabot-alerts",
+			wants: EnableOptions{
+				Feature: "dependabot-alerts",
+			},
+		},
+		{
+			name: "repos",
+			cli:  "secret-scanning --repos cli/cli,cli/go-gh",
+			wants: EnableOptions{
+				Feature: "secret-scanning",
+				Repos:   []string{"cli/cli", "cli/go-gh"},
+			},
+		},
+		{
+			name:        "invalid feature",
+			cli:         "code-scanning",
+			wantsErr:    true,
+			wantsErrMsg: "invalid feature \"code-scanning\": valid values are {dependabot-alerts|automated-fixes|secret-scanning}",
+		},
+		{
+			name:     "no feature",
+			cli:      "",
+			wantsErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ios, _, _, _ := iostreams.Test()
+			f := &cmdutil.Factory{
+				IOStreams: ios,
+			}
+
+			argv, err := shlex.Split(tt.cli)
+			assert.NoError(t, err)
+
+			var gotOpts *EnableOptions
+			cmd := NewCmdEnable(f, func(opts *EnableOptions) error {
+				gotOpts = opts
+				return nil
+			})
+
+			cmd.SetArgs(argv)
+			_, err = cmd.ExecuteC()
+			if tt.wantsErr {
+				assert.Error(t, err)
+				if tt.wantsErrMsg != "" {
+					assert.Equal(t, tt.wantsErrMsg, err.Error())
+				}
+				return
+			}
+			assert.NoError(t, err)
+
+			assert.Equal(t, tt.wants.Feature, gotOpts.Feature)
+			assert.Equal(t, tt.wants.Repos, gotOpts.Repos)
+		})
+	}
+}
+
+func TestEnableRun(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+
+	reg.Register(
+		httpmock.REST("PUT", "repos/cli/cli/vulnerability-alerts"),
+		httpmock.StatusStringResponse(204, "{}"))
+	reg.Register(
+		httpmock.REST("PUT", "repos/cli/go-gh/vulnerability-alerts"),
+		httpmock.StatusStringResponse(404, "{}"))
+
+	ios, _, stdout, stderr := iostreams.Test()
+	opts := &EnableOptions{
+		IO: ios,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		Feature: "dependabot-alerts",
+		Repos:   []string{"cli/cli", "cli/go-gh"},
+	}
+
+	err := enableRun(opts)
+	assert.Equal(t, cmdutil.SilentError, err)
+	assert.Equal(t, "✓ Enabled dependabot-alerts for cli/cli\n", stdout.String())
+	assert.Contains(t, stderr.String(), "X Failed to enable dependabot-alerts for cli/go-gh")
+}
+
+func TestEnableRun_BaseRepo(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+
+	reg.Register(
+		httpmock.REST("PUT", "repos/OWNER/REPO/automated-security-fixes"),
+		httpmock.StatusStringResponse(204, "{}"))
+
+	ios, _, stdout, _ := iostreams.Test()
+	opts := &EnableOptions{
+		IO: ios,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.New("OWNER", "REPO"), nil
+		},
+		Feature: "automated-fixes",
+	}
+
+	err := enableRun(opts)
+	assert.NoError(t, err)
+	assert.Equal(t, "✓ Enabled automated-fixes for OWNER/REPO\n", stdout.String())
+}
@@ -0,0 +1,20 @@
+package security
+
+import (
+	cmdDisable "github.com/cli/cli/v2/pkg/cmd/repo/security/disable"
+	cmdEnable "github.com/cli/cli/v2/pkg/cmd/repo/security/enable"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+func NewCmdSecurity(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "security <command>",
+		Short: "Manage security features in a repository",
+	}
+
+	cmd.AddCommand(cmdEnable.NewCmdEnable(f, nil))
+	cmd.AddCommand(cmdDisable.NewCmdDisable(f, nil))
+
+	return cmd
+}
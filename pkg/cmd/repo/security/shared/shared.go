@@ -0,0 +1,124 @@
+package shared
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+)
+
+// Features are the security features that can be toggled per repository.
+var Features = []string{"dependabot-alerts", "automated-fixes", "secret-scanning"}
+
+// IsFeature reports whether feature is one of Features.
+func IsFeature(feature string) bool {
+	for _, f := range Features {
+		if f == feature {
+			return true
+		}
+	}
+	return false
+}
+
+// ToggleRun applies the feature toggle to every selected repository, printing
+// one result per repository. Remaining repositories are still updated when
+// one of them fails; the error returned is then cmdutil.SilentError.
+func ToggleRun(io *iostreams.IOStreams, httpClient func() (*http.Client, error), baseRepo func() (ghrepo.Interface, error), feature string, repoArgs []string, enable bool) error {
+	client, err := httpClient()
+	if err != nil {
+		return err
+	}
+
+	var repos []ghrepo.Interface
+	if len(repoArgs) == 0 {
+		repo, err := baseRepo()
+		if err != nil {
+			return err
+		}
+		repos = append(repos, repo)
+	} else {
+		for _, arg := range repoArgs {
+			repo, err := ghrepo.FromFullName(arg)
+			if err != nil {
+				return err
+			}
+			repos = append(repos, repo)
+		}
+	}
+
+	verb := "Enabled"
+	failedVerb := "enable"
+	if !enable {
+		verb = "Disabled"
+		failedVerb = "disable"
+	}
+
+	cs := io.ColorScheme()
+	failed := 0
+	for _, repo := range repos {
+		if err := ToggleFeature(client, repo, feature, enable); err != nil {
+			failed++
+			fmt.Fprintf(io.ErrOut, "%s Failed to %s %s for %s: %v\n", cs.FailureIcon(), failedVerb, feature, ghrepo.FullName(repo), err)
+			continue
+		}
+		fmt.Fprintf(io.Out, "%s %s %s for %s\n", cs.SuccessIcon(), verb, feature, ghrepo.FullName(repo))
+	}
+
+	if failed > 0 {
+		return cmdutil.SilentError
+	}
+	return nil
+}
+
+// ToggleFeature enables or disables a security feature on a repository.
+func ToggleFeature(httpClient *http.Client, repo ghrepo.Interface, feature string, enable bool) error {
+	apiClient := api.NewClientFromHTTP(httpClient)
+
+	switch feature {
+	case "dependabot-alerts":
+		return toggleByMethod(apiClient, repo, "vulnerability-alerts", enable)
+	case "automated-fixes":
+		return toggleByMethod(apiClient, repo, "automated-security-fixes", enable)
+	case "secret-scanning":
+		return toggleSecretScanning(apiClient, repo, enable)
+	}
+	return fmt.Errorf("unknown security feature %q", feature)
+}
+
+// toggleByMethod covers the endpoints where a PUT enables the feature and a
+// DELETE disables it.
+func toggleByMethod(apiClient *api.Client, repo ghrepo.Interface, endpoint string, enable bool) error {
+	method := http.MethodPut
+	if !enable {
+		method = http.MethodDelete
+	}
+	path := fmt.Sprintf("repos/%s/%s/%s", repo.RepoOwner(), repo.RepoName(), endpoint)
+	return apiClient.REST(repo.RepoHost(), method, path, nil, nil)
+}
+
+// toggleSecretScanning has no dedicated endpoint; it is part of the
+// repository's security_and_analysis settings.
+func toggleSecretScanning(apiClient *api.Client, repo ghrepo.Interface, enable bool) error {
+	status := "enabled"
+	if !enable {
+		status = "disabled"
+	}
+	params := map[string]interface{}{
+		"security_and_analysis": map[string]interface{}{
+			"secret_scanning": map[string]interface{}{
+				"status": status,
+			},
+		},
+	}
+	body, err := json.Marshal(params)
+	if err != nil {
+		return err
+	}
+	path := fmt.Sprintf("repos/%s/%s", repo.RepoOwner(), repo.RepoName())
+	return apiClient.REST(repo.RepoHost(), http.MethodPatch, path, bytes.NewReader(body), nil)
+}
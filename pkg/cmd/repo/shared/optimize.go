@@ -0,0 +1,36 @@
+package shared
+
+import (
+	"context"
+
+	"github.com/cli/cli/v2/git"
+)
+
+// gitOptimizationSteps are the commands run in a freshly cloned repository
+// when the user has opted in via the git_optimizations config key. They enable
+// the filesystem monitor, commit-graph writing on fetch, and background
+// maintenance, which speed up subsequent git operations on large repositories.
+var gitOptimizationSteps = [][]string{
+	{"config", "core.fsmonitor", "true"},
+	{"config", "core.untrackedCache", "true"},
+	{"config", "fetch.writeCommitGraph", "true"},
+	{"maintenance", "start"},
+}
+
+// ApplyGitOptimizations configures the repository in cloneDir for better
+// performance on large working trees.
+func ApplyGitOptimizations(ctx context.Context, gitClient *git.Client, cloneDir string) error {
+	gc := gitClient.Copy()
+	gc.RepoDir = cloneDir
+
+	for _, args := range gitOptimizationSteps {
+		cmd, err := gc.Command(ctx, args...)
+		if err != nil {
+			return err
+		}
+		if _, err := cmd.Output(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,55 @@
+package shared
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghinstance"
+	"github.com/cli/cli/v2/internal/ghrepo"
+)
+
+// statsRetryDelay is how long to wait between polls while GitHub computes
+// repository statistics; it is a variable so tests can shorten it.
+var statsRetryDelay = 2 * time.Second
+
+const statsMaxAttempts = 5
+
+// FetchStats fetches a repository statistics endpoint such as
+// "stats/contributors". GitHub computes these lazily and answers HTTP 202
+// until the data is ready, so pending responses are retried before giving up.
+func FetchStats(httpClient *http.Client, repo ghrepo.Interface, path string, data interface{}) error {
+	url := fmt.Sprintf("%srepos/%s/%s", ghinstance.RESTPrefix(repo.RepoHost()), ghrepo.FullName(repo), path)
+
+	for attempt := 0; attempt < statsMaxAttempts; attempt++ {
+		if attempt > 0 {
+			time.Sleep(statsRetryDelay)
+		}
+
+		req, err := http.NewRequest("GET", url, nil)
+		if err != nil {
+			return err
+		}
+
+		resp, err := httpClient.Do(req)
+		if err != nil {
+			return err
+		}
+
+		if resp.StatusCode == http.StatusAccepted {
+			resp.Body.Close()
+			continue
+		}
+
+		if resp.StatusCode > 299 {
+			return api.HandleHTTPError(resp)
+		}
+
+		defer resp.Body.Close()
+		return json.NewDecoder(resp.Body).Decode(data)
+	}
+
+	return fmt.Errorf("GitHub is still computing statistics for %s; try again in a few moments", ghrepo.FullName(repo))
+}
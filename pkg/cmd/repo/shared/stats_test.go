@@ -0,0 +1,66 @@
+package shared
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFetchStats(t *testing.T) {
+	oldDelay := statsRetryDelay
+	statsRetryDelay = time.Millisecond
+	defer func() { statsRetryDelay = oldDelay }()
+
+	t.Run("retries while statistics are being computed", func(t *testing.T) {
+		reg := &httpmock.Registry{}
+		defer reg.Verify(t)
+		reg.Register(
+			httpmock.REST("GET", "repos/OWNER/REPO/stats/commit_activity"),
+			httpmock.StatusStringResponse(202, ""))
+		reg.Register(
+			httpmock.REST("GET", "repos/OWNER/REPO/stats/commit_activity"),
+			httpmock.StringResponse(`[{"week": 1704067200, "total": 3}]`))
+
+		var data []struct {
+			Week  int64 `json:"week"`
+			Total int   `json:"total"`
+		}
+		client := &http.Client{Transport: reg}
+		err := FetchStats(client, ghrepo.New("OWNER", "REPO"), "stats/commit_activity", &data)
+		require.NoError(t, err)
+		require.Len(t, data, 1)
+		assert.Equal(t, 3, data[0].Total)
+	})
+
+	t.Run("gives up after repeated pending responses", func(t *testing.T) {
+		reg := &httpmock.Registry{}
+		for i := 0; i < statsMaxAttempts; i++ {
+			reg.Register(
+				httpmock.REST("GET", "repos/OWNER/REPO/stats/contributors"),
+				httpmock.StatusStringResponse(202, ""))
+		}
+
+		var data interface{}
+		client := &http.Client{Transport: reg}
+		err := FetchStats(client, ghrepo.New("OWNER", "REPO"), "stats/contributors", &data)
+		assert.EqualError(t, err, "GitHub is still computing statistics for OWNER/REPO; try again in a few moments")
+	})
+
+	t.Run("surfaces API errors", func(t *testing.T) {
+		reg := &httpmock.Registry{}
+		defer reg.Verify(t)
+		reg.Register(
+			httpmock.REST("GET", "repos/OWNER/REPO/stats/contributors"),
+			httpmock.StatusStringResponse(404, `{"message": "Not Found"}`))
+
+		var data interface{}
+		client := &http.Client{Transport: reg}
+		err := FetchStats(client, ghrepo.New("OWNER", "REPO"), "stats/contributors", &data)
+		assert.EqualError(t, err, "HTTP 404 (https://api.github.com/repos/OWNER/REPO/stats/contributors)")
+	})
+}
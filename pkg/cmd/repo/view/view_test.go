@@ -28,6 +28,7 @@ func TestJSONFields(t *testing.T) {
 		"codeOfConduct",
 		"contactLinks",
 		"createdAt",
+		"defaultBranchProtection",
 		"defaultBranchRef",
 		"deleteBranchOnMerge",
 		"description",
@@ -1,6 +1,7 @@
 package root
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"os/exec"
@@ -9,6 +10,8 @@ import (
 
 	"github.com/cli/cli/v2/pkg/extensions"
 	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/go-gh/v2/pkg/jq"
+	"github.com/cli/go-gh/v2/pkg/template"
 	"github.com/spf13/cobra"
 )
 
@@ -44,14 +47,38 @@ func NewCmdExtension(io *iostreams.IOStreams, em extensions.ExtensionManager, ex
 			}()
 		},
 		RunE: func(c *cobra.Command, args []string) error {
+			var filter, tmpl string
+			if ext.SupportsExporterFlags() {
+				var err error
+				filter, tmpl, args, err = parseExporterArgs(args)
+				if err != nil {
+					return err
+				}
+			}
+
+			var buf *bytes.Buffer
+			if filter != "" || tmpl != "" {
+				buf = &bytes.Buffer{}
+			}
+
 			args = append([]string{ext.Name()}, args...)
-			if _, err := em.Dispatch(args, io.In, io.Out, io.ErrOut); err != nil {
+			var err error
+			if buf != nil {
+				_, err = em.Dispatch(args, io.In, buf, io.ErrOut)
+			} else {
+				_, err = em.Dispatch(args, io.In, io.Out, io.ErrOut)
+			}
+			if err != nil {
 				var execError *exec.ExitError
 				if errors.As(err, &execError) {
 					return &ExternalCommandExitError{execError}
 				}
 				return fmt.Errorf("failed to run extension: %w\n", err)
 			}
+
+			if buf != nil {
+				return applyExporter(io, buf, filter, tmpl)
+			}
 			return nil
 		},
 		// PostRun handles communicating extension release information if found
@@ -83,3 +110,67 @@ func NewCmdExtension(io *iostreams.IOStreams, em extensions.ExtensionManager, ex
 		DisableFlagParsing: true,
 	}
 }
+
+// parseExporterArgs extracts --jq and --template values from the arguments of
+// an extension that declared exporter flag support. --json stays in the
+// argument list since the extension itself handles producing JSON output.
+func parseExporterArgs(args []string) (filter, tmpl string, rest []string, err error) {
+	var hasJSON bool
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		switch {
+		case arg == "--json" || strings.HasPrefix(arg, "--json="):
+			hasJSON = true
+			rest = append(rest, arg)
+		case arg == "--jq" || arg == "--template":
+			if i == len(args)-1 {
+				return "", "", nil, fmt.Errorf("no value given for `%s`", arg)
+			}
+			i++
+			if arg == "--jq" {
+				filter = args[i]
+			} else {
+				tmpl = args[i]
+			}
+		case strings.HasPrefix(arg, "--jq="):
+			filter = strings.TrimPrefix(arg, "--jq=")
+		case strings.HasPrefix(arg, "--template="):
+			tmpl = strings.TrimPrefix(arg, "--template=")
+		default:
+			rest = append(rest, arg)
+		}
+	}
+
+	if filter != "" && tmpl != "" {
+		return "", "", nil, errors.New("cannot use `--jq` with `--template`")
+	}
+	if filter != "" && !hasJSON {
+		return "", "", nil, errors.New("cannot use `--jq` without specifying `--json`")
+	}
+	if tmpl != "" && !hasJSON {
+		return "", "", nil, errors.New("cannot use `--template` without specifying `--json`")
+	}
+	return
+}
+
+// applyExporter runs the captured JSON output of an extension through a jq
+// filter or a Go template, mirroring what core commands do with --jq and
+// --template.
+func applyExporter(ios *iostreams.IOStreams, body *bytes.Buffer, filter, tmpl string) error {
+	if filter != "" {
+		indent := ""
+		if ios.IsStdoutTTY() {
+			indent = "  "
+		}
+		return jq.EvaluateFormatted(body, ios.Out, filter, indent, ios.ColorEnabled())
+	}
+
+	t := template.New(ios.Out, ios.TerminalWidth(), ios.ColorEnabled())
+	if err := t.Parse(tmpl); err != nil {
+		return err
+	}
+	if err := t.Execute(body); err != nil {
+		return err
+	}
+	return t.Flush()
+}
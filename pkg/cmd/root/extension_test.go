@@ -137,6 +137,9 @@ func TestNewCmdExtension_Updates(t *testing.T) {
 			NameFunc: func() string {
 				return tt.extName
 			},
+			SupportsExporterFlagsFunc: func() bool {
+				return false
+			},
 			UpdateAvailableFunc: func() bool {
 				return tt.extUpdateAvailable
 			},
@@ -157,3 +160,100 @@ func TestNewCmdExtension_Updates(t *testing.T) {
 		}
 	}
 }
+
+func TestNewCmdExtension_ExporterFlags(t *testing.T) {
+	tests := []struct {
+		name         string
+		supported    bool
+		args         []string
+		extOutput    string
+		wantDispatch []string
+		wantStdout   string
+		wantErr      string
+	}{
+		{
+			name:         "jq filter applied to extension output",
+			supported:    true,
+			args:         []string{"list", "--json", "name", "--jq", ".name"},
+			extOutput:    `{"name":"hub"}`,
+			wantDispatch: []string{"exporter", "list", "--json", "name"},
+			wantStdout:   "hub\n",
+		},
+		{
+			name:         "template applied to extension output",
+			supported:    true,
+			args:         []string{"list", "--json=name", "--template", "{{.name}}"},
+			extOutput:    `{"name":"hub"}`,
+			wantDispatch: []string{"exporter", "list", "--json=name"},
+			wantStdout:   "hub",
+		},
+		{
+			name:      "jq requires json",
+			supported: true,
+			args:      []string{"list", "--jq", ".name"},
+			wantErr:   "cannot use `--jq` without specifying `--json`",
+		},
+		{
+			name:      "template requires json",
+			supported: true,
+			args:      []string{"list", "--template", "{{.name}}"},
+			wantErr:   "cannot use `--template` without specifying `--json`",
+		},
+		{
+			name:      "jq and template are mutually exclusive",
+			supported: true,
+			args:      []string{"list", "--json", "name", "--jq", ".name", "--template", "{{.name}}"},
+			wantErr:   "cannot use `--jq` with `--template`",
+		},
+		{
+			name:         "unsupported extension receives flags verbatim",
+			supported:    false,
+			args:         []string{"list", "--json", "name", "--jq", ".name"},
+			extOutput:    "raw output\n",
+			wantDispatch: []string{"exporter", "list", "--json", "name", "--jq", ".name"},
+			wantStdout:   "raw output\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ios, _, stdout, _ := iostreams.Test()
+
+			var gotDispatch []string
+			em := &extensions.ExtensionManagerMock{
+				DispatchFunc: func(args []string, stdin io.Reader, stdout io.Writer, stderr io.Writer) (bool, error) {
+					gotDispatch = args
+					_, err := stdout.Write([]byte(tt.extOutput))
+					return true, err
+				},
+			}
+
+			ext := &extensions.ExtensionMock{
+				NameFunc: func() string {
+					return "exporter"
+				},
+				SupportsExporterFlagsFunc: func() bool {
+					return tt.supported
+				},
+				UpdateAvailableFunc: func() bool {
+					return false
+				},
+			}
+
+			cmd := root.NewCmdExtension(ios, em, ext)
+			cmd.SetArgs(tt.args)
+			cmd.SetOut(io.Discard)
+			cmd.SetErr(io.Discard)
+
+			_, err := cmd.ExecuteC()
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.wantDispatch, gotDispatch)
+			assert.Equal(t, tt.wantStdout, stdout.String())
+		})
+	}
+}
@@ -63,7 +63,12 @@ var HelpTopics = []helpTopic{
 			%[1]sGH_BROWSER%[1]s, %[1]sBROWSER%[1]s (in order of precedence): the web browser to use for opening links.
 
 			%[1]sGH_DEBUG%[1]s: set to a truthy value to enable verbose output on standard error. Set to %[1]sapi%[1]s
-			to additionally log details of HTTP traffic.
+			to additionally log details of HTTP traffic. Individual scopes and levels may be
+			selected with a list such as %[1]sapi:trace,oauth:debug%[1]s; recognized scopes are %[1]sapi%[1]s,
+			%[1]soauth%[1]s, %[1]sliveshare%[1]s, and %[1]sextension%[1]s.
+
+			%[1]sGH_DEBUG_FILE%[1]s: a file to append debug output to instead of standard error.
+			Secrets such as access tokens are redacted from debug output.
 
 			%[1]sDEBUG%[1]s (deprecated): set to %[1]s1%[1]s, %[1]strue%[1]s, or %[1]syes%[1]s to enable verbose output on standard
 			error.
@@ -98,6 +103,10 @@ var HelpTopics = []helpTopic{
 
 			%[1]sGH_PROMPT_DISABLED%[1]s: set to any value to disable interactive prompting in the terminal.
 
+			%[1]sGH_ERROR_FORMAT%[1]s: set to %[1]sjson%[1]s to print command failures as a structured JSON object on
+			standard error (error type, HTTP status, request ID, remediation hint, exit code)
+			instead of human-readable messages.
+
 			%[1]sGH_PATH%[1]s: set the path to the gh executable, useful for when gh can not properly determine
 			its own path such as in the cygwin terminal.
 
@@ -0,0 +1,159 @@
+package download
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/cli/cli/v2/pkg/cmd/run/shared"
+	"github.com/klauspost/compress/zstd"
+)
+
+// manifestEntry describes one artifact stored in an archive written with
+// --archive.
+type manifestEntry struct {
+	Name   string `json:"name"`
+	Path   string `json:"path"`
+	Size   int64  `json:"size"`
+	Digest string `json:"digest"`
+}
+
+// archiveWriter bundles downloaded artifact zips into a single compressed tar
+// without extracting them, and records a manifest of what it stored.
+type archiveWriter struct {
+	file       *os.File
+	compressor io.WriteCloser
+	tw         *tar.Writer
+	entries    []manifestEntry
+	closed     bool
+}
+
+func newArchiveWriter(path string) (*archiveWriter, error) {
+	var compress func(io.Writer) (io.WriteCloser, error)
+	switch {
+	case strings.HasSuffix(path, ".tar.gz") || strings.HasSuffix(path, ".tgz"):
+		compress = func(w io.Writer) (io.WriteCloser, error) {
+			return gzip.NewWriter(w), nil
+		}
+	case strings.HasSuffix(path, ".tar.zst"):
+		compress = func(w io.Writer) (io.WriteCloser, error) {
+			return zstd.NewWriter(w)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported archive format for %q: expected a .tar.gz, .tgz, or .tar.zst path", path)
+	}
+
+	file, err := os.Create(path)
+	if err != nil {
+		return nil, err
+	}
+	compressor, err := compress(file)
+	if err != nil {
+		_ = file.Close()
+		_ = os.Remove(path)
+		return nil, err
+	}
+
+	return &archiveWriter{
+		file:       file,
+		compressor: compressor,
+		tw:         tar.NewWriter(compressor),
+	}, nil
+}
+
+// Add stores the raw artifact zip under "<name>.zip" and records it in the
+// manifest.
+func (w *archiveWriter) Add(name string, content io.Reader, size int64, digest string) error {
+	entryName := name + ".zip"
+	hdr := &tar.Header{
+		Name:    entryName,
+		Mode:    0600,
+		Size:    size,
+		ModTime: time.Now(),
+	}
+	if err := w.tw.WriteHeader(hdr); err != nil {
+		return err
+	}
+	if _, err := io.Copy(w.tw, content); err != nil {
+		return err
+	}
+	w.entries = append(w.entries, manifestEntry{
+		Name:   name,
+		Path:   entryName,
+		Size:   size,
+		Digest: digest,
+	})
+	return nil
+}
+
+// Close appends the manifest and finalizes the archive.
+func (w *archiveWriter) Close() error {
+	manifest, err := json.MarshalIndent(w.entries, "", "  ")
+	if err != nil {
+		return err
+	}
+	manifest = append(manifest, '\n')
+
+	hdr := &tar.Header{
+		Name:    "manifest.json",
+		Mode:    0600,
+		Size:    int64(len(manifest)),
+		ModTime: time.Now(),
+	}
+	if err := w.tw.WriteHeader(hdr); err != nil {
+		return err
+	}
+	if _, err := w.tw.Write(manifest); err != nil {
+		return err
+	}
+
+	if err := w.tw.Close(); err != nil {
+		return err
+	}
+	if err := w.compressor.Close(); err != nil {
+		return err
+	}
+	w.closed = true
+	return w.file.Close()
+}
+
+// Abort removes a partially written archive. It is a no-op after Close.
+func (w *archiveWriter) Abort() {
+	if w.closed {
+		return
+	}
+	_ = w.file.Close()
+	_ = os.Remove(w.file.Name())
+}
+
+// archiveArtifact downloads an artifact zip to a temporary file, hashing it
+// along the way, and stores it in the archive without extracting it.
+func archiveArtifact(p platform, aw *archiveWriter, a shared.Artifact) error {
+	tmpfile, err := os.CreateTemp("", "gh-artifact.*.zip")
+	if err != nil {
+		return fmt.Errorf("error initializing temporary file: %w", err)
+	}
+	defer func() {
+		_ = tmpfile.Close()
+		_ = os.Remove(tmpfile.Name())
+	}()
+
+	hash := sha256.New()
+	size, err := p.DownloadRaw(a.DownloadURL, io.MultiWriter(tmpfile, hash))
+	if err != nil {
+		return err
+	}
+	if _, err := tmpfile.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+
+	digest := "sha256:" + hex.EncodeToString(hash.Sum(nil))
+	return aw.Add(a.Name, tmpfile, size, digest)
+}
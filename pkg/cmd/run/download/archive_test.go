@@ -0,0 +1,137 @@
+package download
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/cli/cli/v2/pkg/cmd/run/shared"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/klauspost/compress/zstd"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_newArchiveWriter_unsupportedFormat(t *testing.T) {
+	_, err := newArchiveWriter("artifacts.zip")
+	assert.EqualError(t, err, `unsupported archive format for "artifacts.zip": expected a .tar.gz, .tgz, or .tar.zst path`)
+}
+
+func Test_runDownload_archive(t *testing.T) {
+	tests := []struct {
+		name        string
+		archiveName string
+		decompress  func(io.Reader) (io.Reader, error)
+	}{
+		{
+			name:        "tar.gz",
+			archiveName: "artifacts.tar.gz",
+			decompress: func(r io.Reader) (io.Reader, error) {
+				return gzip.NewReader(r)
+			},
+		},
+		{
+			name:        "tar.zst",
+			archiveName: "artifacts.tar.zst",
+			decompress: func(r io.Reader) (io.Reader, error) {
+				return zstd.NewReader(r)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			platform := &fakePlatform{
+				runs: []run{
+					{
+						id: "2345",
+						testArtifacts: []testArtifact{
+							{
+								artifact: shared.Artifact{
+									Name:        "artifact-1",
+									DownloadURL: "http://download.com/artifact1.zip",
+								},
+							},
+							{
+								artifact: shared.Artifact{
+									Name:        "artifact-2",
+									DownloadURL: "http://download.com/artifact2.zip",
+								},
+							},
+							{
+								artifact: shared.Artifact{
+									Name:        "expired",
+									DownloadURL: "http://download.com/expired.zip",
+									Expired:     true,
+								},
+							},
+						},
+					},
+				},
+			}
+
+			archivePath := filepath.Join(t.TempDir(), tt.archiveName)
+			ios, _, stdout, _ := iostreams.Test()
+			ios.SetStdoutTTY(true)
+			opts := DownloadOptions{
+				IO:          ios,
+				Platform:    platform,
+				RunID:       "2345",
+				ArchivePath: archivePath,
+			}
+
+			err := runDownload(&opts)
+			require.NoError(t, err)
+			assert.Equal(t, fmt.Sprintf("Wrote 2 artifacts to %s\n", archivePath), stdout.String())
+
+			f, err := os.Open(archivePath)
+			require.NoError(t, err)
+			defer f.Close()
+
+			decompressed, err := tt.decompress(f)
+			require.NoError(t, err)
+
+			var entryNames []string
+			var manifest []manifestEntry
+			tr := tar.NewReader(decompressed)
+			for {
+				hdr, err := tr.Next()
+				if err == io.EOF {
+					break
+				}
+				require.NoError(t, err)
+				entryNames = append(entryNames, hdr.Name)
+				if hdr.Name == "manifest.json" {
+					require.NoError(t, json.NewDecoder(tr).Decode(&manifest))
+				}
+			}
+			assert.Equal(t, []string{"artifact-1.zip", "artifact-2.zip", "manifest.json"}, entryNames)
+
+			digest := func(s string) string {
+				sum := sha256.Sum256([]byte(s))
+				return "sha256:" + hex.EncodeToString(sum[:])
+			}
+			assert.Equal(t, []manifestEntry{
+				{
+					Name:   "artifact-1",
+					Path:   "artifact-1.zip",
+					Size:   int64(len("http://download.com/artifact1.zip")),
+					Digest: digest("http://download.com/artifact1.zip"),
+				},
+				{
+					Name:   "artifact-2",
+					Path:   "artifact-2.zip",
+					Size:   int64(len("http://download.com/artifact2.zip")),
+					Digest: digest("http://download.com/artifact2.zip"),
+				},
+			}, manifest)
+		})
+	}
+}
@@ -3,6 +3,7 @@ package download
 import (
 	"errors"
 	"fmt"
+	"io"
 	"path/filepath"
 
 	"github.com/MakeNowJust/heredoc"
@@ -21,6 +22,7 @@ type DownloadOptions struct {
 	DoPrompt       bool
 	RunID          string
 	DestinationDir string
+	ArchivePath    string
 	Names          []string
 	FilePatterns   []string
 }
@@ -28,6 +30,7 @@ type DownloadOptions struct {
 type platform interface {
 	List(runID string) ([]shared.Artifact, error)
 	Download(url string, dir string) error
+	DownloadRaw(url string, w io.Writer) (int64, error)
 }
 type iprompter interface {
 	MultiSelect(string, []string, []string) ([]int, error)
@@ -52,6 +55,11 @@ func NewCmdDownload(f *cmdutil.Factory, runF func(*DownloadOptions) error) *cobr
 			By default, this command downloads the latest artifact created and uploaded through
 			GitHub Actions. Because workflows can delete or overwrite artifacts, %[1]s<run-id>%[1]s
 			must be used to select an artifact from a specific workflow run.
+
+			With %[1]s--archive%[1]s, the selected artifacts are bundled as-is into a single
+			%[1]s.tar.gz%[1]s or %[1]s.tar.zst%[1]s archive instead of being extracted. The archive
+			contains one zip per artifact plus a %[1]smanifest.json%[1]s listing the name, size,
+			and SHA-256 digest of every stored artifact.
 		`, "`"),
 		Args: cobra.MaximumNArgs(1),
 		Example: heredoc.Doc(`
@@ -66,8 +74,14 @@ func NewCmdDownload(f *cmdutil.Factory, runF func(*DownloadOptions) error) *cobr
 
 			# Select artifacts to download interactively
 			$ gh run download
+
+			# Bundle all artifacts of a run into a single archive with a checksum manifest
+			$ gh run download <run-id> --archive artifacts.tar.zst
 		`),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.ArchivePath != "" && cmd.Flags().Changed("dir") {
+				return cmdutil.FlagErrorf("cannot use `--dir` with `--archive`")
+			}
 			if len(args) > 0 {
 				opts.RunID = args[0]
 			} else if len(opts.Names) == 0 &&
@@ -97,6 +111,7 @@ func NewCmdDownload(f *cmdutil.Factory, runF func(*DownloadOptions) error) *cobr
 	}
 
 	cmd.Flags().StringVarP(&opts.DestinationDir, "dir", "D", ".", "The directory to download artifacts into")
+	cmd.Flags().StringVar(&opts.ArchivePath, "archive", "", "Bundle artifacts into a single archive `file` (.tar.gz or .tar.zst) instead of extracting them")
 	cmd.Flags().StringArrayVarP(&opts.Names, "name", "n", nil, "Download artifacts that match any of the given names")
 	cmd.Flags().StringArrayVarP(&opts.FilePatterns, "pattern", "p", nil, "Download artifacts that match a glob pattern")
 
@@ -148,6 +163,14 @@ func runDownload(opts *DownloadOptions) error {
 		}
 	}
 
+	var aw *archiveWriter
+	if opts.ArchivePath != "" {
+		if aw, err = newArchiveWriter(opts.ArchivePath); err != nil {
+			return err
+		}
+		defer aw.Abort()
+	}
+
 	opts.IO.StartProgressIndicator()
 	defer opts.IO.StopProgressIndicator()
 
@@ -168,6 +191,14 @@ func runDownload(opts *DownloadOptions) error {
 			}
 		}
 
+		if aw != nil {
+			if err := archiveArtifact(opts.Platform, aw, a); err != nil {
+				return fmt.Errorf("error downloading %s: %w", a.Name, err)
+			}
+			downloaded.Add(a.Name)
+			continue
+		}
+
 		destDir := opts.DestinationDir
 		if isolateArtifacts {
 			destDir = filepath.Join(destDir, a.Name)
@@ -188,6 +219,16 @@ func runDownload(opts *DownloadOptions) error {
 		return errors.New("no artifact matches any of the names or patterns provided")
 	}
 
+	if aw != nil {
+		if err := aw.Close(); err != nil {
+			return fmt.Errorf("error writing archive: %w", err)
+		}
+		opts.IO.StopProgressIndicator()
+		if opts.IO.IsStdoutTTY() {
+			fmt.Fprintf(opts.IO.Out, "Wrote %d artifacts to %s\n", downloaded.Len(), opts.ArchivePath)
+		}
+	}
+
 	return nil
 }
 
@@ -95,6 +95,24 @@ func Test_NewCmdDownload(t *testing.T) {
 				DestinationDir: ".",
 			},
 		},
+		{
+			name:  "to archive",
+			args:  "2345 --archive artifacts.tar.zst",
+			isTTY: true,
+			want: DownloadOptions{
+				RunID:          "2345",
+				DoPrompt:       false,
+				Names:          []string(nil),
+				DestinationDir: ".",
+				ArchivePath:    "artifacts.tar.zst",
+			},
+		},
+		{
+			name:    "archive conflicts with dir",
+			args:    "2345 -D tmp/dest --archive artifacts.tar.gz",
+			isTTY:   true,
+			wantErr: "cannot use `--dir` with `--archive`",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -140,6 +158,7 @@ func Test_NewCmdDownload(t *testing.T) {
 			assert.Equal(t, tt.want.Names, opts.Names)
 			assert.Equal(t, tt.want.FilePatterns, opts.FilePatterns)
 			assert.Equal(t, tt.want.DestinationDir, opts.DestinationDir)
+			assert.Equal(t, tt.want.ArchivePath, opts.ArchivePath)
 			assert.Equal(t, tt.want.DoPrompt, opts.DoPrompt)
 		})
 	}
@@ -208,6 +227,19 @@ func (f *fakePlatform) Download(url string, dir string) error {
 	return errors.New("no artifact matches the provided URL")
 }
 
+func (f *fakePlatform) DownloadRaw(url string, w io.Writer) (int64, error) {
+	for _, run := range f.runs {
+		for _, testArtifact := range run.testArtifacts {
+			if testArtifact.artifact.DownloadURL == url {
+				n, err := w.Write([]byte(url))
+				return int64(n), err
+			}
+		}
+	}
+
+	return 0, errors.New("no artifact matches the provided URL")
+}
+
 func Test_runDownload(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -25,6 +25,27 @@ func (p *apiPlatform) Download(url string, dir string) error {
 	return downloadArtifact(p.client, url, dir)
 }
 
+// DownloadRaw streams an artifact zip without extracting it and returns the
+// number of bytes written.
+func (p *apiPlatform) DownloadRaw(url string, w io.Writer) (int64, error) {
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode > 299 {
+		return 0, api.HandleHTTPError(resp)
+	}
+
+	return io.Copy(w, resp.Body)
+}
+
 func downloadArtifact(httpClient *http.Client, url, destDir string) error {
 	req, err := http.NewRequest("GET", url, nil)
 	if err != nil {
@@ -3,6 +3,7 @@ package list
 import (
 	"fmt"
 	"net/http"
+	"sort"
 	"time"
 
 	"github.com/MakeNowJust/heredoc"
@@ -38,6 +39,7 @@ type ListOptions struct {
 	Created          string
 	Commit           string
 	All              bool
+	Summary          bool
 
 	now time.Time
 }
@@ -73,6 +75,10 @@ func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Comman
 				return cmdutil.FlagErrorf("invalid limit: %v", opts.Limit)
 			}
 
+			if opts.Summary && opts.Exporter != nil {
+				return cmdutil.FlagErrorf("cannot use `--summary` with `--json`")
+			}
+
 			if runF != nil {
 				return runF(opts)
 			}
@@ -89,6 +95,7 @@ func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Comman
 	cmd.Flags().StringVarP(&opts.Created, "created", "", "", "Filter runs by the `date` it was created")
 	cmd.Flags().StringVarP(&opts.Commit, "commit", "c", "", "Filter runs by the `SHA` of the commit")
 	cmd.Flags().BoolVarP(&opts.All, "all", "a", false, "Include disabled workflows")
+	cmd.Flags().BoolVar(&opts.Summary, "summary", false, "Print p50/p95 run durations per workflow instead of individual runs")
 	cmdutil.StringEnumFlag(cmd, &opts.Status, "status", "s", "", shared.AllStatuses, "Filter runs by status")
 	cmdutil.AddJSONFlags(cmd, &opts.Exporter, shared.RunFields)
 
@@ -154,6 +161,10 @@ func listRun(opts *ListOptions) error {
 		return opts.Exporter.Write(opts.IO, runs)
 	}
 
+	if opts.Summary {
+		return printSummary(opts, runs)
+	}
+
 	tp := tableprinter.New(opts.IO, tableprinter.WithHeader("STATUS", "TITLE", "WORKFLOW", "BRANCH", "EVENT", "ID", "ELAPSED", "AGE"))
 
 	cs := opts.IO.ColorScheme()
@@ -182,3 +193,45 @@ func listRun(opts *ListOptions) error {
 
 	return nil
 }
+
+// printSummary renders per-workflow p50/p95 durations over the listed runs.
+func printSummary(opts *ListOptions, runs []shared.Run) error {
+	durationsByWorkflow := map[string][]time.Duration{}
+	for _, run := range runs {
+		name := run.WorkflowName()
+		durationsByWorkflow[name] = append(durationsByWorkflow[name], run.Duration(opts.now))
+	}
+
+	workflows := make([]string, 0, len(durationsByWorkflow))
+	for name := range durationsByWorkflow {
+		workflows = append(workflows, name)
+	}
+	sort.Strings(workflows)
+
+	tp := tableprinter.New(opts.IO, tableprinter.WithHeader("WORKFLOW", "RUNS", "P50", "P95"))
+	cs := opts.IO.ColorScheme()
+
+	for _, name := range workflows {
+		durations := durationsByWorkflow[name]
+		sort.Slice(durations, func(i, j int) bool { return durations[i] < durations[j] })
+		tp.AddField(name, tableprinter.WithColor(cs.Bold))
+		tp.AddField(fmt.Sprintf("%d", len(durations)))
+		tp.AddField(percentile(durations, 50).String())
+		tp.AddField(percentile(durations, 95).String())
+		tp.EndRow()
+	}
+
+	return tp.Render()
+}
+
+// percentile returns the nearest-rank percentile of sorted durations.
+func percentile(sorted []time.Duration, p int) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := (p*len(sorted) + 99) / 100
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
@@ -182,6 +182,33 @@ func TestListRun(t *testing.T) {
 				X       cool commit  CI        trunk   push   10    4m34s    about 4 minutes ago
 			`),
 		},
+		{
+			name: "summary mode",
+			opts: &ListOptions{
+				Limit:   defaultLimit,
+				Summary: true,
+				now:     shared.TestRunStartTime.Add(time.Minute*4 + time.Second*34),
+			},
+			isTTY: true,
+			stubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/runs"),
+					httpmock.JSONResponse(shared.RunsPayload{
+						WorkflowRuns: shared.TestRuns,
+					}))
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/workflows"),
+					httpmock.JSONResponse(workflowShared.WorkflowsPayload{
+						Workflows: []workflowShared.Workflow{
+							shared.TestWorkflow,
+						},
+					}))
+			},
+			wantOut: heredoc.Doc(`
+				WORKFLOW  RUNS  P50    P95
+				CI        10    4m34s  4m34s
+			`),
+		},
 		{
 			name: "inactive disabled workflow selected",
 			opts: &ListOptions{
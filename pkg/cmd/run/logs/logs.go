@@ -0,0 +1,225 @@
+package logs
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghinstance"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/run/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type LogsOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+
+	RunID   string
+	JobName string
+	Follow  bool
+
+	// PollInterval is the wait between polls of the job and its logs in
+	// follow mode. Exposed so tests can avoid sleeping.
+	PollInterval time.Duration
+}
+
+func NewCmdLogs(f *cmdutil.Factory, runF func(*LogsOptions) error) *cobra.Command {
+	opts := &LogsOptions{
+		IO:           f.IOStreams,
+		HttpClient:   f.HttpClient,
+		PollInterval: 2 * time.Second,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "logs <run-id>",
+		Short: "View the logs of a workflow run's job",
+		Long: heredoc.Docf(`
+			View the logs of a single job of a workflow run.
+
+			With %[1]s--follow%[1]s, the job does not have to be complete: its logs are polled
+			and streamed as the job runs, so CI can be tailed like a local process. The
+			command returns once the job completes.
+
+			When the run has more than one job, select one with %[1]s--job%[1]s.
+		`, "`"),
+		Example: heredoc.Doc(`
+			# View the logs of the only job of a run
+			$ gh run logs 12345
+
+			# Stream the logs of an in-progress job
+			$ gh run logs 12345 --job build --follow
+		`),
+		Args: cmdutil.ExactArgs(1, "cannot view logs: run ID required"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// support `-R, --repo` override
+			opts.BaseRepo = f.BaseRepo
+			opts.RunID = args[0]
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return logsRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.JobName, "job", "j", "", "The `name` of the job to view logs for")
+	cmd.Flags().BoolVarP(&opts.Follow, "follow", "f", false, "Stream logs until the job completes")
+
+	return cmd
+}
+
+func logsRun(opts *LogsOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+	client := api.NewClientFromHTTP(httpClient)
+
+	repo, err := opts.BaseRepo()
+	if err != nil {
+		return fmt.Errorf("failed to determine base repo: %w", err)
+	}
+
+	run, err := shared.GetRun(client, repo, opts.RunID, 0)
+	if err != nil {
+		return fmt.Errorf("failed to get run: %w", err)
+	}
+
+	jobs, err := shared.GetJobs(client, repo, run, 0)
+	if err != nil {
+		return fmt.Errorf("failed to get jobs: %w", err)
+	}
+	if len(jobs) == 0 {
+		return fmt.Errorf("run %d has no jobs", run.ID)
+	}
+
+	job, err := selectJob(jobs, opts.JobName, run.ID)
+	if err != nil {
+		return err
+	}
+
+	logURL := fmt.Sprintf("%srepos/%s/actions/jobs/%d/logs",
+		ghinstance.RESTPrefix(repo.RepoHost()), ghrepo.FullName(repo), job.ID)
+
+	if !opts.Follow {
+		return printLogs(opts.IO, httpClient, logURL)
+	}
+
+	return followLogs(opts, httpClient, client, repo, job, logURL)
+}
+
+// selectJob picks the job whose logs to show. A name is required whenever the
+// run has more than one job.
+func selectJob(jobs []shared.Job, name string, runID int64) (*shared.Job, error) {
+	if name == "" {
+		if len(jobs) > 1 {
+			return nil, cmdutil.FlagErrorf("run %d has %d jobs; specify one with `--job`: %s", runID, len(jobs), jobNames(jobs))
+		}
+		return &jobs[0], nil
+	}
+
+	for i, job := range jobs {
+		if strings.EqualFold(job.Name, name) {
+			return &jobs[i], nil
+		}
+	}
+	return nil, fmt.Errorf("run %d has no job named %q; available jobs: %s", runID, name, jobNames(jobs))
+}
+
+func jobNames(jobs []shared.Job) string {
+	names := make([]string, 0, len(jobs))
+	for _, job := range jobs {
+		names = append(names, fmt.Sprintf("%q", job.Name))
+	}
+	return strings.Join(names, ", ")
+}
+
+// printLogs writes the current logs of the job in a single request.
+func printLogs(ios *iostreams.IOStreams, httpClient *http.Client, logURL string) error {
+	logs, err := getLog(httpClient, logURL)
+	if err != nil {
+		return err
+	}
+	defer logs.Close()
+
+	if err := ios.StartPager(); err == nil {
+		defer ios.StopPager()
+	} else {
+		fmt.Fprintf(ios.ErrOut, "failed to start pager: %v\n", err)
+	}
+
+	_, err = io.Copy(ios.Out, logs)
+	return err
+}
+
+// followLogs polls the logs endpoint until the job completes, printing only
+// the part of the log that was not seen on a previous poll. Queued jobs, whose
+// logs do not exist yet, are waited on.
+func followLogs(opts *LogsOptions, httpClient *http.Client, client *api.Client, repo ghrepo.Interface, job *shared.Job, logURL string) error {
+	var offset int
+	for {
+		if job.Status != shared.Queued {
+			logs, err := getLog(httpClient, logURL)
+			if err != nil && !errors.Is(err, errLogNotFound) {
+				return err
+			}
+			if err == nil {
+				data, err := io.ReadAll(logs)
+				logs.Close()
+				if err != nil {
+					return err
+				}
+				if len(data) > offset {
+					if _, err := opts.IO.Out.Write(data[offset:]); err != nil {
+						return err
+					}
+					offset = len(data)
+				}
+			}
+		}
+
+		if job.Status == shared.Completed {
+			return nil
+		}
+		time.Sleep(opts.PollInterval)
+
+		refreshed, err := shared.GetJob(client, repo, fmt.Sprintf("%d", job.ID))
+		if err != nil {
+			return fmt.Errorf("failed to get job: %w", err)
+		}
+		job = refreshed
+	}
+}
+
+var errLogNotFound = errors.New("log not found")
+
+func getLog(httpClient *http.Client, logURL string) (io.ReadCloser, error) {
+	req, err := http.NewRequest("GET", logURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode == 404 {
+		resp.Body.Close()
+		return nil, errLogNotFound
+	} else if resp.StatusCode != 200 {
+		defer resp.Body.Close()
+		return nil, api.HandleHTTPError(resp)
+	}
+
+	return resp.Body, nil
+}
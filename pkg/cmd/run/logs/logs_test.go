@@ -0,0 +1,255 @@
+package logs
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/run/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCmdLogs(t *testing.T) {
+	tests := []struct {
+		name     string
+		cli      string
+		wants    LogsOptions
+		wantsErr bool
+	}{
+		{
+			name:     "blank",
+			cli:      "",
+			wantsErr: true,
+		},
+		{
+			name: "run id",
+			cli:  "1234",
+			wants: LogsOptions{
+				RunID: "1234",
+			},
+		},
+		{
+			name: "job name",
+			cli:  "1234 --job build",
+			wants: LogsOptions{
+				RunID:   "1234",
+				JobName: "build",
+			},
+		},
+		{
+			name: "follow",
+			cli:  "1234 --job build --follow",
+			wants: LogsOptions{
+				RunID:   "1234",
+				JobName: "build",
+				Follow:  true,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ios, _, _, _ := iostreams.Test()
+			f := &cmdutil.Factory{
+				IOStreams: ios,
+			}
+
+			argv, err := shlex.Split(tt.cli)
+			assert.NoError(t, err)
+
+			var gotOpts *LogsOptions
+			cmd := NewCmdLogs(f, func(opts *LogsOptions) error {
+				gotOpts = opts
+				return nil
+			})
+			cmd.SetArgs(argv)
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+
+			_, err = cmd.ExecuteC()
+			if tt.wantsErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+
+			assert.Equal(t, tt.wants.RunID, gotOpts.RunID)
+			assert.Equal(t, tt.wants.JobName, gotOpts.JobName)
+			assert.Equal(t, tt.wants.Follow, gotOpts.Follow)
+		})
+	}
+}
+
+func TestLogsRun(t *testing.T) {
+	inProgressRun := shared.TestRun(2, shared.InProgress, "")
+
+	tests := []struct {
+		name      string
+		opts      *LogsOptions
+		httpStubs func(*httpmock.Registry)
+		wantOut   string
+		wantErr   string
+	}{
+		{
+			name: "completed job",
+			opts: &LogsOptions{
+				RunID: "3",
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/runs/3"),
+					httpmock.JSONResponse(shared.SuccessfulRun))
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/workflows/123"),
+					httpmock.JSONResponse(shared.TestWorkflow))
+				reg.Register(
+					httpmock.REST("GET", "runs/3/jobs"),
+					httpmock.JSONResponse(shared.JobsPayload{
+						Jobs: []shared.Job{
+							{ID: 10, Status: shared.Completed, Conclusion: shared.Success, Name: "build"},
+						},
+					}))
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/jobs/10/logs"),
+					httpmock.StringResponse("log line 1\nlog line 2\n"))
+			},
+			wantOut: "log line 1\nlog line 2\n",
+		},
+		{
+			name: "job selected by name",
+			opts: &LogsOptions{
+				RunID:   "3",
+				JobName: "lint",
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/runs/3"),
+					httpmock.JSONResponse(shared.SuccessfulRun))
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/workflows/123"),
+					httpmock.JSONResponse(shared.TestWorkflow))
+				reg.Register(
+					httpmock.REST("GET", "runs/3/jobs"),
+					httpmock.JSONResponse(shared.JobsPayload{
+						Jobs: []shared.Job{
+							{ID: 10, Status: shared.Completed, Conclusion: shared.Success, Name: "build"},
+							{ID: 11, Status: shared.Completed, Conclusion: shared.Success, Name: "lint"},
+						},
+					}))
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/jobs/11/logs"),
+					httpmock.StringResponse("lint logs\n"))
+			},
+			wantOut: "lint logs\n",
+		},
+		{
+			name: "multiple jobs without name",
+			opts: &LogsOptions{
+				RunID: "3",
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/runs/3"),
+					httpmock.JSONResponse(shared.SuccessfulRun))
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/workflows/123"),
+					httpmock.JSONResponse(shared.TestWorkflow))
+				reg.Register(
+					httpmock.REST("GET", "runs/3/jobs"),
+					httpmock.JSONResponse(shared.JobsPayload{
+						Jobs: []shared.Job{
+							{ID: 10, Status: shared.Completed, Conclusion: shared.Success, Name: "build"},
+							{ID: 11, Status: shared.Completed, Conclusion: shared.Success, Name: "lint"},
+						},
+					}))
+			},
+			wantErr: "run 3 has 2 jobs; specify one with `--job`: \"build\", \"lint\"",
+		},
+		{
+			name: "unknown job name",
+			opts: &LogsOptions{
+				RunID:   "3",
+				JobName: "deploy",
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/runs/3"),
+					httpmock.JSONResponse(shared.SuccessfulRun))
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/workflows/123"),
+					httpmock.JSONResponse(shared.TestWorkflow))
+				reg.Register(
+					httpmock.REST("GET", "runs/3/jobs"),
+					httpmock.JSONResponse(shared.JobsPayload{
+						Jobs: []shared.Job{
+							{ID: 10, Status: shared.Completed, Conclusion: shared.Success, Name: "build"},
+						},
+					}))
+			},
+			wantErr: "run 3 has no job named \"deploy\"; available jobs: \"build\"",
+		},
+		{
+			name: "follow in-progress job",
+			opts: &LogsOptions{
+				RunID:  "2",
+				Follow: true,
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/runs/2"),
+					httpmock.JSONResponse(inProgressRun))
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/workflows/123"),
+					httpmock.JSONResponse(shared.TestWorkflow))
+				reg.Register(
+					httpmock.REST("GET", "runs/2/jobs"),
+					httpmock.JSONResponse(shared.JobsPayload{
+						Jobs: []shared.Job{
+							{ID: 20, Status: shared.InProgress, Name: "build"},
+						},
+					}))
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/jobs/20/logs"),
+					httpmock.StringResponse("partial "))
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/jobs/20"),
+					httpmock.JSONResponse(shared.Job{ID: 20, Status: shared.Completed, Conclusion: shared.Success, Name: "build"}))
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/jobs/20/logs"),
+					httpmock.StringResponse("partial complete\n"))
+			},
+			wantOut: "partial complete\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reg := &httpmock.Registry{}
+			defer reg.Verify(t)
+			tt.httpStubs(reg)
+
+			ios, _, stdout, _ := iostreams.Test()
+			tt.opts.IO = ios
+			tt.opts.HttpClient = func() (*http.Client, error) {
+				return &http.Client{Transport: reg}, nil
+			}
+			tt.opts.BaseRepo = func() (ghrepo.Interface, error) {
+				return ghrepo.FromFullName("OWNER/REPO")
+			}
+
+			err := logsRun(tt.opts)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantOut, stdout.String())
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 	cmdDelete "github.com/cli/cli/v2/pkg/cmd/run/delete"
 	cmdDownload "github.com/cli/cli/v2/pkg/cmd/run/download"
 	cmdList "github.com/cli/cli/v2/pkg/cmd/run/list"
+	cmdLogs "github.com/cli/cli/v2/pkg/cmd/run/logs"
 	cmdRerun "github.com/cli/cli/v2/pkg/cmd/run/rerun"
 	cmdView "github.com/cli/cli/v2/pkg/cmd/run/view"
 	cmdWatch "github.com/cli/cli/v2/pkg/cmd/run/watch"
@@ -23,6 +24,7 @@ func NewCmdRun(f *cmdutil.Factory) *cobra.Command {
 
 	cmd.AddCommand(cmdList.NewCmdList(f, nil))
 	cmd.AddCommand(cmdView.NewCmdView(f, nil))
+	cmd.AddCommand(cmdLogs.NewCmdLogs(f, nil))
 	cmd.AddCommand(cmdRerun.NewCmdRerun(f, nil))
 	cmd.AddCommand(cmdDownload.NewCmdDownload(f, nil))
 	cmd.AddCommand(cmdWatch.NewCmdWatch(f, nil))
@@ -81,6 +81,8 @@ var RunFields = []string{
 	"workflowDatabaseId",
 	"workflowName",
 	"url",
+	"duration",
+	"queueTime",
 }
 
 var SingleRunFields = append(RunFields, "jobs")
@@ -127,6 +129,15 @@ func (r *Run) Duration(now time.Time) time.Duration {
 	return d.Round(time.Second)
 }
 
+// QueueTime is the time a run spent waiting for a runner before it started.
+func (r *Run) QueueTime() time.Duration {
+	d := r.StartedTime().Sub(r.CreatedAt)
+	if d < 0 {
+		return 0
+	}
+	return d.Round(time.Second)
+}
+
 type Repo struct {
 	Owner struct {
 		Login string
@@ -175,6 +186,10 @@ func (r *Run) ExportData(fields []string) map[string]interface{} {
 			data[f] = r.WorkflowID
 		case "workflowName":
 			data[f] = r.WorkflowName()
+		case "duration":
+			data[f] = r.Duration(time.Now()).String()
+		case "queueTime":
+			data[f] = r.QueueTime().String()
 		case "jobs":
 			jobs := make([]interface{}, 0, len(r.Jobs))
 			for _, j := range r.Jobs {
@@ -206,6 +221,7 @@ func (r *Run) ExportData(fields []string) map[string]interface{} {
 					"startedAt":   j.StartedAt,
 					"completedAt": jobCompletedAt,
 					"url":         j.URL,
+					"labels":      j.Labels,
 				})
 			}
 			data[f] = jobs
@@ -228,6 +244,7 @@ type Job struct {
 	CompletedAt time.Time `json:"completed_at"`
 	URL         string    `json:"html_url"`
 	RunID       int64     `json:"run_id"`
+	Labels      []string  `json:"labels"`
 }
 
 type Step struct {
@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/MakeNowJust/heredoc"
 	"github.com/cli/cli/v2/api"
@@ -165,6 +166,26 @@ func NewCmdSet(f *cmdutil.Factory, runF func(*SetOptions) error) *cobra.Command
 	return cmd
 }
 
+// publicKeyCacheTTL is how long fetched repo/org/user public keys may be served
+// from the local HTTP cache before being re-fetched.
+const publicKeyCacheTTL = 30 * time.Minute
+
+// validatePubKey rejects malformed public keys before any value is encrypted
+// with them, so the key ID sent alongside a secret always matches a usable key.
+func validatePubKey(pk *PubKey) error {
+	if pk.ID == "" {
+		return fmt.Errorf("public key is missing a key ID")
+	}
+	decoded, err := base64.StdEncoding.DecodeString(pk.Key)
+	if err != nil {
+		return fmt.Errorf("failed to decode public key: %w", err)
+	}
+	if len(decoded) != 32 {
+		return fmt.Errorf("public key %s has invalid length %d", pk.ID, len(decoded))
+	}
+	return nil
+}
+
 func setRun(opts *SetOptions) error {
 	secrets, err := getSecretsFromOptions(opts)
 	if err != nil {
@@ -210,21 +231,29 @@ func setRun(opts *SetOptions) error {
 		return fmt.Errorf("%s secrets are not supported for %s", secretEntity, secretApp)
 	}
 
+	// Public keys change rarely, so fetch them through a cached client: repeat
+	// invocations within the TTL reuse the key instead of re-fetching it per secret.
+	keyClient := api.NewClientFromHTTP(api.NewCachedHTTPClient(c, publicKeyCacheTTL))
+
 	var pk *PubKey
 	switch secretEntity {
 	case shared.Organization:
-		pk, err = getOrgPublicKey(client, host, orgName, secretApp)
+		pk, err = getOrgPublicKey(keyClient, host, orgName, secretApp)
 	case shared.Environment:
-		pk, err = getEnvPubKey(client, baseRepo, envName)
+		pk, err = getEnvPubKey(keyClient, baseRepo, envName)
 	case shared.User:
-		pk, err = getUserPublicKey(client, host)
+		pk, err = getUserPublicKey(keyClient, host)
 	default:
-		pk, err = getRepoPubKey(client, baseRepo, secretApp)
+		pk, err = getRepoPubKey(keyClient, baseRepo, secretApp)
 	}
 	if err != nil {
 		return fmt.Errorf("failed to fetch public key: %w", err)
 	}
 
+	if err := validatePubKey(pk); err != nil {
+		return err
+	}
+
 	type repoNamesResult struct {
 		ids []int64
 		err error
@@ -313,6 +342,13 @@ func setSecret(opts *SetOptions, pk *PubKey, host string, client *api.Client, ba
 		return
 	}
 
+	// Sanity-check the sealed box before sending: a well-formed anonymous box
+	// is exactly the plaintext plus the fixed overhead.
+	if len(eBody) != len(secret)+box.AnonymousOverhead {
+		res.err = fmt.Errorf("failed to encrypt body: sealed box has unexpected length %d", len(eBody))
+		return
+	}
+
 	encoded := base64.StdEncoding.EncodeToString(eBody)
 	if opts.DoNotStore {
 		res.encrypted = encoded
@@ -703,3 +703,42 @@ func Test_getSecretsFromOptions(t *testing.T) {
 func fakeRandom() io.Reader {
 	return bytes.NewReader(bytes.Repeat([]byte{5}, 32))
 }
+
+func Test_validatePubKey(t *testing.T) {
+	tests := []struct {
+		name    string
+		pk      PubKey
+		wantErr string
+	}{
+		{
+			name: "valid key",
+			pk:   PubKey{ID: "123", Key: "CDjXqf7AJBXWhMczcy+Fs7JlACEptgceysutztHaFQI="},
+		},
+		{
+			name:    "missing key ID",
+			pk:      PubKey{Key: "CDjXqf7AJBXWhMczcy+Fs7JlACEptgceysutztHaFQI="},
+			wantErr: "public key is missing a key ID",
+		},
+		{
+			name:    "not base64",
+			pk:      PubKey{ID: "123", Key: "not base64!"},
+			wantErr: "failed to decode public key: illegal base64 data at input byte 3",
+		},
+		{
+			name:    "wrong length",
+			pk:      PubKey{ID: "123", Key: "c2hvcnQ="},
+			wantErr: "public key 123 has invalid length 5",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validatePubKey(&tt.pk)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,106 @@
+package team
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/gh"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type createOptions struct {
+	HttpClient func() (*http.Client, error)
+	Config     func() (gh.Config, error)
+	IO         *iostreams.IOStreams
+
+	Org         string
+	Name        string
+	Description string
+	Privacy     string
+	ParentSlug  string
+}
+
+func newCmdCreate(f *cmdutil.Factory, runF func(*createOptions) error) *cobra.Command {
+	opts := createOptions{
+		HttpClient: f.HttpClient,
+		Config:     f.Config,
+		IO:         f.IOStreams,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "create <name>",
+		Short: "Create a new team",
+		Example: heredoc.Doc(`
+			$ gh team create Engineering --org myorg
+			$ gh team create "Platform Team" --org myorg --privacy closed --parent engineering
+		`),
+		Args: cmdutil.ExactArgs(1, "cannot create team: name argument required"),
+		RunE: func(c *cobra.Command, args []string) error {
+			opts.Name = args[0]
+
+			if err := requireOrg(opts.Org); err != nil {
+				return err
+			}
+
+			if runF != nil {
+				return runF(&opts)
+			}
+			return createRun(&opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Org, "org", "o", "", "Organization to create the team in")
+	cmd.Flags().StringVarP(&opts.Description, "description", "d", "", "Description of the team")
+	cmdutil.StringEnumFlag(cmd, &opts.Privacy, "privacy", "", "closed", []string{"secret", "closed"}, "Level of privacy for the team")
+	cmd.Flags().StringVar(&opts.ParentSlug, "parent", "", "Slug of the parent team")
+
+	return cmd
+}
+
+func createRun(opts *createOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+	host, _ := cfg.Authentication().DefaultHost()
+
+	params := map[string]interface{}{
+		"name":    opts.Name,
+		"privacy": opts.Privacy,
+	}
+	if opts.Description != "" {
+		params["description"] = opts.Description
+	}
+	if opts.ParentSlug != "" {
+		parent, err := getTeam(httpClient, host, opts.Org, opts.ParentSlug)
+		if err != nil {
+			return fmt.Errorf("could not resolve parent team %q: %w", opts.ParentSlug, err)
+		}
+		params["parent_team_id"] = parent.ID
+	}
+
+	opts.IO.StartProgressIndicator()
+	t, err := createTeam(httpClient, host, opts.Org, params)
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		return err
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		cs := opts.IO.ColorScheme()
+		fmt.Fprintf(opts.IO.Out, "%s Created team %s in %s\n", cs.SuccessIcon(), cs.Bold(t.Slug), opts.Org)
+	}
+	if t.URL != "" {
+		fmt.Fprintln(opts.IO.Out, t.URL)
+	}
+
+	return nil
+}
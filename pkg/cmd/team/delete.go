@@ -0,0 +1,99 @@
+package team
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/gh"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type iprompter interface {
+	ConfirmDeletion(string) error
+}
+
+type deleteOptions struct {
+	HttpClient func() (*http.Client, error)
+	Config     func() (gh.Config, error)
+	IO         *iostreams.IOStreams
+	Prompter   iprompter
+
+	Org       string
+	Slug      string
+	Confirmed bool
+}
+
+func newCmdDelete(f *cmdutil.Factory, runF func(*deleteOptions) error) *cobra.Command {
+	opts := deleteOptions{
+		HttpClient: f.HttpClient,
+		Config:     f.Config,
+		IO:         f.IOStreams,
+		Prompter:   f.Prompter,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "delete <slug>",
+		Short: "Delete a team",
+		Example: heredoc.Doc(`
+			$ gh team delete engineering --org myorg --yes
+		`),
+		Args: cmdutil.ExactArgs(1, "cannot delete team: slug argument required"),
+		RunE: func(c *cobra.Command, args []string) error {
+			opts.Slug = args[0]
+
+			if err := requireOrg(opts.Org); err != nil {
+				return err
+			}
+
+			if !opts.IO.CanPrompt() && !opts.Confirmed {
+				return cmdutil.FlagErrorf("--yes required when not running interactively")
+			}
+
+			if runF != nil {
+				return runF(&opts)
+			}
+			return deleteRun(&opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Org, "org", "o", "", "Organization the team belongs to")
+	cmd.Flags().BoolVar(&opts.Confirmed, "yes", false, "Confirm deletion without prompting")
+
+	return cmd
+}
+
+func deleteRun(opts *deleteOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+	host, _ := cfg.Authentication().DefaultHost()
+
+	if !opts.Confirmed {
+		if err := opts.Prompter.ConfirmDeletion(opts.Slug); err != nil {
+			return err
+		}
+	}
+
+	opts.IO.StartProgressIndicator()
+	err = deleteTeam(httpClient, host, opts.Org, opts.Slug)
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		return err
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		cs := opts.IO.ColorScheme()
+		fmt.Fprintf(opts.IO.Out, "%s Deleted team %s from %s\n", cs.SuccessIcon(), opts.Slug, opts.Org)
+	}
+
+	return nil
+}
@@ -0,0 +1,123 @@
+package team
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/gh"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type editOptions struct {
+	HttpClient func() (*http.Client, error)
+	Config     func() (gh.Config, error)
+	IO         *iostreams.IOStreams
+
+	Org         string
+	Slug        string
+	Name        string
+	Description string
+	Privacy     string
+	ParentSlug  string
+
+	flagsChanged map[string]bool
+}
+
+func newCmdEdit(f *cmdutil.Factory, runF func(*editOptions) error) *cobra.Command {
+	opts := editOptions{
+		HttpClient: f.HttpClient,
+		Config:     f.Config,
+		IO:         f.IOStreams,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "edit <slug>",
+		Short: "Edit a team",
+		Example: heredoc.Doc(`
+			$ gh team edit engineering --org myorg --description "Builds the product"
+			$ gh team edit engineering --org myorg --privacy secret
+		`),
+		Args: cmdutil.ExactArgs(1, "cannot edit team: slug argument required"),
+		RunE: func(c *cobra.Command, args []string) error {
+			opts.Slug = args[0]
+
+			if err := requireOrg(opts.Org); err != nil {
+				return err
+			}
+
+			opts.flagsChanged = map[string]bool{}
+			for _, name := range []string{"name", "description", "privacy", "parent"} {
+				opts.flagsChanged[name] = c.Flags().Changed(name)
+			}
+			if !opts.flagsChanged["name"] && !opts.flagsChanged["description"] &&
+				!opts.flagsChanged["privacy"] && !opts.flagsChanged["parent"] {
+				return cmdutil.FlagErrorf("specify at least one field to edit")
+			}
+
+			if runF != nil {
+				return runF(&opts)
+			}
+			return editRun(&opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Org, "org", "o", "", "Organization the team belongs to")
+	cmd.Flags().StringVarP(&opts.Name, "name", "n", "", "New name for the team")
+	cmd.Flags().StringVarP(&opts.Description, "description", "d", "", "New description for the team")
+	cmdutil.StringEnumFlag(cmd, &opts.Privacy, "privacy", "", "", []string{"secret", "closed"}, "New privacy level for the team")
+	cmd.Flags().StringVar(&opts.ParentSlug, "parent", "", "Slug of the new parent team (empty to remove)")
+
+	return cmd
+}
+
+func editRun(opts *editOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+	host, _ := cfg.Authentication().DefaultHost()
+
+	params := map[string]interface{}{}
+	if opts.flagsChanged["name"] {
+		params["name"] = opts.Name
+	}
+	if opts.flagsChanged["description"] {
+		params["description"] = opts.Description
+	}
+	if opts.flagsChanged["privacy"] {
+		params["privacy"] = opts.Privacy
+	}
+	if opts.flagsChanged["parent"] {
+		if opts.ParentSlug == "" {
+			params["parent_team_id"] = nil
+		} else {
+			parent, err := getTeam(httpClient, host, opts.Org, opts.ParentSlug)
+			if err != nil {
+				return fmt.Errorf("could not resolve parent team %q: %w", opts.ParentSlug, err)
+			}
+			params["parent_team_id"] = parent.ID
+		}
+	}
+
+	opts.IO.StartProgressIndicator()
+	t, err := updateTeam(httpClient, host, opts.Org, opts.Slug, params)
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		return err
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		cs := opts.IO.ColorScheme()
+		fmt.Fprintf(opts.IO.Out, "%s Updated team %s in %s\n", cs.SuccessIcon(), cs.Bold(t.Slug), opts.Org)
+	}
+
+	return nil
+}
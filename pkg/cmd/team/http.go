@@ -0,0 +1,165 @@
+package team
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+)
+
+type team struct {
+	ID           int64  `json:"id"`
+	Name         string `json:"name"`
+	Slug         string `json:"slug"`
+	Description  string `json:"description"`
+	Privacy      string `json:"privacy"`
+	Permission   string `json:"permission"`
+	MembersCount int    `json:"members_count"`
+	ReposCount   int    `json:"repos_count"`
+	URL          string `json:"html_url"`
+	Parent       *team  `json:"parent"`
+}
+
+var teamFields = []string{
+	"name",
+	"slug",
+	"description",
+	"privacy",
+	"permission",
+	"membersCount",
+	"reposCount",
+	"url",
+	"parent",
+}
+
+func (t *team) ExportData(fields []string) map[string]interface{} {
+	v := map[string]interface{}{}
+	for _, f := range fields {
+		switch f {
+		case "name":
+			v[f] = t.Name
+		case "slug":
+			v[f] = t.Slug
+		case "description":
+			v[f] = t.Description
+		case "privacy":
+			v[f] = t.Privacy
+		case "permission":
+			v[f] = t.Permission
+		case "membersCount":
+			v[f] = t.MembersCount
+		case "reposCount":
+			v[f] = t.ReposCount
+		case "url":
+			v[f] = t.URL
+		case "parent":
+			if t.Parent != nil {
+				v[f] = t.Parent.Slug
+			} else {
+				v[f] = nil
+			}
+		}
+	}
+	return v
+}
+
+func listTeams(client *http.Client, host, org string, limit int) ([]team, error) {
+	apiClient := api.NewClientFromHTTP(client)
+	path := fmt.Sprintf("orgs/%s/teams?per_page=100", url.PathEscape(org))
+
+	var results []team
+	for path != "" && len(results) < limit {
+		var page []team
+		var err error
+		path, err = apiClient.RESTWithNext(host, "GET", path, nil, &page)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, page...)
+	}
+
+	if len(results) > limit {
+		results = results[:limit]
+	}
+	return results, nil
+}
+
+func getTeam(client *http.Client, host, org, slug string) (*team, error) {
+	apiClient := api.NewClientFromHTTP(client)
+	path := fmt.Sprintf("orgs/%s/teams/%s", url.PathEscape(org), url.PathEscape(slug))
+
+	var result team
+	if err := apiClient.REST(host, "GET", path, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func createTeam(client *http.Client, host, org string, params map[string]interface{}) (*team, error) {
+	body := &bytes.Buffer{}
+	if err := json.NewEncoder(body).Encode(params); err != nil {
+		return nil, err
+	}
+
+	apiClient := api.NewClientFromHTTP(client)
+	path := fmt.Sprintf("orgs/%s/teams", url.PathEscape(org))
+
+	var result team
+	if err := apiClient.REST(host, "POST", path, body, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func updateTeam(client *http.Client, host, org, slug string, params map[string]interface{}) (*team, error) {
+	body := &bytes.Buffer{}
+	if err := json.NewEncoder(body).Encode(params); err != nil {
+		return nil, err
+	}
+
+	apiClient := api.NewClientFromHTTP(client)
+	path := fmt.Sprintf("orgs/%s/teams/%s", url.PathEscape(org), url.PathEscape(slug))
+
+	var result team
+	if err := apiClient.REST(host, "PATCH", path, body, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func deleteTeam(client *http.Client, host, org, slug string) error {
+	apiClient := api.NewClientFromHTTP(client)
+	path := fmt.Sprintf("orgs/%s/teams/%s", url.PathEscape(org), url.PathEscape(slug))
+	return apiClient.REST(host, "DELETE", path, nil, nil)
+}
+
+func addTeamRepo(client *http.Client, host, org, slug, owner, repo, permission string) error {
+	body := &bytes.Buffer{}
+	if err := json.NewEncoder(body).Encode(map[string]string{"permission": permission}); err != nil {
+		return err
+	}
+
+	apiClient := api.NewClientFromHTTP(client)
+	path := fmt.Sprintf("orgs/%s/teams/%s/repos/%s/%s",
+		url.PathEscape(org), url.PathEscape(slug), url.PathEscape(owner), url.PathEscape(repo))
+	return apiClient.REST(host, "PUT", path, body, nil)
+}
+
+func removeTeamRepo(client *http.Client, host, org, slug, owner, repo string) error {
+	apiClient := api.NewClientFromHTTP(client)
+	path := fmt.Sprintf("orgs/%s/teams/%s/repos/%s/%s",
+		url.PathEscape(org), url.PathEscape(slug), url.PathEscape(owner), url.PathEscape(repo))
+	return apiClient.REST(host, "DELETE", path, nil, nil)
+}
+
+// requireOrg validates that the --org flag was provided.
+func requireOrg(org string) error {
+	if org == "" {
+		return cmdutil.FlagErrorf("an organization must be specified with `--org`")
+	}
+	return nil
+}
@@ -0,0 +1,99 @@
+package team
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/gh"
+	"github.com/cli/cli/v2/internal/tableprinter"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type listOptions struct {
+	HttpClient func() (*http.Client, error)
+	Config     func() (gh.Config, error)
+	IO         *iostreams.IOStreams
+
+	Org      string
+	Limit    int
+	Exporter cmdutil.Exporter
+}
+
+func newCmdList(f *cmdutil.Factory, runF func(*listOptions) error) *cobra.Command {
+	opts := listOptions{
+		HttpClient: f.HttpClient,
+		Config:     f.Config,
+		IO:         f.IOStreams,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List teams in an organization",
+		Example: heredoc.Doc(`
+			$ gh team list --org myorg
+			$ gh team list --org myorg --json slug,membersCount
+		`),
+		Args:    cobra.NoArgs,
+		Aliases: []string{"ls"},
+		RunE: func(c *cobra.Command, args []string) error {
+			if err := requireOrg(opts.Org); err != nil {
+				return err
+			}
+			if opts.Limit < 1 {
+				return cmdutil.FlagErrorf("invalid limit: %v", opts.Limit)
+			}
+
+			if runF != nil {
+				return runF(&opts)
+			}
+			return listRun(&opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Org, "org", "o", "", "Organization to list teams for")
+	cmd.Flags().IntVarP(&opts.Limit, "limit", "L", 30, "Maximum number of teams to fetch")
+	cmdutil.AddJSONFlags(cmd, &opts.Exporter, teamFields)
+
+	return cmd
+}
+
+func listRun(opts *listOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+	host, _ := cfg.Authentication().DefaultHost()
+
+	opts.IO.StartProgressIndicator()
+	teams, err := listTeams(httpClient, host, opts.Org, opts.Limit)
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		return err
+	}
+
+	if opts.Exporter != nil {
+		return opts.Exporter.Write(opts.IO, teams)
+	}
+
+	if len(teams) == 0 {
+		return cmdutil.NewNoResultsError(fmt.Sprintf("no teams found in %s", opts.Org))
+	}
+
+	table := tableprinter.New(opts.IO, tableprinter.WithHeader("NAME", "SLUG", "PRIVACY", "DESCRIPTION"))
+	for _, t := range teams {
+		table.AddField(t.Name)
+		table.AddField(t.Slug)
+		table.AddField(t.Privacy)
+		table.AddField(t.Description)
+		table.EndRow()
+	}
+	return table.Render()
+}
@@ -0,0 +1,104 @@
+package team
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/internal/gh"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCmdList(t *testing.T) {
+	tests := []struct {
+		name     string
+		args     string
+		wants    listOptions
+		wantsErr string
+	}{
+		{
+			name: "org and limit",
+			args: "--org myorg --limit 10",
+			wants: listOptions{
+				Org:   "myorg",
+				Limit: 10,
+			},
+		},
+		{
+			name:     "missing org",
+			args:     "",
+			wantsErr: "an organization must be specified with `--org`",
+		},
+		{
+			name:     "invalid limit",
+			args:     "--org myorg --limit 0",
+			wantsErr: "invalid limit: 0",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ios, _, _, _ := iostreams.Test()
+			f := &cmdutil.Factory{
+				IOStreams: ios,
+			}
+
+			argv, err := shlex.Split(tt.args)
+			require.NoError(t, err)
+
+			var gotOpts *listOptions
+			cmd := newCmdList(f, func(opts *listOptions) error {
+				gotOpts = opts
+				return nil
+			})
+			cmd.SetArgs(argv)
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+
+			_, err = cmd.ExecuteC()
+			if tt.wantsErr != "" {
+				assert.EqualError(t, err, tt.wantsErr)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.wants.Org, gotOpts.Org)
+			assert.Equal(t, tt.wants.Limit, gotOpts.Limit)
+		})
+	}
+}
+
+func TestListRun(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("GET", "orgs/myorg/teams"),
+		httpmock.StringResponse(`[
+			{"name": "Engineering", "slug": "engineering", "privacy": "closed", "description": "Builds the product"},
+			{"name": "Ops", "slug": "ops", "privacy": "secret", "description": ""}
+		]`))
+
+	ios, _, stdout, _ := iostreams.Test()
+	opts := &listOptions{
+		IO: ios,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		Config: func() (gh.Config, error) {
+			return config.NewBlankConfig(), nil
+		},
+		Org:   "myorg",
+		Limit: 30,
+	}
+
+	err := listRun(opts)
+	require.NoError(t, err)
+	assert.Equal(t, "Engineering\tengineering\tclosed\tBuilds the product\nOps\tops\tsecret\t\n", stdout.String())
+}
@@ -0,0 +1,255 @@
+package team
+
+import (
+	"encoding/csv"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/gh"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+var repoPermissions = []string{"pull", "triage", "push", "maintain", "admin"}
+
+type reposOptions struct {
+	HttpClient func() (*http.Client, error)
+	Config     func() (gh.Config, error)
+	IO         *iostreams.IOStreams
+
+	Org        string
+	Slug       string
+	Repos      []string
+	Permission string
+	File       string
+	Remove     bool
+}
+
+// teamRepo is a single repository to grant to or revoke from a team,
+// optionally with a per-repository permission from a CSV input file.
+type teamRepo struct {
+	owner      string
+	name       string
+	permission string
+}
+
+func newCmdRepos(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "repos <command>",
+		Short: "Manage the repositories a team has access to",
+	}
+
+	cmd.AddCommand(newCmdReposAdd(f, nil))
+	cmd.AddCommand(newCmdReposRemove(f, nil))
+
+	return cmd
+}
+
+func newCmdReposAdd(f *cmdutil.Factory, runF func(*reposOptions) error) *cobra.Command {
+	opts := reposOptions{
+		HttpClient: f.HttpClient,
+		Config:     f.Config,
+		IO:         f.IOStreams,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "add <slug> [<repository>...]",
+		Short: "Grant a team access to repositories",
+		Long: heredoc.Docf(`
+			Grant a team access to one or more repositories with a given permission.
+
+			Repositories may be listed as arguments, or supplied in bulk with %[1]s--file%[1]s.
+			Each line of the CSV file names a repository and, optionally, a permission
+			that overrides %[1]s--permission%[1]s for that repository:
+
+				myorg/api,push
+				myorg/docs,pull
+				myorg/infra
+		`, "`"),
+		Example: heredoc.Doc(`
+			$ gh team repos add engineering myorg/api myorg/docs --org myorg --permission push
+			$ gh team repos add engineering --org myorg --file repos.csv
+		`),
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(c *cobra.Command, args []string) error {
+			opts.Slug = args[0]
+			opts.Repos = args[1:]
+
+			if err := requireOrg(opts.Org); err != nil {
+				return err
+			}
+			if len(opts.Repos) == 0 && opts.File == "" {
+				return cmdutil.FlagErrorf("specify repositories as arguments or with `--file`")
+			}
+
+			if runF != nil {
+				return runF(&opts)
+			}
+			return reposRun(&opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Org, "org", "o", "", "Organization the team belongs to")
+	cmdutil.StringEnumFlag(cmd, &opts.Permission, "permission", "p", "pull", repoPermissions, "Permission to grant")
+	cmd.Flags().StringVarP(&opts.File, "file", "f", "", "Read repositories from a CSV `file` (use \"-\" for standard input)")
+
+	return cmd
+}
+
+func newCmdReposRemove(f *cmdutil.Factory, runF func(*reposOptions) error) *cobra.Command {
+	opts := reposOptions{
+		HttpClient: f.HttpClient,
+		Config:     f.Config,
+		IO:         f.IOStreams,
+		Remove:     true,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "remove <slug> [<repository>...]",
+		Short: "Revoke a team's access to repositories",
+		Example: heredoc.Doc(`
+			$ gh team repos remove engineering myorg/api --org myorg
+			$ gh team repos remove engineering --org myorg --file repos.csv
+		`),
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(c *cobra.Command, args []string) error {
+			opts.Slug = args[0]
+			opts.Repos = args[1:]
+
+			if err := requireOrg(opts.Org); err != nil {
+				return err
+			}
+			if len(opts.Repos) == 0 && opts.File == "" {
+				return cmdutil.FlagErrorf("specify repositories as arguments or with `--file`")
+			}
+
+			if runF != nil {
+				return runF(&opts)
+			}
+			return reposRun(&opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Org, "org", "o", "", "Organization the team belongs to")
+	cmd.Flags().StringVarP(&opts.File, "file", "f", "", "Read repositories from a CSV `file` (use \"-\" for standard input)")
+
+	return cmd
+}
+
+func reposRun(opts *reposOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+	host, _ := cfg.Authentication().DefaultHost()
+
+	repos, err := resolveTeamRepos(opts)
+	if err != nil {
+		return err
+	}
+
+	opts.IO.StartProgressIndicator()
+	for _, r := range repos {
+		if opts.Remove {
+			err = removeTeamRepo(httpClient, host, opts.Org, opts.Slug, r.owner, r.name)
+		} else {
+			err = addTeamRepo(httpClient, host, opts.Org, opts.Slug, r.owner, r.name, r.permission)
+		}
+		if err != nil {
+			opts.IO.StopProgressIndicator()
+			return fmt.Errorf("failed to update %s/%s: %w", r.owner, r.name, err)
+		}
+	}
+	opts.IO.StopProgressIndicator()
+
+	if opts.IO.IsStdoutTTY() {
+		cs := opts.IO.ColorScheme()
+		verb := "Granted access to"
+		if opts.Remove {
+			verb = "Revoked access to"
+		}
+		fmt.Fprintf(opts.IO.Out, "%s %s %d repositories for team %s\n", cs.SuccessIcon(), verb, len(repos), opts.Slug)
+	}
+
+	return nil
+}
+
+// resolveTeamRepos combines repositories given as arguments with those read
+// from a CSV file into a single list.
+func resolveTeamRepos(opts *reposOptions) ([]teamRepo, error) {
+	var repos []teamRepo
+
+	for _, arg := range opts.Repos {
+		r, err := parseTeamRepo(arg, opts.Permission, opts.Org)
+		if err != nil {
+			return nil, err
+		}
+		repos = append(repos, r)
+	}
+
+	if opts.File != "" {
+		var reader *csv.Reader
+		if opts.File == "-" {
+			reader = csv.NewReader(opts.IO.In)
+		} else {
+			f, err := os.Open(opts.File)
+			if err != nil {
+				return nil, err
+			}
+			defer f.Close()
+			reader = csv.NewReader(f)
+		}
+		reader.FieldsPerRecord = -1
+
+		records, err := reader.ReadAll()
+		if err != nil {
+			return nil, fmt.Errorf("could not parse CSV input: %w", err)
+		}
+		for _, record := range records {
+			permission := opts.Permission
+			if len(record) > 1 && record[1] != "" {
+				permission = record[1]
+				if !isValidPermission(permission) {
+					return nil, fmt.Errorf("invalid permission %q for %s: valid values are {%s}", permission, record[0], strings.Join(repoPermissions, "|"))
+				}
+			}
+			r, err := parseTeamRepo(record[0], permission, opts.Org)
+			if err != nil {
+				return nil, err
+			}
+			repos = append(repos, r)
+		}
+	}
+
+	return repos, nil
+}
+
+func parseTeamRepo(name, permission, defaultOwner string) (teamRepo, error) {
+	r := teamRepo{owner: defaultOwner, name: name, permission: permission}
+	if owner, rest, found := strings.Cut(name, "/"); found {
+		r.owner = owner
+		r.name = rest
+	}
+	if r.name == "" {
+		return teamRepo{}, fmt.Errorf("invalid repository name %q", name)
+	}
+	return r, nil
+}
+
+func isValidPermission(permission string) bool {
+	for _, p := range repoPermissions {
+		if p == permission {
+			return true
+		}
+	}
+	return false
+}
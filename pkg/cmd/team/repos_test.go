@@ -0,0 +1,69 @@
+package team
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveTeamRepos(t *testing.T) {
+	csvFile := filepath.Join(t.TempDir(), "repos.csv")
+	require.NoError(t, os.WriteFile(csvFile, []byte("myorg/api,push\nmyorg/docs,pull\ninfra\n"), 0600))
+
+	tests := []struct {
+		name     string
+		opts     reposOptions
+		want     []teamRepo
+		wantsErr string
+	}{
+		{
+			name: "arguments only",
+			opts: reposOptions{
+				Org:        "myorg",
+				Repos:      []string{"myorg/api", "docs"},
+				Permission: "push",
+			},
+			want: []teamRepo{
+				{owner: "myorg", name: "api", permission: "push"},
+				{owner: "myorg", name: "docs", permission: "push"},
+			},
+		},
+		{
+			name: "CSV file with per-repository permissions",
+			opts: reposOptions{
+				Org:        "myorg",
+				Permission: "triage",
+				File:       csvFile,
+			},
+			want: []teamRepo{
+				{owner: "myorg", name: "api", permission: "push"},
+				{owner: "myorg", name: "docs", permission: "pull"},
+				{owner: "myorg", name: "infra", permission: "triage"},
+			},
+		},
+		{
+			name: "invalid repository name",
+			opts: reposOptions{
+				Org:        "myorg",
+				Repos:      []string{"myorg/"},
+				Permission: "pull",
+			},
+			wantsErr: `invalid repository name "myorg/"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := resolveTeamRepos(&tt.opts)
+			if tt.wantsErr != "" {
+				assert.EqualError(t, err, tt.wantsErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,23 @@
+package team
+
+import (
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+func NewCmdTeam(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "team <command>",
+		Short: "Manage teams",
+		Long:  `Work with GitHub teams in an organization.`,
+	}
+
+	cmd.AddCommand(newCmdList(f, nil))
+	cmd.AddCommand(newCmdView(f, nil))
+	cmd.AddCommand(newCmdCreate(f, nil))
+	cmd.AddCommand(newCmdEdit(f, nil))
+	cmd.AddCommand(newCmdDelete(f, nil))
+	cmd.AddCommand(newCmdRepos(f))
+
+	return cmd
+}
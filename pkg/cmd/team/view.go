@@ -0,0 +1,102 @@
+package team
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/gh"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type viewOptions struct {
+	HttpClient func() (*http.Client, error)
+	Config     func() (gh.Config, error)
+	IO         *iostreams.IOStreams
+
+	Org      string
+	Slug     string
+	Exporter cmdutil.Exporter
+}
+
+func newCmdView(f *cmdutil.Factory, runF func(*viewOptions) error) *cobra.Command {
+	opts := viewOptions{
+		HttpClient: f.HttpClient,
+		Config:     f.Config,
+		IO:         f.IOStreams,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "view <slug>",
+		Short: "View a team",
+		Example: heredoc.Doc(`
+			$ gh team view engineering --org myorg
+		`),
+		Args: cmdutil.ExactArgs(1, "cannot view team: slug argument required"),
+		RunE: func(c *cobra.Command, args []string) error {
+			opts.Slug = args[0]
+
+			if err := requireOrg(opts.Org); err != nil {
+				return err
+			}
+
+			if runF != nil {
+				return runF(&opts)
+			}
+			return viewRun(&opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Org, "org", "o", "", "Organization the team belongs to")
+	cmdutil.AddJSONFlags(cmd, &opts.Exporter, teamFields)
+
+	return cmd
+}
+
+func viewRun(opts *viewOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+	host, _ := cfg.Authentication().DefaultHost()
+
+	opts.IO.StartProgressIndicator()
+	t, err := getTeam(httpClient, host, opts.Org, opts.Slug)
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		return err
+	}
+
+	if opts.Exporter != nil {
+		return opts.Exporter.Write(opts.IO, t)
+	}
+
+	cs := opts.IO.ColorScheme()
+	out := opts.IO.Out
+
+	fmt.Fprintf(out, "%s\n", cs.Bold(t.Name))
+	if t.Description != "" {
+		fmt.Fprintf(out, "%s\n", t.Description)
+	}
+	fmt.Fprintln(out)
+	fmt.Fprintf(out, "Slug: %s\n", t.Slug)
+	fmt.Fprintf(out, "Privacy: %s\n", t.Privacy)
+	if t.Parent != nil {
+		fmt.Fprintf(out, "Parent: %s\n", t.Parent.Slug)
+	}
+	fmt.Fprintf(out, "Members: %d\n", t.MembersCount)
+	fmt.Fprintf(out, "Repositories: %d\n", t.ReposCount)
+	if opts.IO.IsStdoutTTY() && t.URL != "" {
+		fmt.Fprintln(out)
+		fmt.Fprintf(out, "%s\n", cs.Gray(fmt.Sprintf("View this team on GitHub: %s", t.URL)))
+	}
+
+	return nil
+}
@@ -10,10 +10,12 @@ import (
 	"reflect"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/MakeNowJust/heredoc"
 	"github.com/cli/cli/v2/api"
 	"github.com/cli/cli/v2/internal/ghrepo"
+	runShared "github.com/cli/cli/v2/pkg/cmd/run/shared"
 	"github.com/cli/cli/v2/pkg/cmd/workflow/shared"
 	"github.com/cli/cli/v2/pkg/cmdutil"
 	"github.com/cli/cli/v2/pkg/iostreams"
@@ -21,6 +23,14 @@ import (
 	"gopkg.in/yaml.v3"
 )
 
+const (
+	// how often to poll the watched run for completion
+	watchInterval = 3 * time.Second
+	// how often and for how long to poll for the dispatched run to appear
+	resolvePollInterval = 2 * time.Second
+	resolveTimeout      = 30 * time.Second
+)
+
 type RunOptions struct {
 	HttpClient func() (*http.Client, error)
 	IO         *iostreams.IOStreams
@@ -35,6 +45,10 @@ type RunOptions struct {
 	MagicFields []string
 	RawFields   []string
 
+	Watch          bool
+	ExitStatus     bool
+	CaptureOutputs bool
+
 	Prompt bool
 }
 
@@ -64,6 +78,12 @@ func NewCmdRun(f *cmdutil.Factory, runF func(*RunOptions) error) *cobra.Command
 			- Interactively
 			- Via %[1]s-f/--raw-field%[1]s or %[1]s-F/--field%[1]s flags
 			- As JSON, via standard input
+
+			With %[1]s--watch%[1]s, wait for the dispatched run to appear, then follow it until
+			it completes. Because the dispatch event does not report the run it creates, the
+			run is found by polling the workflow's runs for one that did not exist before the
+			dispatch. Combine with %[1]s--exit-status%[1]s to exit non-zero if the run fails, and
+			with %[1]s--capture-outputs%[1]s to print the completed run's job outputs as JSON.
 		`, "`"),
 		Example: heredoc.Doc(`
 			# Have gh prompt you for what workflow you'd like to run and interactively collect inputs
@@ -80,6 +100,9 @@ func NewCmdRun(f *cmdutil.Factory, runF func(*RunOptions) error) *cobra.Command
 
 			# Run the workflow file 'triage.yml' with JSON via standard input
 			$ echo '{"name":"scully", "greeting":"hello"}' | gh workflow run triage.yml --json
+
+			# Run the workflow file 'release.yml' and follow the dispatched run to completion
+			$ gh workflow run release.yml --watch --exit-status --capture-outputs
 		`),
 		Args: func(cmd *cobra.Command, args []string) error {
 			if len(opts.MagicFields)+len(opts.RawFields) > 0 && len(args) == 0 {
@@ -121,6 +144,15 @@ func NewCmdRun(f *cmdutil.Factory, runF func(*RunOptions) error) *cobra.Command
 				}
 			}
 
+			if !opts.Watch {
+				if opts.ExitStatus {
+					return cmdutil.FlagErrorf("cannot use `--exit-status` without `--watch`")
+				}
+				if opts.CaptureOutputs {
+					return cmdutil.FlagErrorf("cannot use `--capture-outputs` without `--watch`")
+				}
+			}
+
 			if runF != nil {
 				return runF(opts)
 			}
@@ -132,6 +164,9 @@ func NewCmdRun(f *cmdutil.Factory, runF func(*RunOptions) error) *cobra.Command
 	cmd.Flags().StringArrayVarP(&opts.MagicFields, "field", "F", nil, "Add a string parameter in `key=value` format, respecting @ syntax (see \"gh help api\").")
 	cmd.Flags().StringArrayVarP(&opts.RawFields, "raw-field", "f", nil, "Add a string parameter in `key=value` format")
 	cmd.Flags().BoolVar(&opts.JSON, "json", false, "Read workflow inputs as JSON via STDIN")
+	cmd.Flags().BoolVar(&opts.Watch, "watch", false, "Watch the dispatched run until it completes")
+	cmd.Flags().BoolVar(&opts.ExitStatus, "exit-status", false, "Exit with non-zero status if the watched run fails")
+	cmd.Flags().BoolVar(&opts.CaptureOutputs, "capture-outputs", false, "Print the watched run's job outputs as JSON once it completes")
 
 	_ = cmdutil.RegisterBranchCompletionFlags(f.GitClient, cmd, "ref")
 
@@ -316,6 +351,16 @@ func runRun(opts *RunOptions) error {
 
 	body := bytes.NewReader(requestByte)
 
+	// snapshot the workflow's known runs so the run created by the dispatch
+	// can be told apart from pre-existing ones
+	var knownRunIDs map[int64]struct{}
+	if opts.Watch {
+		knownRunIDs, err = workflowRunIDs(client, repo, workflow, ref)
+		if err != nil {
+			return err
+		}
+	}
+
 	err = client.REST(repo.RepoHost(), "POST", path, body, nil)
 	if err != nil {
 		return fmt.Errorf("could not create workflow dispatch event: %w", err)
@@ -329,13 +374,161 @@ func runRun(opts *RunOptions) error {
 
 		fmt.Fprintln(out)
 
-		fmt.Fprintf(out, "To see runs for this workflow, try: %s\n",
-			cs.Boldf("gh run list --workflow=%s", workflow.Base()))
+		if !opts.Watch {
+			fmt.Fprintf(out, "To see runs for this workflow, try: %s\n",
+				cs.Boldf("gh run list --workflow=%s", workflow.Base()))
+		}
+	}
+
+	if !opts.Watch {
+		return nil
+	}
+
+	run, err := resolveDispatchedRun(client, repo, workflow, ref, knownRunIDs)
+	if err != nil {
+		return err
+	}
+
+	return watchDispatchedRun(opts, client, repo, run)
+}
+
+// workflowRunIDs returns the IDs of the workflow's existing dispatch runs on
+// the given ref.
+func workflowRunIDs(client *api.Client, repo ghrepo.Interface, workflow *shared.Workflow, ref string) (map[int64]struct{}, error) {
+	runs, err := runShared.GetRuns(client, repo, &runShared.FilterOptions{
+		WorkflowID:   workflow.ID,
+		WorkflowName: workflow.Name,
+		Branch:       ref,
+		Event:        "workflow_dispatch",
+	}, 100)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list workflow runs: %w", err)
+	}
+
+	ids := make(map[int64]struct{}, len(runs.WorkflowRuns))
+	for _, run := range runs.WorkflowRuns {
+		ids[run.ID] = struct{}{}
+	}
+	return ids, nil
+}
+
+// resolveDispatchedRun polls the workflow's runs until one appears that was
+// not known before the dispatch event was created. The dispatches endpoint
+// does not report the run it creates, so polling is the only way to find it.
+func resolveDispatchedRun(client *api.Client, repo ghrepo.Interface, workflow *shared.Workflow, ref string, knownRunIDs map[int64]struct{}) (*runShared.Run, error) {
+	deadline := time.Now().Add(resolveTimeout)
+	for {
+		runs, err := runShared.GetRuns(client, repo, &runShared.FilterOptions{
+			WorkflowID:   workflow.ID,
+			WorkflowName: workflow.Name,
+			Branch:       ref,
+			Event:        "workflow_dispatch",
+		}, 10)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list workflow runs: %w", err)
+		}
+
+		for i := range runs.WorkflowRuns {
+			if _, known := knownRunIDs[runs.WorkflowRuns[i].ID]; !known {
+				return &runs.WorkflowRuns[i], nil
+			}
+		}
+
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("timed out waiting for the dispatched run of %s to appear; try `gh run list --workflow=%s`", workflow.Base(), workflow.Base())
+		}
+		time.Sleep(resolvePollInterval)
+	}
+}
+
+func watchDispatchedRun(opts *RunOptions, client *api.Client, repo ghrepo.Interface, run *runShared.Run) error {
+	out := opts.IO.Out
+	cs := opts.IO.ColorScheme()
+
+	if opts.IO.IsStdoutTTY() {
+		fmt.Fprintf(out, "Watching run %s\n", cs.Cyanf("%d", run.ID))
+	}
+
+	var err error
+	for run.Status != runShared.Completed {
+		time.Sleep(watchInterval)
+		run, err = runShared.GetRun(client, repo, fmt.Sprintf("%d", run.ID), 0)
+		if err != nil {
+			return fmt.Errorf("failed to get run: %w", err)
+		}
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		symbol, symbolColor := runShared.Symbol(cs, run.Status, run.Conclusion)
+		fmt.Fprintf(out, "%s Run %s (%s) completed with '%s'\n", symbolColor(symbol), cs.Bold(run.WorkflowName()), cs.Cyanf("%d", run.ID), run.Conclusion)
+	}
+
+	if opts.CaptureOutputs {
+		if err := printRunOutputs(opts, client, repo, run); err != nil {
+			return err
+		}
+	}
+
+	if opts.ExitStatus && run.Conclusion != runShared.Success {
+		return cmdutil.SilentError
 	}
 
 	return nil
 }
 
+type capturedJob struct {
+	Name       string            `json:"name"`
+	Conclusion string            `json:"conclusion"`
+	Outputs    map[string]string `json:"outputs"`
+}
+
+type capturedOutputs struct {
+	RunID      int64             `json:"run_id"`
+	Conclusion string            `json:"conclusion"`
+	Outputs    map[string]string `json:"outputs"`
+	Jobs       []capturedJob     `json:"jobs"`
+}
+
+// printRunOutputs prints the outputs the API recorded for the run and its
+// jobs as a JSON document. Outputs the API does not report are rendered as
+// empty objects.
+func printRunOutputs(opts *RunOptions, client *api.Client, repo ghrepo.Interface, run *runShared.Run) error {
+	var runResponse struct {
+		Outputs map[string]string `json:"outputs"`
+	}
+	path := fmt.Sprintf("repos/%s/actions/runs/%d", ghrepo.FullName(repo), run.ID)
+	if err := client.REST(repo.RepoHost(), "GET", path, nil, &runResponse); err != nil {
+		return fmt.Errorf("failed to get run: %w", err)
+	}
+
+	var jobsResponse struct {
+		Jobs []capturedJob `json:"jobs"`
+	}
+	path = fmt.Sprintf("repos/%s/actions/runs/%d/jobs?per_page=100", ghrepo.FullName(repo), run.ID)
+	if err := client.REST(repo.RepoHost(), "GET", path, nil, &jobsResponse); err != nil {
+		return fmt.Errorf("failed to get jobs: %w", err)
+	}
+
+	captured := capturedOutputs{
+		RunID:      run.ID,
+		Conclusion: string(run.Conclusion),
+		Outputs:    runResponse.Outputs,
+		Jobs:       jobsResponse.Jobs,
+	}
+	if captured.Outputs == nil {
+		captured.Outputs = map[string]string{}
+	}
+	for i := range captured.Jobs {
+		if captured.Jobs[i].Outputs == nil {
+			captured.Jobs[i].Outputs = map[string]string{}
+		}
+	}
+
+	encoder := json.NewEncoder(opts.IO.Out)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(captured)
+}
+
 type WorkflowInput struct {
 	Name        string
 	Required    bool
@@ -10,6 +10,7 @@ import (
 	"os"
 	"testing"
 
+	"github.com/MakeNowJust/heredoc"
 	"github.com/cli/cli/v2/api"
 	"github.com/cli/cli/v2/internal/ghrepo"
 	"github.com/cli/cli/v2/internal/prompter"
@@ -97,6 +98,31 @@ func TestNewCmdRun(t *testing.T) {
 				Selector:  "workflow.yml",
 			},
 		},
+		{
+			name: "watch args",
+			tty:  true,
+			cli:  "workflow.yml --watch --exit-status --capture-outputs",
+			wants: RunOptions{
+				Selector:       "workflow.yml",
+				Watch:          true,
+				ExitStatus:     true,
+				CaptureOutputs: true,
+			},
+		},
+		{
+			name:     "exit-status without watch",
+			tty:      true,
+			cli:      "workflow.yml --exit-status",
+			errMsg:   "cannot use `--exit-status` without `--watch`",
+			wantsErr: true,
+		},
+		{
+			name:     "capture-outputs without watch",
+			tty:      true,
+			cli:      "workflow.yml --capture-outputs",
+			errMsg:   "cannot use `--capture-outputs` without `--watch`",
+			wantsErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -142,6 +168,9 @@ func TestNewCmdRun(t *testing.T) {
 			assert.Equal(t, tt.wants.JSONInput, gotOpts.JSONInput)
 			assert.Equal(t, tt.wants.JSON, gotOpts.JSON)
 			assert.Equal(t, tt.wants.Ref, gotOpts.Ref)
+			assert.Equal(t, tt.wants.Watch, gotOpts.Watch)
+			assert.Equal(t, tt.wants.ExitStatus, gotOpts.ExitStatus)
+			assert.Equal(t, tt.wants.CaptureOutputs, gotOpts.CaptureOutputs)
 			assert.ElementsMatch(t, tt.wants.RawFields, gotOpts.RawFields)
 			assert.ElementsMatch(t, tt.wants.MagicFields, gotOpts.MagicFields)
 		})
@@ -772,6 +801,92 @@ jobs:
 			wantErr: true,
 			errOut:  "workflow input \"name\" is of type choice, but has no options",
 		},
+		{
+			name: "watch with captured outputs",
+			opts: &RunOptions{
+				Selector:       "workflow.yml",
+				Watch:          true,
+				ExitStatus:     true,
+				CaptureOutputs: true,
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/workflows/workflow.yml"),
+					httpmock.JSONResponse(shared.Workflow{
+						Name: "a workflow",
+						Path: ".github/workflows/workflow.yml",
+						ID:   12345,
+					}))
+				// the run snapshot taken before the dispatch
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/workflows/12345/runs"),
+					httpmock.StringResponse(`{"total_count":1,"workflow_runs":[{"id":100,"status":"completed","conclusion":"success"}]}`))
+				reg.Register(
+					httpmock.REST("POST", "repos/OWNER/REPO/actions/workflows/12345/dispatches"),
+					httpmock.StatusStringResponse(204, "cool"))
+				// the dispatched run appears on the first poll
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/workflows/12345/runs"),
+					httpmock.StringResponse(`{"total_count":2,"workflow_runs":[{"id":201,"status":"completed","conclusion":"success"},{"id":100,"status":"completed","conclusion":"success"}]}`))
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/runs/201"),
+					httpmock.StringResponse(`{"id":201,"outputs":{"version":"1.2.3"}}`))
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/runs/201/jobs"),
+					httpmock.StringResponse(`{"jobs":[{"name":"build","conclusion":"success","outputs":{"digest":"sha256:abc"}},{"name":"publish","conclusion":"success"}]}`))
+			},
+			wantOut: heredoc.Doc(`
+				{
+				  "run_id": 201,
+				  "conclusion": "success",
+				  "outputs": {
+				    "version": "1.2.3"
+				  },
+				  "jobs": [
+				    {
+				      "name": "build",
+				      "conclusion": "success",
+				      "outputs": {
+				        "digest": "sha256:abc"
+				      }
+				    },
+				    {
+				      "name": "publish",
+				      "conclusion": "success",
+				      "outputs": {}
+				    }
+				  ]
+				}
+			`),
+		},
+		{
+			name: "watch failed run with exit status",
+			opts: &RunOptions{
+				Selector:   "workflow.yml",
+				Watch:      true,
+				ExitStatus: true,
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/workflows/workflow.yml"),
+					httpmock.JSONResponse(shared.Workflow{
+						Name: "a workflow",
+						Path: ".github/workflows/workflow.yml",
+						ID:   12345,
+					}))
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/workflows/12345/runs"),
+					httpmock.StringResponse(`{"total_count":0,"workflow_runs":[]}`))
+				reg.Register(
+					httpmock.REST("POST", "repos/OWNER/REPO/actions/workflows/12345/dispatches"),
+					httpmock.StatusStringResponse(204, "cool"))
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/workflows/12345/runs"),
+					httpmock.StringResponse(`{"total_count":1,"workflow_runs":[{"id":201,"status":"completed","conclusion":"failure"}]}`))
+			},
+			wantErr: true,
+			errOut:  "SilentError",
+		},
 	}
 
 	for _, tt := range tests {
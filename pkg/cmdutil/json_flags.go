@@ -119,9 +119,70 @@ func checkJSONFlags(cmd *cobra.Command) (*jsonExporter, error) {
 	return nil, nil
 }
 
+// AddFormatAndJSONFlags adds the --json, --jq, and --template flags to a
+// command that historically exposed --format. The --format flag is kept for
+// backwards compatibility, but deprecated in favor of --json.
+func AddFormatAndJSONFlags(cmd *cobra.Command, exportTarget *Exporter, fields []string) {
+	var format string
+	AddFormatAndJSONFlagsWithFormats(cmd, exportTarget, &format, []string{"json"}, fields)
+	_ = cmd.Flags().MarkDeprecated("format", "use `--json` instead")
+}
+
+// AddFormatAndJSONFlagsWithFormats is like AddFormatAndJSONFlags but accepts
+// additional output formats beyond "json". The selected format is written to
+// formatTarget; serializing the non-JSON formats is left to the command.
+func AddFormatAndJSONFlagsWithFormats(cmd *cobra.Command, exportTarget *Exporter, formatTarget *string, formats []string, fields []string) {
+	AddJSONFlags(cmd, exportTarget, fields)
+	StringEnumFlag(cmd, formatTarget, "format", "", "", formats, "Output format")
+
+	oldPreRun := cmd.PreRunE
+	cmd.PreRunE = func(c *cobra.Command, args []string) error {
+		f := c.Flags()
+		if f.Lookup("format").Changed {
+			if f.Lookup("json").Changed {
+				return errors.New("cannot use `--format` with `--json`")
+			}
+			if webFlag := f.Lookup("web"); webFlag != nil && webFlag.Changed {
+				return errors.New("cannot use `--web` with `--format`")
+			}
+			if *formatTarget != "json" {
+				if f.Lookup("jq").Changed {
+					return errors.New("cannot use `--jq` without specifying `--format json`")
+				} else if f.Lookup("template").Changed {
+					return errors.New("cannot use `--template` without specifying `--format json`")
+				}
+				*exportTarget = nil
+				return nil
+			}
+			// legacy JSON output: all fields, with optional --jq and --template
+			*exportTarget = &jsonExporter{
+				filter:   f.Lookup("jq").Value.String(),
+				template: f.Lookup("template").Value.String(),
+			}
+			return nil
+		}
+		if err := oldPreRun(c, args); err != nil {
+			return err
+		}
+		// a --json field selection narrows these fixed-key payloads at the top level
+		if export, ok := (*exportTarget).(*jsonExporter); ok && export != nil {
+			export.narrowTopLevel = true
+		}
+		return nil
+	}
+}
+
 func AddFormatFlags(cmd *cobra.Command, exportTarget *Exporter) {
 	var format string
-	StringEnumFlag(cmd, &format, "format", "", "", []string{"json"}, "Output format")
+	AddFormatFlagsWithFormats(cmd, exportTarget, &format, []string{"json"})
+}
+
+// AddFormatFlagsWithFormats is like AddFormatFlags but accepts additional output
+// formats beyond "json". The selected format is written to formatTarget. The
+// exporter is only populated for JSON output; serializing the other formats is
+// left to the command.
+func AddFormatFlagsWithFormats(cmd *cobra.Command, exportTarget *Exporter, formatTarget *string, formats []string) {
+	StringEnumFlag(cmd, formatTarget, "format", "", "", formats, "Output format")
 	f := cmd.Flags()
 	f.StringP("jq", "q", "", "Filter JSON output using a jq `expression`")
 	f.StringP("template", "t", "", "Format JSON output using a Go template; see \"gh help formatting\"")
@@ -159,11 +220,14 @@ func checkFormatFlags(cmd *cobra.Command) (*jsonExporter, error) {
 		if webFlag != nil && webFlag.Changed {
 			return nil, errors.New("cannot use `--web` with `--format`")
 		}
-		return &jsonExporter{
-			filter:   jqFlag.Value.String(),
-			template: tplFlag.Value.String(),
-		}, nil
-	} else if jqFlag.Changed && formatValue != "json" {
+		if formatValue == "json" {
+			return &jsonExporter{
+				filter:   jqFlag.Value.String(),
+				template: tplFlag.Value.String(),
+			}, nil
+		}
+	}
+	if jqFlag.Changed && formatValue != "json" {
 		return nil, errors.New("cannot use `--jq` without specifying `--format json`")
 	} else if tplFlag.Changed && formatValue != "json" {
 		return nil, errors.New("cannot use `--template` without specifying `--format json`")
@@ -180,6 +244,9 @@ type jsonExporter struct {
 	fields   []string
 	filter   string
 	template string
+	// narrowTopLevel drops unrequested top-level keys from payloads whose
+	// ExportData exports a fixed set of keys regardless of the selection.
+	narrowTopLevel bool
 }
 
 // NewJSONExporter returns an Exporter to emit JSON.
@@ -199,10 +266,24 @@ func (e *jsonExporter) SetFields(fields []string) {
 // or if data is a map or slice of exportable object, ExportData() will be called on each object to obtain
 // raw data for serialization.
 func (e *jsonExporter) Write(ios *iostreams.IOStreams, data interface{}) error {
+	exported := e.exportData(reflect.ValueOf(data))
+	if m, ok := exported.(map[string]interface{}); ok && e.narrowTopLevel && len(e.fields) > 0 {
+		filtered := make(map[string]interface{}, len(e.fields))
+		for k, v := range m {
+			for _, f := range e.fields {
+				if strings.EqualFold(k, f) {
+					filtered[k] = v
+					break
+				}
+			}
+		}
+		exported = filtered
+	}
+
 	buf := bytes.Buffer{}
 	encoder := json.NewEncoder(&buf)
 	encoder.SetEscapeHTML(false)
-	if err := encoder.Encode(e.exportData(reflect.ValueOf(data))); err != nil {
+	if err := encoder.Encode(exported); err != nil {
 		return err
 	}
 
@@ -260,6 +260,104 @@ func TestAddFormatFlags(t *testing.T) {
 	}
 }
 
+func TestAddFormatAndJSONFlags(t *testing.T) {
+	tests := []struct {
+		name        string
+		args        []string
+		wantsExport *jsonExporter
+		wantsError  string
+	}{
+		{
+			name:        "no flags",
+			args:        []string{},
+			wantsExport: nil,
+		},
+		{
+			name: "with json format",
+			args: []string{"--format", "json"},
+			wantsExport: &jsonExporter{
+				filter:   "",
+				template: "",
+			},
+		},
+		{
+			name: "with JSON fields",
+			args: []string{"--json", "number,title"},
+			wantsExport: &jsonExporter{
+				fields:         []string{"number", "title"},
+				filter:         "",
+				template:       "",
+				narrowTopLevel: true,
+			},
+		},
+		{
+			name:        "invalid JSON field",
+			args:        []string{"--json", "idontexist"},
+			wantsExport: nil,
+			wantsError:  "Unknown JSON field: \"idontexist\"\nAvailable fields:\n  id\n  number\n  title",
+		},
+		{
+			name:        "cannot combine --format with --json",
+			args:        []string{"--format", "json", "--json", "number"},
+			wantsExport: nil,
+			wantsError:  "cannot use `--format` with `--json`",
+		},
+		{
+			name:        "cannot combine --format with --web",
+			args:        []string{"--format", "json", "--web"},
+			wantsExport: nil,
+			wantsError:  "cannot use `--web` with `--format`",
+		},
+		{
+			name:        "cannot use --jq without --json",
+			args:        []string{"--jq", ".number"},
+			wantsExport: nil,
+			wantsError:  "cannot use `--jq` without specifying `--json`",
+		},
+		{
+			name: "with json format and jq filter",
+			args: []string{"--format", "json", "-q.number"},
+			wantsExport: &jsonExporter{
+				filter:   ".number",
+				template: "",
+			},
+		},
+		{
+			name: "with JSON fields and Go template",
+			args: []string{"--json", "number", "-t", "{{.number}}"},
+			wantsExport: &jsonExporter{
+				fields:         []string{"number"},
+				filter:         "",
+				template:       "{{.number}}",
+				narrowTopLevel: true,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := &cobra.Command{Run: func(*cobra.Command, []string) {}}
+			cmd.Flags().Bool("web", false, "")
+			var exporter Exporter
+			AddFormatAndJSONFlags(cmd, &exporter, []string{"id", "number", "title"})
+			cmd.SetArgs(tt.args)
+			cmd.SetOut(io.Discard)
+			cmd.SetErr(io.Discard)
+			_, err := cmd.ExecuteC()
+			if tt.wantsError == "" {
+				require.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.wantsError)
+				return
+			}
+			if tt.wantsExport == nil {
+				assert.Nil(t, exporter)
+			} else {
+				assert.Equal(t, tt.wantsExport, exporter)
+			}
+		})
+	}
+}
+
 func Test_exportFormat_Write(t *testing.T) {
 	type args struct {
 		data interface{}
@@ -26,6 +26,10 @@ type Extension interface {
 	IsBinary() bool
 	IsLocal() bool
 	Owner() string
+	// SupportsExporterFlags reports whether the extension declares that it
+	// handles --json itself, allowing gh to apply --jq and --template to its
+	// output like it does for core commands.
+	SupportsExporterFlags() bool
 }
 
 //go:generate moq -rm -out manager_mock.go . ExtensionManager
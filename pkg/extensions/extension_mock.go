@@ -41,6 +41,9 @@ var _ Extension = &ExtensionMock{}
 //			PathFunc: func() string {
 //				panic("mock out the Path method")
 //			},
+//			SupportsExporterFlagsFunc: func() bool {
+//				panic("mock out the SupportsExporterFlags method")
+//			},
 //			URLFunc: func() string {
 //				panic("mock out the URL method")
 //			},
@@ -78,6 +81,9 @@ type ExtensionMock struct {
 	// PathFunc mocks the Path method.
 	PathFunc func() string
 
+	// SupportsExporterFlagsFunc mocks the SupportsExporterFlags method.
+	SupportsExporterFlagsFunc func() bool
+
 	// URLFunc mocks the URL method.
 	URLFunc func() string
 
@@ -110,6 +116,9 @@ type ExtensionMock struct {
 		// Path holds details about calls to the Path method.
 		Path []struct {
 		}
+		// SupportsExporterFlags holds details about calls to the SupportsExporterFlags method.
+		SupportsExporterFlags []struct {
+		}
 		// URL holds details about calls to the URL method.
 		URL []struct {
 		}
@@ -117,16 +126,17 @@ type ExtensionMock struct {
 		UpdateAvailable []struct {
 		}
 	}
-	lockCurrentVersion  sync.RWMutex
-	lockIsBinary        sync.RWMutex
-	lockIsLocal         sync.RWMutex
-	lockIsPinned        sync.RWMutex
-	lockLatestVersion   sync.RWMutex
-	lockName            sync.RWMutex
-	lockOwner           sync.RWMutex
-	lockPath            sync.RWMutex
-	lockURL             sync.RWMutex
-	lockUpdateAvailable sync.RWMutex
+	lockCurrentVersion        sync.RWMutex
+	lockIsBinary              sync.RWMutex
+	lockIsLocal               sync.RWMutex
+	lockIsPinned              sync.RWMutex
+	lockLatestVersion         sync.RWMutex
+	lockName                  sync.RWMutex
+	lockOwner                 sync.RWMutex
+	lockPath                  sync.RWMutex
+	lockSupportsExporterFlags sync.RWMutex
+	lockURL                   sync.RWMutex
+	lockUpdateAvailable       sync.RWMutex
 }
 
 // CurrentVersion calls CurrentVersionFunc.
@@ -345,6 +355,33 @@ func (mock *ExtensionMock) PathCalls() []struct {
 	return calls
 }
 
+// SupportsExporterFlags calls SupportsExporterFlagsFunc.
+func (mock *ExtensionMock) SupportsExporterFlags() bool {
+	if mock.SupportsExporterFlagsFunc == nil {
+		panic("ExtensionMock.SupportsExporterFlagsFunc: method is nil but Extension.SupportsExporterFlags was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockSupportsExporterFlags.Lock()
+	mock.calls.SupportsExporterFlags = append(mock.calls.SupportsExporterFlags, callInfo)
+	mock.lockSupportsExporterFlags.Unlock()
+	return mock.SupportsExporterFlagsFunc()
+}
+
+// SupportsExporterFlagsCalls gets all the calls that were made to SupportsExporterFlags.
+// Check the length with:
+//
+//	len(mockedExtension.SupportsExporterFlagsCalls())
+func (mock *ExtensionMock) SupportsExporterFlagsCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockSupportsExporterFlags.RLock()
+	calls = mock.calls.SupportsExporterFlags
+	mock.lockSupportsExporterFlags.RUnlock()
+	return calls
+}
+
 // URL calls URLFunc.
 func (mock *ExtensionMock) URL() string {
 	if mock.URLFunc == nil {
@@ -73,6 +73,8 @@ type IOStreams struct {
 	colorOverride bool
 	colorEnabled  bool
 
+	hyperlinkPolicy string
+
 	pagerCommand string
 	pagerProcess *os.Process
 
@@ -134,6 +136,51 @@ func (s *IOStreams) SetColorEnabled(colorEnabled bool) {
 	s.colorEnabled = colorEnabled
 }
 
+// SetHyperlinksPolicy configures whether terminal hyperlinks are emitted:
+// "always", "never", or "auto" (the default, based on terminal capabilities).
+func (s *IOStreams) SetHyperlinksPolicy(policy string) {
+	s.hyperlinkPolicy = policy
+}
+
+// HyperlinksEnabled reports whether it is safe to emit OSC 8 hyperlink
+// sequences to stdout.
+func (s *IOStreams) HyperlinksEnabled() bool {
+	switch s.hyperlinkPolicy {
+	case "always":
+		return true
+	case "never":
+		return false
+	default:
+		return s.IsStdoutTTY() && s.ColorEnabled()
+	}
+}
+
+// Hyperlink wraps text in an OSC 8 hyperlink escape sequence pointing at url,
+// or returns the text unchanged when hyperlinks are not enabled.
+func (s *IOStreams) Hyperlink(text, url string) string {
+	if !s.HyperlinksEnabled() {
+		return text
+	}
+	return fmt.Sprintf("\x1b]8;;%s\x1b\\%s\x1b]8;;\x1b\\", url, text)
+}
+
+// EnvColorDisabled reports whether the environment requests that color output
+// be suppressed, per <https://no-color.org> and the CLICOLOR/FORCE_COLOR
+// conventions.
+func EnvColorDisabled() bool {
+	return os.Getenv("NO_COLOR") != "" || os.Getenv("CLICOLOR") == "0" || os.Getenv("FORCE_COLOR") == "0"
+}
+
+// EnvColorForced reports whether the environment requests color output even
+// when stdout is not a terminal.
+func EnvColorForced() bool {
+	if force := os.Getenv("CLICOLOR_FORCE"); force != "" && force != "0" {
+		return true
+	}
+	force := os.Getenv("FORCE_COLOR")
+	return force != "" && force != "0"
+}
+
 func (s *IOStreams) SetStdinTTY(isTTY bool) {
 	s.stdinTTYOverride = true
 	s.stdinIsTTY = isTTY
@@ -424,6 +471,15 @@ func System() *IOStreams {
 		term:         &terminal,
 	}
 
+	// go-gh resolves the CLICOLOR family of variables; FORCE_COLOR is also
+	// honored here so that the environment has a single, consistent effect on
+	// every command that emits color.
+	if EnvColorForced() {
+		io.SetColorEnabled(true)
+	} else if EnvColorDisabled() && io.ColorEnabled() {
+		io.SetColorEnabled(false)
+	}
+
 	stdoutIsTTY := io.IsStdoutTTY()
 	stderrIsTTY := io.IsStderrTTY()
 
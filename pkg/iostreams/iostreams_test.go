@@ -60,3 +60,91 @@ func TestHelperProcess(t *testing.T) {
 	}
 	os.Exit(0)
 }
+
+func TestHyperlink(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy string
+		tty    bool
+		want   string
+	}{
+		{
+			name:   "always",
+			policy: "always",
+			want:   "\x1b]8;;https://github.com\x1b\\GitHub\x1b]8;;\x1b\\",
+		},
+		{
+			name:   "never on a TTY",
+			policy: "never",
+			tty:    true,
+			want:   "GitHub",
+		},
+		{
+			name: "auto without a TTY",
+			want: "GitHub",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ios, _, _, _ := Test()
+			ios.SetStdoutTTY(tt.tty)
+			ios.SetColorEnabled(tt.tty)
+			ios.SetHyperlinksPolicy(tt.policy)
+
+			if got := ios.Hyperlink("GitHub", "https://github.com"); got != tt.want {
+				t.Errorf("Hyperlink() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEnvColor(t *testing.T) {
+	tests := []struct {
+		name         string
+		env          map[string]string
+		wantDisabled bool
+		wantForced   bool
+	}{
+		{
+			name: "no environment",
+		},
+		{
+			name:         "NO_COLOR",
+			env:          map[string]string{"NO_COLOR": "1"},
+			wantDisabled: true,
+		},
+		{
+			name:         "FORCE_COLOR=0",
+			env:          map[string]string{"FORCE_COLOR": "0"},
+			wantDisabled: true,
+		},
+		{
+			name:       "FORCE_COLOR=1",
+			env:        map[string]string{"FORCE_COLOR": "1"},
+			wantForced: true,
+		},
+		{
+			name:       "CLICOLOR_FORCE",
+			env:        map[string]string{"CLICOLOR_FORCE": "1"},
+			wantForced: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, key := range []string{"NO_COLOR", "CLICOLOR", "CLICOLOR_FORCE", "FORCE_COLOR"} {
+				t.Setenv(key, "")
+				os.Unsetenv(key)
+			}
+			for key, value := range tt.env {
+				t.Setenv(key, value)
+			}
+
+			if got := EnvColorDisabled(); got != tt.wantDisabled {
+				t.Errorf("EnvColorDisabled() = %v, want %v", got, tt.wantDisabled)
+			}
+			if got := EnvColorForced(); got != tt.wantForced {
+				t.Errorf("EnvColorForced() = %v, want %v", got, tt.wantForced)
+			}
+		})
+	}
+}